@@ -0,0 +1,116 @@
+package di
+
+import (
+	"context"
+	"time"
+
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	infradb "github.com/yashikota/scene-hunter/server/internal/infra/db"
+	"github.com/yashikota/scene-hunter/server/internal/infra/db/queries"
+	accountsvc "github.com/yashikota/scene-hunter/server/internal/service/account"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// accountServiceHandler implements Connect RPC AccountService interface with transaction support.
+type accountServiceHandler struct {
+	service  *accountsvc.Service
+	dbClient *infradb.Client
+}
+
+// newAccountServiceHandler creates a new account service handler.
+func newAccountServiceHandler(
+	service *accountsvc.Service,
+	dbClient *infradb.Client,
+) *accountServiceHandler {
+	return &accountServiceHandler{
+		service:  service,
+		dbClient: dbClient,
+	}
+}
+
+// DeleteAccount soft-deletes the user, removes identities and cleans up game data.
+func (s *accountServiceHandler) DeleteAccount(
+	ctx context.Context,
+	req *scene_hunterv1.DeleteAccountRequest,
+) (*scene_hunterv1.DeleteAccountResponse, error) {
+	preparedData, err := s.service.PrepareAccountDeletion(ctx, req)
+	if err != nil {
+		//nolint:wrapcheck // wrapper delegates to service, error wrapping done in service layer
+		return nil, err
+	}
+
+	// Game data is purged first so that the deletion can be retried while the account exists
+	resp, err := s.service.PurgeAccountData(ctx, preparedData)
+	if err != nil {
+		//nolint:wrapcheck // wrapper delegates to service
+		return nil, err
+	}
+
+	// Anonymous users have no database records
+	if preparedData.User != nil {
+		if err := s.executeDeleteTransaction(ctx, preparedData); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.service.CompleteAccountDeletion(ctx, preparedData)
+	if err != nil {
+		//nolint:wrapcheck // wrapper delegates to service
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ExportMyData exports the user's personal data as a ZIP archive.
+func (s *accountServiceHandler) ExportMyData(
+	ctx context.Context,
+	req *scene_hunterv1.ExportMyDataRequest,
+) (*scene_hunterv1.ExportMyDataResponse, error) {
+	//nolint:wrapcheck // wrapper delegates to service, error wrapping done in service layer
+	return s.service.ExportMyData(ctx, req)
+}
+
+// executeDeleteTransaction handles the database transaction for account deletion.
+func (s *accountServiceHandler) executeDeleteTransaction(
+	ctx context.Context,
+	preparedData *accountsvc.PreparedDeletionData,
+) error {
+	dbTx, err := s.dbClient.Begin(ctx)
+	if err != nil {
+		return errors.Errorf("failed to start transaction: %w", err)
+	}
+
+	committed := false
+
+	var txErr error
+
+	defer func() {
+		if !committed && txErr != nil {
+			_ = dbTx.Rollback(ctx)
+		}
+	}()
+
+	qtx := s.dbClient.Queries.WithTx(dbTx)
+
+	txErr = qtx.DeleteUserIdentitiesByUserID(ctx, preparedData.User.ID)
+	if txErr != nil {
+		return errors.Errorf("failed to delete identities: %w", txErr)
+	}
+
+	txErr = qtx.DeleteUser(ctx, queries.DeleteUserParams{
+		ID:        preparedData.User.ID,
+		DeletedAt: time.Now(),
+	})
+	if txErr != nil {
+		return errors.Errorf("failed to delete user: %w", txErr)
+	}
+
+	if txErr = dbTx.Commit(ctx); txErr != nil {
+		return errors.Errorf("failed to commit transaction: %w", txErr)
+	}
+
+	committed = true
+
+	return nil
+}
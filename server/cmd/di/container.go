@@ -5,17 +5,32 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 
+	"connectrpc.com/connect"
 	"github.com/go-chi/chi/v5"
 	"github.com/yashikota/scene-hunter/server/internal/config"
+	domainauth "github.com/yashikota/scene-hunter/server/internal/domain/auth"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	authhandler "github.com/yashikota/scene-hunter/server/internal/handler/auth"
 	infrablob "github.com/yashikota/scene-hunter/server/internal/infra/blob"
 	infradb "github.com/yashikota/scene-hunter/server/internal/infra/db"
 	infragemini "github.com/yashikota/scene-hunter/server/internal/infra/gemini"
 	infrakvs "github.com/yashikota/scene-hunter/server/internal/infra/kvs"
+	infraopenai "github.com/yashikota/scene-hunter/server/internal/infra/openai"
+	infraotel "github.com/yashikota/scene-hunter/server/internal/infra/otel"
+	infravision "github.com/yashikota/scene-hunter/server/internal/infra/vision"
 	"github.com/yashikota/scene-hunter/server/internal/repository"
 	"github.com/yashikota/scene-hunter/server/internal/service"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	visionsvc "github.com/yashikota/scene-hunter/server/internal/service/vision"
 	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/dig"
 )
 
@@ -32,6 +47,9 @@ func New(ctx context.Context, cfg *config.AppConfig, logger *slog.Logger) *Conta
 	_ = container.Provide(func() *config.AppConfig { return cfg })
 	_ = container.Provide(func() *slog.Logger { return logger })
 	_ = container.Provide(chrono.New)
+	_ = container.Provide(newTokenSigner)
+	_ = container.Provide(newCatalogOptions)
+	_ = container.Provide(newHintFilter)
 
 	// Provide infra clients
 	provideInfraClients(container, ctx, cfg, logger)
@@ -96,6 +114,7 @@ func provideInfraClients(
 
 		client, err := infrablob.NewClient(
 			cfg.Blob.URL,
+			cfg.Blob.PublicURL,
 			blobAccessKey,
 			blobSecretKey,
 			blobBucketName,
@@ -118,18 +137,46 @@ func provideInfraClients(
 		return client, nil
 	})
 
-	// Gemini Client
-	_ = container.Provide(func() (service.Gemini, error) {
-		client, err := infragemini.NewClient(ctx, cfg.Gemini.APIKey, cfg.Gemini.Model)
+	// Vision Model
+	_ = container.Provide(func(chronoProvider chrono.Chrono) (*visionsvc.Resilient, error) {
+		model, err := newVisionModel(ctx, cfg)
 		if err != nil {
-			logger.Error("failed to initialize Gemini client", "error", err)
+			logger.Error("failed to initialize vision model", "error", err)
 
-			return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+			return nil, fmt.Errorf("failed to create vision model: %w", err)
 		}
 
-		logger.Info("Gemini client initialized successfully")
+		logger.Info("vision model initialized successfully", "provider", cfg.Vision.Provider)
 
-		return client, nil
+		// タイムアウト・再試行・サーキットブレーカーで包み、状態をStatusServiceに公開する
+		return visionsvc.NewResilient(model, newResilienceOptions(cfg), chronoProvider), nil
+	})
+
+	// Vision Metrics
+	_ = container.Provide(func() (service.VisionMetrics, error) {
+		// infraotel.Initで設定したMeterProviderに記録する。無効な場合は何も記録しない
+		metrics, err := infraotel.NewVisionMetrics(otel.GetMeterProvider())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create vision metrics: %w", err)
+		}
+
+		return metrics, nil
+	})
+
+	// 同じ写真の解析結果を再利用し、トークンの予算内で呼び出す
+	_ = container.Provide(func(
+		resilient *visionsvc.Resilient,
+		kvsClient service.KVS,
+		metrics service.VisionMetrics,
+		chronoProvider chrono.Chrono,
+	) service.VisionModel {
+		return visionsvc.NewAccounting(
+			resilient,
+			kvsClient,
+			metrics,
+			newAccountingOptions(cfg),
+			chronoProvider,
+		)
 	})
 }
 
@@ -140,11 +187,211 @@ func provideRepositories(container *dig.Container) {
 	// Room Repository
 	_ = container.Provide(repository.NewRoomRepository)
 
+	// Vote Repository
+	_ = container.Provide(repository.NewVoteRepository)
+
 	// Anon Repository
 	_ = container.Provide(repository.NewAnonRepository)
 
 	// Identity Repository
 	_ = container.Provide(repository.NewIdentityRepository)
+
+	// User Repository
+	_ = container.Provide(repository.NewUserRepository)
+
+	// Image catalog
+	_ = container.Provide(repository.NewImageRepository)
+	_ = container.Provide(repository.NewUploadRepository)
+	_ = container.Provide(imagesvc.NewCatalog)
+
+	// Hint job queue
+	_ = container.Provide(newHintJobQueue)
+
+	// Game service, shared by the handlers and the hint workers
+	_ = container.Provide(newGameService)
+}
+
+// newTokenSigner creates the access token signer from the configured HMAC keyring and JWT key.
+func newTokenSigner(cfg *config.AppConfig) (*domainauth.TokenSigner, error) {
+	signingKeyID, keys, err := cfg.Auth.HMACKeyring()
+	if err != nil {
+		return nil, errors.Errorf("failed to load HMAC keys: %w", err)
+	}
+
+	keyring, err := domainauth.NewKeyring(signingKeyID, keys)
+	if err != nil {
+		return nil, errors.Errorf("failed to create HMAC keyring: %w", err)
+	}
+
+	switch cfg.Auth.TokenFormat {
+	case config.TokenFormatHMAC, config.TokenFormatJWT:
+	default:
+		return nil, errors.Errorf("unknown auth.token_format: %q", cfg.Auth.TokenFormat)
+	}
+
+	if cfg.Auth.JWTPrivateKey == "" {
+		if cfg.Auth.TokenFormat == config.TokenFormatJWT {
+			return nil, errors.New("auth.jwt_private_key is required for JWT access tokens")
+		}
+
+		return domainauth.NewTokenSigner(keyring), nil
+	}
+
+	jwtSigner, err := domainauth.NewJWTSigner(
+		[]byte(cfg.Auth.JWTPrivateKey),
+		cfg.Auth.JWTKeyID,
+		cfg.Auth.JWTIssuer,
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to create JWT signer: %w", err)
+	}
+
+	issueJWT := cfg.Auth.TokenFormat == config.TokenFormatJWT
+
+	return domainauth.NewTokenSignerWithJWT(keyring, jwtSigner, issueJWT), nil
+}
+
+// newVisionModel creates the vision model of the configured AI provider.
+func newVisionModel(ctx context.Context, cfg *config.AppConfig) (service.VisionModel, error) {
+	var (
+		model service.VisionModel
+		err   error
+	)
+
+	switch cfg.Vision.Provider {
+	case config.VisionProviderGemini:
+		model, err = infragemini.NewClient(ctx, cfg.Gemini.APIKey, cfg.Gemini.Model)
+	case config.VisionProviderOpenAI:
+		model, err = infraopenai.NewClient(cfg.OpenAI.BaseURL, cfg.OpenAI.APIKey, cfg.OpenAI.Model)
+	case config.VisionProviderFake:
+		model = infravision.NewFake()
+	default:
+		return nil, errors.Errorf("unknown vision.provider: %q", cfg.Vision.Provider)
+	}
+
+	if err != nil {
+		return nil, errors.Errorf("failed to create %s client: %w", cfg.Vision.Provider, err)
+	}
+
+	return model, nil
+}
+
+// newAccountingOptions creates the options of the cache and budgets of the vision model.
+func newAccountingOptions(cfg *config.AppConfig) visionsvc.AccountingOptions {
+	return visionsvc.AccountingOptions{
+		CacheTTL:         cfg.Vision.CacheTTL,
+		RoomTokenBudget:  cfg.Vision.RoomTokenBudget,
+		DailyTokenBudget: cfg.Vision.DailyTokenBudget,
+	}
+}
+
+// newResilienceOptions creates the options of the calls to the vision model.
+func newResilienceOptions(cfg *config.AppConfig) visionsvc.ResilienceOptions {
+	return visionsvc.ResilienceOptions{
+		Timeout:          cfg.Vision.Timeout,
+		MaxRetries:       cfg.Vision.MaxRetries,
+		RetryBaseDelay:   cfg.Vision.RetryBaseDelay,
+		FailureThreshold: cfg.Vision.FailureThreshold,
+		OpenDuration:     cfg.Vision.OpenDuration,
+	}
+}
+
+// newCatalogOptions creates the options of the image catalog.
+func newCatalogOptions(cfg *config.AppConfig) imagesvc.CatalogOptions {
+	return imagesvc.CatalogOptions{
+		Normalize: domainimage.NormalizeOptions{
+			MaxDimension: cfg.Image.MaxDimension,
+			Quality:      cfg.Image.Quality,
+			ThumbnailDimensions: domainimage.ThumbnailDimensions{
+				Small:  cfg.Image.ThumbnailSmallDimension,
+				Medium: cfg.Image.ThumbnailMediumDimension,
+				Large:  cfg.Image.ThumbnailLargeDimension,
+			},
+			ThumbnailQuality: cfg.Image.ThumbnailQuality,
+			MaxPixels:        cfg.Image.MaxPixels,
+		},
+		PresignedURLTTL:  cfg.Image.PresignedURLTTL,
+		ThumbnailWorkers: cfg.Image.ThumbnailWorkers,
+	}
+}
+
+// newHintFilter creates the content filter applied to hints before they are released.
+func newHintFilter(cfg *config.AppConfig) (*hint.Filter, error) {
+	filter, err := hint.NewFilter(cfg.Hint.BannedWords, cfg.Hint.PIIPatterns)
+	if err != nil {
+		return nil, errors.Errorf("failed to create hint filter: %w", err)
+	}
+
+	return filter, nil
+}
+
+// newHintJobQueue creates the queue of background hint generation jobs.
+func newHintJobQueue(cfg *config.AppConfig, kvsClient service.KVS) service.HintJobQueue {
+	return repository.NewHintJobQueue(kvsClient, cfg.Hint.JobClaimAfter)
+}
+
+// newGameService creates the game service.
+func newGameService(
+	cfg *config.AppConfig,
+	gameRepo service.GameRepository,
+	roomRepo service.RoomRepository,
+	voteRepo service.VoteRepository,
+	blobClient service.Blob,
+	visionModel service.VisionModel,
+	imageCatalog *imagesvc.Catalog,
+	hintFilter *hint.Filter,
+	hintJobs service.HintJobQueue,
+	chronoProvider chrono.Chrono,
+) *gamesvc.Service {
+	return gamesvc.NewService(
+		gameRepo,
+		roomRepo,
+		voteRepo,
+		blobClient,
+		visionModel,
+		imageCatalog,
+		hintFilter,
+		hintJobs,
+		cfg.Hint.JobAttempts,
+		chronoProvider,
+	)
+}
+
+// StartHintWorkers starts the workers that generate hints in the background until ctx is canceled.
+// Hints are not generated if the game service is unavailable.
+func (c *Container) StartHintWorkers(ctx context.Context) {
+	var logger *slog.Logger
+
+	c.MustInvoke(func(l *slog.Logger) {
+		logger = l
+	})
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "server"
+	}
+
+	if err := c.container.Invoke(func(cfg *config.AppConfig, gameSvc *gamesvc.Service) {
+		for index := range cfg.Hint.Workers {
+			// ワーカー名はサーバー間で重複しないようにホスト名を含める
+			go gameSvc.RunHintWorker(ctx, fmt.Sprintf("%s-%d", hostname, index))
+		}
+
+		logger.Info("hint workers started", "workers", cfg.Hint.Workers)
+	}); err != nil {
+		logger.Warn("failed to start hint workers", "error", err)
+	}
+}
+
+// JWKSHandler returns the handler publishing the public keys for JWT access tokens.
+func (c *Container) JWKSHandler() http.Handler {
+	var handler http.Handler
+
+	c.MustInvoke(func(tokenSigner *domainauth.TokenSigner) {
+		handler = authhandler.NewJWKSHandler(tokenSigner)
+	})
+
+	return handler
 }
 
 // Invoke runs a function with dependencies injected.
@@ -191,29 +438,36 @@ func (c *Container) RegisterHandlers(mux *chi.Mux) {
 
 	var chronoProvider chrono.Chrono
 
-	c.MustInvoke(func(l *slog.Logger, cp chrono.Chrono) {
+	var tokenSigner *domainauth.TokenSigner
+
+	c.MustInvoke(func(l *slog.Logger, cp chrono.Chrono, ts *domainauth.TokenSigner) {
 		logger = l
 		chronoProvider = cp
-		registerHealthService(mux, chronoProvider)
+		tokenSigner = ts
 	})
 
+	interceptors := c.newInterceptorsWithFallback(logger, tokenSigner)
+
+	registerHealthService(mux, interceptors, chronoProvider)
+
 	// StatusService should always be registered for health monitoring
 	// Even if some dependencies fail, we want to report their status
-	c.registerStatusServiceWithFallback(mux, logger, chronoProvider)
+	c.registerStatusServiceWithFallback(mux, interceptors, logger, chronoProvider)
 
 	// Register optional services with error logging
 	if err := c.container.Invoke(func(
 		kvsClient service.KVS,
-		blobClient service.Blob,
 		roomRepo service.RoomRepository,
+		gameRepo service.GameRepository,
+		imageCatalog *imagesvc.Catalog,
 	) {
-		registerImageService(mux, kvsClient, blobClient, roomRepo)
+		registerImageService(mux, interceptors, kvsClient, roomRepo, gameRepo, imageCatalog)
 	}); err != nil {
 		logger.Warn("failed to register ImageService", "error", err)
 	}
 
 	if err := c.container.Invoke(func(roomRepo service.RoomRepository) {
-		registerRoomService(mux, roomRepo)
+		registerRoomService(mux, interceptors, roomRepo)
 	}); err != nil {
 		logger.Warn("failed to register RoomService", "error", err)
 	}
@@ -224,26 +478,90 @@ func (c *Container) RegisterHandlers(mux *chi.Mux) {
 		anonRepo service.AnonRepository,
 		identityRepo service.IdentityRepository,
 	) {
-		registerAuthService(mux, cfg, dbClient, anonRepo, identityRepo)
+		registerAuthService(
+			mux,
+			interceptors,
+			cfg,
+			dbClient,
+			anonRepo,
+			identityRepo,
+			tokenSigner,
+		)
 	}); err != nil {
 		logger.Warn("failed to register AuthService", "error", err)
 	}
 
 	if err := c.container.Invoke(func(
-		gameRepo service.GameRepository,
+		gameSvc *gamesvc.Service,
 		roomRepo service.RoomRepository,
-		blobClient service.Blob,
-		geminiClient service.Gemini,
 	) {
-		registerGameService(mux, gameRepo, roomRepo, blobClient, geminiClient)
+		registerGameService(mux, interceptors, gameSvc, roomRepo)
 	}); err != nil {
 		logger.Warn("failed to register GameService", "error", err)
 	}
+
+	if err := c.container.Invoke(func(
+		cfg *config.AppConfig,
+		dbClient *infradb.Client,
+		userRepo service.UserRepository,
+		identityRepo service.IdentityRepository,
+		anonRepo service.AnonRepository,
+		gameRepo service.GameRepository,
+		imageCatalog *imagesvc.Catalog,
+	) {
+		registerAccountService(
+			mux,
+			interceptors,
+			cfg,
+			dbClient,
+			userRepo,
+			identityRepo,
+			anonRepo,
+			gameRepo,
+			imageCatalog,
+		)
+	}); err != nil {
+		logger.Warn("failed to register AccountService", "error", err)
+	}
+}
+
+// newInterceptorsWithFallback builds the interceptors shared by all services.
+// Token revocation checks and rate limiting are disabled if the KVS is unavailable.
+func (c *Container) newInterceptorsWithFallback(
+	logger *slog.Logger,
+	tokenSigner *domainauth.TokenSigner,
+) connect.Option {
+	var revocations middleware.RevocationChecker
+
+	if err := c.container.Invoke(func(anonRepo service.AnonRepository) {
+		revocations = anonRepo
+	}); err != nil {
+		logger.Warn("anon repository unavailable, token revocation is disabled", "error", err)
+	}
+
+	var rateLimiter connect.Interceptor
+
+	if err := c.container.Invoke(func(
+		cfg *config.AppConfig,
+		kvsClient service.KVS,
+		chronoProvider chrono.Chrono,
+	) error {
+		var err error
+
+		rateLimiter, err = newRateLimitInterceptor(cfg, kvsClient, chronoProvider, logger)
+
+		return err
+	}); err != nil {
+		logger.Warn("rate limiter unavailable, rate limiting is disabled", "error", err)
+	}
+
+	return newInterceptors(tokenSigner, revocations, rateLimiter)
 }
 
 // registerStatusServiceWithFallback registers StatusService even if some dependencies are unavailable.
 func (c *Container) registerStatusServiceWithFallback(
 	mux *chi.Mux,
+	interceptors connect.Option,
 	logger *slog.Logger,
 	chronoProvider chrono.Chrono,
 ) {
@@ -254,6 +572,8 @@ func (c *Container) registerStatusServiceWithFallback(
 
 	var blobClient service.Blob
 
+	var visionModel service.VisionModel
+
 	// Try to resolve each dependency individually
 	if err := c.container.Invoke(func(db *infradb.Client) {
 		dbClient = db
@@ -273,6 +593,20 @@ func (c *Container) registerStatusServiceWithFallback(
 		logger.Warn("Blob client unavailable for StatusService", "error", err)
 	}
 
+	if err := c.container.Invoke(func(model *visionsvc.Resilient) {
+		visionModel = model
+	}); err != nil {
+		logger.Warn("Vision model unavailable for StatusService", "error", err)
+	}
+
 	// Always register StatusService with whatever dependencies are available
-	registerStatusService(mux, chronoProvider, dbClient, kvsClient, blobClient)
+	registerStatusService(
+		mux,
+		interceptors,
+		chronoProvider,
+		dbClient,
+		kvsClient,
+		blobClient,
+		visionModel,
+	)
 }
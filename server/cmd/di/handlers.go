@@ -8,30 +8,82 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1/scene_hunterv1connect"
 	"github.com/yashikota/scene-hunter/server/internal/config"
+	domainauth "github.com/yashikota/scene-hunter/server/internal/domain/auth"
 	gamehandler "github.com/yashikota/scene-hunter/server/internal/handler/game"
 	infradb "github.com/yashikota/scene-hunter/server/internal/infra/db"
 	"github.com/yashikota/scene-hunter/server/internal/service"
+	accountsvc "github.com/yashikota/scene-hunter/server/internal/service/account"
 	authsvc "github.com/yashikota/scene-hunter/server/internal/service/auth"
 	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
 	healthsvc "github.com/yashikota/scene-hunter/server/internal/service/health"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
 	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
 	roomsvc "github.com/yashikota/scene-hunter/server/internal/service/room"
 	"github.com/yashikota/scene-hunter/server/internal/service/status"
 	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
-func newInterceptors() connect.Option {
+func newInterceptors(
+	tokenSigner *domainauth.TokenSigner,
+	revocations middleware.RevocationChecker,
+	rateLimiter connect.Interceptor,
+) connect.Option {
 	logger := slog.Default()
 
-	return connect.WithInterceptors(
+	interceptors := []connect.Interceptor{
 		validate.NewInterceptor(),
 		newErrorLoggingInterceptor(logger),
-		middleware.AuthInterceptor(),
-	)
+		middleware.AuthInterceptor(tokenSigner, revocations),
+	}
+
+	// Rate limiting runs after authentication so that requests are keyed by principal
+	if rateLimiter != nil {
+		interceptors = append(interceptors, rateLimiter)
+	}
+
+	return connect.WithInterceptors(interceptors...)
+}
+
+// newRateLimitInterceptor builds the rate limit interceptor from the configured rules.
+// It returns nil if rate limiting is disabled.
+func newRateLimitInterceptor(
+	cfg *config.AppConfig,
+	kvsClient service.KVS,
+	chronoProvider chrono.Chrono,
+	logger *slog.Logger,
+) (connect.Interceptor, error) {
+	if !cfg.RateLimit.Enabled {
+		return nil, nil //nolint:nilnil // a nil interceptor disables rate limiting
+	}
+
+	trustedProxies, err := cfg.RateLimit.TrustedProxyPrefixes()
+	if err != nil {
+		return nil, errors.Errorf("failed to load trusted proxies: %w", err)
+	}
+
+	limits := make(map[string]middleware.RateLimit, len(cfg.RateLimit.Rules))
+	for _, rule := range cfg.RateLimit.Rules {
+		limits[rule.Procedure] = middleware.RateLimit{
+			Limit:  rule.Limit,
+			Window: rule.Window,
+		}
+	}
+
+	return middleware.RateLimitInterceptor(
+		kvsClient,
+		chronoProvider,
+		logger,
+		limits,
+		trustedProxies,
+	), nil
 }
 
-func registerHealthService(mux *chi.Mux, chronoProvider chrono.Chrono) {
-	interceptors := newInterceptors()
+func registerHealthService(
+	mux *chi.Mux,
+	interceptors connect.Option,
+	chronoProvider chrono.Chrono,
+) {
 	healthService := healthsvc.NewService(chronoProvider)
 	healthPath, healthHandler := scene_hunterv1connect.NewHealthServiceHandler(
 		healthService,
@@ -42,13 +94,14 @@ func registerHealthService(mux *chi.Mux, chronoProvider chrono.Chrono) {
 
 func registerStatusService(
 	mux *chi.Mux,
+	interceptors connect.Option,
 	chronoProvider chrono.Chrono,
 	dbClient *infradb.Client,
 	kvsClient service.KVS,
 	blobClient service.Blob,
+	visionModel service.VisionModel,
 ) {
-	interceptors := newInterceptors()
-	checkers := buildHealthCheckers(dbClient, kvsClient, blobClient)
+	checkers := buildHealthCheckers(dbClient, kvsClient, blobClient, visionModel)
 	statusService := status.NewService(checkers, chronoProvider)
 	statusPath, statusHandler := scene_hunterv1connect.NewStatusServiceHandler(
 		statusService,
@@ -61,6 +114,7 @@ func buildHealthCheckers(
 	dbClient *infradb.Client,
 	kvsClient service.KVS,
 	blobClient service.Blob,
+	visionModel service.VisionModel,
 ) []status.Checker {
 	checkers := []status.Checker{}
 
@@ -80,17 +134,25 @@ func buildHealthCheckers(
 		}
 	}
 
+	if visionModel != nil {
+		if checker, ok := visionModel.(status.Checker); ok {
+			checkers = append(checkers, checker)
+		}
+	}
+
 	return checkers
 }
 
 func registerImageService(
 	mux *chi.Mux,
+	interceptors connect.Option,
 	kvsClient service.KVS,
-	blobClient service.Blob,
 	roomRepo service.RoomRepository,
+	gameRepo service.GameRepository,
+	imageCatalog *imagesvc.Catalog,
 ) {
-	interceptors := newInterceptors()
-	imageService := newImageServiceHandler(blobClient, kvsClient, roomRepo)
+	imageSvc := imagesvc.NewService(imageCatalog, kvsClient, roomRepo, gameRepo)
+	imageService := newImageServiceHandler(imageSvc)
 	imagePath, imageHandler := scene_hunterv1connect.NewImageServiceHandler(
 		imageService,
 		interceptors,
@@ -98,8 +160,11 @@ func registerImageService(
 	mux.Mount(imagePath, imageHandler)
 }
 
-func registerRoomService(mux *chi.Mux, roomRepo service.RoomRepository) {
-	interceptors := newInterceptors()
+func registerRoomService(
+	mux *chi.Mux,
+	interceptors connect.Option,
+	roomRepo service.RoomRepository,
+) {
 	roomService := roomsvc.NewService(roomRepo)
 	roomPath, roomHandler := scene_hunterv1connect.NewRoomServiceHandler(
 		roomService,
@@ -110,13 +175,14 @@ func registerRoomService(mux *chi.Mux, roomRepo service.RoomRepository) {
 
 func registerAuthService(
 	mux *chi.Mux,
+	interceptors connect.Option,
 	cfg *config.AppConfig,
 	dbClient *infradb.Client,
 	anonRepo service.AnonRepository,
 	identityRepo service.IdentityRepository,
+	tokenSigner *domainauth.TokenSigner,
 ) {
-	interceptors := newInterceptors()
-	authSvc := authsvc.NewService(anonRepo, identityRepo, cfg)
+	authSvc := authsvc.NewService(anonRepo, identityRepo, tokenSigner, cfg)
 	authService := newAuthServiceHandler(authSvc, dbClient)
 	authPath, authHandler := scene_hunterv1connect.NewAuthServiceHandler(
 		authService,
@@ -127,13 +193,10 @@ func registerAuthService(
 
 func registerGameService(
 	mux *chi.Mux,
-	gameRepo service.GameRepository,
+	interceptors connect.Option,
+	gameSvc *gamesvc.Service,
 	roomRepo service.RoomRepository,
-	blobClient service.Blob,
-	geminiClient service.Gemini,
 ) {
-	interceptors := newInterceptors()
-	gameSvc := gamesvc.NewService(gameRepo, roomRepo, blobClient, geminiClient)
 	gameService := gamehandler.NewHandler(gameSvc, roomRepo)
 	gamePath, gameHandler := scene_hunterv1connect.NewGameServiceHandler(
 		gameService,
@@ -141,3 +204,30 @@ func registerGameService(
 	)
 	mux.Mount(gamePath, gameHandler)
 }
+
+func registerAccountService(
+	mux *chi.Mux,
+	interceptors connect.Option,
+	cfg *config.AppConfig,
+	dbClient *infradb.Client,
+	userRepo service.UserRepository,
+	identityRepo service.IdentityRepository,
+	anonRepo service.AnonRepository,
+	gameRepo service.GameRepository,
+	imageCatalog *imagesvc.Catalog,
+) {
+	accountSvc := accountsvc.NewService(
+		userRepo,
+		identityRepo,
+		anonRepo,
+		gameRepo,
+		imageCatalog,
+		cfg,
+	)
+	accountService := newAccountServiceHandler(accountSvc, dbClient)
+	accountPath, accountHandler := scene_hunterv1connect.NewAccountServiceHandler(
+		accountService,
+		interceptors,
+	)
+	mux.Mount(accountPath, accountHandler)
+}
@@ -6,7 +6,7 @@ import (
 	"connectrpc.com/connect"
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
 	imagehandler "github.com/yashikota/scene-hunter/server/internal/handler/image"
-	"github.com/yashikota/scene-hunter/server/internal/service"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
 )
 
 // imageServiceHandler implements Connect RPC ImageService interface with handler support.
@@ -15,13 +15,9 @@ type imageServiceHandler struct {
 }
 
 // newImageServiceHandler creates a new image service with handler support.
-func newImageServiceHandler(
-	blobClient service.Blob,
-	kvsClient service.KVS,
-	roomRepo service.RoomRepository,
-) *imageServiceHandler {
+func newImageServiceHandler(imageSvc *imagesvc.Service) *imageServiceHandler {
 	return &imageServiceHandler{
-		handler: imagehandler.NewImageHandler(blobClient, kvsClient, roomRepo),
+		handler: imagehandler.NewImageHandler(imageSvc),
 	}
 }
 
@@ -67,7 +63,7 @@ func (s *imageServiceHandler) ListImages(
 	return resp.Msg, nil
 }
 
-// ListImageThumbnails lists all images in a room as thumbnails.
+// ListImageThumbnails lists a page of thumbnail URLs of the images in a room.
 func (s *imageServiceHandler) ListImageThumbnails(
 	ctx context.Context,
 	req *scene_hunterv1.ListImageThumbnailsRequest,
@@ -80,3 +76,83 @@ func (s *imageServiceHandler) ListImageThumbnails(
 
 	return resp.Msg, nil
 }
+
+// StreamImageThumbnails streams the thumbnails of the images in a room.
+func (s *imageServiceHandler) StreamImageThumbnails(
+	ctx context.Context,
+	req *scene_hunterv1.StreamImageThumbnailsRequest,
+	stream *connect.ServerStream[scene_hunterv1.StreamImageThumbnailsResponse],
+) error {
+	//nolint:wrapcheck // service delegates to handler, error wrapping done in handler layer
+	return s.handler.StreamImageThumbnails(ctx, connect.NewRequest(req), stream)
+}
+
+// CreateUploadURL returns a presigned URL to upload an image directly to blob storage.
+func (s *imageServiceHandler) CreateUploadURL(
+	ctx context.Context,
+	req *scene_hunterv1.CreateUploadURLRequest,
+) (*scene_hunterv1.CreateUploadURLResponse, error) {
+	resp, err := s.handler.CreateUploadURL(ctx, connect.NewRequest(req))
+	if err != nil {
+		//nolint:wrapcheck // service delegates to handler, error wrapping done in handler layer
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// CompleteUpload validates an image uploaded to a presigned URL and stores it.
+func (s *imageServiceHandler) CompleteUpload(
+	ctx context.Context,
+	req *scene_hunterv1.CompleteUploadRequest,
+) (*scene_hunterv1.CompleteUploadResponse, error) {
+	resp, err := s.handler.CompleteUpload(ctx, connect.NewRequest(req))
+	if err != nil {
+		//nolint:wrapcheck // service delegates to handler, error wrapping done in handler layer
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// CreateDownloadURL returns a presigned URL to download an image directly from blob storage.
+func (s *imageServiceHandler) CreateDownloadURL(
+	ctx context.Context,
+	req *scene_hunterv1.CreateDownloadURLRequest,
+) (*scene_hunterv1.CreateDownloadURLResponse, error) {
+	resp, err := s.handler.CreateDownloadURL(ctx, connect.NewRequest(req))
+	if err != nil {
+		//nolint:wrapcheck // service delegates to handler, error wrapping done in handler layer
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// UploadPhotoStream receives an image in chunks and stores it as an upload.
+func (s *imageServiceHandler) UploadPhotoStream(
+	ctx context.Context,
+	stream *connect.ClientStream[scene_hunterv1.UploadPhotoStreamRequest],
+) (*scene_hunterv1.UploadPhotoStreamResponse, error) {
+	resp, err := s.handler.UploadPhotoStream(ctx, stream)
+	if err != nil {
+		//nolint:wrapcheck // service delegates to handler, error wrapping done in handler layer
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
+
+// GetUploadStatus returns how many bytes of a chunked upload have been received.
+func (s *imageServiceHandler) GetUploadStatus(
+	ctx context.Context,
+	req *scene_hunterv1.GetUploadStatusRequest,
+) (*scene_hunterv1.GetUploadStatusResponse, error) {
+	resp, err := s.handler.GetUploadStatus(ctx, connect.NewRequest(req))
+	if err != nil {
+		//nolint:wrapcheck // service delegates to handler, error wrapping done in handler layer
+		return nil, err
+	}
+
+	return resp.Msg, nil
+}
@@ -89,9 +89,18 @@ func main() {
 		WithRequestID:    true,
 	}))
 
+	// Publish public keys for verifying JWT access tokens
+	mux.Method(http.MethodGet, "/.well-known/jwks.json", container.JWKSHandler())
+
 	// Register handlers
 	container.RegisterHandlers(mux)
 
+	// Start background hint generation
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+
+	container.StartHintWorkers(workerCtx)
+
 	// Start server
 	server := &http.Server{
 		Addr:         cfg.Server.Port,
@@ -28,3 +28,7 @@ WHERE id = $1;
 SELECT * FROM user_identities
 WHERE id = $1
 LIMIT 1;
+
+-- name: DeleteUserIdentitiesByUserID :exec
+DELETE FROM user_identities
+WHERE user_id = $1;
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: scene_hunter/v1/account.proto
+
+package scene_hunterv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeleteAccountRequest requests deletion of the authenticated user's account.
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DeleteAccountResponse reports what was removed or anonymized.
+type DeleteAccountResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AnonymizedGames uint32                 `protobuf:"varint,1,opt,name=anonymized_games,json=anonymizedGames,proto3" json:"anonymized_games,omitempty"` // Games in which the player was anonymized
+	DeletedImages   uint32                 `protobuf:"varint,2,opt,name=deleted_images,json=deletedImages,proto3" json:"deleted_images,omitempty"`       // Photos removed from blob storage
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeleteAccountResponse) GetAnonymizedGames() uint32 {
+	if x != nil {
+		return x.AnonymizedGames
+	}
+	return 0
+}
+
+func (x *DeleteAccountResponse) GetDeletedImages() uint32 {
+	if x != nil {
+		return x.DeletedImages
+	}
+	return 0
+}
+
+// ExportMyDataRequest requests an export of the authenticated user's personal data.
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportMyDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ExportMyDataResponse returns a ZIP archive with the user's personal data.
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"` // ZIP archive (profile, identities, match history and photos)
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportMyDataResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportMyDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+var File_scene_hunter_v1_account_proto protoreflect.FileDescriptor
+
+const file_scene_hunter_v1_account_proto_rawDesc = "" +
+	"\n" +
+	"\x1dscene_hunter/v1/account.proto\x12\x0fscene_hunter.v1\x1a\x1bbuf/validate/validate.proto\"9\n" +
+	"\x14DeleteAccountRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"i\n" +
+	"\x15DeleteAccountResponse\x12)\n" +
+	"\x10anonymized_games\x18\x01 \x01(\rR\x0fanonymizedGames\x12%\n" +
+	"\x0edeleted_images\x18\x02 \x01(\rR\rdeletedImages\"8\n" +
+	"\x13ExportMyDataRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"o\n" +
+	"\x14ExportMyDataResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType2\xcd\x01\n" +
+	"\x0eAccountService\x12^\n" +
+	"\rDeleteAccount\x12%.scene_hunter.v1.DeleteAccountRequest\x1a&.scene_hunter.v1.DeleteAccountResponse\x12[\n" +
+	"\fExportMyData\x12$.scene_hunter.v1.ExportMyDataRequest\x1a%.scene_hunter.v1.ExportMyDataResponseB\xc9\x01\n" +
+	"\x13com.scene_hunter.v1B\fAccountProtoP\x01ZKgithub.com/yashikota/scene-hunter/server/gen/scene_hunter/v1;scene_hunterv1\xa2\x02\x03SXX\xaa\x02\x0eSceneHunter.V1\xca\x02\x0eSceneHunter\\V1\xe2\x02\x1aSceneHunter\\V1\\GPBMetadata\xea\x02\x0fSceneHunter::V1b\x06proto3"
+
+var (
+	file_scene_hunter_v1_account_proto_rawDescOnce sync.Once
+	file_scene_hunter_v1_account_proto_rawDescData []byte
+)
+
+func file_scene_hunter_v1_account_proto_rawDescGZIP() []byte {
+	file_scene_hunter_v1_account_proto_rawDescOnce.Do(func() {
+		file_scene_hunter_v1_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scene_hunter_v1_account_proto_rawDesc), len(file_scene_hunter_v1_account_proto_rawDesc)))
+	})
+	return file_scene_hunter_v1_account_proto_rawDescData
+}
+
+var file_scene_hunter_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_scene_hunter_v1_account_proto_goTypes = []any{
+	(*DeleteAccountRequest)(nil),  // 0: scene_hunter.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil), // 1: scene_hunter.v1.DeleteAccountResponse
+	(*ExportMyDataRequest)(nil),   // 2: scene_hunter.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),  // 3: scene_hunter.v1.ExportMyDataResponse
+}
+var file_scene_hunter_v1_account_proto_depIdxs = []int32{
+	0, // 0: scene_hunter.v1.AccountService.DeleteAccount:input_type -> scene_hunter.v1.DeleteAccountRequest
+	2, // 1: scene_hunter.v1.AccountService.ExportMyData:input_type -> scene_hunter.v1.ExportMyDataRequest
+	1, // 2: scene_hunter.v1.AccountService.DeleteAccount:output_type -> scene_hunter.v1.DeleteAccountResponse
+	3, // 3: scene_hunter.v1.AccountService.ExportMyData:output_type -> scene_hunter.v1.ExportMyDataResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_scene_hunter_v1_account_proto_init() }
+func file_scene_hunter_v1_account_proto_init() {
+	if File_scene_hunter_v1_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scene_hunter_v1_account_proto_rawDesc), len(file_scene_hunter_v1_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scene_hunter_v1_account_proto_goTypes,
+		DependencyIndexes: file_scene_hunter_v1_account_proto_depIdxs,
+		MessageInfos:      file_scene_hunter_v1_account_proto_msgTypes,
+	}.Build()
+	File_scene_hunter_v1_account_proto = out.File
+	file_scene_hunter_v1_account_proto_goTypes = nil
+	file_scene_hunter_v1_account_proto_depIdxs = nil
+}
@@ -30,6 +30,7 @@ const (
 	GameStatus_GAME_STATUS_WAITING     GameStatus = 1 // Waiting for players to join
 	GameStatus_GAME_STATUS_IN_PROGRESS GameStatus = 2 // Game is currently in progress
 	GameStatus_GAME_STATUS_FINISHED    GameStatus = 3 // Game has ended
+	GameStatus_GAME_STATUS_PAUSED      GameStatus = 4 // Game is paused by the admin
 )
 
 // Enum value maps for GameStatus.
@@ -39,12 +40,14 @@ var (
 		1: "GAME_STATUS_WAITING",
 		2: "GAME_STATUS_IN_PROGRESS",
 		3: "GAME_STATUS_FINISHED",
+		4: "GAME_STATUS_PAUSED",
 	}
 	GameStatus_value = map[string]int32{
 		"GAME_STATUS_UNSPECIFIED": 0,
 		"GAME_STATUS_WAITING":     1,
 		"GAME_STATUS_IN_PROGRESS": 2,
 		"GAME_STATUS_FINISHED":    3,
+		"GAME_STATUS_PAUSED":      4,
 	}
 )
 
@@ -75,6 +78,131 @@ func (GameStatus) EnumDescriptor() ([]byte, []int) {
 	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{0}
 }
 
+// GameEventType is what happened in an event of a game.
+type GameEventType int32
+
+const (
+	GameEventType_GAME_EVENT_TYPE_UNSPECIFIED                 GameEventType = 0
+	GameEventType_GAME_EVENT_TYPE_GAME_PAUSED                 GameEventType = 1
+	GameEventType_GAME_EVENT_TYPE_GAME_RESUMED                GameEventType = 2
+	GameEventType_GAME_EVENT_TYPE_ROUND_SKIPPED               GameEventType = 3
+	GameEventType_GAME_EVENT_TYPE_PLAYER_JOINED               GameEventType = 4
+	GameEventType_GAME_EVENT_TYPE_ROUND_STARTED               GameEventType = 5
+	GameEventType_GAME_EVENT_TYPE_HINTS_GENERATED             GameEventType = 6
+	GameEventType_GAME_EVENT_TYPE_PHOTO_SUBMITTED             GameEventType = 7
+	GameEventType_GAME_EVENT_TYPE_WINNERS_SELECTED            GameEventType = 8
+	GameEventType_GAME_EVENT_TYPE_GAME_ENDED                  GameEventType = 9
+	GameEventType_GAME_EVENT_TYPE_GAME_STARTED                GameEventType = 10
+	GameEventType_GAME_EVENT_TYPE_TEAMS_CHANGED               GameEventType = 11
+	GameEventType_GAME_EVENT_TYPE_SPECTATOR_JOINED            GameEventType = 12
+	GameEventType_GAME_EVENT_TYPE_GAME_MASTER_PHOTO_SUBMITTED GameEventType = 13
+	GameEventType_GAME_EVENT_TYPE_HINT_ATTEMPT_STARTED        GameEventType = 14
+	GameEventType_GAME_EVENT_TYPE_HINTS_PROGRESSED            GameEventType = 15
+	GameEventType_GAME_EVENT_TYPE_HINT_ATTEMPT_FAILED         GameEventType = 16
+	GameEventType_GAME_EVENT_TYPE_HINT_GENERATION_FAILED      GameEventType = 17
+	GameEventType_GAME_EVENT_TYPE_HINT_EDITED                 GameEventType = 18
+	GameEventType_GAME_EVENT_TYPE_HINT_REGENERATED            GameEventType = 19
+	GameEventType_GAME_EVENT_TYPE_HINTS_REORDERED             GameEventType = 20
+	GameEventType_GAME_EVENT_TYPE_HINTS_RELEASED              GameEventType = 21
+	GameEventType_GAME_EVENT_TYPE_FINAL_PHOTO_PICKED          GameEventType = 22
+	GameEventType_GAME_EVENT_TYPE_HUNT_FINISHED               GameEventType = 23
+	GameEventType_GAME_EVENT_TYPE_VOTE_CAST                   GameEventType = 24
+	GameEventType_GAME_EVENT_TYPE_VOTING_CLOSED               GameEventType = 25
+	GameEventType_GAME_EVENT_TYPE_PROXIMITY_CHECKED           GameEventType = 26
+	GameEventType_GAME_EVENT_TYPE_PLAYER_ANONYMIZED           GameEventType = 27
+)
+
+// Enum value maps for GameEventType.
+var (
+	GameEventType_name = map[int32]string{
+		0:  "GAME_EVENT_TYPE_UNSPECIFIED",
+		1:  "GAME_EVENT_TYPE_GAME_PAUSED",
+		2:  "GAME_EVENT_TYPE_GAME_RESUMED",
+		3:  "GAME_EVENT_TYPE_ROUND_SKIPPED",
+		4:  "GAME_EVENT_TYPE_PLAYER_JOINED",
+		5:  "GAME_EVENT_TYPE_ROUND_STARTED",
+		6:  "GAME_EVENT_TYPE_HINTS_GENERATED",
+		7:  "GAME_EVENT_TYPE_PHOTO_SUBMITTED",
+		8:  "GAME_EVENT_TYPE_WINNERS_SELECTED",
+		9:  "GAME_EVENT_TYPE_GAME_ENDED",
+		10: "GAME_EVENT_TYPE_GAME_STARTED",
+		11: "GAME_EVENT_TYPE_TEAMS_CHANGED",
+		12: "GAME_EVENT_TYPE_SPECTATOR_JOINED",
+		13: "GAME_EVENT_TYPE_GAME_MASTER_PHOTO_SUBMITTED",
+		14: "GAME_EVENT_TYPE_HINT_ATTEMPT_STARTED",
+		15: "GAME_EVENT_TYPE_HINTS_PROGRESSED",
+		16: "GAME_EVENT_TYPE_HINT_ATTEMPT_FAILED",
+		17: "GAME_EVENT_TYPE_HINT_GENERATION_FAILED",
+		18: "GAME_EVENT_TYPE_HINT_EDITED",
+		19: "GAME_EVENT_TYPE_HINT_REGENERATED",
+		20: "GAME_EVENT_TYPE_HINTS_REORDERED",
+		21: "GAME_EVENT_TYPE_HINTS_RELEASED",
+		22: "GAME_EVENT_TYPE_FINAL_PHOTO_PICKED",
+		23: "GAME_EVENT_TYPE_HUNT_FINISHED",
+		24: "GAME_EVENT_TYPE_VOTE_CAST",
+		25: "GAME_EVENT_TYPE_VOTING_CLOSED",
+		26: "GAME_EVENT_TYPE_PROXIMITY_CHECKED",
+		27: "GAME_EVENT_TYPE_PLAYER_ANONYMIZED",
+	}
+	GameEventType_value = map[string]int32{
+		"GAME_EVENT_TYPE_UNSPECIFIED":                 0,
+		"GAME_EVENT_TYPE_GAME_PAUSED":                 1,
+		"GAME_EVENT_TYPE_GAME_RESUMED":                2,
+		"GAME_EVENT_TYPE_ROUND_SKIPPED":               3,
+		"GAME_EVENT_TYPE_PLAYER_JOINED":               4,
+		"GAME_EVENT_TYPE_ROUND_STARTED":               5,
+		"GAME_EVENT_TYPE_HINTS_GENERATED":             6,
+		"GAME_EVENT_TYPE_PHOTO_SUBMITTED":             7,
+		"GAME_EVENT_TYPE_WINNERS_SELECTED":            8,
+		"GAME_EVENT_TYPE_GAME_ENDED":                  9,
+		"GAME_EVENT_TYPE_GAME_STARTED":                10,
+		"GAME_EVENT_TYPE_TEAMS_CHANGED":               11,
+		"GAME_EVENT_TYPE_SPECTATOR_JOINED":            12,
+		"GAME_EVENT_TYPE_GAME_MASTER_PHOTO_SUBMITTED": 13,
+		"GAME_EVENT_TYPE_HINT_ATTEMPT_STARTED":        14,
+		"GAME_EVENT_TYPE_HINTS_PROGRESSED":            15,
+		"GAME_EVENT_TYPE_HINT_ATTEMPT_FAILED":         16,
+		"GAME_EVENT_TYPE_HINT_GENERATION_FAILED":      17,
+		"GAME_EVENT_TYPE_HINT_EDITED":                 18,
+		"GAME_EVENT_TYPE_HINT_REGENERATED":            19,
+		"GAME_EVENT_TYPE_HINTS_REORDERED":             20,
+		"GAME_EVENT_TYPE_HINTS_RELEASED":              21,
+		"GAME_EVENT_TYPE_FINAL_PHOTO_PICKED":          22,
+		"GAME_EVENT_TYPE_HUNT_FINISHED":               23,
+		"GAME_EVENT_TYPE_VOTE_CAST":                   24,
+		"GAME_EVENT_TYPE_VOTING_CLOSED":               25,
+		"GAME_EVENT_TYPE_PROXIMITY_CHECKED":           26,
+		"GAME_EVENT_TYPE_PLAYER_ANONYMIZED":           27,
+	}
+)
+
+func (x GameEventType) Enum() *GameEventType {
+	p := new(GameEventType)
+	*p = x
+	return p
+}
+
+func (x GameEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_game_proto_enumTypes[1].Descriptor()
+}
+
+func (GameEventType) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_game_proto_enumTypes[1]
+}
+
+func (x GameEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameEventType.Descriptor instead.
+func (GameEventType) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
 // TurnStatus represents the current status of a turn.
 type TurnStatus int32
 
@@ -83,6 +211,9 @@ const (
 	TurnStatus_TURN_STATUS_GAME_MASTER           TurnStatus = 1 // Game master's turn (taking photo)
 	TurnStatus_TURN_STATUS_HUNTERS               TurnStatus = 2 // Hunters' turn (finding location)
 	TurnStatus_TURN_STATUS_WAITING_FOR_SELECTION TurnStatus = 3 // All hunters submitted, waiting for game master to select winners
+	TurnStatus_TURN_STATUS_REVIEWING_HINTS       TurnStatus = 4 // Game master reviewing hints before hunters see them
+	TurnStatus_TURN_STATUS_GENERATING_HINTS      TurnStatus = 5 // Hints are being generated in the background
+	TurnStatus_TURN_STATUS_COMPLETED             TurnStatus = 6 // Round has been ranked or skipped
 )
 
 // Enum value maps for TurnStatus.
@@ -92,12 +223,18 @@ var (
 		1: "TURN_STATUS_GAME_MASTER",
 		2: "TURN_STATUS_HUNTERS",
 		3: "TURN_STATUS_WAITING_FOR_SELECTION",
+		4: "TURN_STATUS_REVIEWING_HINTS",
+		5: "TURN_STATUS_GENERATING_HINTS",
+		6: "TURN_STATUS_COMPLETED",
 	}
 	TurnStatus_value = map[string]int32{
 		"TURN_STATUS_UNSPECIFIED":           0,
 		"TURN_STATUS_GAME_MASTER":           1,
 		"TURN_STATUS_HUNTERS":               2,
 		"TURN_STATUS_WAITING_FOR_SELECTION": 3,
+		"TURN_STATUS_REVIEWING_HINTS":       4,
+		"TURN_STATUS_GENERATING_HINTS":      5,
+		"TURN_STATUS_COMPLETED":             6,
 	}
 )
 
@@ -112,11 +249,11 @@ func (x TurnStatus) String() string {
 }
 
 func (TurnStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_scene_hunter_v1_game_proto_enumTypes[1].Descriptor()
+	return file_scene_hunter_v1_game_proto_enumTypes[2].Descriptor()
 }
 
 func (TurnStatus) Type() protoreflect.EnumType {
-	return &file_scene_hunter_v1_game_proto_enumTypes[1]
+	return &file_scene_hunter_v1_game_proto_enumTypes[2]
 }
 
 func (x TurnStatus) Number() protoreflect.EnumNumber {
@@ -125,7 +262,267 @@ func (x TurnStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TurnStatus.Descriptor instead.
 func (TurnStatus) EnumDescriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{1}
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{2}
+}
+
+// HintDifficulty controls how specific the hints of a round are.
+type HintDifficulty int32
+
+const (
+	HintDifficulty_HINT_DIFFICULTY_UNSPECIFIED HintDifficulty = 0 // Treated as normal
+	HintDifficulty_HINT_DIFFICULTY_EASY        HintDifficulty = 1 // The last hints nearly identify the place
+	HintDifficulty_HINT_DIFFICULTY_NORMAL      HintDifficulty = 2
+	HintDifficulty_HINT_DIFFICULTY_HARD        HintDifficulty = 3 // Hints avoid text and names that identify the place
+)
+
+// Enum value maps for HintDifficulty.
+var (
+	HintDifficulty_name = map[int32]string{
+		0: "HINT_DIFFICULTY_UNSPECIFIED",
+		1: "HINT_DIFFICULTY_EASY",
+		2: "HINT_DIFFICULTY_NORMAL",
+		3: "HINT_DIFFICULTY_HARD",
+	}
+	HintDifficulty_value = map[string]int32{
+		"HINT_DIFFICULTY_UNSPECIFIED": 0,
+		"HINT_DIFFICULTY_EASY":        1,
+		"HINT_DIFFICULTY_NORMAL":      2,
+		"HINT_DIFFICULTY_HARD":        3,
+	}
+)
+
+func (x HintDifficulty) Enum() *HintDifficulty {
+	p := new(HintDifficulty)
+	*p = x
+	return p
+}
+
+func (x HintDifficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HintDifficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_game_proto_enumTypes[3].Descriptor()
+}
+
+func (HintDifficulty) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_game_proto_enumTypes[3]
+}
+
+func (x HintDifficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HintDifficulty.Descriptor instead.
+func (HintDifficulty) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+// HintSchedule is how the hints of a round are released during the hunters' turn.
+type HintSchedule int32
+
+const (
+	HintSchedule_HINT_SCHEDULE_UNSPECIFIED HintSchedule = 0
+	HintSchedule_HINT_SCHEDULE_LINEAR      HintSchedule = 1 // The first hint at once, then one every interval
+	HintSchedule_HINT_SCHEDULE_CUSTOM      HintSchedule = 2 // Each hint at its own offset from the start of the turn
+)
+
+// Enum value maps for HintSchedule.
+var (
+	HintSchedule_name = map[int32]string{
+		0: "HINT_SCHEDULE_UNSPECIFIED",
+		1: "HINT_SCHEDULE_LINEAR",
+		2: "HINT_SCHEDULE_CUSTOM",
+	}
+	HintSchedule_value = map[string]int32{
+		"HINT_SCHEDULE_UNSPECIFIED": 0,
+		"HINT_SCHEDULE_LINEAR":      1,
+		"HINT_SCHEDULE_CUSTOM":      2,
+	}
+)
+
+func (x HintSchedule) Enum() *HintSchedule {
+	p := new(HintSchedule)
+	*p = x
+	return p
+}
+
+func (x HintSchedule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HintSchedule) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_game_proto_enumTypes[4].Descriptor()
+}
+
+func (HintSchedule) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_game_proto_enumTypes[4]
+}
+
+func (x HintSchedule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HintSchedule.Descriptor instead.
+func (HintSchedule) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{4}
+}
+
+// DistanceScoring is how the distance of the hunters' photos from the game master's photo
+// counts in the ranking of a round.
+type DistanceScoring int32
+
+const (
+	DistanceScoring_DISTANCE_SCORING_UNSPECIFIED DistanceScoring = 0 // Same as DISTANCE_SCORING_OFF
+	DistanceScoring_DISTANCE_SCORING_OFF         DistanceScoring = 1 // Only the game master's ranking counts
+	DistanceScoring_DISTANCE_SCORING_TIEBREAK    DistanceScoring = 2 // Hunters sharing a rank are ranked by distance, nearest first
+	DistanceScoring_DISTANCE_SCORING_POINTS      DistanceScoring = 3 // Photos taken within 50m, 200m and 1km earn 3, 2 and 1 extra points
+)
+
+// Enum value maps for DistanceScoring.
+var (
+	DistanceScoring_name = map[int32]string{
+		0: "DISTANCE_SCORING_UNSPECIFIED",
+		1: "DISTANCE_SCORING_OFF",
+		2: "DISTANCE_SCORING_TIEBREAK",
+		3: "DISTANCE_SCORING_POINTS",
+	}
+	DistanceScoring_value = map[string]int32{
+		"DISTANCE_SCORING_UNSPECIFIED": 0,
+		"DISTANCE_SCORING_OFF":         1,
+		"DISTANCE_SCORING_TIEBREAK":    2,
+		"DISTANCE_SCORING_POINTS":      3,
+	}
+)
+
+func (x DistanceScoring) Enum() *DistanceScoring {
+	p := new(DistanceScoring)
+	*p = x
+	return p
+}
+
+func (x DistanceScoring) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DistanceScoring) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_game_proto_enumTypes[5].Descriptor()
+}
+
+func (DistanceScoring) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_game_proto_enumTypes[5]
+}
+
+func (x DistanceScoring) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DistanceScoring.Descriptor instead.
+func (DistanceScoring) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+// Proximity tells a hunter whether they moved closer to where the game master's photo was taken.
+type Proximity int32
+
+const (
+	Proximity_PROXIMITY_UNSPECIFIED Proximity = 0 // First check in the round, or a location is unknown
+	Proximity_PROXIMITY_WARMER      Proximity = 1
+	Proximity_PROXIMITY_COLDER      Proximity = 2
+	Proximity_PROXIMITY_SAME        Proximity = 3
+)
+
+// Enum value maps for Proximity.
+var (
+	Proximity_name = map[int32]string{
+		0: "PROXIMITY_UNSPECIFIED",
+		1: "PROXIMITY_WARMER",
+		2: "PROXIMITY_COLDER",
+		3: "PROXIMITY_SAME",
+	}
+	Proximity_value = map[string]int32{
+		"PROXIMITY_UNSPECIFIED": 0,
+		"PROXIMITY_WARMER":      1,
+		"PROXIMITY_COLDER":      2,
+		"PROXIMITY_SAME":        3,
+	}
+)
+
+func (x Proximity) Enum() *Proximity {
+	p := new(Proximity)
+	*p = x
+	return p
+}
+
+func (x Proximity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Proximity) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_game_proto_enumTypes[6].Descriptor()
+}
+
+func (Proximity) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_game_proto_enumTypes[6]
+}
+
+func (x Proximity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Proximity.Descriptor instead.
+func (Proximity) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+// RankingMode is who ranks the hunters' photos of a round.
+type RankingMode int32
+
+const (
+	RankingMode_RANKING_MODE_UNSPECIFIED RankingMode = 0 // Same as RANKING_MODE_GAME_MASTER
+	RankingMode_RANKING_MODE_GAME_MASTER RankingMode = 1 // The game master ranks the photos with SelectWinners
+	RankingMode_RANKING_MODE_AUDIENCE    RankingMode = 2 // Players and spectators vote with SubmitVote and the photos are ranked by Borda count
+)
+
+// Enum value maps for RankingMode.
+var (
+	RankingMode_name = map[int32]string{
+		0: "RANKING_MODE_UNSPECIFIED",
+		1: "RANKING_MODE_GAME_MASTER",
+		2: "RANKING_MODE_AUDIENCE",
+	}
+	RankingMode_value = map[string]int32{
+		"RANKING_MODE_UNSPECIFIED": 0,
+		"RANKING_MODE_GAME_MASTER": 1,
+		"RANKING_MODE_AUDIENCE":    2,
+	}
+)
+
+func (x RankingMode) Enum() *RankingMode {
+	p := new(RankingMode)
+	*p = x
+	return p
+}
+
+func (x RankingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RankingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_game_proto_enumTypes[7].Descriptor()
+}
+
+func (RankingMode) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_game_proto_enumTypes[7]
+}
+
+func (x RankingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RankingMode.Descriptor instead.
+func (RankingMode) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{7}
 }
 
 // Player represents a player in the game.
@@ -137,6 +534,7 @@ type Player struct {
 	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
 	TotalPoints   int32                  `protobuf:"varint,5,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
 	IsConnected   bool                   `protobuf:"varint,6,opt,name=is_connected,json=isConnected,proto3" json:"is_connected,omitempty"`
+	TeamId        string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Team of the player in team mode
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,29 +611,51 @@ func (x *Player) GetIsConnected() bool {
 	return false
 }
 
-// Hint represents a single hint for hunters.
-type Hint struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HintNumber    int32                  `protobuf:"varint,1,opt,name=hint_number,json=hintNumber,proto3" json:"hint_number,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+func (x *Player) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+// GameEvent is an entry in the event stream of a game.
+// Its payload holds what the event set, and is empty when the type tells everything
+// or while the round of the event is being played.
+type GameEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Type        GameEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=scene_hunter.v1.GameEventType" json:"type,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // Who caused the event, empty when no one did
+	RoundNumber int32                  `protobuf:"varint,3,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"` // Current round when the event occurred, 0 before the first round
+	OccurredAt  string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Id          string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"` // Position in the event stream of the game
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*GameEvent_Photo
+	//	*GameEvent_Hints
+	//	*GameEvent_HintAttempt
+	//	*GameEvent_Results
+	//	*GameEvent_Voting
+	//	*GameEvent_Proximity
+	//	*GameEvent_Teams
+	Payload       isGameEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Hint) Reset() {
-	*x = Hint{}
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
 	mi := &file_scene_hunter_v1_game_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Hint) String() string {
+func (x *GameEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Hint) ProtoMessage() {}
+func (*GameEvent) ProtoMessage() {}
 
-func (x *Hint) ProtoReflect() protoreflect.Message {
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_scene_hunter_v1_game_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,111 +667,188 @@ func (x *Hint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Hint.ProtoReflect.Descriptor instead.
-func (*Hint) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
 	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Hint) GetHintNumber() int32 {
+func (x *GameEvent) GetType() GameEventType {
 	if x != nil {
-		return x.HintNumber
+		return x.Type
 	}
-	return 0
+	return GameEventType_GAME_EVENT_TYPE_UNSPECIFIED
 }
 
-func (x *Hint) GetText() string {
+func (x *GameEvent) GetUserId() string {
 	if x != nil {
-		return x.Text
+		return x.UserId
 	}
 	return ""
 }
 
-// HunterSubmission represents a hunter's photo submission.
-type HunterSubmission struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ImageId            string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	SubmittedAtSeconds int32                  `protobuf:"varint,3,opt,name=submitted_at_seconds,json=submittedAtSeconds,proto3" json:"submitted_at_seconds,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *GameEvent) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
 }
 
-func (x *HunterSubmission) Reset() {
-	*x = HunterSubmission{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GameEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
 }
 
-func (x *HunterSubmission) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GameEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (*HunterSubmission) ProtoMessage() {}
+func (x *GameEvent) GetPayload() isGameEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
 
-func (x *HunterSubmission) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[2]
+func (x *GameEvent) GetPhoto() *PhotoEventPayload {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Payload.(*GameEvent_Photo); ok {
+			return x.Photo
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use HunterSubmission.ProtoReflect.Descriptor instead.
-func (*HunterSubmission) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{2}
+func (x *GameEvent) GetHints() *HintsEventPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_Hints); ok {
+			return x.Hints
+		}
+	}
+	return nil
 }
 
-func (x *HunterSubmission) GetUserId() string {
+func (x *GameEvent) GetHintAttempt() *HintAttemptEventPayload {
 	if x != nil {
-		return x.UserId
+		if x, ok := x.Payload.(*GameEvent_HintAttempt); ok {
+			return x.HintAttempt
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *HunterSubmission) GetImageId() string {
+func (x *GameEvent) GetResults() *ResultsEventPayload {
 	if x != nil {
-		return x.ImageId
+		if x, ok := x.Payload.(*GameEvent_Results); ok {
+			return x.Results
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *HunterSubmission) GetSubmittedAtSeconds() int32 {
+func (x *GameEvent) GetVoting() *VotingEventPayload {
 	if x != nil {
-		return x.SubmittedAtSeconds
+		if x, ok := x.Payload.(*GameEvent_Voting); ok {
+			return x.Voting
+		}
 	}
-	return 0
+	return nil
 }
 
-// RoundResult represents the result of a single round for a player.
-type RoundResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`     // Rank assigned by game master (1st, 2nd, 3rd, etc.)
-	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"` // Points awarded based on rank
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GameEvent) GetProximity() *ProximityEventPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_Proximity); ok {
+			return x.Proximity
+		}
+	}
+	return nil
 }
 
-func (x *RoundResult) Reset() {
-	*x = RoundResult{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[3]
+func (x *GameEvent) GetTeams() *TeamsEventPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*GameEvent_Teams); ok {
+			return x.Teams
+		}
+	}
+	return nil
+}
+
+type isGameEvent_Payload interface {
+	isGameEvent_Payload()
+}
+
+type GameEvent_Photo struct {
+	Photo *PhotoEventPayload `protobuf:"bytes,6,opt,name=photo,proto3,oneof"` // GAME_MASTER_PHOTO_SUBMITTED, PHOTO_SUBMITTED and FINAL_PHOTO_PICKED
+}
+
+type GameEvent_Hints struct {
+	Hints *HintsEventPayload `protobuf:"bytes,7,opt,name=hints,proto3,oneof"` // HINTS_GENERATED, HINTS_REORDERED, HINTS_RELEASED, HINT_EDITED and HINT_REGENERATED
+}
+
+type GameEvent_HintAttempt struct {
+	HintAttempt *HintAttemptEventPayload `protobuf:"bytes,8,opt,name=hint_attempt,json=hintAttempt,proto3,oneof"` // HINT_ATTEMPT_STARTED, HINTS_PROGRESSED and HINT_ATTEMPT_FAILED
+}
+
+type GameEvent_Results struct {
+	Results *ResultsEventPayload `protobuf:"bytes,9,opt,name=results,proto3,oneof"` // WINNERS_SELECTED
+}
+
+type GameEvent_Voting struct {
+	Voting *VotingEventPayload `protobuf:"bytes,10,opt,name=voting,proto3,oneof"` // VOTING_CLOSED
+}
+
+type GameEvent_Proximity struct {
+	Proximity *ProximityEventPayload `protobuf:"bytes,11,opt,name=proximity,proto3,oneof"` // PROXIMITY_CHECKED
+}
+
+type GameEvent_Teams struct {
+	Teams *TeamsEventPayload `protobuf:"bytes,12,opt,name=teams,proto3,oneof"` // TEAMS_CHANGED
+}
+
+func (*GameEvent_Photo) isGameEvent_Payload() {}
+
+func (*GameEvent_Hints) isGameEvent_Payload() {}
+
+func (*GameEvent_HintAttempt) isGameEvent_Payload() {}
+
+func (*GameEvent_Results) isGameEvent_Payload() {}
+
+func (*GameEvent_Voting) isGameEvent_Payload() {}
+
+func (*GameEvent_Proximity) isGameEvent_Payload() {}
+
+func (*GameEvent_Teams) isGameEvent_Payload() {}
+
+// PhotoEventPayload is a photo of the game master or of a hunter.
+type PhotoEventPayload struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ImageId            string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Attempt            int32                  `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"` // Attempt of the hunter, 0 for the photo of the game master
+	SubmittedAtSeconds int32                  `protobuf:"varint,3,opt,name=submitted_at_seconds,json=submittedAtSeconds,proto3" json:"submitted_at_seconds,omitempty"`
+	Final              bool                   `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PhotoEventPayload) Reset() {
+	*x = PhotoEventPayload{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoundResult) String() string {
+func (x *PhotoEventPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundResult) ProtoMessage() {}
+func (*PhotoEventPayload) ProtoMessage() {}
 
-func (x *RoundResult) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[3]
+func (x *PhotoEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,61 +859,108 @@ func (x *RoundResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundResult.ProtoReflect.Descriptor instead.
-func (*RoundResult) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PhotoEventPayload.ProtoReflect.Descriptor instead.
+func (*PhotoEventPayload) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RoundResult) GetUserId() string {
+func (x *PhotoEventPayload) GetImageId() string {
 	if x != nil {
-		return x.UserId
+		return x.ImageId
 	}
 	return ""
 }
 
-func (x *RoundResult) GetRank() int32 {
+func (x *PhotoEventPayload) GetAttempt() int32 {
 	if x != nil {
-		return x.Rank
+		return x.Attempt
 	}
 	return 0
 }
 
-func (x *RoundResult) GetPoints() int32 {
+func (x *PhotoEventPayload) GetSubmittedAtSeconds() int32 {
 	if x != nil {
-		return x.Points
+		return x.SubmittedAtSeconds
 	}
 	return 0
 }
 
-// Round represents a single round in the game.
-type Round struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	RoundNumber        int32                  `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
-	GameMasterUserId   string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
-	GameMasterImageId  string                 `protobuf:"bytes,3,opt,name=game_master_image_id,json=gameMasterImageId,proto3" json:"game_master_image_id,omitempty"` // Image ID of game master's photo
-	Hints              []*Hint                `protobuf:"bytes,4,rep,name=hints,proto3" json:"hints,omitempty"`
-	HunterSubmissions  []*HunterSubmission    `protobuf:"bytes,5,rep,name=hunter_submissions,json=hunterSubmissions,proto3" json:"hunter_submissions,omitempty"` // Photos submitted by hunters
-	Results            []*RoundResult         `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`                                              // Results after game master selects winners
-	TurnStatus         TurnStatus             `protobuf:"varint,7,opt,name=turn_status,json=turnStatus,proto3,enum=scene_hunter.v1.TurnStatus" json:"turn_status,omitempty"`
-	TurnElapsedSeconds int32                  `protobuf:"varint,8,opt,name=turn_elapsed_seconds,json=turnElapsedSeconds,proto3" json:"turn_elapsed_seconds,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *PhotoEventPayload) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
 }
 
-func (x *Round) Reset() {
-	*x = Round{}
+// HintsEventPayload is every hint of a round, or the changed one when a hint is edited or regenerated.
+type HintsEventPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintsEventPayload) Reset() {
+	*x = HintsEventPayload{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintsEventPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintsEventPayload) ProtoMessage() {}
+
+func (x *HintsEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintsEventPayload.ProtoReflect.Descriptor instead.
+func (*HintsEventPayload) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HintsEventPayload) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+// HintAttemptEventPayload is the progress of an attempt to generate the hints.
+type HintAttemptEventPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempt       int32                  `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Generated     int32                  `protobuf:"varint,2,opt,name=generated,proto3" json:"generated,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Why the attempt failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintAttemptEventPayload) Reset() {
+	*x = HintAttemptEventPayload{}
 	mi := &file_scene_hunter_v1_game_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Round) String() string {
+func (x *HintAttemptEventPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Round) ProtoMessage() {}
+func (*HintAttemptEventPayload) ProtoMessage() {}
 
-func (x *Round) ProtoReflect() protoreflect.Message {
+func (x *HintAttemptEventPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_scene_hunter_v1_game_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,97 +972,145 @@ func (x *Round) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Round.ProtoReflect.Descriptor instead.
-func (*Round) Descriptor() ([]byte, []int) {
+// Deprecated: Use HintAttemptEventPayload.ProtoReflect.Descriptor instead.
+func (*HintAttemptEventPayload) Descriptor() ([]byte, []int) {
 	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Round) GetRoundNumber() int32 {
+func (x *HintAttemptEventPayload) GetAttempt() int32 {
 	if x != nil {
-		return x.RoundNumber
+		return x.Attempt
 	}
 	return 0
 }
 
-func (x *Round) GetGameMasterUserId() string {
+func (x *HintAttemptEventPayload) GetGenerated() int32 {
 	if x != nil {
-		return x.GameMasterUserId
+		return x.Generated
 	}
-	return ""
+	return 0
 }
 
-func (x *Round) GetGameMasterImageId() string {
+func (x *HintAttemptEventPayload) GetMessage() string {
 	if x != nil {
-		return x.GameMasterImageId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Round) GetHints() []*Hint {
-	if x != nil {
-		return x.Hints
-	}
-	return nil
+// ResultsEventPayload is the ranks and points awarded in a round.
+type ResultsEventPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RoundResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Round) GetHunterSubmissions() []*HunterSubmission {
+func (x *ResultsEventPayload) Reset() {
+	*x = ResultsEventPayload{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsEventPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsEventPayload) ProtoMessage() {}
+
+func (x *ResultsEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[5]
 	if x != nil {
-		return x.HunterSubmissions
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Round) GetResults() []*RoundResult {
+// Deprecated: Use ResultsEventPayload.ProtoReflect.Descriptor instead.
+func (*ResultsEventPayload) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResultsEventPayload) GetResults() []*RoundResult {
 	if x != nil {
 		return x.Results
 	}
 	return nil
 }
 
-func (x *Round) GetTurnStatus() TurnStatus {
+// VotingEventPayload is how many ballots were cast when voting closed.
+type VotingEventPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ballots       int32                  `protobuf:"varint,1,opt,name=ballots,proto3" json:"ballots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingEventPayload) Reset() {
+	*x = VotingEventPayload{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingEventPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingEventPayload) ProtoMessage() {}
+
+func (x *VotingEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[6]
 	if x != nil {
-		return x.TurnStatus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TurnStatus_TURN_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Round) GetTurnElapsedSeconds() int32 {
+// Deprecated: Use VotingEventPayload.ProtoReflect.Descriptor instead.
+func (*VotingEventPayload) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VotingEventPayload) GetBallots() int32 {
 	if x != nil {
-		return x.TurnElapsedSeconds
+		return x.Ballots
 	}
 	return 0
 }
 
-// Game represents a game session.
-type Game struct {
+// ProximityEventPayload is how close a hunter got.
+type ProximityEventPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	Status        GameStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=scene_hunter.v1.GameStatus" json:"status,omitempty"`
-	TotalRounds   int32                  `protobuf:"varint,3,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
-	CurrentRound  int32                  `protobuf:"varint,4,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`
-	Players       []*Player              `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
-	Rounds        []*Round               `protobuf:"bytes,6,rep,name=rounds,proto3" json:"rounds,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Proximity     Proximity              `protobuf:"varint,1,opt,name=proximity,proto3,enum=scene_hunter.v1.Proximity" json:"proximity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[5]
+func (x *ProximityEventPayload) Reset() {
+	*x = ProximityEventPayload{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) String() string {
+func (x *ProximityEventPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*ProximityEventPayload) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[5]
+func (x *ProximityEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,92 +1121,2967 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ProximityEventPayload.ProtoReflect.Descriptor instead.
+func (*ProximityEventPayload) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Game) GetRoomId() string {
+func (x *ProximityEventPayload) GetProximity() Proximity {
 	if x != nil {
-		return x.RoomId
+		return x.Proximity
 	}
-	return ""
+	return Proximity_PROXIMITY_UNSPECIFIED
 }
 
-func (x *Game) GetStatus() GameStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GameStatus_GAME_STATUS_UNSPECIFIED
+// TeamsEventPayload is the new teams, empty in individual mode.
+type TeamsEventPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Game) GetTotalRounds() int32 {
+func (x *TeamsEventPayload) Reset() {
+	*x = TeamsEventPayload{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamsEventPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamsEventPayload) ProtoMessage() {}
+
+func (x *TeamsEventPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[8]
 	if x != nil {
-		return x.TotalRounds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Game) GetCurrentRound() int32 {
+// Deprecated: Use TeamsEventPayload.ProtoReflect.Descriptor instead.
+func (*TeamsEventPayload) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TeamsEventPayload) GetTeams() []*Team {
 	if x != nil {
-		return x.CurrentRound
+		return x.Teams
 	}
-	return 0
+	return nil
 }
 
-func (x *Game) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
+// Spectator is someone who watches a game without playing.
+// Spectators do not count as players or hunters.
+type Spectator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spectator) Reset() {
+	*x = Spectator{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spectator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spectator) ProtoMessage() {}
+
+func (x *Spectator) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spectator.ProtoReflect.Descriptor instead.
+func (*Spectator) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Spectator) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Spectator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Team represents a team of players in team mode.
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberUserIds []string               `protobuf:"bytes,3,rep,name=member_user_ids,json=memberUserIds,proto3" json:"member_user_ids,omitempty"`
+	TotalPoints   int32                  `protobuf:"varint,4,opt,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Team) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetMemberUserIds() []string {
+	if x != nil {
+		return x.MemberUserIds
+	}
+	return nil
+}
+
+func (x *Team) GetTotalPoints() int32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return 0
+}
+
+// Hint represents a single hint for hunters.
+type Hint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HintNumber    int32                  `protobuf:"varint,1,opt,name=hint_number,json=hintNumber,proto3" json:"hint_number,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hint) Reset() {
+	*x = Hint{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hint) ProtoMessage() {}
+
+func (x *Hint) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hint.ProtoReflect.Descriptor instead.
+func (*Hint) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Hint) GetHintNumber() int32 {
+	if x != nil {
+		return x.HintNumber
+	}
+	return 0
+}
+
+func (x *Hint) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// HunterSubmission represents a hunter's photo submission.
+type HunterSubmission struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ImageId            string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	SubmittedAtSeconds int32                  `protobuf:"varint,3,opt,name=submitted_at_seconds,json=submittedAtSeconds,proto3" json:"submitted_at_seconds,omitempty"`
+	TeamId             string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Team the photo was submitted for in team mode
+	Attempt            int32                  `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`            // Attempt of the hunter, or of the team in team mode, from 1
+	Final              bool                   `protobuf:"varint,6,opt,name=final,proto3" json:"final,omitempty"`                // The photo is the one picked to be ranked
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HunterSubmission) Reset() {
+	*x = HunterSubmission{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HunterSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HunterSubmission) ProtoMessage() {}
+
+func (x *HunterSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HunterSubmission.ProtoReflect.Descriptor instead.
+func (*HunterSubmission) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HunterSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HunterSubmission) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *HunterSubmission) GetSubmittedAtSeconds() int32 {
+	if x != nil {
+		return x.SubmittedAtSeconds
+	}
+	return 0
+}
+
+func (x *HunterSubmission) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *HunterSubmission) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *HunterSubmission) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+// HintGeneration represents the progress of the hint generation of a round.
+type HintGeneration struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GeneratedHints int32                  `protobuf:"varint,1,opt,name=generated_hints,json=generatedHints,proto3" json:"generated_hints,omitempty"` // Hints written so far in the current attempt
+	TotalHints     int32                  `protobuf:"varint,2,opt,name=total_hints,json=totalHints,proto3" json:"total_hints,omitempty"`
+	Attempts       int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"` // Attempts started so far
+	Errors         []*HintGenerationError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`      // Failed attempts, oldest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HintGeneration) Reset() {
+	*x = HintGeneration{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintGeneration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintGeneration) ProtoMessage() {}
+
+func (x *HintGeneration) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintGeneration.ProtoReflect.Descriptor instead.
+func (*HintGeneration) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HintGeneration) GetGeneratedHints() int32 {
+	if x != nil {
+		return x.GeneratedHints
+	}
+	return 0
+}
+
+func (x *HintGeneration) GetTotalHints() int32 {
+	if x != nil {
+		return x.TotalHints
+	}
+	return 0
+}
+
+func (x *HintGeneration) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *HintGeneration) GetErrors() []*HintGenerationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// HintGenerationError represents a failed attempt to generate hints.
+type HintGenerationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attempt       int32                  `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FailedAt      string                 `protobuf:"bytes,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HintGenerationError) Reset() {
+	*x = HintGenerationError{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HintGenerationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HintGenerationError) ProtoMessage() {}
+
+func (x *HintGenerationError) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HintGenerationError.ProtoReflect.Descriptor instead.
+func (*HintGenerationError) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HintGenerationError) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *HintGenerationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HintGenerationError) GetFailedAt() string {
+	if x != nil {
+		return x.FailedAt
+	}
+	return ""
+}
+
+// RoundResult represents the result of a single round for a player, or for a team in team mode.
+type RoundResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // Empty in team mode
+	Rank           int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`                                           // Rank assigned by game master (1st, 2nd, 3rd, etc.)
+	Points         int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`                                       // Points awarded based on rank
+	TeamId         string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                          // Ranked team in team mode
+	DistancePoints int32                  `protobuf:"varint,5,opt,name=distance_points,json=distancePoints,proto3" json:"distance_points,omitempty"` // Points for the distance of the photo, included in points
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RoundResult) Reset() {
+	*x = RoundResult{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundResult) ProtoMessage() {}
+
+func (x *RoundResult) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundResult.ProtoReflect.Descriptor instead.
+func (*RoundResult) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RoundResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RoundResult) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RoundResult) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RoundResult) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *RoundResult) GetDistancePoints() int32 {
+	if x != nil {
+		return x.DistancePoints
+	}
+	return 0
+}
+
+// Location is where a photo was taken, in degrees.
+// Locations are never shown to other players.
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// GameSettings represents the turn length and hint schedule of a game.
+type GameSettings struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TurnSeconds           int32                  `protobuf:"varint,1,opt,name=turn_seconds,json=turnSeconds,proto3" json:"turn_seconds,omitempty"`
+	HintCount             int32                  `protobuf:"varint,2,opt,name=hint_count,json=hintCount,proto3" json:"hint_count,omitempty"`
+	HintSchedule          HintSchedule           `protobuf:"varint,3,opt,name=hint_schedule,json=hintSchedule,proto3,enum=scene_hunter.v1.HintSchedule" json:"hint_schedule,omitempty"`
+	HintIntervalSeconds   int32                  `protobuf:"varint,4,opt,name=hint_interval_seconds,json=hintIntervalSeconds,proto3" json:"hint_interval_seconds,omitempty"`     // Set for a linear schedule
+	HintOffsetsSeconds    []int32                `protobuf:"varint,5,rep,packed,name=hint_offsets_seconds,json=hintOffsetsSeconds,proto3" json:"hint_offsets_seconds,omitempty"` // Set for a custom schedule
+	AttemptLimit          int32                  `protobuf:"varint,6,opt,name=attempt_limit,json=attemptLimit,proto3" json:"attempt_limit,omitempty"`                            // Photos each hunter, or each team, may submit in a round
+	DistanceScoring       DistanceScoring        `protobuf:"varint,7,opt,name=distance_scoring,json=distanceScoring,proto3,enum=scene_hunter.v1.DistanceScoring" json:"distance_scoring,omitempty"`
+	SpectatorDelaySeconds int32                  `protobuf:"varint,8,opt,name=spectator_delay_seconds,json=spectatorDelaySeconds,proto3" json:"spectator_delay_seconds,omitempty"` // How far behind the game spectators see it
+	RankingMode           RankingMode            `protobuf:"varint,9,opt,name=ranking_mode,json=rankingMode,proto3,enum=scene_hunter.v1.RankingMode" json:"ranking_mode,omitempty"`
+	VotingSeconds         int32                  `protobuf:"varint,10,opt,name=voting_seconds,json=votingSeconds,proto3" json:"voting_seconds,omitempty"` // Time the audience has to vote
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GameSettings) Reset() {
+	*x = GameSettings{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSettings) ProtoMessage() {}
+
+func (x *GameSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
+func (*GameSettings) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GameSettings) GetTurnSeconds() int32 {
+	if x != nil {
+		return x.TurnSeconds
+	}
+	return 0
+}
+
+func (x *GameSettings) GetHintCount() int32 {
+	if x != nil {
+		return x.HintCount
+	}
+	return 0
+}
+
+func (x *GameSettings) GetHintSchedule() HintSchedule {
+	if x != nil {
+		return x.HintSchedule
+	}
+	return HintSchedule_HINT_SCHEDULE_UNSPECIFIED
+}
+
+func (x *GameSettings) GetHintIntervalSeconds() int32 {
+	if x != nil {
+		return x.HintIntervalSeconds
+	}
+	return 0
+}
+
+func (x *GameSettings) GetHintOffsetsSeconds() []int32 {
+	if x != nil {
+		return x.HintOffsetsSeconds
+	}
+	return nil
+}
+
+func (x *GameSettings) GetAttemptLimit() int32 {
+	if x != nil {
+		return x.AttemptLimit
+	}
+	return 0
+}
+
+func (x *GameSettings) GetDistanceScoring() DistanceScoring {
+	if x != nil {
+		return x.DistanceScoring
+	}
+	return DistanceScoring_DISTANCE_SCORING_UNSPECIFIED
+}
+
+func (x *GameSettings) GetSpectatorDelaySeconds() int32 {
+	if x != nil {
+		return x.SpectatorDelaySeconds
+	}
+	return 0
+}
+
+func (x *GameSettings) GetRankingMode() RankingMode {
+	if x != nil {
+		return x.RankingMode
+	}
+	return RankingMode_RANKING_MODE_UNSPECIFIED
+}
+
+func (x *GameSettings) GetVotingSeconds() int32 {
+	if x != nil {
+		return x.VotingSeconds
+	}
+	return 0
+}
+
+// Round represents a single round in the game.
+type Round struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RoundNumber        int32                  `protobuf:"varint,1,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"`
+	GameMasterUserId   string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
+	GameMasterImageId  string                 `protobuf:"bytes,3,opt,name=game_master_image_id,json=gameMasterImageId,proto3" json:"game_master_image_id,omitempty"` // Image ID of game master's photo
+	Hints              []*Hint                `protobuf:"bytes,4,rep,name=hints,proto3" json:"hints,omitempty"`
+	HunterSubmissions  []*HunterSubmission    `protobuf:"bytes,5,rep,name=hunter_submissions,json=hunterSubmissions,proto3" json:"hunter_submissions,omitempty"` // Photos submitted by hunters
+	Results            []*RoundResult         `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`                                              // Results after game master selects winners
+	TurnStatus         TurnStatus             `protobuf:"varint,7,opt,name=turn_status,json=turnStatus,proto3,enum=scene_hunter.v1.TurnStatus" json:"turn_status,omitempty"`
+	TurnElapsedSeconds int32                  `protobuf:"varint,8,opt,name=turn_elapsed_seconds,json=turnElapsedSeconds,proto3" json:"turn_elapsed_seconds,omitempty"` // Stops while the game is paused
+	HintGeneration     *HintGeneration        `protobuf:"bytes,9,opt,name=hint_generation,json=hintGeneration,proto3" json:"hint_generation,omitempty"`                // Set once the game master has submitted a photo
+	VotingEndsAt       string                 `protobuf:"bytes,10,opt,name=voting_ends_at,json=votingEndsAt,proto3" json:"voting_ends_at,omitempty"`                   // Set while and after the audience votes on the photos
+	Skipped            bool                   `protobuf:"varint,11,opt,name=skipped,proto3" json:"skipped,omitempty"`                                                  // The admin voided the round, which gives no points
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Round) Reset() {
+	*x = Round{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Round) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Round) ProtoMessage() {}
+
+func (x *Round) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Round.ProtoReflect.Descriptor instead.
+func (*Round) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Round) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *Round) GetGameMasterUserId() string {
+	if x != nil {
+		return x.GameMasterUserId
+	}
+	return ""
+}
+
+func (x *Round) GetGameMasterImageId() string {
+	if x != nil {
+		return x.GameMasterImageId
+	}
+	return ""
+}
+
+func (x *Round) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+func (x *Round) GetHunterSubmissions() []*HunterSubmission {
+	if x != nil {
+		return x.HunterSubmissions
+	}
+	return nil
+}
+
+func (x *Round) GetResults() []*RoundResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Round) GetTurnStatus() TurnStatus {
+	if x != nil {
+		return x.TurnStatus
+	}
+	return TurnStatus_TURN_STATUS_UNSPECIFIED
+}
+
+func (x *Round) GetTurnElapsedSeconds() int32 {
+	if x != nil {
+		return x.TurnElapsedSeconds
+	}
+	return 0
+}
+
+func (x *Round) GetHintGeneration() *HintGeneration {
+	if x != nil {
+		return x.HintGeneration
+	}
+	return nil
+}
+
+func (x *Round) GetVotingEndsAt() string {
+	if x != nil {
+		return x.VotingEndsAt
+	}
+	return ""
+}
+
+func (x *Round) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+// Game represents a game session.
+type Game struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Status        GameStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=scene_hunter.v1.GameStatus" json:"status,omitempty"`
+	TotalRounds   int32                  `protobuf:"varint,3,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	CurrentRound  int32                  `protobuf:"varint,4,opt,name=current_round,json=currentRound,proto3" json:"current_round,omitempty"`
+	Players       []*Player              `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Rounds        []*Round               `protobuf:"bytes,6,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Language      string                 `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"` // Language of the hints
+	Difficulty    HintDifficulty         `protobuf:"varint,10,opt,name=difficulty,proto3,enum=scene_hunter.v1.HintDifficulty" json:"difficulty,omitempty"`
+	Teams         []*Team                `protobuf:"bytes,11,rep,name=teams,proto3" json:"teams,omitempty"`                           // Empty unless players compete as teams
+	ScenePool     bool                   `protobuf:"varint,12,opt,name=scene_pool,json=scenePool,proto3" json:"scene_pool,omitempty"` // Rounds use scenes uploaded to the room instead of game master photos
+	Settings      *GameSettings          `protobuf:"bytes,13,opt,name=settings,proto3" json:"settings,omitempty"`
+	Spectators    []*Spectator           `protobuf:"bytes,14,rep,name=spectators,proto3" json:"spectators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Game) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *Game) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *Game) GetTotalRounds() int32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *Game) GetCurrentRound() int32 {
+	if x != nil {
+		return x.CurrentRound
+	}
+	return 0
+}
+
+func (x *Game) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Game) GetRounds() []*Round {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *Game) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Game) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Game) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Game) GetDifficulty() HintDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return HintDifficulty_HINT_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *Game) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *Game) GetScenePool() bool {
+	if x != nil {
+		return x.ScenePool
+	}
+	return false
+}
+
+func (x *Game) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Game) GetSpectators() []*Spectator {
+	if x != nil {
+		return x.Spectators
+	}
+	return nil
+}
+
+// StartGameRequest starts a new game.
+type StartGameRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RoomId              string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TotalRounds         int32                  `protobuf:"varint,2,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	GameMasterUserId    string                 `protobuf:"bytes,3,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
+	Language            string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"` // Language of the hints, ja when empty
+	Difficulty          HintDifficulty         `protobuf:"varint,5,opt,name=difficulty,proto3,enum=scene_hunter.v1.HintDifficulty" json:"difficulty,omitempty"`
+	ScenePool           bool                   `protobuf:"varint,6,opt,name=scene_pool,json=scenePool,proto3" json:"scene_pool,omitempty"`                                 // Rounds use scenes uploaded to the room instead of game master photos
+	TurnSeconds         int32                  `protobuf:"varint,7,opt,name=turn_seconds,json=turnSeconds,proto3" json:"turn_seconds,omitempty"`                           // Length of the hunters' turn, 60 when zero
+	HintCount           int32                  `protobuf:"varint,8,opt,name=hint_count,json=hintCount,proto3" json:"hint_count,omitempty"`                                 // Hints in a round, 5 when zero or the number of hint_offsets_seconds if set
+	HintIntervalSeconds int32                  `protobuf:"varint,9,opt,name=hint_interval_seconds,json=hintIntervalSeconds,proto3" json:"hint_interval_seconds,omitempty"` // Time between hints, 10 when zero
+	// Release time of each hint from the start of the turn, replacing hint_interval_seconds.
+	// The first must be 0 and each must be later than the one before.
+	HintOffsetsSeconds    []int32         `protobuf:"varint,10,rep,packed,name=hint_offsets_seconds,json=hintOffsetsSeconds,proto3" json:"hint_offsets_seconds,omitempty"`
+	AttemptLimit          int32           `protobuf:"varint,11,opt,name=attempt_limit,json=attemptLimit,proto3" json:"attempt_limit,omitempty"`                                               // Photos each hunter, or each team, may submit in a round, 1 when zero
+	DistanceScoring       DistanceScoring `protobuf:"varint,12,opt,name=distance_scoring,json=distanceScoring,proto3,enum=scene_hunter.v1.DistanceScoring" json:"distance_scoring,omitempty"` // Off when unspecified
+	SpectatorDelaySeconds int32           `protobuf:"varint,13,opt,name=spectator_delay_seconds,json=spectatorDelaySeconds,proto3" json:"spectator_delay_seconds,omitempty"`                  // How far behind the game spectators see it, live when zero
+	RankingMode           RankingMode     `protobuf:"varint,14,opt,name=ranking_mode,json=rankingMode,proto3,enum=scene_hunter.v1.RankingMode" json:"ranking_mode,omitempty"`                 // The game master when unspecified
+	VotingSeconds         int32           `protobuf:"varint,15,opt,name=voting_seconds,json=votingSeconds,proto3" json:"voting_seconds,omitempty"`                                            // Time the audience has to vote, 60 when zero
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StartGameRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetTotalRounds() int32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetGameMasterUserId() string {
+	if x != nil {
+		return x.GameMasterUserId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetDifficulty() HintDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return HintDifficulty_HINT_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *StartGameRequest) GetScenePool() bool {
+	if x != nil {
+		return x.ScenePool
+	}
+	return false
+}
+
+func (x *StartGameRequest) GetTurnSeconds() int32 {
+	if x != nil {
+		return x.TurnSeconds
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetHintCount() int32 {
+	if x != nil {
+		return x.HintCount
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetHintIntervalSeconds() int32 {
+	if x != nil {
+		return x.HintIntervalSeconds
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetHintOffsetsSeconds() []int32 {
+	if x != nil {
+		return x.HintOffsetsSeconds
+	}
+	return nil
+}
+
+func (x *StartGameRequest) GetAttemptLimit() int32 {
+	if x != nil {
+		return x.AttemptLimit
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetDistanceScoring() DistanceScoring {
+	if x != nil {
+		return x.DistanceScoring
+	}
+	return DistanceScoring_DISTANCE_SCORING_UNSPECIFIED
+}
+
+func (x *StartGameRequest) GetSpectatorDelaySeconds() int32 {
+	if x != nil {
+		return x.SpectatorDelaySeconds
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetRankingMode() RankingMode {
+	if x != nil {
+		return x.RankingMode
+	}
+	return RankingMode_RANKING_MODE_UNSPECIFIED
+}
+
+func (x *StartGameRequest) GetVotingSeconds() int32 {
+	if x != nil {
+		return x.VotingSeconds
+	}
+	return 0
+}
+
+type StartGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StartGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// JoinGameRequest allows a player to join a game.
+type JoinGameRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Joins as a spectator, who watches without playing and may join after the game has started.
+	// Spectators see the photos of a round once the game master has ranked them.
+	Spectator     bool `protobuf:"varint,4,opt,name=spectator,proto3" json:"spectator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JoinGameRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+type JoinGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *JoinGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// SubmitGameMasterPhotoRequest submits the game master's photo.
+type SubmitGameMasterPhotoRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Types that are valid to be assigned to Photo:
+	//
+	//	*SubmitGameMasterPhotoRequest_ImageData
+	//	*SubmitGameMasterPhotoRequest_UploadId
+	Photo         isSubmitGameMasterPhotoRequest_Photo `protobuf_oneof:"photo"`
+	Location      *Location                            `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"` // Where the photo was taken, read from the photo when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitGameMasterPhotoRequest) Reset() {
+	*x = SubmitGameMasterPhotoRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitGameMasterPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGameMasterPhotoRequest) ProtoMessage() {}
+
+func (x *SubmitGameMasterPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGameMasterPhotoRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGameMasterPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubmitGameMasterPhotoRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SubmitGameMasterPhotoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitGameMasterPhotoRequest) GetPhoto() isSubmitGameMasterPhotoRequest_Photo {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+func (x *SubmitGameMasterPhotoRequest) GetImageData() []byte {
+	if x != nil {
+		if x, ok := x.Photo.(*SubmitGameMasterPhotoRequest_ImageData); ok {
+			return x.ImageData
+		}
+	}
+	return nil
+}
+
+func (x *SubmitGameMasterPhotoRequest) GetUploadId() string {
+	if x != nil {
+		if x, ok := x.Photo.(*SubmitGameMasterPhotoRequest_UploadId); ok {
+			return x.UploadId
+		}
+	}
+	return ""
+}
+
+func (x *SubmitGameMasterPhotoRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type isSubmitGameMasterPhotoRequest_Photo interface {
+	isSubmitGameMasterPhotoRequest_Photo()
+}
+
+type SubmitGameMasterPhotoRequest_ImageData struct {
+	ImageData []byte `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3,oneof"` // max 10MB
+}
+
+type SubmitGameMasterPhotoRequest_UploadId struct {
+	UploadId string `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3,oneof"` // Upload sent with a presigned URL or UploadPhotoStream
+}
+
+func (*SubmitGameMasterPhotoRequest_ImageData) isSubmitGameMasterPhotoRequest_Photo() {}
+
+func (*SubmitGameMasterPhotoRequest_UploadId) isSubmitGameMasterPhotoRequest_Photo() {}
+
+// SubmitGameMasterPhotoResponse returns before the hints are ready.
+// The round is TURN_STATUS_GENERATING_HINTS until the hints can be reviewed.
+type SubmitGameMasterPhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitGameMasterPhotoResponse) Reset() {
+	*x = SubmitGameMasterPhotoResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitGameMasterPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitGameMasterPhotoResponse) ProtoMessage() {}
+
+func (x *SubmitGameMasterPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitGameMasterPhotoResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGameMasterPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubmitGameMasterPhotoResponse) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+// GetHintDraftRequest gets the hints under review for the game master.
+type GetHintDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHintDraftRequest) Reset() {
+	*x = GetHintDraftRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHintDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHintDraftRequest) ProtoMessage() {}
+
+func (x *GetHintDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHintDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetHintDraftRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetHintDraftRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetHintDraftRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetHintDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHintDraftResponse) Reset() {
+	*x = GetHintDraftResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHintDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHintDraftResponse) ProtoMessage() {}
+
+func (x *GetHintDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHintDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetHintDraftResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetHintDraftResponse) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+// UpdateHintRequest rewrites the text of a hint under review.
+type UpdateHintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HintNumber    int32                  `protobuf:"varint,3,opt,name=hint_number,json=hintNumber,proto3" json:"hint_number,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHintRequest) Reset() {
+	*x = UpdateHintRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHintRequest) ProtoMessage() {}
+
+func (x *UpdateHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHintRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHintRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateHintRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *UpdateHintRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateHintRequest) GetHintNumber() int32 {
+	if x != nil {
+		return x.HintNumber
+	}
+	return 0
+}
+
+func (x *UpdateHintRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UpdateHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHintResponse) Reset() {
+	*x = UpdateHintResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHintResponse) ProtoMessage() {}
+
+func (x *UpdateHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHintResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHintResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateHintResponse) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+// ReorderHintsRequest reorders the hints under review.
+type ReorderHintsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Current hint numbers in their new order. Hints are renumbered from 1.
+	HintNumbers   []int32 `protobuf:"varint,3,rep,packed,name=hint_numbers,json=hintNumbers,proto3" json:"hint_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHintsRequest) Reset() {
+	*x = ReorderHintsRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHintsRequest) ProtoMessage() {}
+
+func (x *ReorderHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHintsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderHintsRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReorderHintsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReorderHintsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderHintsRequest) GetHintNumbers() []int32 {
+	if x != nil {
+		return x.HintNumbers
+	}
+	return nil
+}
+
+type ReorderHintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHintsResponse) Reset() {
+	*x = ReorderHintsResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHintsResponse) ProtoMessage() {}
+
+func (x *ReorderHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHintsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderHintsResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReorderHintsResponse) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+// RegenerateHintRequest asks the AI for a new hint in place of one under review.
+type RegenerateHintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HintNumber    int32                  `protobuf:"varint,3,opt,name=hint_number,json=hintNumber,proto3" json:"hint_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateHintRequest) Reset() {
+	*x = RegenerateHintRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateHintRequest) ProtoMessage() {}
+
+func (x *RegenerateHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateHintRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateHintRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RegenerateHintRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RegenerateHintRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegenerateHintRequest) GetHintNumber() int32 {
+	if x != nil {
+		return x.HintNumber
+	}
+	return 0
+}
+
+type RegenerateHintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hints         []*Hint                `protobuf:"bytes,1,rep,name=hints,proto3" json:"hints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateHintResponse) Reset() {
+	*x = RegenerateHintResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateHintResponse) ProtoMessage() {}
+
+func (x *RegenerateHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateHintResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateHintResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RegenerateHintResponse) GetHints() []*Hint {
+	if x != nil {
+		return x.Hints
+	}
+	return nil
+}
+
+// ReleaseHintsRequest ends the review and starts the hunters' turn.
+type ReleaseHintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHintsRequest) Reset() {
+	*x = ReleaseHintsRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHintsRequest) ProtoMessage() {}
+
+func (x *ReleaseHintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHintsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHintsRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReleaseHintsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ReleaseHintsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReleaseHintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHintsResponse) Reset() {
+	*x = ReleaseHintsResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHintsResponse) ProtoMessage() {}
+
+func (x *ReleaseHintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHintsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHintsResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReleaseHintsResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// SubmitHunterPhotoRequest submits a hunter's photo.
+type SubmitHunterPhotoRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoomId string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Types that are valid to be assigned to Photo:
+	//
+	//	*SubmitHunterPhotoRequest_ImageData
+	//	*SubmitHunterPhotoRequest_UploadId
+	Photo         isSubmitHunterPhotoRequest_Photo `protobuf_oneof:"photo"`
+	Location      *Location                        `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"` // Where the photo was taken, read from the photo when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitHunterPhotoRequest) Reset() {
+	*x = SubmitHunterPhotoRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitHunterPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitHunterPhotoRequest) ProtoMessage() {}
+
+func (x *SubmitHunterPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitHunterPhotoRequest.ProtoReflect.Descriptor instead.
+func (*SubmitHunterPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubmitHunterPhotoRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SubmitHunterPhotoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitHunterPhotoRequest) GetPhoto() isSubmitHunterPhotoRequest_Photo {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+func (x *SubmitHunterPhotoRequest) GetImageData() []byte {
+	if x != nil {
+		if x, ok := x.Photo.(*SubmitHunterPhotoRequest_ImageData); ok {
+			return x.ImageData
+		}
+	}
+	return nil
+}
+
+func (x *SubmitHunterPhotoRequest) GetUploadId() string {
+	if x != nil {
+		if x, ok := x.Photo.(*SubmitHunterPhotoRequest_UploadId); ok {
+			return x.UploadId
+		}
+	}
+	return ""
+}
+
+func (x *SubmitHunterPhotoRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type isSubmitHunterPhotoRequest_Photo interface {
+	isSubmitHunterPhotoRequest_Photo()
+}
+
+type SubmitHunterPhotoRequest_ImageData struct {
+	ImageData []byte `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3,oneof"` // max 10MB
+}
+
+type SubmitHunterPhotoRequest_UploadId struct {
+	UploadId string `protobuf:"bytes,5,opt,name=upload_id,json=uploadId,proto3,oneof"` // Upload sent with a presigned URL or UploadPhotoStream
+}
+
+func (*SubmitHunterPhotoRequest_ImageData) isSubmitHunterPhotoRequest_Photo() {}
+
+func (*SubmitHunterPhotoRequest_UploadId) isSubmitHunterPhotoRequest_Photo() {}
+
+type SubmitHunterPhotoResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ImageId             string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	AllHuntersSubmitted bool                   `protobuf:"varint,2,opt,name=all_hunters_submitted,json=allHuntersSubmitted,proto3" json:"all_hunters_submitted,omitempty"` // True if all hunters have decided their final photos
+	Attempt             int32                  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`                                                      // Attempt of the photo, from 1
+	Final               bool                   `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"`                                                          // True if the photo used up the attempts and is the final photo
+	Proximity           Proximity              `protobuf:"varint,5,opt,name=proximity,proto3,enum=scene_hunter.v1.Proximity" json:"proximity,omitempty"`                   // Whether the photo was taken closer than the hunter's previous location
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SubmitHunterPhotoResponse) Reset() {
+	*x = SubmitHunterPhotoResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitHunterPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitHunterPhotoResponse) ProtoMessage() {}
+
+func (x *SubmitHunterPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitHunterPhotoResponse.ProtoReflect.Descriptor instead.
+func (*SubmitHunterPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SubmitHunterPhotoResponse) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *SubmitHunterPhotoResponse) GetAllHuntersSubmitted() bool {
+	if x != nil {
+		return x.AllHuntersSubmitted
+	}
+	return false
+}
+
+func (x *SubmitHunterPhotoResponse) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *SubmitHunterPhotoResponse) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *SubmitHunterPhotoResponse) GetProximity() Proximity {
+	if x != nil {
+		return x.Proximity
+	}
+	return Proximity_PROXIMITY_UNSPECIFIED
+}
+
+// PickFinalPhotoRequest picks the attempt a hunter, or their team in team mode, is ranked by.
+// The hunter cannot submit again after picking.
+type PickFinalPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Attempt       int32                  `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickFinalPhotoRequest) Reset() {
+	*x = PickFinalPhotoRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickFinalPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickFinalPhotoRequest) ProtoMessage() {}
+
+func (x *PickFinalPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickFinalPhotoRequest.ProtoReflect.Descriptor instead.
+func (*PickFinalPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PickFinalPhotoRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *PickFinalPhotoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PickFinalPhotoRequest) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type PickFinalPhotoResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AllHuntersSubmitted bool                   `protobuf:"varint,1,opt,name=all_hunters_submitted,json=allHuntersSubmitted,proto3" json:"all_hunters_submitted,omitempty"` // True if all hunters have decided their final photos
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PickFinalPhotoResponse) Reset() {
+	*x = PickFinalPhotoResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickFinalPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickFinalPhotoResponse) ProtoMessage() {}
+
+func (x *PickFinalPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickFinalPhotoResponse.ProtoReflect.Descriptor instead.
+func (*PickFinalPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PickFinalPhotoResponse) GetAllHuntersSubmitted() bool {
+	if x != nil {
+		return x.AllHuntersSubmitted
+	}
+	return false
+}
+
+// CheckProximityRequest tells a hunter whether they are warmer or colder
+// than at their previous check or photo in the current round.
+type CheckProximityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckProximityRequest) Reset() {
+	*x = CheckProximityRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckProximityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckProximityRequest) ProtoMessage() {}
+
+func (x *CheckProximityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckProximityRequest.ProtoReflect.Descriptor instead.
+func (*CheckProximityRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CheckProximityRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CheckProximityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckProximityRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type CheckProximityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proximity     Proximity              `protobuf:"varint,1,opt,name=proximity,proto3,enum=scene_hunter.v1.Proximity" json:"proximity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckProximityResponse) Reset() {
+	*x = CheckProximityResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckProximityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckProximityResponse) ProtoMessage() {}
+
+func (x *CheckProximityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckProximityResponse.ProtoReflect.Descriptor instead.
+func (*CheckProximityResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CheckProximityResponse) GetProximity() Proximity {
+	if x != nil {
+		return x.Proximity
+	}
+	return Proximity_PROXIMITY_UNSPECIFIED
+}
+
+// GetGameStateRequest gets the current game state.
+type GetGameStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStateRequest) Reset() {
+	*x = GetGameStateRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateRequest) ProtoMessage() {}
+
+func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGameStateRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type GetGameStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStateResponse) Reset() {
+	*x = GetGameStateResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateResponse) ProtoMessage() {}
+
+func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGameStateResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// GetGameEventsRequest reads the event stream of a game, which records every change of the game.
+type GetGameEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	AfterId       string                 `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"` // Only events after this one, from the start when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameEventsRequest) Reset() {
+	*x = GetGameEventsRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameEventsRequest) ProtoMessage() {}
+
+func (x *GetGameEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetGameEventsRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetGameEventsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetGameEventsRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+type GetGameEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*GameEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Oldest first, at most 100; read again after the last one for more
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameEventsResponse) Reset() {
+	*x = GetGameEventsResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameEventsResponse) ProtoMessage() {}
+
+func (x *GetGameEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetGameEventsResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetGameEventsResponse) GetEvents() []*GameEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// StartNextRoundRequest starts the next round. In scene pool games it picks another scene
+// for the current round instead when the hints of its scene could not be generated.
+type StartNextRoundRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RoomId           string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	GameMasterUserId string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
+	// Scene of the round in scene pool games, chosen by the game master. A random unused scene when empty
+	SceneImageId  string `protobuf:"bytes,3,opt,name=scene_image_id,json=sceneImageId,proto3" json:"scene_image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartNextRoundRequest) Reset() {
+	*x = StartNextRoundRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNextRoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNextRoundRequest) ProtoMessage() {}
+
+func (x *StartNextRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNextRoundRequest.ProtoReflect.Descriptor instead.
+func (*StartNextRoundRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StartNextRoundRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StartNextRoundRequest) GetGameMasterUserId() string {
+	if x != nil {
+		return x.GameMasterUserId
+	}
+	return ""
+}
+
+func (x *StartNextRoundRequest) GetSceneImageId() string {
+	if x != nil {
+		return x.SceneImageId
+	}
+	return ""
+}
+
+type StartNextRoundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartNextRoundResponse) Reset() {
+	*x = StartNextRoundResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNextRoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNextRoundResponse) ProtoMessage() {}
+
+func (x *StartNextRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNextRoundResponse.ProtoReflect.Descriptor instead.
+func (*StartNextRoundResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StartNextRoundResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// GetHunterPhotosRequest gets the final photo of each hunter that has decided one
+// in the current round. Only the game master of the round may get them.
+type GetHunterPhotosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHunterPhotosRequest) Reset() {
+	*x = GetHunterPhotosRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHunterPhotosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHunterPhotosRequest) ProtoMessage() {}
+
+func (x *GetHunterPhotosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHunterPhotosRequest.ProtoReflect.Descriptor instead.
+func (*GetHunterPhotosRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetHunterPhotosRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+type GetHunterPhotosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*HunterSubmission    `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHunterPhotosResponse) Reset() {
+	*x = GetHunterPhotosResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHunterPhotosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHunterPhotosResponse) ProtoMessage() {}
+
+func (x *GetHunterPhotosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHunterPhotosResponse.ProtoReflect.Descriptor instead.
+func (*GetHunterPhotosResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetHunterPhotosResponse) GetSubmissions() []*HunterSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// RankSelection represents a ranking assigned by the game master.
+// Teams are ranked instead of players in team mode.
+type RankSelection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*RankSelection_UserId
+	//	*RankSelection_TeamId
+	Target        isRankSelection_Target `protobuf_oneof:"target"`
+	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankSelection) Reset() {
+	*x = RankSelection{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankSelection) ProtoMessage() {}
+
+func (x *RankSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankSelection.ProtoReflect.Descriptor instead.
+func (*RankSelection) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RankSelection) GetTarget() isRankSelection_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RankSelection) GetUserId() string {
+	if x != nil {
+		if x, ok := x.Target.(*RankSelection_UserId); ok {
+			return x.UserId
+		}
+	}
+	return ""
+}
+
+func (x *RankSelection) GetTeamId() string {
+	if x != nil {
+		if x, ok := x.Target.(*RankSelection_TeamId); ok {
+			return x.TeamId
+		}
+	}
+	return ""
+}
+
+func (x *RankSelection) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type isRankSelection_Target interface {
+	isRankSelection_Target()
+}
+
+type RankSelection_UserId struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type RankSelection_TeamId struct {
+	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3,oneof"`
+}
+
+func (*RankSelection_UserId) isRankSelection_Target() {}
+
+func (*RankSelection_TeamId) isRankSelection_Target() {}
+
+// SelectWinnersRequest allows game master to select winners and assign ranks.
+type SelectWinnersRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RoomId           string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	GameMasterUserId string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
+	Rankings         []*RankSelection       `protobuf:"bytes,3,rep,name=rankings,proto3" json:"rankings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SelectWinnersRequest) Reset() {
+	*x = SelectWinnersRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectWinnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectWinnersRequest) ProtoMessage() {}
+
+func (x *SelectWinnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectWinnersRequest.ProtoReflect.Descriptor instead.
+func (*SelectWinnersRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SelectWinnersRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *SelectWinnersRequest) GetGameMasterUserId() string {
+	if x != nil {
+		return x.GameMasterUserId
 	}
-	return nil
+	return ""
 }
 
-func (x *Game) GetRounds() []*Round {
+func (x *SelectWinnersRequest) GetRankings() []*RankSelection {
 	if x != nil {
-		return x.Rounds
+		return x.Rankings
 	}
 	return nil
 }
 
-func (x *Game) GetCreatedAt() string {
+type SelectWinnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectWinnersResponse) Reset() {
+	*x = SelectWinnersResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectWinnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectWinnersResponse) ProtoMessage() {}
+
+func (x *SelectWinnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[52]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Game) GetUpdatedAt() string {
+// Deprecated: Use SelectWinnersResponse.ProtoReflect.Descriptor instead.
+func (*SelectWinnersResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SelectWinnersResponse) GetGame() *Game {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Game
 	}
-	return ""
+	return nil
 }
 
-// StartGameRequest starts a new game.
-type StartGameRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	RoomId           string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	TotalRounds      int32                  `protobuf:"varint,2,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
-	GameMasterUserId string                 `protobuf:"bytes,3,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// SubmitVoteRequest ranks the photos of the current round, best first, for audience ranking.
+// Photos are identified by their hunter, or by their team in team mode.
+// The voter ranks every photo but their own, or their team's, and votes once in a round.
+type SubmitVoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ranking       []string               `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGameRequest) Reset() {
-	*x = StartGameRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[6]
+func (x *SubmitVoteRequest) Reset() {
+	*x = SubmitVoteRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGameRequest) String() string {
+func (x *SubmitVoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameRequest) ProtoMessage() {}
+func (*SubmitVoteRequest) ProtoMessage() {}
 
-func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[6]
+func (x *SubmitVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,54 +4092,54 @@ func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
-func (*StartGameRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SubmitVoteRequest.ProtoReflect.Descriptor instead.
+func (*SubmitVoteRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *StartGameRequest) GetRoomId() string {
+func (x *SubmitVoteRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *StartGameRequest) GetTotalRounds() int32 {
+func (x *SubmitVoteRequest) GetUserId() string {
 	if x != nil {
-		return x.TotalRounds
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *StartGameRequest) GetGameMasterUserId() string {
+func (x *SubmitVoteRequest) GetRanking() []string {
 	if x != nil {
-		return x.GameMasterUserId
+		return x.Ranking
 	}
-	return ""
+	return nil
 }
 
-type StartGameResponse struct {
+type SubmitVoteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	VotingClosed  bool                   `protobuf:"varint,1,opt,name=voting_closed,json=votingClosed,proto3" json:"voting_closed,omitempty"` // True if everyone has voted and the round has been ranked
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGameResponse) Reset() {
-	*x = StartGameResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[7]
+func (x *SubmitVoteResponse) Reset() {
+	*x = SubmitVoteResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGameResponse) String() string {
+func (x *SubmitVoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameResponse) ProtoMessage() {}
+func (*SubmitVoteResponse) ProtoMessage() {}
 
-func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[7]
+func (x *SubmitVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,43 +4150,43 @@ func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
-func (*StartGameResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SubmitVoteResponse.ProtoReflect.Descriptor instead.
+func (*SubmitVoteResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *StartGameResponse) GetGame() *Game {
+func (x *SubmitVoteResponse) GetVotingClosed() bool {
 	if x != nil {
-		return x.Game
+		return x.VotingClosed
 	}
-	return nil
+	return false
 }
 
-// JoinGameRequest allows a player to join a game.
-type JoinGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CloseVotingRequest ranks the photos of the current round by the votes so far
+// once the voting time is over.
+type CloseVotingRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RoomId           string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	GameMasterUserId string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *JoinGameRequest) Reset() {
-	*x = JoinGameRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[8]
+func (x *CloseVotingRequest) Reset() {
+	*x = CloseVotingRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGameRequest) String() string {
+func (x *CloseVotingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGameRequest) ProtoMessage() {}
+func (*CloseVotingRequest) ProtoMessage() {}
 
-func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[8]
+func (x *CloseVotingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,54 +4197,47 @@ func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
-func (*JoinGameRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CloseVotingRequest.ProtoReflect.Descriptor instead.
+func (*CloseVotingRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *JoinGameRequest) GetRoomId() string {
+func (x *CloseVotingRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *JoinGameRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *JoinGameRequest) GetName() string {
+func (x *CloseVotingRequest) GetGameMasterUserId() string {
 	if x != nil {
-		return x.Name
+		return x.GameMasterUserId
 	}
 	return ""
 }
 
-type JoinGameResponse struct {
+type CloseVotingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinGameResponse) Reset() {
-	*x = JoinGameResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[9]
+func (x *CloseVotingResponse) Reset() {
+	*x = CloseVotingResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGameResponse) String() string {
+func (x *CloseVotingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGameResponse) ProtoMessage() {}
+func (*CloseVotingResponse) ProtoMessage() {}
 
-func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[9]
+func (x *CloseVotingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,43 +4248,43 @@ func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
-func (*JoinGameResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CloseVotingResponse.ProtoReflect.Descriptor instead.
+func (*CloseVotingResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *JoinGameResponse) GetGame() *Game {
+func (x *CloseVotingResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-// SubmitGameMasterPhotoRequest submits the game master's photo.
-type SubmitGameMasterPhotoRequest struct {
+// BeginGameRequest lets the admin start a waiting game once enough players have joined,
+// so that its first round can start.
+type BeginGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ImageData     []byte                 `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"` // max 10MB
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitGameMasterPhotoRequest) Reset() {
-	*x = SubmitGameMasterPhotoRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[10]
+func (x *BeginGameRequest) Reset() {
+	*x = BeginGameRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitGameMasterPhotoRequest) String() string {
+func (x *BeginGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitGameMasterPhotoRequest) ProtoMessage() {}
+func (*BeginGameRequest) ProtoMessage() {}
 
-func (x *SubmitGameMasterPhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[10]
+func (x *BeginGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,55 +4295,47 @@ func (x *SubmitGameMasterPhotoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitGameMasterPhotoRequest.ProtoReflect.Descriptor instead.
-func (*SubmitGameMasterPhotoRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BeginGameRequest.ProtoReflect.Descriptor instead.
+func (*BeginGameRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SubmitGameMasterPhotoRequest) GetRoomId() string {
+func (x *BeginGameRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *SubmitGameMasterPhotoRequest) GetUserId() string {
+func (x *BeginGameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SubmitGameMasterPhotoRequest) GetImageData() []byte {
-	if x != nil {
-		return x.ImageData
-	}
-	return nil
-}
-
-type SubmitGameMasterPhotoResponse struct {
+type BeginGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	Hints         []*Hint                `protobuf:"bytes,2,rep,name=hints,proto3" json:"hints,omitempty"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitGameMasterPhotoResponse) Reset() {
-	*x = SubmitGameMasterPhotoResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[11]
+func (x *BeginGameResponse) Reset() {
+	*x = BeginGameResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitGameMasterPhotoResponse) String() string {
+func (x *BeginGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitGameMasterPhotoResponse) ProtoMessage() {}
+func (*BeginGameResponse) ProtoMessage() {}
 
-func (x *SubmitGameMasterPhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[11]
+func (x *BeginGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,51 +4346,43 @@ func (x *SubmitGameMasterPhotoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitGameMasterPhotoResponse.ProtoReflect.Descriptor instead.
-func (*SubmitGameMasterPhotoResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *SubmitGameMasterPhotoResponse) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
+// Deprecated: Use BeginGameResponse.ProtoReflect.Descriptor instead.
+func (*BeginGameResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SubmitGameMasterPhotoResponse) GetHints() []*Hint {
+func (x *BeginGameResponse) GetGame() *Game {
 	if x != nil {
-		return x.Hints
+		return x.Game
 	}
 	return nil
 }
 
-// SubmitHunterPhotoRequest submits a hunter's photo.
-type SubmitHunterPhotoRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RoomId         string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ImageData      []byte                 `protobuf:"bytes,3,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"` // max 10MB
-	ElapsedSeconds int32                  `protobuf:"varint,4,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// PauseGameRequest lets the admin pause the game.
+// The turn clock and the voting time stop, and the players cannot play until it is resumed.
+type PauseGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitHunterPhotoRequest) Reset() {
-	*x = SubmitHunterPhotoRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[12]
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitHunterPhotoRequest) String() string {
+func (x *PauseGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitHunterPhotoRequest) ProtoMessage() {}
+func (*PauseGameRequest) ProtoMessage() {}
 
-func (x *SubmitHunterPhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[12]
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,62 +4393,47 @@ func (x *SubmitHunterPhotoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitHunterPhotoRequest.ProtoReflect.Descriptor instead.
-func (*SubmitHunterPhotoRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SubmitHunterPhotoRequest) GetRoomId() string {
+func (x *PauseGameRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *SubmitHunterPhotoRequest) GetUserId() string {
+func (x *PauseGameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SubmitHunterPhotoRequest) GetImageData() []byte {
-	if x != nil {
-		return x.ImageData
-	}
-	return nil
-}
-
-func (x *SubmitHunterPhotoRequest) GetElapsedSeconds() int32 {
-	if x != nil {
-		return x.ElapsedSeconds
-	}
-	return 0
-}
-
-type SubmitHunterPhotoResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ImageId             string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	AllHuntersSubmitted bool                   `protobuf:"varint,2,opt,name=all_hunters_submitted,json=allHuntersSubmitted,proto3" json:"all_hunters_submitted,omitempty"` // True if all hunters have submitted their photos
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type PauseGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitHunterPhotoResponse) Reset() {
-	*x = SubmitHunterPhotoResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[13]
+func (x *PauseGameResponse) Reset() {
+	*x = PauseGameResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitHunterPhotoResponse) String() string {
+func (x *PauseGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitHunterPhotoResponse) ProtoMessage() {}
+func (*PauseGameResponse) ProtoMessage() {}
 
-func (x *SubmitHunterPhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[13]
+func (x *PauseGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,48 +4444,42 @@ func (x *SubmitHunterPhotoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitHunterPhotoResponse.ProtoReflect.Descriptor instead.
-func (*SubmitHunterPhotoResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *SubmitHunterPhotoResponse) GetImageId() string {
-	if x != nil {
-		return x.ImageId
-	}
-	return ""
+// Deprecated: Use PauseGameResponse.ProtoReflect.Descriptor instead.
+func (*PauseGameResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SubmitHunterPhotoResponse) GetAllHuntersSubmitted() bool {
+func (x *PauseGameResponse) GetGame() *Game {
 	if x != nil {
-		return x.AllHuntersSubmitted
+		return x.Game
 	}
-	return false
+	return nil
 }
 
-// GetGameStateRequest gets the current game state.
-type GetGameStateRequest struct {
+// ResumeGameRequest lets the admin resume a paused game.
+type ResumeGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStateRequest) Reset() {
-	*x = GetGameStateRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[14]
+func (x *ResumeGameRequest) Reset() {
+	*x = ResumeGameRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStateRequest) String() string {
+func (x *ResumeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStateRequest) ProtoMessage() {}
+func (*ResumeGameRequest) ProtoMessage() {}
 
-func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[14]
+func (x *ResumeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,40 +4490,47 @@ func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
-func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ResumeGameRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGameRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetGameStateRequest) GetRoomId() string {
+func (x *ResumeGameRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-type GetGameStateResponse struct {
+func (x *ResumeGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResumeGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameStateResponse) Reset() {
-	*x = GetGameStateResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[15]
+func (x *ResumeGameResponse) Reset() {
+	*x = ResumeGameResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameStateResponse) String() string {
+func (x *ResumeGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameStateResponse) ProtoMessage() {}
+func (*ResumeGameResponse) ProtoMessage() {}
 
-func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[15]
+func (x *ResumeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,42 +4541,44 @@ func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
-func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ResumeGameResponse.ProtoReflect.Descriptor instead.
+func (*ResumeGameResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetGameStateResponse) GetGame() *Game {
+func (x *ResumeGameResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-// StartNextRoundRequest starts the next round.
-type StartNextRoundRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	RoomId           string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	GameMasterUserId string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// SkipRoundRequest lets the admin void the current round with no points.
+// The next round starts at once with the next player as its game master,
+// or the game finishes after the last round.
+type SkipRoundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartNextRoundRequest) Reset() {
-	*x = StartNextRoundRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[16]
+func (x *SkipRoundRequest) Reset() {
+	*x = SkipRoundRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartNextRoundRequest) String() string {
+func (x *SkipRoundRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartNextRoundRequest) ProtoMessage() {}
+func (*SkipRoundRequest) ProtoMessage() {}
 
-func (x *StartNextRoundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[16]
+func (x *SkipRoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,47 +4589,47 @@ func (x *StartNextRoundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartNextRoundRequest.ProtoReflect.Descriptor instead.
-func (*StartNextRoundRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SkipRoundRequest.ProtoReflect.Descriptor instead.
+func (*SkipRoundRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StartNextRoundRequest) GetRoomId() string {
+func (x *SkipRoundRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *StartNextRoundRequest) GetGameMasterUserId() string {
+func (x *SkipRoundRequest) GetUserId() string {
 	if x != nil {
-		return x.GameMasterUserId
+		return x.UserId
 	}
 	return ""
 }
 
-type StartNextRoundResponse struct {
+type SkipRoundResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartNextRoundResponse) Reset() {
-	*x = StartNextRoundResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[17]
+func (x *SkipRoundResponse) Reset() {
+	*x = SkipRoundResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartNextRoundResponse) String() string {
+func (x *SkipRoundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartNextRoundResponse) ProtoMessage() {}
+func (*SkipRoundResponse) ProtoMessage() {}
 
-func (x *StartNextRoundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[17]
+func (x *SkipRoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,41 +4640,41 @@ func (x *StartNextRoundResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartNextRoundResponse.ProtoReflect.Descriptor instead.
-func (*StartNextRoundResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SkipRoundResponse.ProtoReflect.Descriptor instead.
+func (*SkipRoundResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *StartNextRoundResponse) GetGame() *Game {
+func (x *SkipRoundResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-// GetHunterPhotosRequest gets all hunter photo submissions for the current round.
-type GetHunterPhotosRequest struct {
+// EndGameRequest ends the game and calculates final rankings.
+type EndGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHunterPhotosRequest) Reset() {
-	*x = GetHunterPhotosRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[18]
+func (x *EndGameRequest) Reset() {
+	*x = EndGameRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHunterPhotosRequest) String() string {
+func (x *EndGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHunterPhotosRequest) ProtoMessage() {}
+func (*EndGameRequest) ProtoMessage() {}
 
-func (x *GetHunterPhotosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[18]
+func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,40 +4685,42 @@ func (x *GetHunterPhotosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHunterPhotosRequest.ProtoReflect.Descriptor instead.
-func (*GetHunterPhotosRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use EndGameRequest.ProtoReflect.Descriptor instead.
+func (*EndGameRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetHunterPhotosRequest) GetRoomId() string {
+func (x *EndGameRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-type GetHunterPhotosResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submissions   []*HunterSubmission    `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EndGameResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Game              *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	FinalRankings     []*Player              `protobuf:"bytes,2,rep,name=final_rankings,json=finalRankings,proto3" json:"final_rankings,omitempty"`
+	FinalTeamRankings []*Team                `protobuf:"bytes,3,rep,name=final_team_rankings,json=finalTeamRankings,proto3" json:"final_team_rankings,omitempty"` // Empty unless players compete as teams
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetHunterPhotosResponse) Reset() {
-	*x = GetHunterPhotosResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[19]
+func (x *EndGameResponse) Reset() {
+	*x = EndGameResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHunterPhotosResponse) String() string {
+func (x *EndGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHunterPhotosResponse) ProtoMessage() {}
+func (*EndGameResponse) ProtoMessage() {}
 
-func (x *GetHunterPhotosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[19]
+func (x *EndGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,42 +4731,56 @@ func (x *GetHunterPhotosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHunterPhotosResponse.ProtoReflect.Descriptor instead.
-func (*GetHunterPhotosResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EndGameResponse.ProtoReflect.Descriptor instead.
+func (*EndGameResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetHunterPhotosResponse) GetSubmissions() []*HunterSubmission {
+func (x *EndGameResponse) GetGame() *Game {
 	if x != nil {
-		return x.Submissions
+		return x.Game
 	}
 	return nil
 }
 
-// RankSelection represents a ranking assigned by the game master.
-type RankSelection struct {
+func (x *EndGameResponse) GetFinalRankings() []*Player {
+	if x != nil {
+		return x.FinalRankings
+	}
+	return nil
+}
+
+func (x *EndGameResponse) GetFinalTeamRankings() []*Team {
+	if x != nil {
+		return x.FinalTeamRankings
+	}
+	return nil
+}
+
+// TeamAssignment is a team chosen by the admin.
+type TeamAssignment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MemberUserIds []string               `protobuf:"bytes,2,rep,name=member_user_ids,json=memberUserIds,proto3" json:"member_user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RankSelection) Reset() {
-	*x = RankSelection{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[20]
+func (x *TeamAssignment) Reset() {
+	*x = TeamAssignment{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RankSelection) String() string {
+func (x *TeamAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RankSelection) ProtoMessage() {}
+func (*TeamAssignment) ProtoMessage() {}
 
-func (x *RankSelection) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[20]
+func (x *TeamAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,50 +4791,51 @@ func (x *RankSelection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RankSelection.ProtoReflect.Descriptor instead.
-func (*RankSelection) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TeamAssignment.ProtoReflect.Descriptor instead.
+func (*TeamAssignment) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *RankSelection) GetUserId() string {
+func (x *TeamAssignment) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RankSelection) GetRank() int32 {
+func (x *TeamAssignment) GetMemberUserIds() []string {
 	if x != nil {
-		return x.Rank
+		return x.MemberUserIds
 	}
-	return 0
+	return nil
 }
 
-// SelectWinnersRequest allows game master to select winners and assign ranks.
-type SelectWinnersRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	RoomId           string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	GameMasterUserId string                 `protobuf:"bytes,2,opt,name=game_master_user_id,json=gameMasterUserId,proto3" json:"game_master_user_id,omitempty"`
-	Rankings         []*RankSelection       `protobuf:"bytes,3,rep,name=rankings,proto3" json:"rankings,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// AssignTeamsRequest puts the players into teams chosen by the admin.
+// No teams return the game to individual mode.
+type AssignTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Teams         []*TeamAssignment      `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SelectWinnersRequest) Reset() {
-	*x = SelectWinnersRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[21]
+func (x *AssignTeamsRequest) Reset() {
+	*x = AssignTeamsRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SelectWinnersRequest) String() string {
+func (x *AssignTeamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelectWinnersRequest) ProtoMessage() {}
+func (*AssignTeamsRequest) ProtoMessage() {}
 
-func (x *SelectWinnersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[21]
+func (x *AssignTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,54 +4846,54 @@ func (x *SelectWinnersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelectWinnersRequest.ProtoReflect.Descriptor instead.
-func (*SelectWinnersRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AssignTeamsRequest.ProtoReflect.Descriptor instead.
+func (*AssignTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SelectWinnersRequest) GetRoomId() string {
+func (x *AssignTeamsRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-func (x *SelectWinnersRequest) GetGameMasterUserId() string {
+func (x *AssignTeamsRequest) GetUserId() string {
 	if x != nil {
-		return x.GameMasterUserId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SelectWinnersRequest) GetRankings() []*RankSelection {
+func (x *AssignTeamsRequest) GetTeams() []*TeamAssignment {
 	if x != nil {
-		return x.Rankings
+		return x.Teams
 	}
 	return nil
 }
 
-type SelectWinnersResponse struct {
+type AssignTeamsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SelectWinnersResponse) Reset() {
-	*x = SelectWinnersResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[22]
+func (x *AssignTeamsResponse) Reset() {
+	*x = AssignTeamsResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SelectWinnersResponse) String() string {
+func (x *AssignTeamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelectWinnersResponse) ProtoMessage() {}
+func (*AssignTeamsResponse) ProtoMessage() {}
 
-func (x *SelectWinnersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[22]
+func (x *AssignTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,41 +4904,43 @@ func (x *SelectWinnersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelectWinnersResponse.ProtoReflect.Descriptor instead.
-func (*SelectWinnersResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use AssignTeamsResponse.ProtoReflect.Descriptor instead.
+func (*AssignTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *SelectWinnersResponse) GetGame() *Game {
+func (x *AssignTeamsResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-// EndGameRequest ends the game and calculates final rankings.
-type EndGameRequest struct {
+// BalanceTeamsRequest splits the players into teams of nearly equal size.
+type BalanceTeamsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamCount     int32                  `protobuf:"varint,3,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EndGameRequest) Reset() {
-	*x = EndGameRequest{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[23]
+func (x *BalanceTeamsRequest) Reset() {
+	*x = BalanceTeamsRequest{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndGameRequest) String() string {
+func (x *BalanceTeamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndGameRequest) ProtoMessage() {}
+func (*BalanceTeamsRequest) ProtoMessage() {}
 
-func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[23]
+func (x *BalanceTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,41 +4951,54 @@ func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndGameRequest.ProtoReflect.Descriptor instead.
-func (*EndGameRequest) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BalanceTeamsRequest.ProtoReflect.Descriptor instead.
+func (*BalanceTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *EndGameRequest) GetRoomId() string {
+func (x *BalanceTeamsRequest) GetRoomId() string {
 	if x != nil {
 		return x.RoomId
 	}
 	return ""
 }
 
-type EndGameResponse struct {
+func (x *BalanceTeamsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BalanceTeamsRequest) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+type BalanceTeamsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	FinalRankings []*Player              `protobuf:"bytes,2,rep,name=final_rankings,json=finalRankings,proto3" json:"final_rankings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EndGameResponse) Reset() {
-	*x = EndGameResponse{}
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[24]
+func (x *BalanceTeamsResponse) Reset() {
+	*x = BalanceTeamsResponse{}
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EndGameResponse) String() string {
+func (x *BalanceTeamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndGameResponse) ProtoMessage() {}
+func (*BalanceTeamsResponse) ProtoMessage() {}
 
-func (x *EndGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_scene_hunter_v1_game_proto_msgTypes[24]
+func (x *BalanceTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_game_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,49 +5009,119 @@ func (x *EndGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndGameResponse.ProtoReflect.Descriptor instead.
-func (*EndGameResponse) Descriptor() ([]byte, []int) {
-	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use BalanceTeamsResponse.ProtoReflect.Descriptor instead.
+func (*BalanceTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_game_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *EndGameResponse) GetGame() *Game {
+func (x *BalanceTeamsResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-func (x *EndGameResponse) GetFinalRankings() []*Player {
-	if x != nil {
-		return x.FinalRankings
-	}
-	return nil
-}
-
 var File_scene_hunter_v1_game_proto protoreflect.FileDescriptor
 
 const file_scene_hunter_v1_game_proto_rawDesc = "" +
 	"\n" +
-	"\x1ascene_hunter/v1/game.proto\x12\x0fscene_hunter.v1\x1a\x1bbuf/validate/validate.proto\"\xd1\x01\n" +
+	"\x1ascene_hunter/v1/game.proto\x12\x0fscene_hunter.v1\x1a\x1bbuf/validate/validate.proto\"\xea\x01\n" +
 	"\x06Player\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
 	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12$\n" +
 	"\x0eis_game_master\x18\x03 \x01(\bR\fisGameMaster\x12\x19\n" +
 	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\x12!\n" +
 	"\ftotal_points\x18\x05 \x01(\x05R\vtotalPoints\x12!\n" +
-	"\fis_connected\x18\x06 \x01(\bR\visConnected\"F\n" +
+	"\fis_connected\x18\x06 \x01(\bR\visConnected\x12\x17\n" +
+	"\ateam_id\x18\a \x01(\tR\x06teamId\"\x83\x05\n" +
+	"\tGameEvent\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.scene_hunter.v1.GameEventTypeR\x04type\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fround_number\x18\x03 \x01(\x05R\vroundNumber\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12:\n" +
+	"\x05photo\x18\x06 \x01(\v2\".scene_hunter.v1.PhotoEventPayloadH\x00R\x05photo\x12:\n" +
+	"\x05hints\x18\a \x01(\v2\".scene_hunter.v1.HintsEventPayloadH\x00R\x05hints\x12M\n" +
+	"\fhint_attempt\x18\b \x01(\v2(.scene_hunter.v1.HintAttemptEventPayloadH\x00R\vhintAttempt\x12@\n" +
+	"\aresults\x18\t \x01(\v2$.scene_hunter.v1.ResultsEventPayloadH\x00R\aresults\x12=\n" +
+	"\x06voting\x18\n" +
+	" \x01(\v2#.scene_hunter.v1.VotingEventPayloadH\x00R\x06voting\x12F\n" +
+	"\tproximity\x18\v \x01(\v2&.scene_hunter.v1.ProximityEventPayloadH\x00R\tproximity\x12:\n" +
+	"\x05teams\x18\f \x01(\v2\".scene_hunter.v1.TeamsEventPayloadH\x00R\x05teamsB\t\n" +
+	"\apayload\"\x90\x01\n" +
+	"\x11PhotoEventPayload\x12\x19\n" +
+	"\bimage_id\x18\x01 \x01(\tR\aimageId\x12\x18\n" +
+	"\aattempt\x18\x02 \x01(\x05R\aattempt\x120\n" +
+	"\x14submitted_at_seconds\x18\x03 \x01(\x05R\x12submittedAtSeconds\x12\x14\n" +
+	"\x05final\x18\x04 \x01(\bR\x05final\"@\n" +
+	"\x11HintsEventPayload\x12+\n" +
+	"\x05hints\x18\x01 \x03(\v2\x15.scene_hunter.v1.HintR\x05hints\"k\n" +
+	"\x17HintAttemptEventPayload\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x12\x1c\n" +
+	"\tgenerated\x18\x02 \x01(\x05R\tgenerated\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"M\n" +
+	"\x13ResultsEventPayload\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.scene_hunter.v1.RoundResultR\aresults\".\n" +
+	"\x12VotingEventPayload\x12\x18\n" +
+	"\aballots\x18\x01 \x01(\x05R\aballots\"Q\n" +
+	"\x15ProximityEventPayload\x128\n" +
+	"\tproximity\x18\x01 \x01(\x0e2\x1a.scene_hunter.v1.ProximityR\tproximity\"@\n" +
+	"\x11TeamsEventPayload\x12+\n" +
+	"\x05teams\x18\x01 \x03(\v2\x15.scene_hunter.v1.TeamR\x05teams\"M\n" +
+	"\tSpectator\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\"\x93\x01\n" +
+	"\x04Team\x12!\n" +
+	"\ateam_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06teamId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12&\n" +
+	"\x0fmember_user_ids\x18\x03 \x03(\tR\rmemberUserIds\x12!\n" +
+	"\ftotal_points\x18\x04 \x01(\x05R\vtotalPoints\"F\n" +
 	"\x04Hint\x12*\n" +
-	"\vhint_number\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x05(\x01R\n" +
+	"\vhint_number\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\n" +
+	"(\x01R\n" +
 	"hintNumber\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\"\x8d\x01\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xe4\x01\n" +
 	"\x10HunterSubmission\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x19\n" +
-	"\bimage_id\x18\x02 \x01(\tR\aimageId\x12;\n" +
-	"\x14submitted_at_seconds\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18<(\x00R\x12submittedAtSeconds\"g\n" +
-	"\vRoundResult\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\bimage_id\x18\x02 \x01(\tR\aimageId\x12<\n" +
+	"\x14submitted_at_seconds\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xd8\x04(\x00R\x12submittedAtSeconds\x12$\n" +
+	"\ateam_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06teamId\x12\x18\n" +
+	"\aattempt\x18\x05 \x01(\x05R\aattempt\x12\x14\n" +
+	"\x05final\x18\x06 \x01(\bR\x05final\"\xb4\x01\n" +
+	"\x0eHintGeneration\x12'\n" +
+	"\x0fgenerated_hints\x18\x01 \x01(\x05R\x0egeneratedHints\x12\x1f\n" +
+	"\vtotal_hints\x18\x02 \x01(\x05R\n" +
+	"totalHints\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12<\n" +
+	"\x06errors\x18\x04 \x03(\v2$.scene_hunter.v1.HintGenerationErrorR\x06errors\"f\n" +
+	"\x13HintGenerationError\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tfailed_at\x18\x03 \x01(\tR\bfailedAt\"\xb9\x01\n" +
+	"\vRoundResult\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
 	"\x04rank\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x01R\x04rank\x12\x16\n" +
-	"\x06points\x18\x03 \x01(\x05R\x06points\"\xbb\x03\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12$\n" +
+	"\ateam_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06teamId\x12'\n" +
+	"\x0fdistance_points\x18\x05 \x01(\x05R\x0edistancePoints\"v\n" +
+	"\bLocation\x123\n" +
+	"\blatitude\x18\x01 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\blatitude\x125\n" +
+	"\tlongitude\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\tlongitude\"\x8c\x04\n" +
+	"\fGameSettings\x12!\n" +
+	"\fturn_seconds\x18\x01 \x01(\x05R\vturnSeconds\x12\x1d\n" +
+	"\n" +
+	"hint_count\x18\x02 \x01(\x05R\thintCount\x12B\n" +
+	"\rhint_schedule\x18\x03 \x01(\x0e2\x1d.scene_hunter.v1.HintScheduleR\fhintSchedule\x122\n" +
+	"\x15hint_interval_seconds\x18\x04 \x01(\x05R\x13hintIntervalSeconds\x120\n" +
+	"\x14hint_offsets_seconds\x18\x05 \x03(\x05R\x12hintOffsetsSeconds\x12#\n" +
+	"\rattempt_limit\x18\x06 \x01(\x05R\fattemptLimit\x12K\n" +
+	"\x10distance_scoring\x18\a \x01(\x0e2 .scene_hunter.v1.DistanceScoringR\x0fdistanceScoring\x126\n" +
+	"\x17spectator_delay_seconds\x18\b \x01(\x05R\x15spectatorDelaySeconds\x12?\n" +
+	"\franking_mode\x18\t \x01(\x0e2\x1c.scene_hunter.v1.RankingModeR\vrankingMode\x12%\n" +
+	"\x0evoting_seconds\x18\n" +
+	" \x01(\x05R\rvotingSeconds\"\xc5\x04\n" +
 	"\x05Round\x12!\n" +
 	"\fround_number\x18\x01 \x01(\x05R\vroundNumber\x127\n" +
 	"\x13game_master_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\x12/\n" +
@@ -1597,7 +5131,11 @@ const file_scene_hunter_v1_game_proto_rawDesc = "" +
 	"\aresults\x18\x06 \x03(\v2\x1c.scene_hunter.v1.RoundResultR\aresults\x12<\n" +
 	"\vturn_status\x18\a \x01(\x0e2\x1b.scene_hunter.v1.TurnStatusR\n" +
 	"turnStatus\x120\n" +
-	"\x14turn_elapsed_seconds\x18\b \x01(\x05R\x12turnElapsedSeconds\"\xd2\x02\n" +
+	"\x14turn_elapsed_seconds\x18\b \x01(\x05R\x12turnElapsedSeconds\x12H\n" +
+	"\x0fhint_generation\x18\t \x01(\v2\x1f.scene_hunter.v1.HintGenerationR\x0ehintGeneration\x12$\n" +
+	"\x0evoting_ends_at\x18\n" +
+	" \x01(\tR\fvotingEndsAt\x12\x18\n" +
+	"\askipped\x18\v \x01(\bR\askipped\"\x80\x05\n" +
 	"\x04Game\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x123\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.scene_hunter.v1.GameStatusR\x06status\x12,\n" +
@@ -1608,83 +5146,308 @@ const file_scene_hunter_v1_game_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x9c\x01\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12?\n" +
+	"\n" +
+	"difficulty\x18\n" +
+	" \x01(\x0e2\x1f.scene_hunter.v1.HintDifficultyR\n" +
+	"difficulty\x12+\n" +
+	"\x05teams\x18\v \x03(\v2\x15.scene_hunter.v1.TeamR\x05teams\x12\x1d\n" +
+	"\n" +
+	"scene_pool\x18\f \x01(\bR\tscenePool\x129\n" +
+	"\bsettings\x18\r \x01(\v2\x1d.scene_hunter.v1.GameSettingsR\bsettings\x12:\n" +
+	"\n" +
+	"spectators\x18\x0e \x03(\v2\x1a.scene_hunter.v1.SpectatorR\n" +
+	"spectatorsJ\x04\b\x0f\x10\x10R\x06events\"\xe5\x06\n" +
 	"\x10StartGameRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12,\n" +
 	"\ftotal_rounds\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x05(\x01R\vtotalRounds\x127\n" +
-	"\x13game_master_user_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\">\n" +
+	"\x13game_master_user_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\x12-\n" +
+	"\blanguage\x18\x04 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^(ja|en)?$R\blanguage\x12I\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\x0e2\x1f.scene_hunter.v1.HintDifficultyB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"difficulty\x12\x1d\n" +
+	"\n" +
+	"scene_pool\x18\x06 \x01(\bR\tscenePool\x120\n" +
+	"\fturn_seconds\x18\a \x01(\x05B\r\xbaH\n" +
+	"\xd8\x01\x01\x1a\x05\x18\xd8\x04(\x1eR\vturnSeconds\x12+\n" +
+	"\n" +
+	"hint_count\x18\b \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18\n" +
+	"(\x01R\thintCount\x12;\n" +
+	"\x15hint_interval_seconds\x18\t \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x13hintIntervalSeconds\x12C\n" +
+	"\x14hint_offsets_seconds\x18\n" +
+	" \x03(\x05B\x11\xbaH\x0e\x92\x01\v\x10\n" +
+	"\"\a\x1a\x05\x18\xd8\x04(\x00R\x12hintOffsetsSeconds\x121\n" +
+	"\rattempt_limit\x18\v \x01(\x05B\f\xbaH\t\xd8\x01\x01\x1a\x04\x18\x05(\x01R\fattemptLimit\x12U\n" +
+	"\x10distance_scoring\x18\f \x01(\x0e2 .scene_hunter.v1.DistanceScoringB\b\xbaH\x05\x82\x01\x02\x10\x01R\x0fdistanceScoring\x12B\n" +
+	"\x17spectator_delay_seconds\x18\r \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xac\x02(\x00R\x15spectatorDelaySeconds\x12I\n" +
+	"\franking_mode\x18\x0e \x01(\x0e2\x1c.scene_hunter.v1.RankingModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\vrankingMode\x124\n" +
+	"\x0evoting_seconds\x18\x0f \x01(\x05B\r\xbaH\n" +
+	"\xd8\x01\x01\x1a\x05\x18\xac\x02(\x0fR\rvotingSeconds\">\n" +
 	"\x11StartGameResponse\x12)\n" +
-	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"v\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"\x94\x01\n" +
 	"\x0fJoinGameRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
 	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
-	"\x04name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\"=\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x12\x1c\n" +
+	"\tspectator\x18\x04 \x01(\bR\tspectator\"=\n" +
 	"\x10JoinGameResponse\x12)\n" +
-	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"\x91\x01\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"\x83\x02\n" +
 	"\x1cSubmitGameMasterPhotoRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12-\n" +
 	"\n" +
-	"image_data\x18\x03 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\timageData\"g\n" +
+	"image_data\x18\x03 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05H\x00R\timageData\x12'\n" +
+	"\tupload_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\buploadId\x125\n" +
+	"\blocation\x18\x05 \x01(\v2\x19.scene_hunter.v1.LocationR\blocationB\x0e\n" +
+	"\x05photo\x12\x05\xbaH\x02\b\x01\"G\n" +
 	"\x1dSubmitGameMasterPhotoResponse\x12\x19\n" +
-	"\bimage_id\x18\x01 \x01(\tR\aimageId\x12+\n" +
-	"\x05hints\x18\x02 \x03(\v2\x15.scene_hunter.v1.HintR\x05hints\"\xc1\x01\n" +
+	"\bimage_id\x18\x01 \x01(\tR\aimageIdJ\x04\b\x02\x10\x03R\x05hints\"[\n" +
+	"\x13GetHintDraftRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"C\n" +
+	"\x14GetHintDraftResponse\x12+\n" +
+	"\x05hints\x18\x01 \x03(\v2\x15.scene_hunter.v1.HintR\x05hints\"\xa5\x01\n" +
+	"\x11UpdateHintRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12*\n" +
+	"\vhint_number\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\n" +
+	"(\x01R\n" +
+	"hintNumber\x12\x1e\n" +
+	"\x04text\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x04text\"A\n" +
+	"\x12UpdateHintResponse\x12+\n" +
+	"\x05hints\x18\x01 \x03(\v2\x15.scene_hunter.v1.HintR\x05hints\"\x94\x01\n" +
+	"\x13ReorderHintsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x127\n" +
+	"\fhint_numbers\x18\x03 \x03(\x05B\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\n" +
+	"\x18\x01\"\x06\x1a\x04\x18\n" +
+	"(\x01R\vhintNumbers\"C\n" +
+	"\x14ReorderHintsResponse\x12+\n" +
+	"\x05hints\x18\x01 \x03(\v2\x15.scene_hunter.v1.HintR\x05hints\"\x89\x01\n" +
+	"\x15RegenerateHintRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12*\n" +
+	"\vhint_number\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\n" +
+	"(\x01R\n" +
+	"hintNumber\"E\n" +
+	"\x16RegenerateHintResponse\x12+\n" +
+	"\x05hints\x18\x01 \x03(\v2\x15.scene_hunter.v1.HintR\x05hints\"[\n" +
+	"\x13ReleaseHintsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"A\n" +
+	"\x14ReleaseHintsResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"\x96\x02\n" +
 	"\x18SubmitHunterPhotoRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12-\n" +
 	"\n" +
-	"image_data\x18\x03 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\timageData\x122\n" +
-	"\x0felapsed_seconds\x18\x04 \x01(\x05B\t\xbaH\x06\x1a\x04\x18<(\x00R\x0eelapsedSeconds\"j\n" +
+	"image_data\x18\x03 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05H\x00R\timageData\x12'\n" +
+	"\tupload_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\buploadId\x125\n" +
+	"\blocation\x18\x06 \x01(\v2\x19.scene_hunter.v1.LocationR\blocationB\x0e\n" +
+	"\x05photo\x12\x05\xbaH\x02\b\x01J\x04\b\x04\x10\x05R\x0felapsed_seconds\"\xd4\x01\n" +
 	"\x19SubmitHunterPhotoResponse\x12\x19\n" +
 	"\bimage_id\x18\x01 \x01(\tR\aimageId\x122\n" +
-	"\x15all_hunters_submitted\x18\x02 \x01(\bR\x13allHuntersSubmitted\"8\n" +
+	"\x15all_hunters_submitted\x18\x02 \x01(\bR\x13allHuntersSubmitted\x12\x18\n" +
+	"\aattempt\x18\x03 \x01(\x05R\aattempt\x12\x14\n" +
+	"\x05final\x18\x04 \x01(\bR\x05final\x128\n" +
+	"\tproximity\x18\x05 \x01(\x0e2\x1a.scene_hunter.v1.ProximityR\tproximity\"\x82\x01\n" +
+	"\x15PickFinalPhotoRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12#\n" +
+	"\aattempt\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x05(\x01R\aattempt\"L\n" +
+	"\x16PickFinalPhotoResponse\x122\n" +
+	"\x15all_hunters_submitted\x18\x01 \x01(\bR\x13allHuntersSubmitted\"\x9c\x01\n" +
+	"\x15CheckProximityRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12=\n" +
+	"\blocation\x18\x03 \x01(\v2\x19.scene_hunter.v1.LocationB\x06\xbaH\x03\xc8\x01\x01R\blocation\"R\n" +
+	"\x16CheckProximityResponse\x128\n" +
+	"\tproximity\x18\x01 \x01(\x0e2\x1a.scene_hunter.v1.ProximityR\tproximity\"8\n" +
 	"\x13GetGameStateRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"A\n" +
 	"\x14GetGameStateResponse\x12)\n" +
-	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"s\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"o\n" +
+	"\x14GetGameEventsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x124\n" +
+	"\bafter_id\x18\x02 \x01(\tB\x19\xbaH\x16r\x142\x12^([0-9]+-[0-9]+)?$R\aafterId\"K\n" +
+	"\x15GetGameEventsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.scene_hunter.v1.GameEventR\x06events\"\xa6\x01\n" +
 	"\x15StartNextRoundRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x127\n" +
-	"\x13game_master_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\"C\n" +
+	"\x13game_master_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\x121\n" +
+	"\x0escene_image_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fsceneImageId\"C\n" +
 	"\x16StartNextRoundResponse\x12)\n" +
 	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\";\n" +
 	"\x16GetHunterPhotosRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"^\n" +
 	"\x17GetHunterPhotosResponse\x12C\n" +
-	"\vsubmissions\x18\x01 \x03(\v2!.scene_hunter.v1.HunterSubmissionR\vsubmissions\"Q\n" +
-	"\rRankSelection\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
-	"\x04rank\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x01R\x04rank\"\xae\x01\n" +
+	"\vsubmissions\x18\x01 \x03(\v2!.scene_hunter.v1.HunterSubmissionR\vsubmissions\"\x89\x01\n" +
+	"\rRankSelection\x12#\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06userId\x12#\n" +
+	"\ateam_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06teamId\x12\x1d\n" +
+	"\x04rank\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x01R\x04rankB\x0f\n" +
+	"\x06target\x12\x05\xbaH\x02\b\x01\"\xae\x01\n" +
 	"\x14SelectWinnersRequest\x12!\n" +
 	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x127\n" +
 	"\x13game_master_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\x12:\n" +
 	"\brankings\x18\x03 \x03(\v2\x1e.scene_hunter.v1.RankSelectionR\brankings\"B\n" +
 	"\x15SelectWinnersResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"\x88\x01\n" +
+	"\x11SubmitVoteRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12-\n" +
+	"\aranking\x18\x03 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10\x14\x18\x01\"\x05r\x03\xb0\x01\x01R\aranking\"9\n" +
+	"\x12SubmitVoteResponse\x12#\n" +
+	"\rvoting_closed\x18\x01 \x01(\bR\fvotingClosed\"p\n" +
+	"\x12CloseVotingRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x127\n" +
+	"\x13game_master_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10gameMasterUserId\"@\n" +
+	"\x13CloseVotingResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"X\n" +
+	"\x10BeginGameRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\">\n" +
+	"\x11BeginGameResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"X\n" +
+	"\x10PauseGameRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\">\n" +
+	"\x11PauseGameResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"Y\n" +
+	"\x11ResumeGameRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"?\n" +
+	"\x12ResumeGameResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"X\n" +
+	"\x10SkipRoundRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\">\n" +
+	"\x11SkipRoundResponse\x12)\n" +
 	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"3\n" +
 	"\x0eEndGameRequest\x12!\n" +
-	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"|\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"\xc3\x01\n" +
 	"\x0fEndGameResponse\x12)\n" +
 	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\x12>\n" +
-	"\x0efinal_rankings\x18\x02 \x03(\v2\x17.scene_hunter.v1.PlayerR\rfinalRankings*y\n" +
+	"\x0efinal_rankings\x18\x02 \x03(\v2\x17.scene_hunter.v1.PlayerR\rfinalRankings\x12E\n" +
+	"\x13final_team_rankings\x18\x03 \x03(\v2\x15.scene_hunter.v1.TeamR\x11finalTeamRankings\"j\n" +
+	"\x0eTeamAssignment\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\x14R\x04name\x129\n" +
+	"\x0fmember_user_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x18\x01\"\x05r\x03\xb0\x01\x01R\rmemberUserIds\"\x9b\x01\n" +
+	"\x12AssignTeamsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12?\n" +
+	"\x05teams\x18\x03 \x03(\v2\x1f.scene_hunter.v1.TeamAssignmentB\b\xbaH\x05\x92\x01\x02\x10\n" +
+	"R\x05teams\"@\n" +
+	"\x13AssignTeamsResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game\"\x85\x01\n" +
+	"\x13BalanceTeamsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12(\n" +
+	"\n" +
+	"team_count\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\n" +
+	"(\x02R\tteamCount\"A\n" +
+	"\x14BalanceTeamsResponse\x12)\n" +
+	"\x04game\x18\x01 \x01(\v2\x15.scene_hunter.v1.GameR\x04game*\x91\x01\n" +
 	"\n" +
 	"GameStatus\x12\x1b\n" +
 	"\x17GAME_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13GAME_STATUS_WAITING\x10\x01\x12\x1b\n" +
 	"\x17GAME_STATUS_IN_PROGRESS\x10\x02\x12\x18\n" +
-	"\x14GAME_STATUS_FINISHED\x10\x03*\x86\x01\n" +
+	"\x14GAME_STATUS_FINISHED\x10\x03\x12\x16\n" +
+	"\x12GAME_STATUS_PAUSED\x10\x04*\x98\b\n" +
+	"\rGameEventType\x12\x1f\n" +
+	"\x1bGAME_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bGAME_EVENT_TYPE_GAME_PAUSED\x10\x01\x12 \n" +
+	"\x1cGAME_EVENT_TYPE_GAME_RESUMED\x10\x02\x12!\n" +
+	"\x1dGAME_EVENT_TYPE_ROUND_SKIPPED\x10\x03\x12!\n" +
+	"\x1dGAME_EVENT_TYPE_PLAYER_JOINED\x10\x04\x12!\n" +
+	"\x1dGAME_EVENT_TYPE_ROUND_STARTED\x10\x05\x12#\n" +
+	"\x1fGAME_EVENT_TYPE_HINTS_GENERATED\x10\x06\x12#\n" +
+	"\x1fGAME_EVENT_TYPE_PHOTO_SUBMITTED\x10\a\x12$\n" +
+	" GAME_EVENT_TYPE_WINNERS_SELECTED\x10\b\x12\x1e\n" +
+	"\x1aGAME_EVENT_TYPE_GAME_ENDED\x10\t\x12 \n" +
+	"\x1cGAME_EVENT_TYPE_GAME_STARTED\x10\n" +
+	"\x12!\n" +
+	"\x1dGAME_EVENT_TYPE_TEAMS_CHANGED\x10\v\x12$\n" +
+	" GAME_EVENT_TYPE_SPECTATOR_JOINED\x10\f\x12/\n" +
+	"+GAME_EVENT_TYPE_GAME_MASTER_PHOTO_SUBMITTED\x10\r\x12(\n" +
+	"$GAME_EVENT_TYPE_HINT_ATTEMPT_STARTED\x10\x0e\x12$\n" +
+	" GAME_EVENT_TYPE_HINTS_PROGRESSED\x10\x0f\x12'\n" +
+	"#GAME_EVENT_TYPE_HINT_ATTEMPT_FAILED\x10\x10\x12*\n" +
+	"&GAME_EVENT_TYPE_HINT_GENERATION_FAILED\x10\x11\x12\x1f\n" +
+	"\x1bGAME_EVENT_TYPE_HINT_EDITED\x10\x12\x12$\n" +
+	" GAME_EVENT_TYPE_HINT_REGENERATED\x10\x13\x12#\n" +
+	"\x1fGAME_EVENT_TYPE_HINTS_REORDERED\x10\x14\x12\"\n" +
+	"\x1eGAME_EVENT_TYPE_HINTS_RELEASED\x10\x15\x12&\n" +
+	"\"GAME_EVENT_TYPE_FINAL_PHOTO_PICKED\x10\x16\x12!\n" +
+	"\x1dGAME_EVENT_TYPE_HUNT_FINISHED\x10\x17\x12\x1d\n" +
+	"\x19GAME_EVENT_TYPE_VOTE_CAST\x10\x18\x12!\n" +
+	"\x1dGAME_EVENT_TYPE_VOTING_CLOSED\x10\x19\x12%\n" +
+	"!GAME_EVENT_TYPE_PROXIMITY_CHECKED\x10\x1a\x12%\n" +
+	"!GAME_EVENT_TYPE_PLAYER_ANONYMIZED\x10\x1b*\xe4\x01\n" +
 	"\n" +
 	"TurnStatus\x12\x1b\n" +
 	"\x17TURN_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17TURN_STATUS_GAME_MASTER\x10\x01\x12\x17\n" +
 	"\x13TURN_STATUS_HUNTERS\x10\x02\x12%\n" +
-	"!TURN_STATUS_WAITING_FOR_SELECTION\x10\x032\xea\x06\n" +
+	"!TURN_STATUS_WAITING_FOR_SELECTION\x10\x03\x12\x1f\n" +
+	"\x1bTURN_STATUS_REVIEWING_HINTS\x10\x04\x12 \n" +
+	"\x1cTURN_STATUS_GENERATING_HINTS\x10\x05\x12\x19\n" +
+	"\x15TURN_STATUS_COMPLETED\x10\x06*\x81\x01\n" +
+	"\x0eHintDifficulty\x12\x1f\n" +
+	"\x1bHINT_DIFFICULTY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14HINT_DIFFICULTY_EASY\x10\x01\x12\x1a\n" +
+	"\x16HINT_DIFFICULTY_NORMAL\x10\x02\x12\x18\n" +
+	"\x14HINT_DIFFICULTY_HARD\x10\x03*a\n" +
+	"\fHintSchedule\x12\x1d\n" +
+	"\x19HINT_SCHEDULE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14HINT_SCHEDULE_LINEAR\x10\x01\x12\x18\n" +
+	"\x14HINT_SCHEDULE_CUSTOM\x10\x02*\x89\x01\n" +
+	"\x0fDistanceScoring\x12 \n" +
+	"\x1cDISTANCE_SCORING_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DISTANCE_SCORING_OFF\x10\x01\x12\x1d\n" +
+	"\x19DISTANCE_SCORING_TIEBREAK\x10\x02\x12\x1b\n" +
+	"\x17DISTANCE_SCORING_POINTS\x10\x03*f\n" +
+	"\tProximity\x12\x19\n" +
+	"\x15PROXIMITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10PROXIMITY_WARMER\x10\x01\x12\x14\n" +
+	"\x10PROXIMITY_COLDER\x10\x02\x12\x12\n" +
+	"\x0ePROXIMITY_SAME\x10\x03*d\n" +
+	"\vRankingMode\x12\x1c\n" +
+	"\x18RANKING_MODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RANKING_MODE_GAME_MASTER\x10\x01\x12\x19\n" +
+	"\x15RANKING_MODE_AUDIENCE\x10\x022\x9c\x12\n" +
 	"\vGameService\x12R\n" +
 	"\tStartGame\x12!.scene_hunter.v1.StartGameRequest\x1a\".scene_hunter.v1.StartGameResponse\x12O\n" +
-	"\bJoinGame\x12 .scene_hunter.v1.JoinGameRequest\x1a!.scene_hunter.v1.JoinGameResponse\x12v\n" +
-	"\x15SubmitGameMasterPhoto\x12-.scene_hunter.v1.SubmitGameMasterPhotoRequest\x1a..scene_hunter.v1.SubmitGameMasterPhotoResponse\x12j\n" +
-	"\x11SubmitHunterPhoto\x12).scene_hunter.v1.SubmitHunterPhotoRequest\x1a*.scene_hunter.v1.SubmitHunterPhotoResponse\x12d\n" +
+	"\bJoinGame\x12 .scene_hunter.v1.JoinGameRequest\x1a!.scene_hunter.v1.JoinGameResponse\x12X\n" +
+	"\vAssignTeams\x12#.scene_hunter.v1.AssignTeamsRequest\x1a$.scene_hunter.v1.AssignTeamsResponse\x12[\n" +
+	"\fBalanceTeams\x12$.scene_hunter.v1.BalanceTeamsRequest\x1a%.scene_hunter.v1.BalanceTeamsResponse\x12v\n" +
+	"\x15SubmitGameMasterPhoto\x12-.scene_hunter.v1.SubmitGameMasterPhotoRequest\x1a..scene_hunter.v1.SubmitGameMasterPhotoResponse\x12[\n" +
+	"\fGetHintDraft\x12$.scene_hunter.v1.GetHintDraftRequest\x1a%.scene_hunter.v1.GetHintDraftResponse\x12U\n" +
+	"\n" +
+	"UpdateHint\x12\".scene_hunter.v1.UpdateHintRequest\x1a#.scene_hunter.v1.UpdateHintResponse\x12[\n" +
+	"\fReorderHints\x12$.scene_hunter.v1.ReorderHintsRequest\x1a%.scene_hunter.v1.ReorderHintsResponse\x12a\n" +
+	"\x0eRegenerateHint\x12&.scene_hunter.v1.RegenerateHintRequest\x1a'.scene_hunter.v1.RegenerateHintResponse\x12[\n" +
+	"\fReleaseHints\x12$.scene_hunter.v1.ReleaseHintsRequest\x1a%.scene_hunter.v1.ReleaseHintsResponse\x12j\n" +
+	"\x11SubmitHunterPhoto\x12).scene_hunter.v1.SubmitHunterPhotoRequest\x1a*.scene_hunter.v1.SubmitHunterPhotoResponse\x12a\n" +
+	"\x0ePickFinalPhoto\x12&.scene_hunter.v1.PickFinalPhotoRequest\x1a'.scene_hunter.v1.PickFinalPhotoResponse\x12a\n" +
+	"\x0eCheckProximity\x12&.scene_hunter.v1.CheckProximityRequest\x1a'.scene_hunter.v1.CheckProximityResponse\x12d\n" +
 	"\x0fGetHunterPhotos\x12'.scene_hunter.v1.GetHunterPhotosRequest\x1a(.scene_hunter.v1.GetHunterPhotosResponse\x12^\n" +
-	"\rSelectWinners\x12%.scene_hunter.v1.SelectWinnersRequest\x1a&.scene_hunter.v1.SelectWinnersResponse\x12[\n" +
-	"\fGetGameState\x12$.scene_hunter.v1.GetGameStateRequest\x1a%.scene_hunter.v1.GetGameStateResponse\x12a\n" +
+	"\rSelectWinners\x12%.scene_hunter.v1.SelectWinnersRequest\x1a&.scene_hunter.v1.SelectWinnersResponse\x12U\n" +
+	"\n" +
+	"SubmitVote\x12\".scene_hunter.v1.SubmitVoteRequest\x1a#.scene_hunter.v1.SubmitVoteResponse\x12X\n" +
+	"\vCloseVoting\x12#.scene_hunter.v1.CloseVotingRequest\x1a$.scene_hunter.v1.CloseVotingResponse\x12R\n" +
+	"\tBeginGame\x12!.scene_hunter.v1.BeginGameRequest\x1a\".scene_hunter.v1.BeginGameResponse\x12R\n" +
+	"\tPauseGame\x12!.scene_hunter.v1.PauseGameRequest\x1a\".scene_hunter.v1.PauseGameResponse\x12U\n" +
+	"\n" +
+	"ResumeGame\x12\".scene_hunter.v1.ResumeGameRequest\x1a#.scene_hunter.v1.ResumeGameResponse\x12R\n" +
+	"\tSkipRound\x12!.scene_hunter.v1.SkipRoundRequest\x1a\".scene_hunter.v1.SkipRoundResponse\x12[\n" +
+	"\fGetGameState\x12$.scene_hunter.v1.GetGameStateRequest\x1a%.scene_hunter.v1.GetGameStateResponse\x12^\n" +
+	"\rGetGameEvents\x12%.scene_hunter.v1.GetGameEventsRequest\x1a&.scene_hunter.v1.GetGameEventsResponse\x12a\n" +
 	"\x0eStartNextRound\x12&.scene_hunter.v1.StartNextRoundRequest\x1a'.scene_hunter.v1.StartNextRoundResponse\x12L\n" +
 	"\aEndGame\x12\x1f.scene_hunter.v1.EndGameRequest\x1a .scene_hunter.v1.EndGameResponseB\xc6\x01\n" +
 	"\x13com.scene_hunter.v1B\tGameProtoP\x01ZKgithub.com/yashikota/scene-hunter/server/gen/scene_hunter/v1;scene_hunterv1\xa2\x02\x03SXX\xaa\x02\x0eSceneHunter.V1\xca\x02\x0eSceneHunter\\V1\xe2\x02\x1aSceneHunter\\V1\\GPBMetadata\xea\x02\x0fSceneHunter::V1b\x06proto3"
@@ -1701,78 +5464,206 @@ func file_scene_hunter_v1_game_proto_rawDescGZIP() []byte {
 	return file_scene_hunter_v1_game_proto_rawDescData
 }
 
-var file_scene_hunter_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_scene_hunter_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_scene_hunter_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_scene_hunter_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_scene_hunter_v1_game_proto_goTypes = []any{
 	(GameStatus)(0),                       // 0: scene_hunter.v1.GameStatus
-	(TurnStatus)(0),                       // 1: scene_hunter.v1.TurnStatus
-	(*Player)(nil),                        // 2: scene_hunter.v1.Player
-	(*Hint)(nil),                          // 3: scene_hunter.v1.Hint
-	(*HunterSubmission)(nil),              // 4: scene_hunter.v1.HunterSubmission
-	(*RoundResult)(nil),                   // 5: scene_hunter.v1.RoundResult
-	(*Round)(nil),                         // 6: scene_hunter.v1.Round
-	(*Game)(nil),                          // 7: scene_hunter.v1.Game
-	(*StartGameRequest)(nil),              // 8: scene_hunter.v1.StartGameRequest
-	(*StartGameResponse)(nil),             // 9: scene_hunter.v1.StartGameResponse
-	(*JoinGameRequest)(nil),               // 10: scene_hunter.v1.JoinGameRequest
-	(*JoinGameResponse)(nil),              // 11: scene_hunter.v1.JoinGameResponse
-	(*SubmitGameMasterPhotoRequest)(nil),  // 12: scene_hunter.v1.SubmitGameMasterPhotoRequest
-	(*SubmitGameMasterPhotoResponse)(nil), // 13: scene_hunter.v1.SubmitGameMasterPhotoResponse
-	(*SubmitHunterPhotoRequest)(nil),      // 14: scene_hunter.v1.SubmitHunterPhotoRequest
-	(*SubmitHunterPhotoResponse)(nil),     // 15: scene_hunter.v1.SubmitHunterPhotoResponse
-	(*GetGameStateRequest)(nil),           // 16: scene_hunter.v1.GetGameStateRequest
-	(*GetGameStateResponse)(nil),          // 17: scene_hunter.v1.GetGameStateResponse
-	(*StartNextRoundRequest)(nil),         // 18: scene_hunter.v1.StartNextRoundRequest
-	(*StartNextRoundResponse)(nil),        // 19: scene_hunter.v1.StartNextRoundResponse
-	(*GetHunterPhotosRequest)(nil),        // 20: scene_hunter.v1.GetHunterPhotosRequest
-	(*GetHunterPhotosResponse)(nil),       // 21: scene_hunter.v1.GetHunterPhotosResponse
-	(*RankSelection)(nil),                 // 22: scene_hunter.v1.RankSelection
-	(*SelectWinnersRequest)(nil),          // 23: scene_hunter.v1.SelectWinnersRequest
-	(*SelectWinnersResponse)(nil),         // 24: scene_hunter.v1.SelectWinnersResponse
-	(*EndGameRequest)(nil),                // 25: scene_hunter.v1.EndGameRequest
-	(*EndGameResponse)(nil),               // 26: scene_hunter.v1.EndGameResponse
+	(GameEventType)(0),                    // 1: scene_hunter.v1.GameEventType
+	(TurnStatus)(0),                       // 2: scene_hunter.v1.TurnStatus
+	(HintDifficulty)(0),                   // 3: scene_hunter.v1.HintDifficulty
+	(HintSchedule)(0),                     // 4: scene_hunter.v1.HintSchedule
+	(DistanceScoring)(0),                  // 5: scene_hunter.v1.DistanceScoring
+	(Proximity)(0),                        // 6: scene_hunter.v1.Proximity
+	(RankingMode)(0),                      // 7: scene_hunter.v1.RankingMode
+	(*Player)(nil),                        // 8: scene_hunter.v1.Player
+	(*GameEvent)(nil),                     // 9: scene_hunter.v1.GameEvent
+	(*PhotoEventPayload)(nil),             // 10: scene_hunter.v1.PhotoEventPayload
+	(*HintsEventPayload)(nil),             // 11: scene_hunter.v1.HintsEventPayload
+	(*HintAttemptEventPayload)(nil),       // 12: scene_hunter.v1.HintAttemptEventPayload
+	(*ResultsEventPayload)(nil),           // 13: scene_hunter.v1.ResultsEventPayload
+	(*VotingEventPayload)(nil),            // 14: scene_hunter.v1.VotingEventPayload
+	(*ProximityEventPayload)(nil),         // 15: scene_hunter.v1.ProximityEventPayload
+	(*TeamsEventPayload)(nil),             // 16: scene_hunter.v1.TeamsEventPayload
+	(*Spectator)(nil),                     // 17: scene_hunter.v1.Spectator
+	(*Team)(nil),                          // 18: scene_hunter.v1.Team
+	(*Hint)(nil),                          // 19: scene_hunter.v1.Hint
+	(*HunterSubmission)(nil),              // 20: scene_hunter.v1.HunterSubmission
+	(*HintGeneration)(nil),                // 21: scene_hunter.v1.HintGeneration
+	(*HintGenerationError)(nil),           // 22: scene_hunter.v1.HintGenerationError
+	(*RoundResult)(nil),                   // 23: scene_hunter.v1.RoundResult
+	(*Location)(nil),                      // 24: scene_hunter.v1.Location
+	(*GameSettings)(nil),                  // 25: scene_hunter.v1.GameSettings
+	(*Round)(nil),                         // 26: scene_hunter.v1.Round
+	(*Game)(nil),                          // 27: scene_hunter.v1.Game
+	(*StartGameRequest)(nil),              // 28: scene_hunter.v1.StartGameRequest
+	(*StartGameResponse)(nil),             // 29: scene_hunter.v1.StartGameResponse
+	(*JoinGameRequest)(nil),               // 30: scene_hunter.v1.JoinGameRequest
+	(*JoinGameResponse)(nil),              // 31: scene_hunter.v1.JoinGameResponse
+	(*SubmitGameMasterPhotoRequest)(nil),  // 32: scene_hunter.v1.SubmitGameMasterPhotoRequest
+	(*SubmitGameMasterPhotoResponse)(nil), // 33: scene_hunter.v1.SubmitGameMasterPhotoResponse
+	(*GetHintDraftRequest)(nil),           // 34: scene_hunter.v1.GetHintDraftRequest
+	(*GetHintDraftResponse)(nil),          // 35: scene_hunter.v1.GetHintDraftResponse
+	(*UpdateHintRequest)(nil),             // 36: scene_hunter.v1.UpdateHintRequest
+	(*UpdateHintResponse)(nil),            // 37: scene_hunter.v1.UpdateHintResponse
+	(*ReorderHintsRequest)(nil),           // 38: scene_hunter.v1.ReorderHintsRequest
+	(*ReorderHintsResponse)(nil),          // 39: scene_hunter.v1.ReorderHintsResponse
+	(*RegenerateHintRequest)(nil),         // 40: scene_hunter.v1.RegenerateHintRequest
+	(*RegenerateHintResponse)(nil),        // 41: scene_hunter.v1.RegenerateHintResponse
+	(*ReleaseHintsRequest)(nil),           // 42: scene_hunter.v1.ReleaseHintsRequest
+	(*ReleaseHintsResponse)(nil),          // 43: scene_hunter.v1.ReleaseHintsResponse
+	(*SubmitHunterPhotoRequest)(nil),      // 44: scene_hunter.v1.SubmitHunterPhotoRequest
+	(*SubmitHunterPhotoResponse)(nil),     // 45: scene_hunter.v1.SubmitHunterPhotoResponse
+	(*PickFinalPhotoRequest)(nil),         // 46: scene_hunter.v1.PickFinalPhotoRequest
+	(*PickFinalPhotoResponse)(nil),        // 47: scene_hunter.v1.PickFinalPhotoResponse
+	(*CheckProximityRequest)(nil),         // 48: scene_hunter.v1.CheckProximityRequest
+	(*CheckProximityResponse)(nil),        // 49: scene_hunter.v1.CheckProximityResponse
+	(*GetGameStateRequest)(nil),           // 50: scene_hunter.v1.GetGameStateRequest
+	(*GetGameStateResponse)(nil),          // 51: scene_hunter.v1.GetGameStateResponse
+	(*GetGameEventsRequest)(nil),          // 52: scene_hunter.v1.GetGameEventsRequest
+	(*GetGameEventsResponse)(nil),         // 53: scene_hunter.v1.GetGameEventsResponse
+	(*StartNextRoundRequest)(nil),         // 54: scene_hunter.v1.StartNextRoundRequest
+	(*StartNextRoundResponse)(nil),        // 55: scene_hunter.v1.StartNextRoundResponse
+	(*GetHunterPhotosRequest)(nil),        // 56: scene_hunter.v1.GetHunterPhotosRequest
+	(*GetHunterPhotosResponse)(nil),       // 57: scene_hunter.v1.GetHunterPhotosResponse
+	(*RankSelection)(nil),                 // 58: scene_hunter.v1.RankSelection
+	(*SelectWinnersRequest)(nil),          // 59: scene_hunter.v1.SelectWinnersRequest
+	(*SelectWinnersResponse)(nil),         // 60: scene_hunter.v1.SelectWinnersResponse
+	(*SubmitVoteRequest)(nil),             // 61: scene_hunter.v1.SubmitVoteRequest
+	(*SubmitVoteResponse)(nil),            // 62: scene_hunter.v1.SubmitVoteResponse
+	(*CloseVotingRequest)(nil),            // 63: scene_hunter.v1.CloseVotingRequest
+	(*CloseVotingResponse)(nil),           // 64: scene_hunter.v1.CloseVotingResponse
+	(*BeginGameRequest)(nil),              // 65: scene_hunter.v1.BeginGameRequest
+	(*BeginGameResponse)(nil),             // 66: scene_hunter.v1.BeginGameResponse
+	(*PauseGameRequest)(nil),              // 67: scene_hunter.v1.PauseGameRequest
+	(*PauseGameResponse)(nil),             // 68: scene_hunter.v1.PauseGameResponse
+	(*ResumeGameRequest)(nil),             // 69: scene_hunter.v1.ResumeGameRequest
+	(*ResumeGameResponse)(nil),            // 70: scene_hunter.v1.ResumeGameResponse
+	(*SkipRoundRequest)(nil),              // 71: scene_hunter.v1.SkipRoundRequest
+	(*SkipRoundResponse)(nil),             // 72: scene_hunter.v1.SkipRoundResponse
+	(*EndGameRequest)(nil),                // 73: scene_hunter.v1.EndGameRequest
+	(*EndGameResponse)(nil),               // 74: scene_hunter.v1.EndGameResponse
+	(*TeamAssignment)(nil),                // 75: scene_hunter.v1.TeamAssignment
+	(*AssignTeamsRequest)(nil),            // 76: scene_hunter.v1.AssignTeamsRequest
+	(*AssignTeamsResponse)(nil),           // 77: scene_hunter.v1.AssignTeamsResponse
+	(*BalanceTeamsRequest)(nil),           // 78: scene_hunter.v1.BalanceTeamsRequest
+	(*BalanceTeamsResponse)(nil),          // 79: scene_hunter.v1.BalanceTeamsResponse
 }
 var file_scene_hunter_v1_game_proto_depIdxs = []int32{
-	3,  // 0: scene_hunter.v1.Round.hints:type_name -> scene_hunter.v1.Hint
-	4,  // 1: scene_hunter.v1.Round.hunter_submissions:type_name -> scene_hunter.v1.HunterSubmission
-	5,  // 2: scene_hunter.v1.Round.results:type_name -> scene_hunter.v1.RoundResult
-	1,  // 3: scene_hunter.v1.Round.turn_status:type_name -> scene_hunter.v1.TurnStatus
-	0,  // 4: scene_hunter.v1.Game.status:type_name -> scene_hunter.v1.GameStatus
-	2,  // 5: scene_hunter.v1.Game.players:type_name -> scene_hunter.v1.Player
-	6,  // 6: scene_hunter.v1.Game.rounds:type_name -> scene_hunter.v1.Round
-	7,  // 7: scene_hunter.v1.StartGameResponse.game:type_name -> scene_hunter.v1.Game
-	7,  // 8: scene_hunter.v1.JoinGameResponse.game:type_name -> scene_hunter.v1.Game
-	3,  // 9: scene_hunter.v1.SubmitGameMasterPhotoResponse.hints:type_name -> scene_hunter.v1.Hint
-	7,  // 10: scene_hunter.v1.GetGameStateResponse.game:type_name -> scene_hunter.v1.Game
-	7,  // 11: scene_hunter.v1.StartNextRoundResponse.game:type_name -> scene_hunter.v1.Game
-	4,  // 12: scene_hunter.v1.GetHunterPhotosResponse.submissions:type_name -> scene_hunter.v1.HunterSubmission
-	22, // 13: scene_hunter.v1.SelectWinnersRequest.rankings:type_name -> scene_hunter.v1.RankSelection
-	7,  // 14: scene_hunter.v1.SelectWinnersResponse.game:type_name -> scene_hunter.v1.Game
-	7,  // 15: scene_hunter.v1.EndGameResponse.game:type_name -> scene_hunter.v1.Game
-	2,  // 16: scene_hunter.v1.EndGameResponse.final_rankings:type_name -> scene_hunter.v1.Player
-	8,  // 17: scene_hunter.v1.GameService.StartGame:input_type -> scene_hunter.v1.StartGameRequest
-	10, // 18: scene_hunter.v1.GameService.JoinGame:input_type -> scene_hunter.v1.JoinGameRequest
-	12, // 19: scene_hunter.v1.GameService.SubmitGameMasterPhoto:input_type -> scene_hunter.v1.SubmitGameMasterPhotoRequest
-	14, // 20: scene_hunter.v1.GameService.SubmitHunterPhoto:input_type -> scene_hunter.v1.SubmitHunterPhotoRequest
-	20, // 21: scene_hunter.v1.GameService.GetHunterPhotos:input_type -> scene_hunter.v1.GetHunterPhotosRequest
-	23, // 22: scene_hunter.v1.GameService.SelectWinners:input_type -> scene_hunter.v1.SelectWinnersRequest
-	16, // 23: scene_hunter.v1.GameService.GetGameState:input_type -> scene_hunter.v1.GetGameStateRequest
-	18, // 24: scene_hunter.v1.GameService.StartNextRound:input_type -> scene_hunter.v1.StartNextRoundRequest
-	25, // 25: scene_hunter.v1.GameService.EndGame:input_type -> scene_hunter.v1.EndGameRequest
-	9,  // 26: scene_hunter.v1.GameService.StartGame:output_type -> scene_hunter.v1.StartGameResponse
-	11, // 27: scene_hunter.v1.GameService.JoinGame:output_type -> scene_hunter.v1.JoinGameResponse
-	13, // 28: scene_hunter.v1.GameService.SubmitGameMasterPhoto:output_type -> scene_hunter.v1.SubmitGameMasterPhotoResponse
-	15, // 29: scene_hunter.v1.GameService.SubmitHunterPhoto:output_type -> scene_hunter.v1.SubmitHunterPhotoResponse
-	21, // 30: scene_hunter.v1.GameService.GetHunterPhotos:output_type -> scene_hunter.v1.GetHunterPhotosResponse
-	24, // 31: scene_hunter.v1.GameService.SelectWinners:output_type -> scene_hunter.v1.SelectWinnersResponse
-	17, // 32: scene_hunter.v1.GameService.GetGameState:output_type -> scene_hunter.v1.GetGameStateResponse
-	19, // 33: scene_hunter.v1.GameService.StartNextRound:output_type -> scene_hunter.v1.StartNextRoundResponse
-	26, // 34: scene_hunter.v1.GameService.EndGame:output_type -> scene_hunter.v1.EndGameResponse
-	26, // [26:35] is the sub-list for method output_type
-	17, // [17:26] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	1,  // 0: scene_hunter.v1.GameEvent.type:type_name -> scene_hunter.v1.GameEventType
+	10, // 1: scene_hunter.v1.GameEvent.photo:type_name -> scene_hunter.v1.PhotoEventPayload
+	11, // 2: scene_hunter.v1.GameEvent.hints:type_name -> scene_hunter.v1.HintsEventPayload
+	12, // 3: scene_hunter.v1.GameEvent.hint_attempt:type_name -> scene_hunter.v1.HintAttemptEventPayload
+	13, // 4: scene_hunter.v1.GameEvent.results:type_name -> scene_hunter.v1.ResultsEventPayload
+	14, // 5: scene_hunter.v1.GameEvent.voting:type_name -> scene_hunter.v1.VotingEventPayload
+	15, // 6: scene_hunter.v1.GameEvent.proximity:type_name -> scene_hunter.v1.ProximityEventPayload
+	16, // 7: scene_hunter.v1.GameEvent.teams:type_name -> scene_hunter.v1.TeamsEventPayload
+	19, // 8: scene_hunter.v1.HintsEventPayload.hints:type_name -> scene_hunter.v1.Hint
+	23, // 9: scene_hunter.v1.ResultsEventPayload.results:type_name -> scene_hunter.v1.RoundResult
+	6,  // 10: scene_hunter.v1.ProximityEventPayload.proximity:type_name -> scene_hunter.v1.Proximity
+	18, // 11: scene_hunter.v1.TeamsEventPayload.teams:type_name -> scene_hunter.v1.Team
+	22, // 12: scene_hunter.v1.HintGeneration.errors:type_name -> scene_hunter.v1.HintGenerationError
+	4,  // 13: scene_hunter.v1.GameSettings.hint_schedule:type_name -> scene_hunter.v1.HintSchedule
+	5,  // 14: scene_hunter.v1.GameSettings.distance_scoring:type_name -> scene_hunter.v1.DistanceScoring
+	7,  // 15: scene_hunter.v1.GameSettings.ranking_mode:type_name -> scene_hunter.v1.RankingMode
+	19, // 16: scene_hunter.v1.Round.hints:type_name -> scene_hunter.v1.Hint
+	20, // 17: scene_hunter.v1.Round.hunter_submissions:type_name -> scene_hunter.v1.HunterSubmission
+	23, // 18: scene_hunter.v1.Round.results:type_name -> scene_hunter.v1.RoundResult
+	2,  // 19: scene_hunter.v1.Round.turn_status:type_name -> scene_hunter.v1.TurnStatus
+	21, // 20: scene_hunter.v1.Round.hint_generation:type_name -> scene_hunter.v1.HintGeneration
+	0,  // 21: scene_hunter.v1.Game.status:type_name -> scene_hunter.v1.GameStatus
+	8,  // 22: scene_hunter.v1.Game.players:type_name -> scene_hunter.v1.Player
+	26, // 23: scene_hunter.v1.Game.rounds:type_name -> scene_hunter.v1.Round
+	3,  // 24: scene_hunter.v1.Game.difficulty:type_name -> scene_hunter.v1.HintDifficulty
+	18, // 25: scene_hunter.v1.Game.teams:type_name -> scene_hunter.v1.Team
+	25, // 26: scene_hunter.v1.Game.settings:type_name -> scene_hunter.v1.GameSettings
+	17, // 27: scene_hunter.v1.Game.spectators:type_name -> scene_hunter.v1.Spectator
+	3,  // 28: scene_hunter.v1.StartGameRequest.difficulty:type_name -> scene_hunter.v1.HintDifficulty
+	5,  // 29: scene_hunter.v1.StartGameRequest.distance_scoring:type_name -> scene_hunter.v1.DistanceScoring
+	7,  // 30: scene_hunter.v1.StartGameRequest.ranking_mode:type_name -> scene_hunter.v1.RankingMode
+	27, // 31: scene_hunter.v1.StartGameResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 32: scene_hunter.v1.JoinGameResponse.game:type_name -> scene_hunter.v1.Game
+	24, // 33: scene_hunter.v1.SubmitGameMasterPhotoRequest.location:type_name -> scene_hunter.v1.Location
+	19, // 34: scene_hunter.v1.GetHintDraftResponse.hints:type_name -> scene_hunter.v1.Hint
+	19, // 35: scene_hunter.v1.UpdateHintResponse.hints:type_name -> scene_hunter.v1.Hint
+	19, // 36: scene_hunter.v1.ReorderHintsResponse.hints:type_name -> scene_hunter.v1.Hint
+	19, // 37: scene_hunter.v1.RegenerateHintResponse.hints:type_name -> scene_hunter.v1.Hint
+	27, // 38: scene_hunter.v1.ReleaseHintsResponse.game:type_name -> scene_hunter.v1.Game
+	24, // 39: scene_hunter.v1.SubmitHunterPhotoRequest.location:type_name -> scene_hunter.v1.Location
+	6,  // 40: scene_hunter.v1.SubmitHunterPhotoResponse.proximity:type_name -> scene_hunter.v1.Proximity
+	24, // 41: scene_hunter.v1.CheckProximityRequest.location:type_name -> scene_hunter.v1.Location
+	6,  // 42: scene_hunter.v1.CheckProximityResponse.proximity:type_name -> scene_hunter.v1.Proximity
+	27, // 43: scene_hunter.v1.GetGameStateResponse.game:type_name -> scene_hunter.v1.Game
+	9,  // 44: scene_hunter.v1.GetGameEventsResponse.events:type_name -> scene_hunter.v1.GameEvent
+	27, // 45: scene_hunter.v1.StartNextRoundResponse.game:type_name -> scene_hunter.v1.Game
+	20, // 46: scene_hunter.v1.GetHunterPhotosResponse.submissions:type_name -> scene_hunter.v1.HunterSubmission
+	58, // 47: scene_hunter.v1.SelectWinnersRequest.rankings:type_name -> scene_hunter.v1.RankSelection
+	27, // 48: scene_hunter.v1.SelectWinnersResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 49: scene_hunter.v1.CloseVotingResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 50: scene_hunter.v1.BeginGameResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 51: scene_hunter.v1.PauseGameResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 52: scene_hunter.v1.ResumeGameResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 53: scene_hunter.v1.SkipRoundResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 54: scene_hunter.v1.EndGameResponse.game:type_name -> scene_hunter.v1.Game
+	8,  // 55: scene_hunter.v1.EndGameResponse.final_rankings:type_name -> scene_hunter.v1.Player
+	18, // 56: scene_hunter.v1.EndGameResponse.final_team_rankings:type_name -> scene_hunter.v1.Team
+	75, // 57: scene_hunter.v1.AssignTeamsRequest.teams:type_name -> scene_hunter.v1.TeamAssignment
+	27, // 58: scene_hunter.v1.AssignTeamsResponse.game:type_name -> scene_hunter.v1.Game
+	27, // 59: scene_hunter.v1.BalanceTeamsResponse.game:type_name -> scene_hunter.v1.Game
+	28, // 60: scene_hunter.v1.GameService.StartGame:input_type -> scene_hunter.v1.StartGameRequest
+	30, // 61: scene_hunter.v1.GameService.JoinGame:input_type -> scene_hunter.v1.JoinGameRequest
+	76, // 62: scene_hunter.v1.GameService.AssignTeams:input_type -> scene_hunter.v1.AssignTeamsRequest
+	78, // 63: scene_hunter.v1.GameService.BalanceTeams:input_type -> scene_hunter.v1.BalanceTeamsRequest
+	32, // 64: scene_hunter.v1.GameService.SubmitGameMasterPhoto:input_type -> scene_hunter.v1.SubmitGameMasterPhotoRequest
+	34, // 65: scene_hunter.v1.GameService.GetHintDraft:input_type -> scene_hunter.v1.GetHintDraftRequest
+	36, // 66: scene_hunter.v1.GameService.UpdateHint:input_type -> scene_hunter.v1.UpdateHintRequest
+	38, // 67: scene_hunter.v1.GameService.ReorderHints:input_type -> scene_hunter.v1.ReorderHintsRequest
+	40, // 68: scene_hunter.v1.GameService.RegenerateHint:input_type -> scene_hunter.v1.RegenerateHintRequest
+	42, // 69: scene_hunter.v1.GameService.ReleaseHints:input_type -> scene_hunter.v1.ReleaseHintsRequest
+	44, // 70: scene_hunter.v1.GameService.SubmitHunterPhoto:input_type -> scene_hunter.v1.SubmitHunterPhotoRequest
+	46, // 71: scene_hunter.v1.GameService.PickFinalPhoto:input_type -> scene_hunter.v1.PickFinalPhotoRequest
+	48, // 72: scene_hunter.v1.GameService.CheckProximity:input_type -> scene_hunter.v1.CheckProximityRequest
+	56, // 73: scene_hunter.v1.GameService.GetHunterPhotos:input_type -> scene_hunter.v1.GetHunterPhotosRequest
+	59, // 74: scene_hunter.v1.GameService.SelectWinners:input_type -> scene_hunter.v1.SelectWinnersRequest
+	61, // 75: scene_hunter.v1.GameService.SubmitVote:input_type -> scene_hunter.v1.SubmitVoteRequest
+	63, // 76: scene_hunter.v1.GameService.CloseVoting:input_type -> scene_hunter.v1.CloseVotingRequest
+	65, // 77: scene_hunter.v1.GameService.BeginGame:input_type -> scene_hunter.v1.BeginGameRequest
+	67, // 78: scene_hunter.v1.GameService.PauseGame:input_type -> scene_hunter.v1.PauseGameRequest
+	69, // 79: scene_hunter.v1.GameService.ResumeGame:input_type -> scene_hunter.v1.ResumeGameRequest
+	71, // 80: scene_hunter.v1.GameService.SkipRound:input_type -> scene_hunter.v1.SkipRoundRequest
+	50, // 81: scene_hunter.v1.GameService.GetGameState:input_type -> scene_hunter.v1.GetGameStateRequest
+	52, // 82: scene_hunter.v1.GameService.GetGameEvents:input_type -> scene_hunter.v1.GetGameEventsRequest
+	54, // 83: scene_hunter.v1.GameService.StartNextRound:input_type -> scene_hunter.v1.StartNextRoundRequest
+	73, // 84: scene_hunter.v1.GameService.EndGame:input_type -> scene_hunter.v1.EndGameRequest
+	29, // 85: scene_hunter.v1.GameService.StartGame:output_type -> scene_hunter.v1.StartGameResponse
+	31, // 86: scene_hunter.v1.GameService.JoinGame:output_type -> scene_hunter.v1.JoinGameResponse
+	77, // 87: scene_hunter.v1.GameService.AssignTeams:output_type -> scene_hunter.v1.AssignTeamsResponse
+	79, // 88: scene_hunter.v1.GameService.BalanceTeams:output_type -> scene_hunter.v1.BalanceTeamsResponse
+	33, // 89: scene_hunter.v1.GameService.SubmitGameMasterPhoto:output_type -> scene_hunter.v1.SubmitGameMasterPhotoResponse
+	35, // 90: scene_hunter.v1.GameService.GetHintDraft:output_type -> scene_hunter.v1.GetHintDraftResponse
+	37, // 91: scene_hunter.v1.GameService.UpdateHint:output_type -> scene_hunter.v1.UpdateHintResponse
+	39, // 92: scene_hunter.v1.GameService.ReorderHints:output_type -> scene_hunter.v1.ReorderHintsResponse
+	41, // 93: scene_hunter.v1.GameService.RegenerateHint:output_type -> scene_hunter.v1.RegenerateHintResponse
+	43, // 94: scene_hunter.v1.GameService.ReleaseHints:output_type -> scene_hunter.v1.ReleaseHintsResponse
+	45, // 95: scene_hunter.v1.GameService.SubmitHunterPhoto:output_type -> scene_hunter.v1.SubmitHunterPhotoResponse
+	47, // 96: scene_hunter.v1.GameService.PickFinalPhoto:output_type -> scene_hunter.v1.PickFinalPhotoResponse
+	49, // 97: scene_hunter.v1.GameService.CheckProximity:output_type -> scene_hunter.v1.CheckProximityResponse
+	57, // 98: scene_hunter.v1.GameService.GetHunterPhotos:output_type -> scene_hunter.v1.GetHunterPhotosResponse
+	60, // 99: scene_hunter.v1.GameService.SelectWinners:output_type -> scene_hunter.v1.SelectWinnersResponse
+	62, // 100: scene_hunter.v1.GameService.SubmitVote:output_type -> scene_hunter.v1.SubmitVoteResponse
+	64, // 101: scene_hunter.v1.GameService.CloseVoting:output_type -> scene_hunter.v1.CloseVotingResponse
+	66, // 102: scene_hunter.v1.GameService.BeginGame:output_type -> scene_hunter.v1.BeginGameResponse
+	68, // 103: scene_hunter.v1.GameService.PauseGame:output_type -> scene_hunter.v1.PauseGameResponse
+	70, // 104: scene_hunter.v1.GameService.ResumeGame:output_type -> scene_hunter.v1.ResumeGameResponse
+	72, // 105: scene_hunter.v1.GameService.SkipRound:output_type -> scene_hunter.v1.SkipRoundResponse
+	51, // 106: scene_hunter.v1.GameService.GetGameState:output_type -> scene_hunter.v1.GetGameStateResponse
+	53, // 107: scene_hunter.v1.GameService.GetGameEvents:output_type -> scene_hunter.v1.GetGameEventsResponse
+	55, // 108: scene_hunter.v1.GameService.StartNextRound:output_type -> scene_hunter.v1.StartNextRoundResponse
+	74, // 109: scene_hunter.v1.GameService.EndGame:output_type -> scene_hunter.v1.EndGameResponse
+	85, // [85:110] is the sub-list for method output_type
+	60, // [60:85] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_scene_hunter_v1_game_proto_init() }
@@ -1780,13 +5671,34 @@ func file_scene_hunter_v1_game_proto_init() {
 	if File_scene_hunter_v1_game_proto != nil {
 		return
 	}
+	file_scene_hunter_v1_game_proto_msgTypes[1].OneofWrappers = []any{
+		(*GameEvent_Photo)(nil),
+		(*GameEvent_Hints)(nil),
+		(*GameEvent_HintAttempt)(nil),
+		(*GameEvent_Results)(nil),
+		(*GameEvent_Voting)(nil),
+		(*GameEvent_Proximity)(nil),
+		(*GameEvent_Teams)(nil),
+	}
+	file_scene_hunter_v1_game_proto_msgTypes[24].OneofWrappers = []any{
+		(*SubmitGameMasterPhotoRequest_ImageData)(nil),
+		(*SubmitGameMasterPhotoRequest_UploadId)(nil),
+	}
+	file_scene_hunter_v1_game_proto_msgTypes[36].OneofWrappers = []any{
+		(*SubmitHunterPhotoRequest_ImageData)(nil),
+		(*SubmitHunterPhotoRequest_UploadId)(nil),
+	}
+	file_scene_hunter_v1_game_proto_msgTypes[50].OneofWrappers = []any{
+		(*RankSelection_UserId)(nil),
+		(*RankSelection_TeamId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scene_hunter_v1_game_proto_rawDesc), len(file_scene_hunter_v1_game_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      8,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,11 +23,120 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ImageRole describes why an image was uploaded.
+type ImageRole int32
+
+const (
+	ImageRole_IMAGE_ROLE_UNSPECIFIED ImageRole = 0
+	ImageRole_IMAGE_ROLE_UPLOAD      ImageRole = 1 // Uploaded through ImageService outside of a round
+	ImageRole_IMAGE_ROLE_GAME_MASTER ImageRole = 2 // Game master's photo of a round
+	ImageRole_IMAGE_ROLE_HUNTER      ImageRole = 3 // Hunter's photo of a round
+	ImageRole_IMAGE_ROLE_SCENE       ImageRole = 4 // Scene in the room's pool for games without game master photos
+)
+
+// Enum value maps for ImageRole.
+var (
+	ImageRole_name = map[int32]string{
+		0: "IMAGE_ROLE_UNSPECIFIED",
+		1: "IMAGE_ROLE_UPLOAD",
+		2: "IMAGE_ROLE_GAME_MASTER",
+		3: "IMAGE_ROLE_HUNTER",
+		4: "IMAGE_ROLE_SCENE",
+	}
+	ImageRole_value = map[string]int32{
+		"IMAGE_ROLE_UNSPECIFIED": 0,
+		"IMAGE_ROLE_UPLOAD":      1,
+		"IMAGE_ROLE_GAME_MASTER": 2,
+		"IMAGE_ROLE_HUNTER":      3,
+		"IMAGE_ROLE_SCENE":       4,
+	}
+)
+
+func (x ImageRole) Enum() *ImageRole {
+	p := new(ImageRole)
+	*p = x
+	return p
+}
+
+func (x ImageRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_image_proto_enumTypes[0].Descriptor()
+}
+
+func (ImageRole) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_image_proto_enumTypes[0]
+}
+
+func (x ImageRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageRole.Descriptor instead.
+func (ImageRole) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{0}
+}
+
+type ThumbnailSize int32
+
+const (
+	ThumbnailSize_THUMBNAIL_SIZE_UNSPECIFIED ThumbnailSize = 0 // Treated as medium
+	ThumbnailSize_THUMBNAIL_SIZE_SMALL       ThumbnailSize = 1
+	ThumbnailSize_THUMBNAIL_SIZE_MEDIUM      ThumbnailSize = 2
+	ThumbnailSize_THUMBNAIL_SIZE_LARGE       ThumbnailSize = 3
+)
+
+// Enum value maps for ThumbnailSize.
+var (
+	ThumbnailSize_name = map[int32]string{
+		0: "THUMBNAIL_SIZE_UNSPECIFIED",
+		1: "THUMBNAIL_SIZE_SMALL",
+		2: "THUMBNAIL_SIZE_MEDIUM",
+		3: "THUMBNAIL_SIZE_LARGE",
+	}
+	ThumbnailSize_value = map[string]int32{
+		"THUMBNAIL_SIZE_UNSPECIFIED": 0,
+		"THUMBNAIL_SIZE_SMALL":       1,
+		"THUMBNAIL_SIZE_MEDIUM":      2,
+		"THUMBNAIL_SIZE_LARGE":       3,
+	}
+)
+
+func (x ThumbnailSize) Enum() *ThumbnailSize {
+	p := new(ThumbnailSize)
+	*p = x
+	return p
+}
+
+func (x ThumbnailSize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ThumbnailSize) Descriptor() protoreflect.EnumDescriptor {
+	return file_scene_hunter_v1_image_proto_enumTypes[1].Descriptor()
+}
+
+func (ThumbnailSize) Type() protoreflect.EnumType {
+	return &file_scene_hunter_v1_image_proto_enumTypes[1]
+}
+
+func (x ThumbnailSize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ThumbnailSize.Descriptor instead.
+func (ThumbnailSize) EnumDescriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{1}
+}
+
 type UploadImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
 	ImageData     []byte                 `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`
 	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Scene         bool                   `protobuf:"varint,4,opt,name=scene,proto3" json:"scene,omitempty"` // Adds the image to the room's scene pool. Only the room admin can add scenes
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,6 +192,13 @@ func (x *UploadImageRequest) GetContentType() string {
 	return ""
 }
 
+func (x *UploadImageRequest) GetScene() bool {
+	if x != nil {
+		return x.Scene
+	}
+	return false
+}
+
 type UploadImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
@@ -289,6 +405,10 @@ type ImageInfo struct {
 	ImagePath     string                 `protobuf:"bytes,2,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
 	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	LastModified  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	UploaderId    string                 `protobuf:"bytes,6,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`     // Empty when the uploader is unknown
+	RoundNumber   int32                  `protobuf:"varint,7,opt,name=round_number,json=roundNumber,proto3" json:"round_number,omitempty"` // 0 for images uploaded outside of a round
+	Role          ImageRole              `protobuf:"varint,8,opt,name=role,proto3,enum=scene_hunter.v1.ImageRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,6 +471,34 @@ func (x *ImageInfo) GetLastModified() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ImageInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetUploaderId() string {
+	if x != nil {
+		return x.UploaderId
+	}
+	return ""
+}
+
+func (x *ImageInfo) GetRoundNumber() int32 {
+	if x != nil {
+		return x.RoundNumber
+	}
+	return 0
+}
+
+func (x *ImageInfo) GetRole() ImageRole {
+	if x != nil {
+		return x.Role
+	}
+	return ImageRole_IMAGE_ROLE_UNSPECIFIED
+}
+
 type ListImagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Images        []*ImageInfo           `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
@@ -398,6 +546,10 @@ func (x *ListImagesResponse) GetImages() []*ImageInfo {
 type ListImageThumbnailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Size          ThumbnailSize          `protobuf:"varint,2,opt,name=size,proto3,enum=scene_hunter.v1.ThumbnailSize" json:"size,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 50 when 0
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`                          // Only images stored after this time
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -439,11 +591,42 @@ func (x *ListImageThumbnailsRequest) GetRoomId() string {
 	return ""
 }
 
+func (x *ListImageThumbnailsRequest) GetSize() ThumbnailSize {
+	if x != nil {
+		return x.Size
+	}
+	return ThumbnailSize_THUMBNAIL_SIZE_UNSPECIFIED
+}
+
+func (x *ListImageThumbnailsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListImageThumbnailsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListImageThumbnailsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
 type ThumbnailInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
-	ThumbnailData []byte                 `protobuf:"bytes,2,opt,name=thumbnail_data,json=thumbnailData,proto3" json:"thumbnail_data,omitempty"`
+	ThumbnailData []byte                 `protobuf:"bytes,2,opt,name=thumbnail_data,json=thumbnailData,proto3" json:"thumbnail_data,omitempty"` // Set by StreamImageThumbnails
 	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // Set by ListImageThumbnails
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Unavailable   bool                   `protobuf:"varint,6,opt,name=unavailable,proto3" json:"unavailable,omitempty"` // The thumbnail could not be generated
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -499,9 +682,32 @@ func (x *ThumbnailInfo) GetContentType() string {
 	return ""
 }
 
+func (x *ThumbnailInfo) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ThumbnailInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ThumbnailInfo) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
 type ListImageThumbnailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Thumbnails    []*ThumbnailInfo       `protobuf:"bytes,1,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	UrlExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -543,121 +749,1065 @@ func (x *ListImageThumbnailsResponse) GetThumbnails() []*ThumbnailInfo {
 	return nil
 }
 
-var File_scene_hunter_v1_image_proto protoreflect.FileDescriptor
+func (x *ListImageThumbnailsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_scene_hunter_v1_image_proto_rawDesc = "" +
-	"\n" +
-	"\x1bscene_hunter/v1/image.proto\x12\x0fscene_hunter.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
-	"\x12UploadImageRequest\x12.\n" +
-	"\troom_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
-	"^[0-9]{6}$R\broomCode\x12+\n" +
-	"\n" +
-	"image_data\x18\x02 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\timageData\x12K\n" +
-	"\fcontent_type\x18\x03 \x01(\tB(\xbaH%r#R\n" +
-	"image/jpegR\timage/pngR\n" +
-	"image/webpR\vcontentType\"Y\n" +
-	"\x13UploadImageResponse\x12#\n" +
-	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12\x1d\n" +
-	"\n" +
-	"image_path\x18\x02 \x01(\tR\timagePath\"Y\n" +
-	"\x0fGetImageRequest\x12!\n" +
-	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12#\n" +
-	"\bimage_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\"T\n" +
-	"\x10GetImageResponse\x12\x1d\n" +
-	"\n" +
-	"image_data\x18\x01 \x01(\fR\timageData\x12!\n" +
-	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"6\n" +
-	"\x11ListImagesRequest\x12!\n" +
-	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"\xa4\x01\n" +
-	"\tImageInfo\x12#\n" +
-	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12\x1d\n" +
-	"\n" +
-	"image_path\x18\x02 \x01(\tR\timagePath\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x03R\x04size\x12?\n" +
-	"\rlast_modified\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\"H\n" +
-	"\x12ListImagesResponse\x122\n" +
-	"\x06images\x18\x01 \x03(\v2\x1a.scene_hunter.v1.ImageInfoR\x06images\"?\n" +
-	"\x1aListImageThumbnailsRequest\x12!\n" +
-	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"~\n" +
-	"\rThumbnailInfo\x12#\n" +
-	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12%\n" +
-	"\x0ethumbnail_data\x18\x02 \x01(\fR\rthumbnailData\x12!\n" +
-	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"]\n" +
-	"\x1bListImageThumbnailsResponse\x12>\n" +
-	"\n" +
-	"thumbnails\x18\x01 \x03(\v2\x1e.scene_hunter.v1.ThumbnailInfoR\n" +
-	"thumbnails2\x82\x03\n" +
-	"\fImageService\x12X\n" +
-	"\vUploadImage\x12#.scene_hunter.v1.UploadImageRequest\x1a$.scene_hunter.v1.UploadImageResponse\x12O\n" +
-	"\bGetImage\x12 .scene_hunter.v1.GetImageRequest\x1a!.scene_hunter.v1.GetImageResponse\x12U\n" +
-	"\n" +
-	"ListImages\x12\".scene_hunter.v1.ListImagesRequest\x1a#.scene_hunter.v1.ListImagesResponse\x12p\n" +
-	"\x13ListImageThumbnails\x12+.scene_hunter.v1.ListImageThumbnailsRequest\x1a,.scene_hunter.v1.ListImageThumbnailsResponseB\xc7\x01\n" +
-	"\x13com.scene_hunter.v1B\n" +
-	"ImageProtoP\x01ZKgithub.com/yashikota/scene-hunter/server/gen/scene_hunter/v1;scene_hunterv1\xa2\x02\x03SXX\xaa\x02\x0eSceneHunter.V1\xca\x02\x0eSceneHunter\\V1\xe2\x02\x1aSceneHunter\\V1\\GPBMetadata\xea\x02\x0fSceneHunter::V1b\x06proto3"
+func (x *ListImageThumbnailsResponse) GetUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return nil
+}
 
-var (
-	file_scene_hunter_v1_image_proto_rawDescOnce sync.Once
-	file_scene_hunter_v1_image_proto_rawDescData []byte
-)
+type StreamImageThumbnailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Size          ThumbnailSize          `protobuf:"varint,2,opt,name=size,proto3,enum=scene_hunter.v1.ThumbnailSize" json:"size,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"` // Only images stored after this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func file_scene_hunter_v1_image_proto_rawDescGZIP() []byte {
-	file_scene_hunter_v1_image_proto_rawDescOnce.Do(func() {
-		file_scene_hunter_v1_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scene_hunter_v1_image_proto_rawDesc), len(file_scene_hunter_v1_image_proto_rawDesc)))
-	})
-	return file_scene_hunter_v1_image_proto_rawDescData
+func (x *StreamImageThumbnailsRequest) Reset() {
+	*x = StreamImageThumbnailsRequest{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_scene_hunter_v1_image_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_scene_hunter_v1_image_proto_goTypes = []any{
-	(*UploadImageRequest)(nil),          // 0: scene_hunter.v1.UploadImageRequest
-	(*UploadImageResponse)(nil),         // 1: scene_hunter.v1.UploadImageResponse
-	(*GetImageRequest)(nil),             // 2: scene_hunter.v1.GetImageRequest
-	(*GetImageResponse)(nil),            // 3: scene_hunter.v1.GetImageResponse
-	(*ListImagesRequest)(nil),           // 4: scene_hunter.v1.ListImagesRequest
-	(*ImageInfo)(nil),                   // 5: scene_hunter.v1.ImageInfo
-	(*ListImagesResponse)(nil),          // 6: scene_hunter.v1.ListImagesResponse
-	(*ListImageThumbnailsRequest)(nil),  // 7: scene_hunter.v1.ListImageThumbnailsRequest
-	(*ThumbnailInfo)(nil),               // 8: scene_hunter.v1.ThumbnailInfo
-	(*ListImageThumbnailsResponse)(nil), // 9: scene_hunter.v1.ListImageThumbnailsResponse
-	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+func (x *StreamImageThumbnailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_scene_hunter_v1_image_proto_depIdxs = []int32{
-	10, // 0: scene_hunter.v1.ImageInfo.last_modified:type_name -> google.protobuf.Timestamp
-	5,  // 1: scene_hunter.v1.ListImagesResponse.images:type_name -> scene_hunter.v1.ImageInfo
-	8,  // 2: scene_hunter.v1.ListImageThumbnailsResponse.thumbnails:type_name -> scene_hunter.v1.ThumbnailInfo
-	0,  // 3: scene_hunter.v1.ImageService.UploadImage:input_type -> scene_hunter.v1.UploadImageRequest
-	2,  // 4: scene_hunter.v1.ImageService.GetImage:input_type -> scene_hunter.v1.GetImageRequest
-	4,  // 5: scene_hunter.v1.ImageService.ListImages:input_type -> scene_hunter.v1.ListImagesRequest
-	7,  // 6: scene_hunter.v1.ImageService.ListImageThumbnails:input_type -> scene_hunter.v1.ListImageThumbnailsRequest
-	1,  // 7: scene_hunter.v1.ImageService.UploadImage:output_type -> scene_hunter.v1.UploadImageResponse
-	3,  // 8: scene_hunter.v1.ImageService.GetImage:output_type -> scene_hunter.v1.GetImageResponse
-	6,  // 9: scene_hunter.v1.ImageService.ListImages:output_type -> scene_hunter.v1.ListImagesResponse
-	9,  // 10: scene_hunter.v1.ImageService.ListImageThumbnails:output_type -> scene_hunter.v1.ListImageThumbnailsResponse
-	7,  // [7:11] is the sub-list for method output_type
-	3,  // [3:7] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+func (*StreamImageThumbnailsRequest) ProtoMessage() {}
+
+func (x *StreamImageThumbnailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_scene_hunter_v1_image_proto_init() }
-func file_scene_hunter_v1_image_proto_init() {
-	if File_scene_hunter_v1_image_proto != nil {
-		return
+// Deprecated: Use StreamImageThumbnailsRequest.ProtoReflect.Descriptor instead.
+func (*StreamImageThumbnailsRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamImageThumbnailsRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StreamImageThumbnailsRequest) GetSize() ThumbnailSize {
+	if x != nil {
+		return x.Size
+	}
+	return ThumbnailSize_THUMBNAIL_SIZE_UNSPECIFIED
+}
+
+func (x *StreamImageThumbnailsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type StreamImageThumbnailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Thumbnail     *ThumbnailInfo         `protobuf:"bytes,1,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamImageThumbnailsResponse) Reset() {
+	*x = StreamImageThumbnailsResponse{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamImageThumbnailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamImageThumbnailsResponse) ProtoMessage() {}
+
+func (x *StreamImageThumbnailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamImageThumbnailsResponse.ProtoReflect.Descriptor instead.
+func (*StreamImageThumbnailsResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamImageThumbnailsResponse) GetThumbnail() *ThumbnailInfo {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return nil
+}
+
+type CreateUploadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadURLRequest) Reset() {
+	*x = CreateUploadURLRequest{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadURLRequest) ProtoMessage() {}
+
+func (x *CreateUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateUploadURLRequest) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *CreateUploadURLRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type CreateUploadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // Send the image with HTTP PUT
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxSize       int64                  `protobuf:"varint,4,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"` // Maximum size of the image in bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadURLResponse) Reset() {
+	*x = CreateUploadURLResponse{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadURLResponse) ProtoMessage() {}
+
+func (x *CreateUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*CreateUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateUploadURLResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CreateUploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *CreateUploadURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateUploadURLResponse) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type CompleteUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteUploadRequest) Reset() {
+	*x = CompleteUploadRequest{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteUploadRequest) ProtoMessage() {}
+
+func (x *CompleteUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompleteUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type CompleteUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageId       string                 `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	ImagePath     string                 `protobuf:"bytes,2,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteUploadResponse) Reset() {
+	*x = CompleteUploadResponse{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteUploadResponse) ProtoMessage() {}
+
+func (x *CompleteUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteUploadResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompleteUploadResponse) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *CompleteUploadResponse) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+type CreateDownloadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	ImageId       string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadURLRequest) Reset() {
+	*x = CreateDownloadURLRequest{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadURLRequest) ProtoMessage() {}
+
+func (x *CreateDownloadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadURLRequest.ProtoReflect.Descriptor instead.
+func (*CreateDownloadURLRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateDownloadURLRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateDownloadURLRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type CreateDownloadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadUrl   string                 `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadURLResponse) Reset() {
+	*x = CreateDownloadURLResponse{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadURLResponse) ProtoMessage() {}
+
+func (x *CreateDownloadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadURLResponse.ProtoReflect.Descriptor instead.
+func (*CreateDownloadURLResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateDownloadURLResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *CreateDownloadURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateDownloadURLResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// UploadPhotoStreamRequest is one message of a chunked upload.
+// The first message is a header, followed by chunks and optionally a finish message.
+// An upload interrupted before the finish message can be resumed from
+// GetUploadStatus.received_bytes.
+type UploadPhotoStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*UploadPhotoStreamRequest_Header
+	//	*UploadPhotoStreamRequest_Chunk
+	//	*UploadPhotoStreamRequest_Finish
+	Message       isUploadPhotoStreamRequest_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPhotoStreamRequest) Reset() {
+	*x = UploadPhotoStreamRequest{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPhotoStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoStreamRequest) ProtoMessage() {}
+
+func (x *UploadPhotoStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoStreamRequest.ProtoReflect.Descriptor instead.
+func (*UploadPhotoStreamRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UploadPhotoStreamRequest) GetMessage() isUploadPhotoStreamRequest_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *UploadPhotoStreamRequest) GetHeader() *UploadPhotoStreamHeader {
+	if x != nil {
+		if x, ok := x.Message.(*UploadPhotoStreamRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadPhotoStreamRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Message.(*UploadPhotoStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *UploadPhotoStreamRequest) GetFinish() *UploadPhotoStreamFinish {
+	if x != nil {
+		if x, ok := x.Message.(*UploadPhotoStreamRequest_Finish); ok {
+			return x.Finish
+		}
+	}
+	return nil
+}
+
+type isUploadPhotoStreamRequest_Message interface {
+	isUploadPhotoStreamRequest_Message()
+}
+
+type UploadPhotoStreamRequest_Header struct {
+	Header *UploadPhotoStreamHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadPhotoStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // max 1MB
+}
+
+type UploadPhotoStreamRequest_Finish struct {
+	Finish *UploadPhotoStreamFinish `protobuf:"bytes,3,opt,name=finish,proto3,oneof"`
+}
+
+func (*UploadPhotoStreamRequest_Header) isUploadPhotoStreamRequest_Message() {}
+
+func (*UploadPhotoStreamRequest_Chunk) isUploadPhotoStreamRequest_Message() {}
+
+func (*UploadPhotoStreamRequest_Finish) isUploadPhotoStreamRequest_Message() {}
+
+type UploadPhotoStreamHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // From CreateUploadURL
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                    // Bytes already received, 0 for a new upload
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPhotoStreamHeader) Reset() {
+	*x = UploadPhotoStreamHeader{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPhotoStreamHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoStreamHeader) ProtoMessage() {}
+
+func (x *UploadPhotoStreamHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoStreamHeader.ProtoReflect.Descriptor instead.
+func (*UploadPhotoStreamHeader) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UploadPhotoStreamHeader) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadPhotoStreamHeader) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type UploadPhotoStreamFinish struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sha256        []byte                 `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"` // SHA-256 of the whole image
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPhotoStreamFinish) Reset() {
+	*x = UploadPhotoStreamFinish{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPhotoStreamFinish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoStreamFinish) ProtoMessage() {}
+
+func (x *UploadPhotoStreamFinish) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoStreamFinish.ProtoReflect.Descriptor instead.
+func (*UploadPhotoStreamFinish) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UploadPhotoStreamFinish) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+type UploadPhotoStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	ReceivedBytes int64                  `protobuf:"varint,2,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+	Completed     bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"` // True once the checksum has been verified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPhotoStreamResponse) Reset() {
+	*x = UploadPhotoStreamResponse{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPhotoStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPhotoStreamResponse) ProtoMessage() {}
+
+func (x *UploadPhotoStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPhotoStreamResponse.ProtoReflect.Descriptor instead.
+func (*UploadPhotoStreamResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UploadPhotoStreamResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadPhotoStreamResponse) GetReceivedBytes() int64 {
+	if x != nil {
+		return x.ReceivedBytes
+	}
+	return 0
+}
+
+func (x *UploadPhotoStreamResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type GetUploadStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadStatusRequest) Reset() {
+	*x = GetUploadStatusRequest{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadStatusRequest) ProtoMessage() {}
+
+func (x *GetUploadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetUploadStatusRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type GetUploadStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceivedBytes int64                  `protobuf:"varint,1,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadStatusResponse) Reset() {
+	*x = GetUploadStatusResponse{}
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadStatusResponse) ProtoMessage() {}
+
+func (x *GetUploadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scene_hunter_v1_image_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_scene_hunter_v1_image_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUploadStatusResponse) GetReceivedBytes() int64 {
+	if x != nil {
+		return x.ReceivedBytes
+	}
+	return 0
+}
+
+func (x *GetUploadStatusResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *GetUploadStatusResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_scene_hunter_v1_image_proto protoreflect.FileDescriptor
+
+const file_scene_hunter_v1_image_proto_rawDesc = "" +
+	"\n" +
+	"\x1bscene_hunter/v1/image.proto\x12\x0fscene_hunter.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x01\n" +
+	"\x12UploadImageRequest\x12.\n" +
+	"\troom_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[0-9]{6}$R\broomCode\x12+\n" +
+	"\n" +
+	"image_data\x18\x02 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\timageData\x12K\n" +
+	"\fcontent_type\x18\x03 \x01(\tB(\xbaH%r#R\n" +
+	"image/jpegR\timage/pngR\n" +
+	"image/webpR\vcontentType\x12\x14\n" +
+	"\x05scene\x18\x04 \x01(\bR\x05scene\"Y\n" +
+	"\x13UploadImageResponse\x12#\n" +
+	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\x02 \x01(\tR\timagePath\"Y\n" +
+	"\x0fGetImageRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12#\n" +
+	"\bimage_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\"T\n" +
+	"\x10GetImageResponse\x12\x1d\n" +
+	"\n" +
+	"image_data\x18\x01 \x01(\fR\timageData\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"6\n" +
+	"\x11ListImagesRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\"\xbb\x02\n" +
+	"\tImageInfo\x12#\n" +
+	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\x02 \x01(\tR\timagePath\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12?\n" +
+	"\rlast_modified\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1f\n" +
+	"\vuploader_id\x18\x06 \x01(\tR\n" +
+	"uploaderId\x12!\n" +
+	"\fround_number\x18\a \x01(\x05R\vroundNumber\x12.\n" +
+	"\x04role\x18\b \x01(\x0e2\x1a.scene_hunter.v1.ImageRoleR\x04role\"H\n" +
+	"\x12ListImagesResponse\x122\n" +
+	"\x06images\x18\x01 \x03(\v2\x1a.scene_hunter.v1.ImageInfoR\x06images\"\xf6\x01\n" +
+	"\x1aListImageThumbnailsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12<\n" +
+	"\x04size\x18\x02 \x01(\x0e2\x1e.scene_hunter.v1.ThumbnailSizeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04size\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x80\x02\n" +
+	"\rThumbnailInfo\x12#\n" +
+	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12%\n" +
+	"\x0ethumbnail_data\x18\x02 \x01(\fR\rthumbnailData\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12#\n" +
+	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12 \n" +
+	"\vunavailable\x18\x06 \x01(\bR\vunavailable\"\xc7\x01\n" +
+	"\x1bListImageThumbnailsResponse\x12>\n" +
+	"\n" +
+	"thumbnails\x18\x01 \x03(\v2\x1e.scene_hunter.v1.ThumbnailInfoR\n" +
+	"thumbnails\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12@\n" +
+	"\x0eurl_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\furlExpiresAt\"\xb1\x01\n" +
+	"\x1cStreamImageThumbnailsRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12<\n" +
+	"\x04size\x18\x02 \x01(\x0e2\x1e.scene_hunter.v1.ThumbnailSizeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04size\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"]\n" +
+	"\x1dStreamImageThumbnailsResponse\x12<\n" +
+	"\tthumbnail\x18\x01 \x01(\v2\x1e.scene_hunter.v1.ThumbnailInfoR\tthumbnail\"\x95\x01\n" +
+	"\x16CreateUploadURLRequest\x12.\n" +
+	"\troom_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[0-9]{6}$R\broomCode\x12K\n" +
+	"\fcontent_type\x18\x02 \x01(\tB(\xbaH%r#R\n" +
+	"image/jpegR\timage/pngR\n" +
+	"image/webpR\vcontentType\"\xb5\x01\n" +
+	"\x17CreateUploadURLResponse\x12%\n" +
+	"\tupload_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buploadId\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
+	"\bmax_size\x18\x04 \x01(\x03R\amaxSize\">\n" +
+	"\x15CompleteUploadRequest\x12%\n" +
+	"\tupload_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buploadId\"\\\n" +
+	"\x16CompleteUploadResponse\x12#\n" +
+	"\bimage_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\x12\x1d\n" +
+	"\n" +
+	"image_path\x18\x02 \x01(\tR\timagePath\"b\n" +
+	"\x18CreateDownloadURLRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12#\n" +
+	"\bimage_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aimageId\"\x9c\x01\n" +
+	"\x19CreateDownloadURLResponse\x12!\n" +
+	"\fdownload_url\x18\x01 \x01(\tR\vdownloadUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"\xd9\x01\n" +
+	"\x18UploadPhotoStreamRequest\x12B\n" +
+	"\x06header\x18\x01 \x01(\v2(.scene_hunter.v1.UploadPhotoStreamHeaderH\x00R\x06header\x12#\n" +
+	"\x05chunk\x18\x02 \x01(\fB\v\xbaH\bz\x06\x10\x01\x18\x80\x80@H\x00R\x05chunk\x12B\n" +
+	"\x06finish\x18\x03 \x01(\v2(.scene_hunter.v1.UploadPhotoStreamFinishH\x00R\x06finishB\x10\n" +
+	"\amessage\x12\x05\xbaH\x02\b\x01\"a\n" +
+	"\x17UploadPhotoStreamHeader\x12%\n" +
+	"\tupload_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buploadId\x12\x1f\n" +
+	"\x06offset\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06offset\":\n" +
+	"\x17UploadPhotoStreamFinish\x12\x1f\n" +
+	"\x06sha256\x18\x01 \x01(\fB\a\xbaH\x04z\x02h R\x06sha256\"}\n" +
+	"\x19UploadPhotoStreamResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12%\n" +
+	"\x0ereceived_bytes\x18\x02 \x01(\x03R\rreceivedBytes\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\"?\n" +
+	"\x16GetUploadStatusRequest\x12%\n" +
+	"\tupload_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buploadId\"\x99\x01\n" +
+	"\x17GetUploadStatusResponse\x12%\n" +
+	"\x0ereceived_bytes\x18\x01 \x01(\x03R\rreceivedBytes\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*\x87\x01\n" +
+	"\tImageRole\x12\x1a\n" +
+	"\x16IMAGE_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMAGE_ROLE_UPLOAD\x10\x01\x12\x1a\n" +
+	"\x16IMAGE_ROLE_GAME_MASTER\x10\x02\x12\x15\n" +
+	"\x11IMAGE_ROLE_HUNTER\x10\x03\x12\x14\n" +
+	"\x10IMAGE_ROLE_SCENE\x10\x04*~\n" +
+	"\rThumbnailSize\x12\x1e\n" +
+	"\x1aTHUMBNAIL_SIZE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14THUMBNAIL_SIZE_SMALL\x10\x01\x12\x19\n" +
+	"\x15THUMBNAIL_SIZE_MEDIUM\x10\x02\x12\x18\n" +
+	"\x14THUMBNAIL_SIZE_LARGE\x10\x032\x85\b\n" +
+	"\fImageService\x12X\n" +
+	"\vUploadImage\x12#.scene_hunter.v1.UploadImageRequest\x1a$.scene_hunter.v1.UploadImageResponse\x12O\n" +
+	"\bGetImage\x12 .scene_hunter.v1.GetImageRequest\x1a!.scene_hunter.v1.GetImageResponse\x12U\n" +
+	"\n" +
+	"ListImages\x12\".scene_hunter.v1.ListImagesRequest\x1a#.scene_hunter.v1.ListImagesResponse\x12p\n" +
+	"\x13ListImageThumbnails\x12+.scene_hunter.v1.ListImageThumbnailsRequest\x1a,.scene_hunter.v1.ListImageThumbnailsResponse\x12x\n" +
+	"\x15StreamImageThumbnails\x12-.scene_hunter.v1.StreamImageThumbnailsRequest\x1a..scene_hunter.v1.StreamImageThumbnailsResponse0\x01\x12d\n" +
+	"\x0fCreateUploadURL\x12'.scene_hunter.v1.CreateUploadURLRequest\x1a(.scene_hunter.v1.CreateUploadURLResponse\x12a\n" +
+	"\x0eCompleteUpload\x12&.scene_hunter.v1.CompleteUploadRequest\x1a'.scene_hunter.v1.CompleteUploadResponse\x12j\n" +
+	"\x11CreateDownloadURL\x12).scene_hunter.v1.CreateDownloadURLRequest\x1a*.scene_hunter.v1.CreateDownloadURLResponse\x12l\n" +
+	"\x11UploadPhotoStream\x12).scene_hunter.v1.UploadPhotoStreamRequest\x1a*.scene_hunter.v1.UploadPhotoStreamResponse(\x01\x12d\n" +
+	"\x0fGetUploadStatus\x12'.scene_hunter.v1.GetUploadStatusRequest\x1a(.scene_hunter.v1.GetUploadStatusResponseB\xc7\x01\n" +
+	"\x13com.scene_hunter.v1B\n" +
+	"ImageProtoP\x01ZKgithub.com/yashikota/scene-hunter/server/gen/scene_hunter/v1;scene_hunterv1\xa2\x02\x03SXX\xaa\x02\x0eSceneHunter.V1\xca\x02\x0eSceneHunter\\V1\xe2\x02\x1aSceneHunter\\V1\\GPBMetadata\xea\x02\x0fSceneHunter::V1b\x06proto3"
+
+var (
+	file_scene_hunter_v1_image_proto_rawDescOnce sync.Once
+	file_scene_hunter_v1_image_proto_rawDescData []byte
+)
+
+func file_scene_hunter_v1_image_proto_rawDescGZIP() []byte {
+	file_scene_hunter_v1_image_proto_rawDescOnce.Do(func() {
+		file_scene_hunter_v1_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_scene_hunter_v1_image_proto_rawDesc), len(file_scene_hunter_v1_image_proto_rawDesc)))
+	})
+	return file_scene_hunter_v1_image_proto_rawDescData
+}
+
+var file_scene_hunter_v1_image_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_scene_hunter_v1_image_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_scene_hunter_v1_image_proto_goTypes = []any{
+	(ImageRole)(0),                        // 0: scene_hunter.v1.ImageRole
+	(ThumbnailSize)(0),                    // 1: scene_hunter.v1.ThumbnailSize
+	(*UploadImageRequest)(nil),            // 2: scene_hunter.v1.UploadImageRequest
+	(*UploadImageResponse)(nil),           // 3: scene_hunter.v1.UploadImageResponse
+	(*GetImageRequest)(nil),               // 4: scene_hunter.v1.GetImageRequest
+	(*GetImageResponse)(nil),              // 5: scene_hunter.v1.GetImageResponse
+	(*ListImagesRequest)(nil),             // 6: scene_hunter.v1.ListImagesRequest
+	(*ImageInfo)(nil),                     // 7: scene_hunter.v1.ImageInfo
+	(*ListImagesResponse)(nil),            // 8: scene_hunter.v1.ListImagesResponse
+	(*ListImageThumbnailsRequest)(nil),    // 9: scene_hunter.v1.ListImageThumbnailsRequest
+	(*ThumbnailInfo)(nil),                 // 10: scene_hunter.v1.ThumbnailInfo
+	(*ListImageThumbnailsResponse)(nil),   // 11: scene_hunter.v1.ListImageThumbnailsResponse
+	(*StreamImageThumbnailsRequest)(nil),  // 12: scene_hunter.v1.StreamImageThumbnailsRequest
+	(*StreamImageThumbnailsResponse)(nil), // 13: scene_hunter.v1.StreamImageThumbnailsResponse
+	(*CreateUploadURLRequest)(nil),        // 14: scene_hunter.v1.CreateUploadURLRequest
+	(*CreateUploadURLResponse)(nil),       // 15: scene_hunter.v1.CreateUploadURLResponse
+	(*CompleteUploadRequest)(nil),         // 16: scene_hunter.v1.CompleteUploadRequest
+	(*CompleteUploadResponse)(nil),        // 17: scene_hunter.v1.CompleteUploadResponse
+	(*CreateDownloadURLRequest)(nil),      // 18: scene_hunter.v1.CreateDownloadURLRequest
+	(*CreateDownloadURLResponse)(nil),     // 19: scene_hunter.v1.CreateDownloadURLResponse
+	(*UploadPhotoStreamRequest)(nil),      // 20: scene_hunter.v1.UploadPhotoStreamRequest
+	(*UploadPhotoStreamHeader)(nil),       // 21: scene_hunter.v1.UploadPhotoStreamHeader
+	(*UploadPhotoStreamFinish)(nil),       // 22: scene_hunter.v1.UploadPhotoStreamFinish
+	(*UploadPhotoStreamResponse)(nil),     // 23: scene_hunter.v1.UploadPhotoStreamResponse
+	(*GetUploadStatusRequest)(nil),        // 24: scene_hunter.v1.GetUploadStatusRequest
+	(*GetUploadStatusResponse)(nil),       // 25: scene_hunter.v1.GetUploadStatusResponse
+	(*timestamppb.Timestamp)(nil),         // 26: google.protobuf.Timestamp
+}
+var file_scene_hunter_v1_image_proto_depIdxs = []int32{
+	26, // 0: scene_hunter.v1.ImageInfo.last_modified:type_name -> google.protobuf.Timestamp
+	0,  // 1: scene_hunter.v1.ImageInfo.role:type_name -> scene_hunter.v1.ImageRole
+	7,  // 2: scene_hunter.v1.ListImagesResponse.images:type_name -> scene_hunter.v1.ImageInfo
+	1,  // 3: scene_hunter.v1.ListImageThumbnailsRequest.size:type_name -> scene_hunter.v1.ThumbnailSize
+	26, // 4: scene_hunter.v1.ListImageThumbnailsRequest.since:type_name -> google.protobuf.Timestamp
+	26, // 5: scene_hunter.v1.ThumbnailInfo.created_at:type_name -> google.protobuf.Timestamp
+	10, // 6: scene_hunter.v1.ListImageThumbnailsResponse.thumbnails:type_name -> scene_hunter.v1.ThumbnailInfo
+	26, // 7: scene_hunter.v1.ListImageThumbnailsResponse.url_expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: scene_hunter.v1.StreamImageThumbnailsRequest.size:type_name -> scene_hunter.v1.ThumbnailSize
+	26, // 9: scene_hunter.v1.StreamImageThumbnailsRequest.since:type_name -> google.protobuf.Timestamp
+	10, // 10: scene_hunter.v1.StreamImageThumbnailsResponse.thumbnail:type_name -> scene_hunter.v1.ThumbnailInfo
+	26, // 11: scene_hunter.v1.CreateUploadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	26, // 12: scene_hunter.v1.CreateDownloadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 13: scene_hunter.v1.UploadPhotoStreamRequest.header:type_name -> scene_hunter.v1.UploadPhotoStreamHeader
+	22, // 14: scene_hunter.v1.UploadPhotoStreamRequest.finish:type_name -> scene_hunter.v1.UploadPhotoStreamFinish
+	26, // 15: scene_hunter.v1.GetUploadStatusResponse.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: scene_hunter.v1.ImageService.UploadImage:input_type -> scene_hunter.v1.UploadImageRequest
+	4,  // 17: scene_hunter.v1.ImageService.GetImage:input_type -> scene_hunter.v1.GetImageRequest
+	6,  // 18: scene_hunter.v1.ImageService.ListImages:input_type -> scene_hunter.v1.ListImagesRequest
+	9,  // 19: scene_hunter.v1.ImageService.ListImageThumbnails:input_type -> scene_hunter.v1.ListImageThumbnailsRequest
+	12, // 20: scene_hunter.v1.ImageService.StreamImageThumbnails:input_type -> scene_hunter.v1.StreamImageThumbnailsRequest
+	14, // 21: scene_hunter.v1.ImageService.CreateUploadURL:input_type -> scene_hunter.v1.CreateUploadURLRequest
+	16, // 22: scene_hunter.v1.ImageService.CompleteUpload:input_type -> scene_hunter.v1.CompleteUploadRequest
+	18, // 23: scene_hunter.v1.ImageService.CreateDownloadURL:input_type -> scene_hunter.v1.CreateDownloadURLRequest
+	20, // 24: scene_hunter.v1.ImageService.UploadPhotoStream:input_type -> scene_hunter.v1.UploadPhotoStreamRequest
+	24, // 25: scene_hunter.v1.ImageService.GetUploadStatus:input_type -> scene_hunter.v1.GetUploadStatusRequest
+	3,  // 26: scene_hunter.v1.ImageService.UploadImage:output_type -> scene_hunter.v1.UploadImageResponse
+	5,  // 27: scene_hunter.v1.ImageService.GetImage:output_type -> scene_hunter.v1.GetImageResponse
+	8,  // 28: scene_hunter.v1.ImageService.ListImages:output_type -> scene_hunter.v1.ListImagesResponse
+	11, // 29: scene_hunter.v1.ImageService.ListImageThumbnails:output_type -> scene_hunter.v1.ListImageThumbnailsResponse
+	13, // 30: scene_hunter.v1.ImageService.StreamImageThumbnails:output_type -> scene_hunter.v1.StreamImageThumbnailsResponse
+	15, // 31: scene_hunter.v1.ImageService.CreateUploadURL:output_type -> scene_hunter.v1.CreateUploadURLResponse
+	17, // 32: scene_hunter.v1.ImageService.CompleteUpload:output_type -> scene_hunter.v1.CompleteUploadResponse
+	19, // 33: scene_hunter.v1.ImageService.CreateDownloadURL:output_type -> scene_hunter.v1.CreateDownloadURLResponse
+	23, // 34: scene_hunter.v1.ImageService.UploadPhotoStream:output_type -> scene_hunter.v1.UploadPhotoStreamResponse
+	25, // 35: scene_hunter.v1.ImageService.GetUploadStatus:output_type -> scene_hunter.v1.GetUploadStatusResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_scene_hunter_v1_image_proto_init() }
+func file_scene_hunter_v1_image_proto_init() {
+	if File_scene_hunter_v1_image_proto != nil {
+		return
+	}
+	file_scene_hunter_v1_image_proto_msgTypes[18].OneofWrappers = []any{
+		(*UploadPhotoStreamRequest_Header)(nil),
+		(*UploadPhotoStreamRequest_Chunk)(nil),
+		(*UploadPhotoStreamRequest_Finish)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scene_hunter_v1_image_proto_rawDesc), len(file_scene_hunter_v1_image_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_scene_hunter_v1_image_proto_goTypes,
 		DependencyIndexes: file_scene_hunter_v1_image_proto_depIdxs,
+		EnumInfos:         file_scene_hunter_v1_image_proto_enumTypes,
 		MessageInfos:      file_scene_hunter_v1_image_proto_msgTypes,
 	}.Build()
 	File_scene_hunter_v1_image_proto = out.File
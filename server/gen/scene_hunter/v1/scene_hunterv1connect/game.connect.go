@@ -37,21 +37,61 @@ const (
 	GameServiceStartGameProcedure = "/scene_hunter.v1.GameService/StartGame"
 	// GameServiceJoinGameProcedure is the fully-qualified name of the GameService's JoinGame RPC.
 	GameServiceJoinGameProcedure = "/scene_hunter.v1.GameService/JoinGame"
+	// GameServiceAssignTeamsProcedure is the fully-qualified name of the GameService's AssignTeams RPC.
+	GameServiceAssignTeamsProcedure = "/scene_hunter.v1.GameService/AssignTeams"
+	// GameServiceBalanceTeamsProcedure is the fully-qualified name of the GameService's BalanceTeams
+	// RPC.
+	GameServiceBalanceTeamsProcedure = "/scene_hunter.v1.GameService/BalanceTeams"
 	// GameServiceSubmitGameMasterPhotoProcedure is the fully-qualified name of the GameService's
 	// SubmitGameMasterPhoto RPC.
 	GameServiceSubmitGameMasterPhotoProcedure = "/scene_hunter.v1.GameService/SubmitGameMasterPhoto"
+	// GameServiceGetHintDraftProcedure is the fully-qualified name of the GameService's GetHintDraft
+	// RPC.
+	GameServiceGetHintDraftProcedure = "/scene_hunter.v1.GameService/GetHintDraft"
+	// GameServiceUpdateHintProcedure is the fully-qualified name of the GameService's UpdateHint RPC.
+	GameServiceUpdateHintProcedure = "/scene_hunter.v1.GameService/UpdateHint"
+	// GameServiceReorderHintsProcedure is the fully-qualified name of the GameService's ReorderHints
+	// RPC.
+	GameServiceReorderHintsProcedure = "/scene_hunter.v1.GameService/ReorderHints"
+	// GameServiceRegenerateHintProcedure is the fully-qualified name of the GameService's
+	// RegenerateHint RPC.
+	GameServiceRegenerateHintProcedure = "/scene_hunter.v1.GameService/RegenerateHint"
+	// GameServiceReleaseHintsProcedure is the fully-qualified name of the GameService's ReleaseHints
+	// RPC.
+	GameServiceReleaseHintsProcedure = "/scene_hunter.v1.GameService/ReleaseHints"
 	// GameServiceSubmitHunterPhotoProcedure is the fully-qualified name of the GameService's
 	// SubmitHunterPhoto RPC.
 	GameServiceSubmitHunterPhotoProcedure = "/scene_hunter.v1.GameService/SubmitHunterPhoto"
+	// GameServicePickFinalPhotoProcedure is the fully-qualified name of the GameService's
+	// PickFinalPhoto RPC.
+	GameServicePickFinalPhotoProcedure = "/scene_hunter.v1.GameService/PickFinalPhoto"
+	// GameServiceCheckProximityProcedure is the fully-qualified name of the GameService's
+	// CheckProximity RPC.
+	GameServiceCheckProximityProcedure = "/scene_hunter.v1.GameService/CheckProximity"
 	// GameServiceGetHunterPhotosProcedure is the fully-qualified name of the GameService's
 	// GetHunterPhotos RPC.
 	GameServiceGetHunterPhotosProcedure = "/scene_hunter.v1.GameService/GetHunterPhotos"
 	// GameServiceSelectWinnersProcedure is the fully-qualified name of the GameService's SelectWinners
 	// RPC.
 	GameServiceSelectWinnersProcedure = "/scene_hunter.v1.GameService/SelectWinners"
+	// GameServiceSubmitVoteProcedure is the fully-qualified name of the GameService's SubmitVote RPC.
+	GameServiceSubmitVoteProcedure = "/scene_hunter.v1.GameService/SubmitVote"
+	// GameServiceCloseVotingProcedure is the fully-qualified name of the GameService's CloseVoting RPC.
+	GameServiceCloseVotingProcedure = "/scene_hunter.v1.GameService/CloseVoting"
+	// GameServiceBeginGameProcedure is the fully-qualified name of the GameService's BeginGame RPC.
+	GameServiceBeginGameProcedure = "/scene_hunter.v1.GameService/BeginGame"
+	// GameServicePauseGameProcedure is the fully-qualified name of the GameService's PauseGame RPC.
+	GameServicePauseGameProcedure = "/scene_hunter.v1.GameService/PauseGame"
+	// GameServiceResumeGameProcedure is the fully-qualified name of the GameService's ResumeGame RPC.
+	GameServiceResumeGameProcedure = "/scene_hunter.v1.GameService/ResumeGame"
+	// GameServiceSkipRoundProcedure is the fully-qualified name of the GameService's SkipRound RPC.
+	GameServiceSkipRoundProcedure = "/scene_hunter.v1.GameService/SkipRound"
 	// GameServiceGetGameStateProcedure is the fully-qualified name of the GameService's GetGameState
 	// RPC.
 	GameServiceGetGameStateProcedure = "/scene_hunter.v1.GameService/GetGameState"
+	// GameServiceGetGameEventsProcedure is the fully-qualified name of the GameService's GetGameEvents
+	// RPC.
+	GameServiceGetGameEventsProcedure = "/scene_hunter.v1.GameService/GetGameEvents"
 	// GameServiceStartNextRoundProcedure is the fully-qualified name of the GameService's
 	// StartNextRound RPC.
 	GameServiceStartNextRoundProcedure = "/scene_hunter.v1.GameService/StartNextRound"
@@ -63,11 +103,27 @@ const (
 type GameServiceClient interface {
 	StartGame(context.Context, *v1.StartGameRequest) (*v1.StartGameResponse, error)
 	JoinGame(context.Context, *v1.JoinGameRequest) (*v1.JoinGameResponse, error)
+	AssignTeams(context.Context, *v1.AssignTeamsRequest) (*v1.AssignTeamsResponse, error)
+	BalanceTeams(context.Context, *v1.BalanceTeamsRequest) (*v1.BalanceTeamsResponse, error)
 	SubmitGameMasterPhoto(context.Context, *v1.SubmitGameMasterPhotoRequest) (*v1.SubmitGameMasterPhotoResponse, error)
+	GetHintDraft(context.Context, *v1.GetHintDraftRequest) (*v1.GetHintDraftResponse, error)
+	UpdateHint(context.Context, *v1.UpdateHintRequest) (*v1.UpdateHintResponse, error)
+	ReorderHints(context.Context, *v1.ReorderHintsRequest) (*v1.ReorderHintsResponse, error)
+	RegenerateHint(context.Context, *v1.RegenerateHintRequest) (*v1.RegenerateHintResponse, error)
+	ReleaseHints(context.Context, *v1.ReleaseHintsRequest) (*v1.ReleaseHintsResponse, error)
 	SubmitHunterPhoto(context.Context, *v1.SubmitHunterPhotoRequest) (*v1.SubmitHunterPhotoResponse, error)
+	PickFinalPhoto(context.Context, *v1.PickFinalPhotoRequest) (*v1.PickFinalPhotoResponse, error)
+	CheckProximity(context.Context, *v1.CheckProximityRequest) (*v1.CheckProximityResponse, error)
 	GetHunterPhotos(context.Context, *v1.GetHunterPhotosRequest) (*v1.GetHunterPhotosResponse, error)
 	SelectWinners(context.Context, *v1.SelectWinnersRequest) (*v1.SelectWinnersResponse, error)
+	SubmitVote(context.Context, *v1.SubmitVoteRequest) (*v1.SubmitVoteResponse, error)
+	CloseVoting(context.Context, *v1.CloseVotingRequest) (*v1.CloseVotingResponse, error)
+	BeginGame(context.Context, *v1.BeginGameRequest) (*v1.BeginGameResponse, error)
+	PauseGame(context.Context, *v1.PauseGameRequest) (*v1.PauseGameResponse, error)
+	ResumeGame(context.Context, *v1.ResumeGameRequest) (*v1.ResumeGameResponse, error)
+	SkipRound(context.Context, *v1.SkipRoundRequest) (*v1.SkipRoundResponse, error)
 	GetGameState(context.Context, *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error)
+	GetGameEvents(context.Context, *v1.GetGameEventsRequest) (*v1.GetGameEventsResponse, error)
 	StartNextRound(context.Context, *v1.StartNextRoundRequest) (*v1.StartNextRoundResponse, error)
 	EndGame(context.Context, *v1.EndGameRequest) (*v1.EndGameResponse, error)
 }
@@ -95,18 +151,72 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceMethods.ByName("JoinGame")),
 			connect.WithClientOptions(opts...),
 		),
+		assignTeams: connect.NewClient[v1.AssignTeamsRequest, v1.AssignTeamsResponse](
+			httpClient,
+			baseURL+GameServiceAssignTeamsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("AssignTeams")),
+			connect.WithClientOptions(opts...),
+		),
+		balanceTeams: connect.NewClient[v1.BalanceTeamsRequest, v1.BalanceTeamsResponse](
+			httpClient,
+			baseURL+GameServiceBalanceTeamsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("BalanceTeams")),
+			connect.WithClientOptions(opts...),
+		),
 		submitGameMasterPhoto: connect.NewClient[v1.SubmitGameMasterPhotoRequest, v1.SubmitGameMasterPhotoResponse](
 			httpClient,
 			baseURL+GameServiceSubmitGameMasterPhotoProcedure,
 			connect.WithSchema(gameServiceMethods.ByName("SubmitGameMasterPhoto")),
 			connect.WithClientOptions(opts...),
 		),
+		getHintDraft: connect.NewClient[v1.GetHintDraftRequest, v1.GetHintDraftResponse](
+			httpClient,
+			baseURL+GameServiceGetHintDraftProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetHintDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		updateHint: connect.NewClient[v1.UpdateHintRequest, v1.UpdateHintResponse](
+			httpClient,
+			baseURL+GameServiceUpdateHintProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("UpdateHint")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderHints: connect.NewClient[v1.ReorderHintsRequest, v1.ReorderHintsResponse](
+			httpClient,
+			baseURL+GameServiceReorderHintsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("ReorderHints")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateHint: connect.NewClient[v1.RegenerateHintRequest, v1.RegenerateHintResponse](
+			httpClient,
+			baseURL+GameServiceRegenerateHintProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("RegenerateHint")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseHints: connect.NewClient[v1.ReleaseHintsRequest, v1.ReleaseHintsResponse](
+			httpClient,
+			baseURL+GameServiceReleaseHintsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("ReleaseHints")),
+			connect.WithClientOptions(opts...),
+		),
 		submitHunterPhoto: connect.NewClient[v1.SubmitHunterPhotoRequest, v1.SubmitHunterPhotoResponse](
 			httpClient,
 			baseURL+GameServiceSubmitHunterPhotoProcedure,
 			connect.WithSchema(gameServiceMethods.ByName("SubmitHunterPhoto")),
 			connect.WithClientOptions(opts...),
 		),
+		pickFinalPhoto: connect.NewClient[v1.PickFinalPhotoRequest, v1.PickFinalPhotoResponse](
+			httpClient,
+			baseURL+GameServicePickFinalPhotoProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("PickFinalPhoto")),
+			connect.WithClientOptions(opts...),
+		),
+		checkProximity: connect.NewClient[v1.CheckProximityRequest, v1.CheckProximityResponse](
+			httpClient,
+			baseURL+GameServiceCheckProximityProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("CheckProximity")),
+			connect.WithClientOptions(opts...),
+		),
 		getHunterPhotos: connect.NewClient[v1.GetHunterPhotosRequest, v1.GetHunterPhotosResponse](
 			httpClient,
 			baseURL+GameServiceGetHunterPhotosProcedure,
@@ -119,12 +229,54 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceMethods.ByName("SelectWinners")),
 			connect.WithClientOptions(opts...),
 		),
+		submitVote: connect.NewClient[v1.SubmitVoteRequest, v1.SubmitVoteResponse](
+			httpClient,
+			baseURL+GameServiceSubmitVoteProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("SubmitVote")),
+			connect.WithClientOptions(opts...),
+		),
+		closeVoting: connect.NewClient[v1.CloseVotingRequest, v1.CloseVotingResponse](
+			httpClient,
+			baseURL+GameServiceCloseVotingProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("CloseVoting")),
+			connect.WithClientOptions(opts...),
+		),
+		beginGame: connect.NewClient[v1.BeginGameRequest, v1.BeginGameResponse](
+			httpClient,
+			baseURL+GameServiceBeginGameProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("BeginGame")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseGame: connect.NewClient[v1.PauseGameRequest, v1.PauseGameResponse](
+			httpClient,
+			baseURL+GameServicePauseGameProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("PauseGame")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeGame: connect.NewClient[v1.ResumeGameRequest, v1.ResumeGameResponse](
+			httpClient,
+			baseURL+GameServiceResumeGameProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("ResumeGame")),
+			connect.WithClientOptions(opts...),
+		),
+		skipRound: connect.NewClient[v1.SkipRoundRequest, v1.SkipRoundResponse](
+			httpClient,
+			baseURL+GameServiceSkipRoundProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("SkipRound")),
+			connect.WithClientOptions(opts...),
+		),
 		getGameState: connect.NewClient[v1.GetGameStateRequest, v1.GetGameStateResponse](
 			httpClient,
 			baseURL+GameServiceGetGameStateProcedure,
 			connect.WithSchema(gameServiceMethods.ByName("GetGameState")),
 			connect.WithClientOptions(opts...),
 		),
+		getGameEvents: connect.NewClient[v1.GetGameEventsRequest, v1.GetGameEventsResponse](
+			httpClient,
+			baseURL+GameServiceGetGameEventsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetGameEvents")),
+			connect.WithClientOptions(opts...),
+		),
 		startNextRound: connect.NewClient[v1.StartNextRoundRequest, v1.StartNextRoundResponse](
 			httpClient,
 			baseURL+GameServiceStartNextRoundProcedure,
@@ -144,11 +296,27 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 type gameServiceClient struct {
 	startGame             *connect.Client[v1.StartGameRequest, v1.StartGameResponse]
 	joinGame              *connect.Client[v1.JoinGameRequest, v1.JoinGameResponse]
+	assignTeams           *connect.Client[v1.AssignTeamsRequest, v1.AssignTeamsResponse]
+	balanceTeams          *connect.Client[v1.BalanceTeamsRequest, v1.BalanceTeamsResponse]
 	submitGameMasterPhoto *connect.Client[v1.SubmitGameMasterPhotoRequest, v1.SubmitGameMasterPhotoResponse]
+	getHintDraft          *connect.Client[v1.GetHintDraftRequest, v1.GetHintDraftResponse]
+	updateHint            *connect.Client[v1.UpdateHintRequest, v1.UpdateHintResponse]
+	reorderHints          *connect.Client[v1.ReorderHintsRequest, v1.ReorderHintsResponse]
+	regenerateHint        *connect.Client[v1.RegenerateHintRequest, v1.RegenerateHintResponse]
+	releaseHints          *connect.Client[v1.ReleaseHintsRequest, v1.ReleaseHintsResponse]
 	submitHunterPhoto     *connect.Client[v1.SubmitHunterPhotoRequest, v1.SubmitHunterPhotoResponse]
+	pickFinalPhoto        *connect.Client[v1.PickFinalPhotoRequest, v1.PickFinalPhotoResponse]
+	checkProximity        *connect.Client[v1.CheckProximityRequest, v1.CheckProximityResponse]
 	getHunterPhotos       *connect.Client[v1.GetHunterPhotosRequest, v1.GetHunterPhotosResponse]
 	selectWinners         *connect.Client[v1.SelectWinnersRequest, v1.SelectWinnersResponse]
+	submitVote            *connect.Client[v1.SubmitVoteRequest, v1.SubmitVoteResponse]
+	closeVoting           *connect.Client[v1.CloseVotingRequest, v1.CloseVotingResponse]
+	beginGame             *connect.Client[v1.BeginGameRequest, v1.BeginGameResponse]
+	pauseGame             *connect.Client[v1.PauseGameRequest, v1.PauseGameResponse]
+	resumeGame            *connect.Client[v1.ResumeGameRequest, v1.ResumeGameResponse]
+	skipRound             *connect.Client[v1.SkipRoundRequest, v1.SkipRoundResponse]
 	getGameState          *connect.Client[v1.GetGameStateRequest, v1.GetGameStateResponse]
+	getGameEvents         *connect.Client[v1.GetGameEventsRequest, v1.GetGameEventsResponse]
 	startNextRound        *connect.Client[v1.StartNextRoundRequest, v1.StartNextRoundResponse]
 	endGame               *connect.Client[v1.EndGameRequest, v1.EndGameResponse]
 }
@@ -171,6 +339,24 @@ func (c *gameServiceClient) JoinGame(ctx context.Context, req *v1.JoinGameReques
 	return nil, err
 }
 
+// AssignTeams calls scene_hunter.v1.GameService.AssignTeams.
+func (c *gameServiceClient) AssignTeams(ctx context.Context, req *v1.AssignTeamsRequest) (*v1.AssignTeamsResponse, error) {
+	response, err := c.assignTeams.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BalanceTeams calls scene_hunter.v1.GameService.BalanceTeams.
+func (c *gameServiceClient) BalanceTeams(ctx context.Context, req *v1.BalanceTeamsRequest) (*v1.BalanceTeamsResponse, error) {
+	response, err := c.balanceTeams.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // SubmitGameMasterPhoto calls scene_hunter.v1.GameService.SubmitGameMasterPhoto.
 func (c *gameServiceClient) SubmitGameMasterPhoto(ctx context.Context, req *v1.SubmitGameMasterPhotoRequest) (*v1.SubmitGameMasterPhotoResponse, error) {
 	response, err := c.submitGameMasterPhoto.CallUnary(ctx, connect.NewRequest(req))
@@ -180,6 +366,51 @@ func (c *gameServiceClient) SubmitGameMasterPhoto(ctx context.Context, req *v1.S
 	return nil, err
 }
 
+// GetHintDraft calls scene_hunter.v1.GameService.GetHintDraft.
+func (c *gameServiceClient) GetHintDraft(ctx context.Context, req *v1.GetHintDraftRequest) (*v1.GetHintDraftResponse, error) {
+	response, err := c.getHintDraft.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateHint calls scene_hunter.v1.GameService.UpdateHint.
+func (c *gameServiceClient) UpdateHint(ctx context.Context, req *v1.UpdateHintRequest) (*v1.UpdateHintResponse, error) {
+	response, err := c.updateHint.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReorderHints calls scene_hunter.v1.GameService.ReorderHints.
+func (c *gameServiceClient) ReorderHints(ctx context.Context, req *v1.ReorderHintsRequest) (*v1.ReorderHintsResponse, error) {
+	response, err := c.reorderHints.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RegenerateHint calls scene_hunter.v1.GameService.RegenerateHint.
+func (c *gameServiceClient) RegenerateHint(ctx context.Context, req *v1.RegenerateHintRequest) (*v1.RegenerateHintResponse, error) {
+	response, err := c.regenerateHint.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReleaseHints calls scene_hunter.v1.GameService.ReleaseHints.
+func (c *gameServiceClient) ReleaseHints(ctx context.Context, req *v1.ReleaseHintsRequest) (*v1.ReleaseHintsResponse, error) {
+	response, err := c.releaseHints.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // SubmitHunterPhoto calls scene_hunter.v1.GameService.SubmitHunterPhoto.
 func (c *gameServiceClient) SubmitHunterPhoto(ctx context.Context, req *v1.SubmitHunterPhotoRequest) (*v1.SubmitHunterPhotoResponse, error) {
 	response, err := c.submitHunterPhoto.CallUnary(ctx, connect.NewRequest(req))
@@ -189,6 +420,24 @@ func (c *gameServiceClient) SubmitHunterPhoto(ctx context.Context, req *v1.Submi
 	return nil, err
 }
 
+// PickFinalPhoto calls scene_hunter.v1.GameService.PickFinalPhoto.
+func (c *gameServiceClient) PickFinalPhoto(ctx context.Context, req *v1.PickFinalPhotoRequest) (*v1.PickFinalPhotoResponse, error) {
+	response, err := c.pickFinalPhoto.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckProximity calls scene_hunter.v1.GameService.CheckProximity.
+func (c *gameServiceClient) CheckProximity(ctx context.Context, req *v1.CheckProximityRequest) (*v1.CheckProximityResponse, error) {
+	response, err := c.checkProximity.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // GetHunterPhotos calls scene_hunter.v1.GameService.GetHunterPhotos.
 func (c *gameServiceClient) GetHunterPhotos(ctx context.Context, req *v1.GetHunterPhotosRequest) (*v1.GetHunterPhotosResponse, error) {
 	response, err := c.getHunterPhotos.CallUnary(ctx, connect.NewRequest(req))
@@ -207,6 +456,60 @@ func (c *gameServiceClient) SelectWinners(ctx context.Context, req *v1.SelectWin
 	return nil, err
 }
 
+// SubmitVote calls scene_hunter.v1.GameService.SubmitVote.
+func (c *gameServiceClient) SubmitVote(ctx context.Context, req *v1.SubmitVoteRequest) (*v1.SubmitVoteResponse, error) {
+	response, err := c.submitVote.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CloseVoting calls scene_hunter.v1.GameService.CloseVoting.
+func (c *gameServiceClient) CloseVoting(ctx context.Context, req *v1.CloseVotingRequest) (*v1.CloseVotingResponse, error) {
+	response, err := c.closeVoting.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BeginGame calls scene_hunter.v1.GameService.BeginGame.
+func (c *gameServiceClient) BeginGame(ctx context.Context, req *v1.BeginGameRequest) (*v1.BeginGameResponse, error) {
+	response, err := c.beginGame.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PauseGame calls scene_hunter.v1.GameService.PauseGame.
+func (c *gameServiceClient) PauseGame(ctx context.Context, req *v1.PauseGameRequest) (*v1.PauseGameResponse, error) {
+	response, err := c.pauseGame.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ResumeGame calls scene_hunter.v1.GameService.ResumeGame.
+func (c *gameServiceClient) ResumeGame(ctx context.Context, req *v1.ResumeGameRequest) (*v1.ResumeGameResponse, error) {
+	response, err := c.resumeGame.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SkipRound calls scene_hunter.v1.GameService.SkipRound.
+func (c *gameServiceClient) SkipRound(ctx context.Context, req *v1.SkipRoundRequest) (*v1.SkipRoundResponse, error) {
+	response, err := c.skipRound.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // GetGameState calls scene_hunter.v1.GameService.GetGameState.
 func (c *gameServiceClient) GetGameState(ctx context.Context, req *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error) {
 	response, err := c.getGameState.CallUnary(ctx, connect.NewRequest(req))
@@ -216,6 +519,15 @@ func (c *gameServiceClient) GetGameState(ctx context.Context, req *v1.GetGameSta
 	return nil, err
 }
 
+// GetGameEvents calls scene_hunter.v1.GameService.GetGameEvents.
+func (c *gameServiceClient) GetGameEvents(ctx context.Context, req *v1.GetGameEventsRequest) (*v1.GetGameEventsResponse, error) {
+	response, err := c.getGameEvents.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // StartNextRound calls scene_hunter.v1.GameService.StartNextRound.
 func (c *gameServiceClient) StartNextRound(ctx context.Context, req *v1.StartNextRoundRequest) (*v1.StartNextRoundResponse, error) {
 	response, err := c.startNextRound.CallUnary(ctx, connect.NewRequest(req))
@@ -238,11 +550,27 @@ func (c *gameServiceClient) EndGame(ctx context.Context, req *v1.EndGameRequest)
 type GameServiceHandler interface {
 	StartGame(context.Context, *v1.StartGameRequest) (*v1.StartGameResponse, error)
 	JoinGame(context.Context, *v1.JoinGameRequest) (*v1.JoinGameResponse, error)
+	AssignTeams(context.Context, *v1.AssignTeamsRequest) (*v1.AssignTeamsResponse, error)
+	BalanceTeams(context.Context, *v1.BalanceTeamsRequest) (*v1.BalanceTeamsResponse, error)
 	SubmitGameMasterPhoto(context.Context, *v1.SubmitGameMasterPhotoRequest) (*v1.SubmitGameMasterPhotoResponse, error)
+	GetHintDraft(context.Context, *v1.GetHintDraftRequest) (*v1.GetHintDraftResponse, error)
+	UpdateHint(context.Context, *v1.UpdateHintRequest) (*v1.UpdateHintResponse, error)
+	ReorderHints(context.Context, *v1.ReorderHintsRequest) (*v1.ReorderHintsResponse, error)
+	RegenerateHint(context.Context, *v1.RegenerateHintRequest) (*v1.RegenerateHintResponse, error)
+	ReleaseHints(context.Context, *v1.ReleaseHintsRequest) (*v1.ReleaseHintsResponse, error)
 	SubmitHunterPhoto(context.Context, *v1.SubmitHunterPhotoRequest) (*v1.SubmitHunterPhotoResponse, error)
+	PickFinalPhoto(context.Context, *v1.PickFinalPhotoRequest) (*v1.PickFinalPhotoResponse, error)
+	CheckProximity(context.Context, *v1.CheckProximityRequest) (*v1.CheckProximityResponse, error)
 	GetHunterPhotos(context.Context, *v1.GetHunterPhotosRequest) (*v1.GetHunterPhotosResponse, error)
 	SelectWinners(context.Context, *v1.SelectWinnersRequest) (*v1.SelectWinnersResponse, error)
+	SubmitVote(context.Context, *v1.SubmitVoteRequest) (*v1.SubmitVoteResponse, error)
+	CloseVoting(context.Context, *v1.CloseVotingRequest) (*v1.CloseVotingResponse, error)
+	BeginGame(context.Context, *v1.BeginGameRequest) (*v1.BeginGameResponse, error)
+	PauseGame(context.Context, *v1.PauseGameRequest) (*v1.PauseGameResponse, error)
+	ResumeGame(context.Context, *v1.ResumeGameRequest) (*v1.ResumeGameResponse, error)
+	SkipRound(context.Context, *v1.SkipRoundRequest) (*v1.SkipRoundResponse, error)
 	GetGameState(context.Context, *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error)
+	GetGameEvents(context.Context, *v1.GetGameEventsRequest) (*v1.GetGameEventsResponse, error)
 	StartNextRound(context.Context, *v1.StartNextRoundRequest) (*v1.StartNextRoundResponse, error)
 	EndGame(context.Context, *v1.EndGameRequest) (*v1.EndGameResponse, error)
 }
@@ -266,18 +594,72 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceMethods.ByName("JoinGame")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceAssignTeamsHandler := connect.NewUnaryHandlerSimple(
+		GameServiceAssignTeamsProcedure,
+		svc.AssignTeams,
+		connect.WithSchema(gameServiceMethods.ByName("AssignTeams")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceBalanceTeamsHandler := connect.NewUnaryHandlerSimple(
+		GameServiceBalanceTeamsProcedure,
+		svc.BalanceTeams,
+		connect.WithSchema(gameServiceMethods.ByName("BalanceTeams")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceSubmitGameMasterPhotoHandler := connect.NewUnaryHandlerSimple(
 		GameServiceSubmitGameMasterPhotoProcedure,
 		svc.SubmitGameMasterPhoto,
 		connect.WithSchema(gameServiceMethods.ByName("SubmitGameMasterPhoto")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceGetHintDraftHandler := connect.NewUnaryHandlerSimple(
+		GameServiceGetHintDraftProcedure,
+		svc.GetHintDraft,
+		connect.WithSchema(gameServiceMethods.ByName("GetHintDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceUpdateHintHandler := connect.NewUnaryHandlerSimple(
+		GameServiceUpdateHintProcedure,
+		svc.UpdateHint,
+		connect.WithSchema(gameServiceMethods.ByName("UpdateHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceReorderHintsHandler := connect.NewUnaryHandlerSimple(
+		GameServiceReorderHintsProcedure,
+		svc.ReorderHints,
+		connect.WithSchema(gameServiceMethods.ByName("ReorderHints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceRegenerateHintHandler := connect.NewUnaryHandlerSimple(
+		GameServiceRegenerateHintProcedure,
+		svc.RegenerateHint,
+		connect.WithSchema(gameServiceMethods.ByName("RegenerateHint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceReleaseHintsHandler := connect.NewUnaryHandlerSimple(
+		GameServiceReleaseHintsProcedure,
+		svc.ReleaseHints,
+		connect.WithSchema(gameServiceMethods.ByName("ReleaseHints")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceSubmitHunterPhotoHandler := connect.NewUnaryHandlerSimple(
 		GameServiceSubmitHunterPhotoProcedure,
 		svc.SubmitHunterPhoto,
 		connect.WithSchema(gameServiceMethods.ByName("SubmitHunterPhoto")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServicePickFinalPhotoHandler := connect.NewUnaryHandlerSimple(
+		GameServicePickFinalPhotoProcedure,
+		svc.PickFinalPhoto,
+		connect.WithSchema(gameServiceMethods.ByName("PickFinalPhoto")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceCheckProximityHandler := connect.NewUnaryHandlerSimple(
+		GameServiceCheckProximityProcedure,
+		svc.CheckProximity,
+		connect.WithSchema(gameServiceMethods.ByName("CheckProximity")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceGetHunterPhotosHandler := connect.NewUnaryHandlerSimple(
 		GameServiceGetHunterPhotosProcedure,
 		svc.GetHunterPhotos,
@@ -290,12 +672,54 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceMethods.ByName("SelectWinners")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceSubmitVoteHandler := connect.NewUnaryHandlerSimple(
+		GameServiceSubmitVoteProcedure,
+		svc.SubmitVote,
+		connect.WithSchema(gameServiceMethods.ByName("SubmitVote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceCloseVotingHandler := connect.NewUnaryHandlerSimple(
+		GameServiceCloseVotingProcedure,
+		svc.CloseVoting,
+		connect.WithSchema(gameServiceMethods.ByName("CloseVoting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceBeginGameHandler := connect.NewUnaryHandlerSimple(
+		GameServiceBeginGameProcedure,
+		svc.BeginGame,
+		connect.WithSchema(gameServiceMethods.ByName("BeginGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServicePauseGameHandler := connect.NewUnaryHandlerSimple(
+		GameServicePauseGameProcedure,
+		svc.PauseGame,
+		connect.WithSchema(gameServiceMethods.ByName("PauseGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceResumeGameHandler := connect.NewUnaryHandlerSimple(
+		GameServiceResumeGameProcedure,
+		svc.ResumeGame,
+		connect.WithSchema(gameServiceMethods.ByName("ResumeGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceSkipRoundHandler := connect.NewUnaryHandlerSimple(
+		GameServiceSkipRoundProcedure,
+		svc.SkipRound,
+		connect.WithSchema(gameServiceMethods.ByName("SkipRound")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceGetGameStateHandler := connect.NewUnaryHandlerSimple(
 		GameServiceGetGameStateProcedure,
 		svc.GetGameState,
 		connect.WithSchema(gameServiceMethods.ByName("GetGameState")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceGetGameEventsHandler := connect.NewUnaryHandlerSimple(
+		GameServiceGetGameEventsProcedure,
+		svc.GetGameEvents,
+		connect.WithSchema(gameServiceMethods.ByName("GetGameEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	gameServiceStartNextRoundHandler := connect.NewUnaryHandlerSimple(
 		GameServiceStartNextRoundProcedure,
 		svc.StartNextRound,
@@ -314,16 +738,48 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 			gameServiceStartGameHandler.ServeHTTP(w, r)
 		case GameServiceJoinGameProcedure:
 			gameServiceJoinGameHandler.ServeHTTP(w, r)
+		case GameServiceAssignTeamsProcedure:
+			gameServiceAssignTeamsHandler.ServeHTTP(w, r)
+		case GameServiceBalanceTeamsProcedure:
+			gameServiceBalanceTeamsHandler.ServeHTTP(w, r)
 		case GameServiceSubmitGameMasterPhotoProcedure:
 			gameServiceSubmitGameMasterPhotoHandler.ServeHTTP(w, r)
+		case GameServiceGetHintDraftProcedure:
+			gameServiceGetHintDraftHandler.ServeHTTP(w, r)
+		case GameServiceUpdateHintProcedure:
+			gameServiceUpdateHintHandler.ServeHTTP(w, r)
+		case GameServiceReorderHintsProcedure:
+			gameServiceReorderHintsHandler.ServeHTTP(w, r)
+		case GameServiceRegenerateHintProcedure:
+			gameServiceRegenerateHintHandler.ServeHTTP(w, r)
+		case GameServiceReleaseHintsProcedure:
+			gameServiceReleaseHintsHandler.ServeHTTP(w, r)
 		case GameServiceSubmitHunterPhotoProcedure:
 			gameServiceSubmitHunterPhotoHandler.ServeHTTP(w, r)
+		case GameServicePickFinalPhotoProcedure:
+			gameServicePickFinalPhotoHandler.ServeHTTP(w, r)
+		case GameServiceCheckProximityProcedure:
+			gameServiceCheckProximityHandler.ServeHTTP(w, r)
 		case GameServiceGetHunterPhotosProcedure:
 			gameServiceGetHunterPhotosHandler.ServeHTTP(w, r)
 		case GameServiceSelectWinnersProcedure:
 			gameServiceSelectWinnersHandler.ServeHTTP(w, r)
+		case GameServiceSubmitVoteProcedure:
+			gameServiceSubmitVoteHandler.ServeHTTP(w, r)
+		case GameServiceCloseVotingProcedure:
+			gameServiceCloseVotingHandler.ServeHTTP(w, r)
+		case GameServiceBeginGameProcedure:
+			gameServiceBeginGameHandler.ServeHTTP(w, r)
+		case GameServicePauseGameProcedure:
+			gameServicePauseGameHandler.ServeHTTP(w, r)
+		case GameServiceResumeGameProcedure:
+			gameServiceResumeGameHandler.ServeHTTP(w, r)
+		case GameServiceSkipRoundProcedure:
+			gameServiceSkipRoundHandler.ServeHTTP(w, r)
 		case GameServiceGetGameStateProcedure:
 			gameServiceGetGameStateHandler.ServeHTTP(w, r)
+		case GameServiceGetGameEventsProcedure:
+			gameServiceGetGameEventsHandler.ServeHTTP(w, r)
 		case GameServiceStartNextRoundProcedure:
 			gameServiceStartNextRoundHandler.ServeHTTP(w, r)
 		case GameServiceEndGameProcedure:
@@ -345,14 +801,50 @@ func (UnimplementedGameServiceHandler) JoinGame(context.Context, *v1.JoinGameReq
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.JoinGame is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) AssignTeams(context.Context, *v1.AssignTeamsRequest) (*v1.AssignTeamsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.AssignTeams is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) BalanceTeams(context.Context, *v1.BalanceTeamsRequest) (*v1.BalanceTeamsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.BalanceTeams is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) SubmitGameMasterPhoto(context.Context, *v1.SubmitGameMasterPhotoRequest) (*v1.SubmitGameMasterPhotoResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.SubmitGameMasterPhoto is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) GetHintDraft(context.Context, *v1.GetHintDraftRequest) (*v1.GetHintDraftResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.GetHintDraft is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) UpdateHint(context.Context, *v1.UpdateHintRequest) (*v1.UpdateHintResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.UpdateHint is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) ReorderHints(context.Context, *v1.ReorderHintsRequest) (*v1.ReorderHintsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.ReorderHints is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) RegenerateHint(context.Context, *v1.RegenerateHintRequest) (*v1.RegenerateHintResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.RegenerateHint is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) ReleaseHints(context.Context, *v1.ReleaseHintsRequest) (*v1.ReleaseHintsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.ReleaseHints is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) SubmitHunterPhoto(context.Context, *v1.SubmitHunterPhotoRequest) (*v1.SubmitHunterPhotoResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.SubmitHunterPhoto is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) PickFinalPhoto(context.Context, *v1.PickFinalPhotoRequest) (*v1.PickFinalPhotoResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.PickFinalPhoto is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) CheckProximity(context.Context, *v1.CheckProximityRequest) (*v1.CheckProximityResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.CheckProximity is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) GetHunterPhotos(context.Context, *v1.GetHunterPhotosRequest) (*v1.GetHunterPhotosResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.GetHunterPhotos is not implemented"))
 }
@@ -361,10 +853,38 @@ func (UnimplementedGameServiceHandler) SelectWinners(context.Context, *v1.Select
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.SelectWinners is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) SubmitVote(context.Context, *v1.SubmitVoteRequest) (*v1.SubmitVoteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.SubmitVote is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) CloseVoting(context.Context, *v1.CloseVotingRequest) (*v1.CloseVotingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.CloseVoting is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) BeginGame(context.Context, *v1.BeginGameRequest) (*v1.BeginGameResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.BeginGame is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) PauseGame(context.Context, *v1.PauseGameRequest) (*v1.PauseGameResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.PauseGame is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) ResumeGame(context.Context, *v1.ResumeGameRequest) (*v1.ResumeGameResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.ResumeGame is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) SkipRound(context.Context, *v1.SkipRoundRequest) (*v1.SkipRoundResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.SkipRound is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) GetGameState(context.Context, *v1.GetGameStateRequest) (*v1.GetGameStateResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.GetGameState is not implemented"))
 }
 
+func (UnimplementedGameServiceHandler) GetGameEvents(context.Context, *v1.GetGameEventsRequest) (*v1.GetGameEventsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.GetGameEvents is not implemented"))
+}
+
 func (UnimplementedGameServiceHandler) StartNextRound(context.Context, *v1.StartNextRoundRequest) (*v1.StartNextRoundResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.GameService.StartNextRound is not implemented"))
 }
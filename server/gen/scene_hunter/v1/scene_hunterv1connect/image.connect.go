@@ -43,6 +43,24 @@ const (
 	// ImageServiceListImageThumbnailsProcedure is the fully-qualified name of the ImageService's
 	// ListImageThumbnails RPC.
 	ImageServiceListImageThumbnailsProcedure = "/scene_hunter.v1.ImageService/ListImageThumbnails"
+	// ImageServiceStreamImageThumbnailsProcedure is the fully-qualified name of the ImageService's
+	// StreamImageThumbnails RPC.
+	ImageServiceStreamImageThumbnailsProcedure = "/scene_hunter.v1.ImageService/StreamImageThumbnails"
+	// ImageServiceCreateUploadURLProcedure is the fully-qualified name of the ImageService's
+	// CreateUploadURL RPC.
+	ImageServiceCreateUploadURLProcedure = "/scene_hunter.v1.ImageService/CreateUploadURL"
+	// ImageServiceCompleteUploadProcedure is the fully-qualified name of the ImageService's
+	// CompleteUpload RPC.
+	ImageServiceCompleteUploadProcedure = "/scene_hunter.v1.ImageService/CompleteUpload"
+	// ImageServiceCreateDownloadURLProcedure is the fully-qualified name of the ImageService's
+	// CreateDownloadURL RPC.
+	ImageServiceCreateDownloadURLProcedure = "/scene_hunter.v1.ImageService/CreateDownloadURL"
+	// ImageServiceUploadPhotoStreamProcedure is the fully-qualified name of the ImageService's
+	// UploadPhotoStream RPC.
+	ImageServiceUploadPhotoStreamProcedure = "/scene_hunter.v1.ImageService/UploadPhotoStream"
+	// ImageServiceGetUploadStatusProcedure is the fully-qualified name of the ImageService's
+	// GetUploadStatus RPC.
+	ImageServiceGetUploadStatusProcedure = "/scene_hunter.v1.ImageService/GetUploadStatus"
 )
 
 // ImageServiceClient is a client for the scene_hunter.v1.ImageService service.
@@ -50,7 +68,16 @@ type ImageServiceClient interface {
 	UploadImage(context.Context, *v1.UploadImageRequest) (*v1.UploadImageResponse, error)
 	GetImage(context.Context, *v1.GetImageRequest) (*v1.GetImageResponse, error)
 	ListImages(context.Context, *v1.ListImagesRequest) (*v1.ListImagesResponse, error)
+	// Thumbnails are generated once and cached; list returns URLs, stream returns the data
 	ListImageThumbnails(context.Context, *v1.ListImageThumbnailsRequest) (*v1.ListImageThumbnailsResponse, error)
+	StreamImageThumbnails(context.Context, *v1.StreamImageThumbnailsRequest) (*connect.ServerStreamForClient[v1.StreamImageThumbnailsResponse], error)
+	// Direct uploads: get a presigned URL, PUT the image to it, then complete the upload
+	CreateUploadURL(context.Context, *v1.CreateUploadURLRequest) (*v1.CreateUploadURLResponse, error)
+	CompleteUpload(context.Context, *v1.CompleteUploadRequest) (*v1.CompleteUploadResponse, error)
+	CreateDownloadURL(context.Context, *v1.CreateDownloadURLRequest) (*v1.CreateDownloadURLResponse, error)
+	// Chunked uploads: send the upload ID from CreateUploadURL in chunks instead of using the URL
+	UploadPhotoStream(context.Context) (*connect.ClientStreamForClientSimple[v1.UploadPhotoStreamRequest, v1.UploadPhotoStreamResponse], error)
+	GetUploadStatus(context.Context, *v1.GetUploadStatusRequest) (*v1.GetUploadStatusResponse, error)
 }
 
 // NewImageServiceClient constructs a client for the scene_hunter.v1.ImageService service. By
@@ -88,15 +115,57 @@ func NewImageServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(imageServiceMethods.ByName("ListImageThumbnails")),
 			connect.WithClientOptions(opts...),
 		),
+		streamImageThumbnails: connect.NewClient[v1.StreamImageThumbnailsRequest, v1.StreamImageThumbnailsResponse](
+			httpClient,
+			baseURL+ImageServiceStreamImageThumbnailsProcedure,
+			connect.WithSchema(imageServiceMethods.ByName("StreamImageThumbnails")),
+			connect.WithClientOptions(opts...),
+		),
+		createUploadURL: connect.NewClient[v1.CreateUploadURLRequest, v1.CreateUploadURLResponse](
+			httpClient,
+			baseURL+ImageServiceCreateUploadURLProcedure,
+			connect.WithSchema(imageServiceMethods.ByName("CreateUploadURL")),
+			connect.WithClientOptions(opts...),
+		),
+		completeUpload: connect.NewClient[v1.CompleteUploadRequest, v1.CompleteUploadResponse](
+			httpClient,
+			baseURL+ImageServiceCompleteUploadProcedure,
+			connect.WithSchema(imageServiceMethods.ByName("CompleteUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		createDownloadURL: connect.NewClient[v1.CreateDownloadURLRequest, v1.CreateDownloadURLResponse](
+			httpClient,
+			baseURL+ImageServiceCreateDownloadURLProcedure,
+			connect.WithSchema(imageServiceMethods.ByName("CreateDownloadURL")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadPhotoStream: connect.NewClient[v1.UploadPhotoStreamRequest, v1.UploadPhotoStreamResponse](
+			httpClient,
+			baseURL+ImageServiceUploadPhotoStreamProcedure,
+			connect.WithSchema(imageServiceMethods.ByName("UploadPhotoStream")),
+			connect.WithClientOptions(opts...),
+		),
+		getUploadStatus: connect.NewClient[v1.GetUploadStatusRequest, v1.GetUploadStatusResponse](
+			httpClient,
+			baseURL+ImageServiceGetUploadStatusProcedure,
+			connect.WithSchema(imageServiceMethods.ByName("GetUploadStatus")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // imageServiceClient implements ImageServiceClient.
 type imageServiceClient struct {
-	uploadImage         *connect.Client[v1.UploadImageRequest, v1.UploadImageResponse]
-	getImage            *connect.Client[v1.GetImageRequest, v1.GetImageResponse]
-	listImages          *connect.Client[v1.ListImagesRequest, v1.ListImagesResponse]
-	listImageThumbnails *connect.Client[v1.ListImageThumbnailsRequest, v1.ListImageThumbnailsResponse]
+	uploadImage           *connect.Client[v1.UploadImageRequest, v1.UploadImageResponse]
+	getImage              *connect.Client[v1.GetImageRequest, v1.GetImageResponse]
+	listImages            *connect.Client[v1.ListImagesRequest, v1.ListImagesResponse]
+	listImageThumbnails   *connect.Client[v1.ListImageThumbnailsRequest, v1.ListImageThumbnailsResponse]
+	streamImageThumbnails *connect.Client[v1.StreamImageThumbnailsRequest, v1.StreamImageThumbnailsResponse]
+	createUploadURL       *connect.Client[v1.CreateUploadURLRequest, v1.CreateUploadURLResponse]
+	completeUpload        *connect.Client[v1.CompleteUploadRequest, v1.CompleteUploadResponse]
+	createDownloadURL     *connect.Client[v1.CreateDownloadURLRequest, v1.CreateDownloadURLResponse]
+	uploadPhotoStream     *connect.Client[v1.UploadPhotoStreamRequest, v1.UploadPhotoStreamResponse]
+	getUploadStatus       *connect.Client[v1.GetUploadStatusRequest, v1.GetUploadStatusResponse]
 }
 
 // UploadImage calls scene_hunter.v1.ImageService.UploadImage.
@@ -135,12 +204,67 @@ func (c *imageServiceClient) ListImageThumbnails(ctx context.Context, req *v1.Li
 	return nil, err
 }
 
+// StreamImageThumbnails calls scene_hunter.v1.ImageService.StreamImageThumbnails.
+func (c *imageServiceClient) StreamImageThumbnails(ctx context.Context, req *v1.StreamImageThumbnailsRequest) (*connect.ServerStreamForClient[v1.StreamImageThumbnailsResponse], error) {
+	return c.streamImageThumbnails.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// CreateUploadURL calls scene_hunter.v1.ImageService.CreateUploadURL.
+func (c *imageServiceClient) CreateUploadURL(ctx context.Context, req *v1.CreateUploadURLRequest) (*v1.CreateUploadURLResponse, error) {
+	response, err := c.createUploadURL.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CompleteUpload calls scene_hunter.v1.ImageService.CompleteUpload.
+func (c *imageServiceClient) CompleteUpload(ctx context.Context, req *v1.CompleteUploadRequest) (*v1.CompleteUploadResponse, error) {
+	response, err := c.completeUpload.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateDownloadURL calls scene_hunter.v1.ImageService.CreateDownloadURL.
+func (c *imageServiceClient) CreateDownloadURL(ctx context.Context, req *v1.CreateDownloadURLRequest) (*v1.CreateDownloadURLResponse, error) {
+	response, err := c.createDownloadURL.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UploadPhotoStream calls scene_hunter.v1.ImageService.UploadPhotoStream.
+func (c *imageServiceClient) UploadPhotoStream(ctx context.Context) (*connect.ClientStreamForClientSimple[v1.UploadPhotoStreamRequest, v1.UploadPhotoStreamResponse], error) {
+	return c.uploadPhotoStream.CallClientStreamSimple(ctx)
+}
+
+// GetUploadStatus calls scene_hunter.v1.ImageService.GetUploadStatus.
+func (c *imageServiceClient) GetUploadStatus(ctx context.Context, req *v1.GetUploadStatusRequest) (*v1.GetUploadStatusResponse, error) {
+	response, err := c.getUploadStatus.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ImageServiceHandler is an implementation of the scene_hunter.v1.ImageService service.
 type ImageServiceHandler interface {
 	UploadImage(context.Context, *v1.UploadImageRequest) (*v1.UploadImageResponse, error)
 	GetImage(context.Context, *v1.GetImageRequest) (*v1.GetImageResponse, error)
 	ListImages(context.Context, *v1.ListImagesRequest) (*v1.ListImagesResponse, error)
+	// Thumbnails are generated once and cached; list returns URLs, stream returns the data
 	ListImageThumbnails(context.Context, *v1.ListImageThumbnailsRequest) (*v1.ListImageThumbnailsResponse, error)
+	StreamImageThumbnails(context.Context, *v1.StreamImageThumbnailsRequest, *connect.ServerStream[v1.StreamImageThumbnailsResponse]) error
+	// Direct uploads: get a presigned URL, PUT the image to it, then complete the upload
+	CreateUploadURL(context.Context, *v1.CreateUploadURLRequest) (*v1.CreateUploadURLResponse, error)
+	CompleteUpload(context.Context, *v1.CompleteUploadRequest) (*v1.CompleteUploadResponse, error)
+	CreateDownloadURL(context.Context, *v1.CreateDownloadURLRequest) (*v1.CreateDownloadURLResponse, error)
+	// Chunked uploads: send the upload ID from CreateUploadURL in chunks instead of using the URL
+	UploadPhotoStream(context.Context, *connect.ClientStream[v1.UploadPhotoStreamRequest]) (*v1.UploadPhotoStreamResponse, error)
+	GetUploadStatus(context.Context, *v1.GetUploadStatusRequest) (*v1.GetUploadStatusResponse, error)
 }
 
 // NewImageServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -174,6 +298,42 @@ func NewImageServiceHandler(svc ImageServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(imageServiceMethods.ByName("ListImageThumbnails")),
 		connect.WithHandlerOptions(opts...),
 	)
+	imageServiceStreamImageThumbnailsHandler := connect.NewServerStreamHandlerSimple(
+		ImageServiceStreamImageThumbnailsProcedure,
+		svc.StreamImageThumbnails,
+		connect.WithSchema(imageServiceMethods.ByName("StreamImageThumbnails")),
+		connect.WithHandlerOptions(opts...),
+	)
+	imageServiceCreateUploadURLHandler := connect.NewUnaryHandlerSimple(
+		ImageServiceCreateUploadURLProcedure,
+		svc.CreateUploadURL,
+		connect.WithSchema(imageServiceMethods.ByName("CreateUploadURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	imageServiceCompleteUploadHandler := connect.NewUnaryHandlerSimple(
+		ImageServiceCompleteUploadProcedure,
+		svc.CompleteUpload,
+		connect.WithSchema(imageServiceMethods.ByName("CompleteUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	imageServiceCreateDownloadURLHandler := connect.NewUnaryHandlerSimple(
+		ImageServiceCreateDownloadURLProcedure,
+		svc.CreateDownloadURL,
+		connect.WithSchema(imageServiceMethods.ByName("CreateDownloadURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	imageServiceUploadPhotoStreamHandler := connect.NewClientStreamHandlerSimple(
+		ImageServiceUploadPhotoStreamProcedure,
+		svc.UploadPhotoStream,
+		connect.WithSchema(imageServiceMethods.ByName("UploadPhotoStream")),
+		connect.WithHandlerOptions(opts...),
+	)
+	imageServiceGetUploadStatusHandler := connect.NewUnaryHandlerSimple(
+		ImageServiceGetUploadStatusProcedure,
+		svc.GetUploadStatus,
+		connect.WithSchema(imageServiceMethods.ByName("GetUploadStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/scene_hunter.v1.ImageService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ImageServiceUploadImageProcedure:
@@ -184,6 +344,18 @@ func NewImageServiceHandler(svc ImageServiceHandler, opts ...connect.HandlerOpti
 			imageServiceListImagesHandler.ServeHTTP(w, r)
 		case ImageServiceListImageThumbnailsProcedure:
 			imageServiceListImageThumbnailsHandler.ServeHTTP(w, r)
+		case ImageServiceStreamImageThumbnailsProcedure:
+			imageServiceStreamImageThumbnailsHandler.ServeHTTP(w, r)
+		case ImageServiceCreateUploadURLProcedure:
+			imageServiceCreateUploadURLHandler.ServeHTTP(w, r)
+		case ImageServiceCompleteUploadProcedure:
+			imageServiceCompleteUploadHandler.ServeHTTP(w, r)
+		case ImageServiceCreateDownloadURLProcedure:
+			imageServiceCreateDownloadURLHandler.ServeHTTP(w, r)
+		case ImageServiceUploadPhotoStreamProcedure:
+			imageServiceUploadPhotoStreamHandler.ServeHTTP(w, r)
+		case ImageServiceGetUploadStatusProcedure:
+			imageServiceGetUploadStatusHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -208,3 +380,27 @@ func (UnimplementedImageServiceHandler) ListImages(context.Context, *v1.ListImag
 func (UnimplementedImageServiceHandler) ListImageThumbnails(context.Context, *v1.ListImageThumbnailsRequest) (*v1.ListImageThumbnailsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.ListImageThumbnails is not implemented"))
 }
+
+func (UnimplementedImageServiceHandler) StreamImageThumbnails(context.Context, *v1.StreamImageThumbnailsRequest, *connect.ServerStream[v1.StreamImageThumbnailsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.StreamImageThumbnails is not implemented"))
+}
+
+func (UnimplementedImageServiceHandler) CreateUploadURL(context.Context, *v1.CreateUploadURLRequest) (*v1.CreateUploadURLResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.CreateUploadURL is not implemented"))
+}
+
+func (UnimplementedImageServiceHandler) CompleteUpload(context.Context, *v1.CompleteUploadRequest) (*v1.CompleteUploadResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.CompleteUpload is not implemented"))
+}
+
+func (UnimplementedImageServiceHandler) CreateDownloadURL(context.Context, *v1.CreateDownloadURLRequest) (*v1.CreateDownloadURLResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.CreateDownloadURL is not implemented"))
+}
+
+func (UnimplementedImageServiceHandler) UploadPhotoStream(context.Context, *connect.ClientStream[v1.UploadPhotoStreamRequest]) (*v1.UploadPhotoStreamResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.UploadPhotoStream is not implemented"))
+}
+
+func (UnimplementedImageServiceHandler) GetUploadStatus(context.Context, *v1.GetUploadStatusRequest) (*v1.GetUploadStatusResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scene_hunter.v1.ImageService.GetUploadStatus is not implemented"))
+}
@@ -4,23 +4,30 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"net/netip"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
 // AppConfig represents the application configuration.
 type AppConfig struct {
-	App      appConfig      `mapstructure:"app"`
-	Server   serverConfig   `mapstructure:"server"`
-	Database databaseConfig `mapstructure:"database"`
-	Kvs      kvsConfig      `mapstructure:"kvs"`
-	Blob     blobConfig     `mapstructure:"blob"`
-	Gemini   geminiConfig   `mapstructure:"gemini"`
-	Auth     authConfig     `mapstructure:"auth"`
-	Logger   loggerConfig   `mapstructure:"logger"`
-	Otel     otelConfig     `mapstructure:"otel"`
+	App       appConfig       `mapstructure:"app"`
+	Server    serverConfig    `mapstructure:"server"`
+	Database  databaseConfig  `mapstructure:"database"`
+	Kvs       kvsConfig       `mapstructure:"kvs"`
+	Blob      blobConfig      `mapstructure:"blob"`
+	Image     imageConfig     `mapstructure:"image"`
+	Vision    visionConfig    `mapstructure:"vision"`
+	Gemini    geminiConfig    `mapstructure:"gemini"`
+	OpenAI    openAIConfig    `mapstructure:"openai"`
+	Hint      hintConfig      `mapstructure:"hint"`
+	Auth      authConfig      `mapstructure:"auth"`
+	Logger    loggerConfig    `mapstructure:"logger"`
+	Otel      otelConfig      `mapstructure:"otel"`
+	RateLimit rateLimitConfig `mapstructure:"rate_limit"`
 }
 
 type appConfig struct {
@@ -57,17 +64,148 @@ type kvsConfig struct {
 
 type blobConfig struct {
 	URL string `mapstructure:"url"`
+	// PublicURL is the storage address that presigned URLs point to.
+	// It defaults to URL when empty.
+	PublicURL string `mapstructure:"public_url"`
 }
 
+// imageConfig configures the normalization of uploaded photos.
+type imageConfig struct {
+	MaxDimension             int `mapstructure:"max_dimension"`
+	Quality                  int `mapstructure:"quality"`
+	ThumbnailSmallDimension  int `mapstructure:"thumbnail_small_dimension"`
+	ThumbnailMediumDimension int `mapstructure:"thumbnail_medium_dimension"`
+	ThumbnailLargeDimension  int `mapstructure:"thumbnail_large_dimension"`
+	ThumbnailQuality         int `mapstructure:"thumbnail_quality"`
+	// MaxPixels is the maximum width × height of an uploaded photo, checked before decoding.
+	MaxPixels int `mapstructure:"max_pixels"`
+	// ThumbnailWorkers is how many thumbnails the server reads or generates at once.
+	ThumbnailWorkers int `mapstructure:"thumbnail_workers"`
+	// PresignedURLTTL is how long presigned upload and download URLs stay valid.
+	PresignedURLTTL time.Duration `mapstructure:"presigned_url_ttl"`
+}
+
+// visionConfig selects the AI provider that analyzes photos.
+type visionConfig struct {
+	// Provider is "gemini", "openai" or "fake".
+	Provider string `mapstructure:"provider"`
+	// Timeout limits each call to the provider.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxRetries is how many times a call that failed temporarily is retried.
+	MaxRetries int `mapstructure:"max_retries"`
+	// RetryBaseDelay is the longest wait before the first retry, doubled for each later one.
+	RetryBaseDelay time.Duration `mapstructure:"retry_base_delay"`
+	// FailureThreshold is how many calls in a row must fail before calls are stopped.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// OpenDuration is how long calls are stopped before the provider is tried again.
+	OpenDuration time.Duration `mapstructure:"open_duration"`
+	// CacheTTL is how long answers are reused for the same photos. Zero disables the cache.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+	// RoomTokenBudget is how many tokens one room may use. Zero means no limit.
+	RoomTokenBudget int64 `mapstructure:"room_token_budget"`
+	// DailyTokenBudget is how many tokens all rooms may use in a day (UTC). Zero means no limit.
+	DailyTokenBudget int64 `mapstructure:"daily_token_budget"`
+}
+
+const (
+	// VisionProviderGemini analyzes photos with Google Gemini.
+	VisionProviderGemini = "gemini"
+	// VisionProviderOpenAI analyzes photos with an OpenAI-compatible API.
+	VisionProviderOpenAI = "openai"
+	// VisionProviderFake answers with canned hints without calling any provider.
+	VisionProviderFake = "fake"
+)
+
 type geminiConfig struct {
 	Model  string `mapstructure:"model"`
 	APIKey string `mapstructure:"api_key"`
 }
 
+// openAIConfig configures an OpenAI-compatible API, such as a self-hosted model server.
+type openAIConfig struct {
+	BaseURL string `mapstructure:"base_url"`
+	Model   string `mapstructure:"model"`
+	APIKey  string `mapstructure:"api_key"`
+}
+
+// hintConfig configures the background generation of hints
+// and the content filter applied to hints before hunters see them.
+type hintConfig struct {
+	// BannedWords are rejected case-insensitively anywhere in a hint.
+	BannedWords []string `mapstructure:"banned_words"`
+	// PIIPatterns are regular expressions of personal information such as phone numbers.
+	PIIPatterns []string `mapstructure:"pii_patterns"`
+	// Workers is how many hint generation jobs the server runs at once.
+	Workers int `mapstructure:"workers"`
+	// JobAttempts is how many times a job runs before hints are made without AI.
+	JobAttempts int `mapstructure:"job_attempts"`
+	// JobClaimAfter is how long a job may run before another worker takes it over,
+	// for example when the server running it has stopped.
+	JobClaimAfter time.Duration `mapstructure:"job_claim_after"`
+}
+
 type authConfig struct {
 	AccessTokenTTL    time.Duration `mapstructure:"access_token_ttl"`
 	RefreshTokenTTL   time.Duration `mapstructure:"refresh_token_ttl"`
 	GoogleRedirectURI string        `mapstructure:"google_redirect_uri"`
+	// HMACKeys is a comma-separated list of "keyID:secret" pairs accepted for token verification.
+	HMACKeys string `mapstructure:"hmac_keys"`
+	// HMACSigningKeyID selects the key in HMACKeys used to sign new tokens.
+	HMACSigningKeyID string `mapstructure:"hmac_signing_key_id"`
+	// HMACSecret is a single signing key, used when HMACKeys is not set.
+	HMACSecret string `mapstructure:"hmac_secret"`
+	// TokenFormat selects the format of issued access tokens: "hmac" or "jwt".
+	TokenFormat string `mapstructure:"token_format"`
+	// JWTPrivateKey is a PEM-encoded ECDSA P-256 or Ed25519 key; JWTs are accepted when set.
+	JWTPrivateKey string `mapstructure:"jwt_private_key"`
+	// JWTKeyID is the kid of the JWT key; the key thumbprint is used when empty.
+	JWTKeyID string `mapstructure:"jwt_key_id"`
+	// JWTIssuer is the iss claim of issued JWTs.
+	JWTIssuer string `mapstructure:"jwt_issuer"`
+}
+
+const (
+	// TokenFormatHMAC issues HMAC-signed access tokens.
+	TokenFormatHMAC = "hmac"
+	// TokenFormatJWT issues ES256/EdDSA-signed JWT access tokens.
+	TokenFormatJWT = "jwt"
+)
+
+// legacyHMACKeyID is the key ID assigned to HMACSecret.
+const legacyHMACKeyID = "default"
+
+// HMACKeyring returns the signing key ID and all verification keys by ID.
+// If no signing key ID is configured, the first key in HMACKeys signs new tokens.
+func (a authConfig) HMACKeyring() (string, map[string][]byte, error) {
+	if strings.TrimSpace(a.HMACKeys) == "" {
+		if a.HMACSecret == "" {
+			return "", nil, errors.New("auth.hmac_keys or auth.hmac_secret is required")
+		}
+
+		return legacyHMACKeyID, map[string][]byte{legacyHMACKeyID: []byte(a.HMACSecret)}, nil
+	}
+
+	signingKeyID := a.HMACSigningKeyID
+	keys := make(map[string][]byte)
+
+	for entry := range strings.SplitSeq(a.HMACKeys, ",") {
+		keyID, secret, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found || keyID == "" || secret == "" {
+			return "", nil, errors.New("invalid auth.hmac_keys entry: expected keyID:secret")
+		}
+
+		if _, exists := keys[keyID]; exists {
+			return "", nil, errors.Errorf("duplicate key ID in auth.hmac_keys: %q", keyID)
+		}
+
+		keys[keyID] = []byte(secret)
+
+		if signingKeyID == "" {
+			signingKeyID = keyID
+		}
+	}
+
+	return signingKeyID, keys, nil
 }
 
 type loggerConfig struct {
@@ -81,6 +219,92 @@ type otelConfig struct {
 	SampleRatio float64 `mapstructure:"sample_ratio"`
 }
 
+type rateLimitConfig struct {
+	Enabled bool            `mapstructure:"enabled"`
+	Rules   []rateLimitRule `mapstructure:"rules"`
+	// TrustedProxies is a comma-separated list of IP addresses or CIDR ranges of the reverse
+	// proxies whose X-Forwarded-For and X-Real-IP headers are trusted.
+	TrustedProxies string `mapstructure:"trusted_proxies"`
+}
+
+// TrustedProxyPrefixes returns the parsed TrustedProxies.
+// A single IP address is treated as a range that contains only that address.
+func (r rateLimitConfig) TrustedProxyPrefixes() ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0)
+
+	for entry := range strings.SplitSeq(r.TrustedProxies, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, errors.Errorf(
+					"invalid rate_limit.trusted_proxies entry %q: %w",
+					entry,
+					err,
+				)
+			}
+
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, errors.Errorf("invalid rate_limit.trusted_proxies entry %q: %w", entry, err)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// rateLimitRule allows Limit requests per Window for a Connect procedure.
+type rateLimitRule struct {
+	Procedure string        `mapstructure:"procedure"`
+	Limit     int           `mapstructure:"limit"`
+	Window    time.Duration `mapstructure:"window"`
+}
+
+// defaultPIIPatterns matches phone numbers, email addresses, postal codes and street addresses.
+//
+//nolint:gosmopolitan // Japanese addresses are filtered
+func defaultPIIPatterns() []string {
+	return []string{
+		`0\d{1,4}-\d{1,4}-\d{3,4}`,
+		`0[5789]0\d{8}`,
+		`\+\d{1,3}[\s-]?\d{1,4}[\s-]?\d{2,4}[\s-]?\d{3,4}`,
+		`[\w.+-]+@[\w-]+(?:\.[\w-]+)+`,
+		`〒\s*\d{3}-?\d{4}`,
+		`[0-9０-９一二三四五六七八九十]+\s*(?:丁目|番地)`,
+		`[0-9０-９]+\s*番\s*[0-9０-９]+\s*号`,
+		`\d+-\d+-\d+`,
+		`(?i)\b\d+\s+(?:[a-z]+\s+){0,3}(?:street|st|avenue|ave|road|rd|boulevard|blvd|lane|ln|drive|dr)\b`,
+	}
+}
+
+// defaultRateLimitRules throttles unauthenticated and expensive procedures.
+func defaultRateLimitRules() []map[string]any {
+	perMinute := func(procedure string, limit int) map[string]any {
+		return map[string]any{"procedure": procedure, "limit": limit, "window": time.Minute}
+	}
+
+	return []map[string]any{
+		perMinute("/scene_hunter.v1.AuthService/IssueAnon", 10),
+		perMinute("/scene_hunter.v1.RoomService/CreateRoom", 10),
+		perMinute("/scene_hunter.v1.GameService/SubmitHunterPhoto", 30),
+		perMinute("/scene_hunter.v1.GameService/SubmitGameMasterPhoto", 5),
+		perMinute("/scene_hunter.v1.GameService/CheckProximity", 30),
+		perMinute("/scene_hunter.v1.GameService/RegenerateHint", 10),
+		perMinute("/scene_hunter.v1.ImageService/CreateUploadURL", 30),
+	}
+}
+
 // LoadConfig loads the configuration from the config.toml file.
 func LoadConfig() *AppConfig {
 	return LoadConfigFromPath(".")
@@ -101,14 +325,51 @@ func LoadConfigFromPath(configPath string) *AppConfig {
 	viper.SetDefault("server.read_timeout", 30*time.Second)
 	viper.SetDefault("server.write_timeout", 30*time.Second)
 	viper.SetDefault("server.idle_timeout", 60*time.Second)
+	viper.SetDefault("blob.public_url", "")
+	viper.SetDefault("image.max_dimension", 2048)
+	viper.SetDefault("image.quality", 85)
+	viper.SetDefault("image.thumbnail_small_dimension", 160)
+	viper.SetDefault("image.thumbnail_medium_dimension", 540)
+	viper.SetDefault("image.thumbnail_large_dimension", 1080)
+	viper.SetDefault("image.thumbnail_quality", 75)
+	viper.SetDefault("image.max_pixels", 50_000_000)
+	viper.SetDefault("image.thumbnail_workers", 4)
+	viper.SetDefault("image.presigned_url_ttl", 15*time.Minute)
+	viper.SetDefault("vision.provider", VisionProviderGemini)
+	viper.SetDefault("vision.timeout", 15*time.Second)
+	viper.SetDefault("vision.max_retries", 2)
+	viper.SetDefault("vision.retry_base_delay", 500*time.Millisecond)
+	viper.SetDefault("vision.failure_threshold", 5)
+	viper.SetDefault("vision.open_duration", 30*time.Second)
+	viper.SetDefault("vision.cache_ttl", 24*time.Hour)
+	viper.SetDefault("vision.room_token_budget", 200000)
+	viper.SetDefault("vision.daily_token_budget", 5000000)
 	viper.SetDefault("gemini.model", "gemini-2.0-flash")
+	viper.SetDefault("openai.base_url", "https://api.openai.com/v1")
+	viper.SetDefault("openai.model", "gpt-4o-mini")
+	viper.SetDefault("openai.api_key", "")
+	viper.SetDefault("hint.banned_words", []string{})
+	viper.SetDefault("hint.pii_patterns", defaultPIIPatterns())
+	viper.SetDefault("hint.workers", 2)
+	viper.SetDefault("hint.job_attempts", 3)
+	viper.SetDefault("hint.job_claim_after", 2*time.Minute)
 	viper.SetDefault("auth.access_token_ttl", 10*time.Minute)
 	viper.SetDefault("auth.refresh_token_ttl", 168*time.Hour)
+	viper.SetDefault("auth.hmac_keys", "")
+	viper.SetDefault("auth.hmac_signing_key_id", "")
+	viper.SetDefault("auth.hmac_secret", "")
+	viper.SetDefault("auth.token_format", TokenFormatHMAC)
+	viper.SetDefault("auth.jwt_private_key", "")
+	viper.SetDefault("auth.jwt_key_id", "")
+	viper.SetDefault("auth.jwt_issuer", "scene-hunter")
 	viper.SetDefault("logger.level", slog.LevelDebug)
 	viper.SetDefault("otel.enabled", true)
 	viper.SetDefault("otel.endpoint", "localhost:4317")
 	viper.SetDefault("otel.insecure", true)
 	viper.SetDefault("otel.sample_ratio", 1.0)
+	viper.SetDefault("rate_limit.enabled", true)
+	viper.SetDefault("rate_limit.rules", defaultRateLimitRules())
+	viper.SetDefault("rate_limit.trusted_proxies", "")
 
 	// Load environment variables
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
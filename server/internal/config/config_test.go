@@ -4,6 +4,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -75,6 +76,30 @@ sslmode = "disable"
 	assertEqual(t, cfg.Server.WriteTimeout, 30*time.Second, "default write timeout")
 	assertEqual(t, cfg.Server.IdleTimeout, 60*time.Second, "default idle timeout")
 	assertEqual(t, cfg.Logger.Level, slog.LevelDebug, "default logger level")
+	assertEqual(t, cfg.Image.MaxDimension, 2048, "default image max dimension")
+	assertEqual(t, cfg.Image.Quality, 85, "default image quality")
+	assertEqual(t, cfg.Image.ThumbnailSmallDimension, 160, "default small thumbnail dimension")
+	assertEqual(t, cfg.Image.ThumbnailMediumDimension, 540, "default medium thumbnail dimension")
+	assertEqual(t, cfg.Image.ThumbnailLargeDimension, 1080, "default large thumbnail dimension")
+	assertEqual(t, cfg.Image.ThumbnailQuality, 75, "default thumbnail quality")
+	assertEqual(t, cfg.Image.ThumbnailWorkers, 4, "default thumbnail workers")
+	assertEqual(t, cfg.Image.PresignedURLTTL, 15*time.Minute, "default presigned URL TTL")
+	assertEqual(t, cfg.Blob.PublicURL, "", "default blob public url")
+	assertEqual(t, cfg.Vision.Provider, config.VisionProviderGemini, "default vision provider")
+	assertEqual(t, cfg.Vision.Timeout, 15*time.Second, "default vision timeout")
+	assertEqual(t, cfg.Vision.MaxRetries, 2, "default vision max retries")
+	assertEqual(t, cfg.Vision.RetryBaseDelay, 500*time.Millisecond, "default vision retry delay")
+	assertEqual(t, cfg.Vision.FailureThreshold, 5, "default vision failure threshold")
+	assertEqual(t, cfg.Vision.OpenDuration, 30*time.Second, "default vision open duration")
+	assertEqual(t, cfg.Vision.CacheTTL, 24*time.Hour, "default vision cache TTL")
+	assertEqual(t, cfg.Vision.RoomTokenBudget, int64(200000), "default vision room budget")
+	assertEqual(t, cfg.Vision.DailyTokenBudget, int64(5000000), "default vision daily budget")
+	assertEqual(t, cfg.OpenAI.BaseURL, "https://api.openai.com/v1", "default OpenAI base url")
+	assertEqual(t, len(cfg.Hint.BannedWords), 0, "default banned words")
+	assertEqual(t, len(cfg.Hint.PIIPatterns) > 0, true, "default PII patterns")
+	assertEqual(t, cfg.Hint.Workers, 2, "default hint workers")
+	assertEqual(t, cfg.Hint.JobAttempts, 3, "default hint job attempts")
+	assertEqual(t, cfg.Hint.JobClaimAfter, 2*time.Minute, "default hint job claim time")
 }
 
 // TestLoadConfigFull tests loading config with all settings.
@@ -101,6 +126,39 @@ url = "redis://kvs.example.com:6379"
 
 [blob]
 url = "http://blob.example.com:9000"
+public_url = "https://storage.example.com"
+
+[image]
+max_dimension = 1024
+quality = 90
+thumbnail_small_dimension = 96
+thumbnail_medium_dimension = 320
+thumbnail_large_dimension = 720
+thumbnail_quality = 60
+thumbnail_workers = 2
+presigned_url_ttl = "5m"
+
+[vision]
+provider = "openai"
+timeout = "5s"
+max_retries = 1
+retry_base_delay = "100ms"
+failure_threshold = 3
+open_duration = "1m"
+cache_ttl = "0s"
+room_token_budget = 1000
+daily_token_budget = 20000
+
+[openai]
+base_url = "http://llm.example.com:8000/v1"
+model = "llava"
+
+[hint]
+banned_words = ["secret base", "password"]
+pii_patterns = ['\d{4}-\d{4}']
+workers = 4
+job_attempts = 5
+job_claim_after = "30s"
 
 [logger]
 level = 0
@@ -128,11 +186,179 @@ level = 0
 
 	// Check blob settings
 	assertEqual(t, cfg.Blob.URL, "http://blob.example.com:9000", "blob url")
+	assertEqual(t, cfg.Blob.PublicURL, "https://storage.example.com", "blob public url")
+
+	// Check image settings
+	assertEqual(t, cfg.Image.MaxDimension, 1024, "image max dimension")
+	assertEqual(t, cfg.Image.Quality, 90, "image quality")
+	assertEqual(t, cfg.Image.ThumbnailSmallDimension, 96, "small thumbnail dimension")
+	assertEqual(t, cfg.Image.ThumbnailMediumDimension, 320, "medium thumbnail dimension")
+	assertEqual(t, cfg.Image.ThumbnailLargeDimension, 720, "large thumbnail dimension")
+	assertEqual(t, cfg.Image.ThumbnailQuality, 60, "thumbnail quality")
+	assertEqual(t, cfg.Image.ThumbnailWorkers, 2, "thumbnail workers")
+	assertEqual(t, cfg.Image.PresignedURLTTL, 5*time.Minute, "presigned URL TTL")
+
+	// Check vision settings
+	assertEqual(t, cfg.Vision.Provider, config.VisionProviderOpenAI, "vision provider")
+	assertEqual(t, cfg.Vision.Timeout, 5*time.Second, "vision timeout")
+	assertEqual(t, cfg.Vision.MaxRetries, 1, "vision max retries")
+	assertEqual(t, cfg.Vision.RetryBaseDelay, 100*time.Millisecond, "vision retry delay")
+	assertEqual(t, cfg.Vision.FailureThreshold, 3, "vision failure threshold")
+	assertEqual(t, cfg.Vision.OpenDuration, time.Minute, "vision open duration")
+	assertEqual(t, cfg.Vision.CacheTTL, time.Duration(0), "vision cache TTL")
+	assertEqual(t, cfg.Vision.RoomTokenBudget, int64(1000), "vision room budget")
+	assertEqual(t, cfg.Vision.DailyTokenBudget, int64(20000), "vision daily budget")
+	assertEqual(t, cfg.OpenAI.BaseURL, "http://llm.example.com:8000/v1", "OpenAI base url")
+	assertEqual(t, cfg.OpenAI.Model, "llava", "OpenAI model")
+
+	// Check hint settings
+	assertEqual(t, strings.Join(cfg.Hint.BannedWords, ","), "secret base,password", "banned words")
+	assertEqual(t, strings.Join(cfg.Hint.PIIPatterns, ","), `\d{4}-\d{4}`, "PII patterns")
+	assertEqual(t, cfg.Hint.Workers, 4, "hint workers")
+	assertEqual(t, cfg.Hint.JobAttempts, 5, "hint job attempts")
+	assertEqual(t, cfg.Hint.JobClaimAfter, 30*time.Second, "hint job claim time")
 
 	// Check logger settings
 	assertEqual(t, cfg.Logger.Level, slog.LevelInfo, "logger level")
 }
 
+// TestLoadConfigRateLimit tests that rate limit rules from the config file replace the defaults.
+func TestLoadConfigRateLimit(t *testing.T) {
+	t.Parallel()
+
+	defaults := config.LoadConfigFromPath(createTempConfigFile(t, "[app]\nenv = \"dev\"\n"))
+
+	assertEqual(t, defaults.RateLimit.Enabled, true, "default rate limit enabled")
+
+	if len(defaults.RateLimit.Rules) == 0 {
+		t.Error("Expected default rate limit rules, got none")
+	}
+
+	content := `
+[rate_limit]
+enabled = false
+
+[[rate_limit.rules]]
+procedure = "/scene_hunter.v1.RoomService/CreateRoom"
+limit = 3
+window = "30s"
+`
+
+	cfg := config.LoadConfigFromPath(createTempConfigFile(t, content))
+
+	assertEqual(t, cfg.RateLimit.Enabled, false, "rate limit enabled")
+	assertEqual(t, len(cfg.RateLimit.Rules), 1, "rate limit rule count")
+	assertEqual(
+		t,
+		cfg.RateLimit.Rules[0].Procedure,
+		"/scene_hunter.v1.RoomService/CreateRoom",
+		"rate limit procedure",
+	)
+	assertEqual(t, cfg.RateLimit.Rules[0].Limit, 3, "rate limit request count")
+	assertEqual(t, cfg.RateLimit.Rules[0].Window, 30*time.Second, "rate limit window")
+}
+
+// TestLoadConfigHMACKeyring tests resolving the HMAC keyring from the auth settings.
+func TestLoadConfigHMACKeyring(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		auth             string
+		wantSigningKeyID string
+		wantKeyIDs       []string
+		wantErr          bool
+	}{
+		"keys with signing key ID": {
+			"hmac_keys = \"k1:s1, k2:s2\"\nhmac_signing_key_id = \"k2\"",
+			"k2",
+			[]string{"k1", "k2"},
+			false,
+		},
+		"first key signs by default": {
+			"hmac_keys = \"k1:s1,k2:s2\"",
+			"k1",
+			[]string{"k1", "k2"},
+			false,
+		},
+		"legacy single secret": {
+			"hmac_secret = \"s1\"",
+			"default",
+			[]string{"default"},
+			false,
+		},
+		"keys take precedence over legacy secret": {
+			"hmac_keys = \"k1:s1\"\nhmac_secret = \"legacy\"",
+			"k1",
+			[]string{"k1"},
+			false,
+		},
+		"no keys":          {"", "", nil, true},
+		"malformed entry":  {"hmac_keys = \"k1\"", "", nil, true},
+		"duplicate key ID": {"hmac_keys = \"k1:s1,k1:s2\"", "", nil, true},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := config.LoadConfigFromPath(createTempConfigFile(t, "[auth]\n"+testCase.auth+"\n"))
+
+			signingKeyID, keys, err := cfg.Auth.HMACKeyring()
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("HMACKeyring() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			assertEqual(t, signingKeyID, testCase.wantSigningKeyID, "signing key ID")
+			assertEqual(t, len(keys), len(testCase.wantKeyIDs), "key count")
+
+			for _, keyID := range testCase.wantKeyIDs {
+				if _, ok := keys[keyID]; !ok {
+					t.Errorf("Expected key %q in keyring", keyID)
+				}
+			}
+		})
+	}
+}
+
+// TestLoadConfigTrustedProxies tests parsing the reverse proxies trusted by the rate limiter.
+func TestLoadConfigTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		trustedProxies string
+		want           []string
+		wantErr        bool
+	}{
+		"none":            {"", []string{}, false},
+		"addresses":       {"10.0.0.1, ::1", []string{"10.0.0.1/32", "::1/128"}, false},
+		"ranges":          {"10.0.0.7/8,fd00::/8", []string{"10.0.0.0/8", "fd00::/8"}, false},
+		"invalid address": {"proxy.local", nil, true},
+		"invalid range":   {"10.0.0.0/33", nil, true},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := config.LoadConfigFromPath(createTempConfigFile(
+				t,
+				"[rate_limit]\ntrusted_proxies = \""+testCase.trustedProxies+"\"\n",
+			))
+
+			prefixes, err := cfg.RateLimit.TrustedProxyPrefixes()
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("TrustedProxyPrefixes() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			assertEqual(t, len(prefixes), len(testCase.want), "trusted proxy count")
+
+			for index, prefix := range prefixes {
+				assertEqual(t, prefix.String(), testCase.want[index], "trusted proxy")
+			}
+		})
+	}
+}
+
 // TestLoadConfigNotFound tests loading config when file doesn't exist.
 func TestLoadConfigNotFound(t *testing.T) {
 	t.Parallel()
@@ -11,79 +11,104 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
-// AnonToken represents an anonymous access token.
+// AnonToken represents an access token.
 type AnonToken struct {
-	AnonID    string
-	ExpiresAt time.Time
-	Token     string
+	AnonID string
+	// PrincipalType is only known for JWTs; verified HMAC tokens leave it empty.
+	PrincipalType PrincipalType
+	ExpiresAt     time.Time
+	Token         string
 }
 
-// TokenSigner signs and verifies anonymous tokens.
+// TokenSigner signs and verifies access tokens.
+// Tokens are HMAC-signed by default; if a JWTSigner is configured, JWTs are accepted as well
+// and can be issued instead of HMAC tokens.
 type TokenSigner struct {
-	secret []byte
+	keyring   *Keyring
+	jwtSigner *JWTSigner
+	issueJWT  bool
 }
 
-// NewTokenSigner creates a new TokenSigner with the given secret.
-func NewTokenSigner(secret []byte) *TokenSigner {
+// NewTokenSigner creates a new TokenSigner with the given keyring.
+func NewTokenSigner(keyring *Keyring) *TokenSigner {
 	return &TokenSigner{
-		secret: secret,
+		keyring:   keyring,
+		jwtSigner: nil,
+		issueJWT:  false,
 	}
 }
 
-// SignAnonToken creates a signed anonymous access token.
-func (s *TokenSigner) SignAnonToken(anonID string, ttl time.Duration) (*AnonToken, error) {
-	if anonID == "" {
-		return nil, errors.Errorf("anon_id cannot be empty")
+// NewTokenSignerWithJWT creates a new TokenSigner that also verifies JWTs.
+// If issueJWT is true, new tokens are issued as JWTs instead of HMAC tokens.
+func NewTokenSignerWithJWT(keyring *Keyring, jwtSigner *JWTSigner, issueJWT bool) *TokenSigner {
+	return &TokenSigner{
+		keyring:   keyring,
+		jwtSigner: jwtSigner,
+		issueJWT:  issueJWT,
 	}
+}
 
-	expiresAt := time.Now().Add(ttl)
-	expUnix := expiresAt.Unix()
-
-	// Format: anonID.expUnix
-	payload := fmt.Sprintf("%s.%d", anonID, expUnix)
+// SignAnonToken creates a signed access token for an anonymous player.
+func (s *TokenSigner) SignAnonToken(anonID string, ttl time.Duration) (*AnonToken, error) {
+	return s.sign(anonID, PrincipalTypeAnonymous, ttl)
+}
 
-	// Create HMAC signature
-	h := hmac.New(sha256.New, s.secret)
-	h.Write([]byte(payload))
-	sig := h.Sum(nil)
+// SignUserToken creates a signed access token for a permanent user.
+func (s *TokenSigner) SignUserToken(userID string, ttl time.Duration) (*AnonToken, error) {
+	return s.sign(userID, PrincipalTypeUser, ttl)
+}
 
-	// Encode: payload.signature
-	token := base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
-		base64.RawURLEncoding.EncodeToString(sig)
+// PublicKeys returns the JWK set for verifying JWTs, which is empty if JWTs are disabled.
+func (s *TokenSigner) PublicKeys() jwk.Set {
+	if s.jwtSigner == nil {
+		return jwk.NewSet()
+	}
 
-	return &AnonToken{
-		AnonID:    anonID,
-		ExpiresAt: expiresAt,
-		Token:     token,
-	}, nil
+	return s.jwtSigner.PublicKeys()
 }
 
-// VerifyAnonToken verifies and decodes an anonymous access token.
+// VerifyAnonToken verifies and decodes an access token in either format.
+// HMAC tokens signed by any key in the keyring are accepted.
 func (s *TokenSigner) VerifyAnonToken(token string) (*AnonToken, error) {
+	if isJWT(token) {
+		if s.jwtSigner == nil {
+			return nil, ErrJWTDisabled
+		}
+
+		return s.jwtSigner.Verify(token)
+	}
+
 	parts := strings.Split(token, ".")
-	if len(parts) != 2 {
+	if len(parts) != 3 {
 		return nil, errors.Errorf("invalid token format")
 	}
 
+	key, err := s.keyring.verificationKey(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// 未使用のビットが異なる別表記を同じトークンとして受け付けないよう厳密にデコード
+	encoding := base64.RawURLEncoding.Strict()
+
 	// Decode payload
-	payloadBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	payloadBytes, err := encoding.DecodeString(parts[1])
 	if err != nil {
 		return nil, errors.Errorf("failed to decode payload: %w", err)
 	}
 
 	// Decode signature
-	sigBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	sigBytes, err := encoding.DecodeString(parts[2])
 	if err != nil {
 		return nil, errors.Errorf("failed to decode signature: %w", err)
 	}
 
 	// Verify signature
-	h := hmac.New(sha256.New, s.secret)
-	h.Write(payloadBytes)
-	expectedSig := h.Sum(nil)
+	expectedSig := hmacSign(key, parts[0]+"."+parts[1])
 
 	if !hmac.Equal(sigBytes, expectedSig) {
 		return nil, errors.Errorf("invalid token signature")
@@ -112,12 +137,57 @@ func (s *TokenSigner) VerifyAnonToken(token string) (*AnonToken, error) {
 	}
 
 	return &AnonToken{
-		AnonID:    anonID,
-		ExpiresAt: expiresAt,
-		Token:     token,
+		AnonID:        anonID,
+		PrincipalType: "",
+		ExpiresAt:     expiresAt,
+		Token:         token,
+	}, nil
+}
+
+// sign creates a signed access token in the configured format.
+func (s *TokenSigner) sign(
+	anonID string,
+	principalType PrincipalType,
+	ttl time.Duration,
+) (*AnonToken, error) {
+	if s.issueJWT && s.jwtSigner != nil {
+		return s.jwtSigner.Sign(anonID, principalType, ttl)
+	}
+
+	if anonID == "" {
+		return nil, errors.Errorf("anon_id cannot be empty")
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	expUnix := expiresAt.Unix()
+
+	// Format: anonID.expUnix
+	payload := fmt.Sprintf("%s.%d", anonID, expUnix)
+
+	// The key ID is part of the signed input so that it cannot be swapped
+	signingInput := s.keyring.SigningKeyID() + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	// Encode: keyID.payload.signature
+	token := signingInput + "." +
+		base64.RawURLEncoding.EncodeToString(hmacSign(s.keyring.signingKey(), signingInput))
+
+	return &AnonToken{
+		AnonID:        anonID,
+		PrincipalType: principalType,
+		ExpiresAt:     expiresAt,
+		Token:         token,
 	}, nil
 }
 
+// hmacSign creates an HMAC-SHA256 signature of the input.
+func hmacSign(key []byte, input string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(input))
+
+	return h.Sum(nil)
+}
+
 // GenerateAnonID generates a new unique anonymous ID.
 func GenerateAnonID() (string, error) {
 	id, err := uuid.NewV7()
@@ -0,0 +1,212 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/auth"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	testAnonID = "0199a0b2-7c5e-7d3a-9f1e-2b4c6d8e0a1f"
+	secret1    = "secret-1"
+	secret2    = "secret-2"
+)
+
+// mustNewSigner creates a TokenSigner or fails the test.
+func mustNewSigner(t *testing.T, signingKeyID string, keys map[string]string) *auth.TokenSigner {
+	t.Helper()
+
+	secrets := make(map[string][]byte, len(keys))
+	for keyID, secret := range keys {
+		secrets[keyID] = []byte(secret)
+	}
+
+	keyring, err := auth.NewKeyring(signingKeyID, secrets)
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v, want nil", err)
+	}
+
+	return auth.NewTokenSigner(keyring)
+}
+
+// tamperSignature flips a bit of the decoded signature of a token.
+// Replacing a base64url character instead could only change unused bits and keep the same bytes.
+func tamperSignature(t *testing.T, token string) string {
+	t.Helper()
+
+	index := strings.LastIndex(token, ".")
+
+	signature, err := base64.RawURLEncoding.DecodeString(token[index+1:])
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+
+	signature[0] ^= 0x01
+
+	return token[:index+1] + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// reencodeSignature changes an unused low bit of the last character of a token, which
+// encodes the same signature bytes in a form that strict base64url decoding rejects.
+func reencodeSignature(token string) string {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+	last := strings.IndexByte(alphabet, token[len(token)-1])
+
+	return token[:len(token)-1] + string(alphabet[last^0x01])
+}
+
+func TestNewKeyring(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		signingKeyID string
+		keys         map[string][]byte
+		wantErr      error
+	}{
+		"single key": {"k1", map[string][]byte{"k1": []byte("s1")}, nil},
+		"several keys": {
+			"k2",
+			map[string][]byte{"k1": []byte("s1"), "k2": []byte("s2")},
+			nil,
+		},
+		"no keys": {"k1", map[string][]byte{}, auth.ErrEmptyKeyring},
+		"signing key not found": {
+			"k3",
+			map[string][]byte{"k1": []byte("s1")},
+			auth.ErrSigningKeyNotFound,
+		},
+		"key ID with dot": {
+			"k.1",
+			map[string][]byte{"k.1": []byte("s1")},
+			auth.ErrInvalidKeyID,
+		},
+		"empty secret": {"k1", map[string][]byte{"k1": {}}, auth.ErrEmptyKey},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			keyring, err := auth.NewKeyring(testCase.signingKeyID, testCase.keys)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewKeyring() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil && keyring.SigningKeyID() != testCase.signingKeyID {
+				t.Errorf(
+					"SigningKeyID() = %v, want %v",
+					keyring.SigningKeyID(),
+					testCase.signingKeyID,
+				)
+			}
+		})
+	}
+}
+
+// TestTokenSigner_KeyRotation signs a token with one keyring and verifies it with another,
+// simulating servers before and after a key rotation.
+func TestTokenSigner_KeyRotation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		signer   *auth.TokenSigner
+		verifier *auth.TokenSigner
+		wantErr  bool
+	}{
+		"same key": {
+			mustNewSigner(t, "k1", map[string]string{"k1": secret1}),
+			mustNewSigner(t, "k1", map[string]string{"k1": secret1}),
+			false,
+		},
+		"token signed by retired key during overlap window": {
+			mustNewSigner(t, "k1", map[string]string{"k1": secret1}),
+			mustNewSigner(t, "k2", map[string]string{"k1": secret1, "k2": secret2}),
+			false,
+		},
+		"token signed by new key before all servers switched": {
+			mustNewSigner(t, "k2", map[string]string{"k1": secret1, "k2": secret2}),
+			mustNewSigner(t, "k1", map[string]string{"k1": secret1, "k2": secret2}),
+			false,
+		},
+		"token signed by removed key": {
+			mustNewSigner(t, "k1", map[string]string{"k1": secret1}),
+			mustNewSigner(t, "k2", map[string]string{"k2": secret2}),
+			true,
+		},
+		"key ID reused with a different secret": {
+			mustNewSigner(t, "k1", map[string]string{"k1": secret1}),
+			mustNewSigner(t, "k1", map[string]string{"k1": "another-secret"}),
+			true,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := testCase.signer.SignAnonToken(testAnonID, time.Minute)
+			if err != nil {
+				t.Fatalf("SignAnonToken() error = %v, want nil", err)
+			}
+
+			got, err := testCase.verifier.VerifyAnonToken(token.Token)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("VerifyAnonToken() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if !testCase.wantErr && got.AnonID != testAnonID {
+				t.Errorf("VerifyAnonToken() AnonID = %v, want %v", got.AnonID, testAnonID)
+			}
+		})
+	}
+}
+
+func TestTokenSigner_VerifyAnonToken_Invalid(t *testing.T) {
+	t.Parallel()
+
+	signer := mustNewSigner(t, "k1", map[string]string{"k1": secret1})
+
+	valid, err := signer.SignAnonToken(testAnonID, time.Minute)
+	if err != nil {
+		t.Fatalf("SignAnonToken() error = %v, want nil", err)
+	}
+
+	expired, err := signer.SignAnonToken(testAnonID, -time.Minute)
+	if err != nil {
+		t.Fatalf("SignAnonToken() error = %v, want nil", err)
+	}
+
+	tests := map[string]struct {
+		token   string
+		wantErr error
+	}{
+		"expired token":        {expired.Token, nil},
+		"missing key ID":       {valid.Token[len("k1."):], nil},
+		"swapped key ID":       {"k2" + valid.Token[len("k1"):], auth.ErrUnknownKeyID},
+		"tampered signature":   {tamperSignature(t, valid.Token), nil},
+		"re-encoded signature": {reencodeSignature(valid.Token), nil},
+		"not a token":          {"invalid", nil},
+		"empty token":          {"", nil},
+		"extra token parts":    {valid.Token + ".extra", nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := signer.VerifyAnonToken(testCase.token)
+			if err == nil {
+				t.Fatal("VerifyAnonToken() error = nil, want error")
+			}
+
+			if testCase.wantErr != nil && !errors.Is(err, testCase.wantErr) {
+				t.Errorf("VerifyAnonToken() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// PrincipalType represents the kind of account an access token was issued for.
+type PrincipalType string
+
+const (
+	// PrincipalTypeAnonymous is an anonymous player.
+	PrincipalTypeAnonymous PrincipalType = "anonymous"
+	// PrincipalTypeUser is a permanent user linked to an OAuth identity.
+	PrincipalTypeUser PrincipalType = "user"
+)
+
+// PrincipalTypeClaim is the private JWT claim holding the principal type.
+const PrincipalTypeClaim = "principal_type"
+
+var (
+	// ErrUnsupportedJWTKey is returned when the private key is neither ECDSA P-256 nor Ed25519.
+	ErrUnsupportedJWTKey = errors.New("unsupported JWT key: ECDSA P-256 or Ed25519 is required")
+	// ErrJWTDisabled is returned when a JWT is verified but no JWT key is configured.
+	ErrJWTDisabled = errors.New("JWT access tokens are not enabled")
+)
+
+// JWTSigner signs and verifies access tokens as JWS-signed JWTs (ES256 or EdDSA).
+// Other services can verify the tokens with the public keys returned by PublicKeys.
+type JWTSigner struct {
+	privateKey jwk.Key
+	publicKeys jwk.Set
+	algorithm  jwa.SignatureAlgorithm
+	issuer     string
+}
+
+// NewJWTSigner creates a new JWTSigner from a PEM-encoded private key.
+// The algorithm is derived from the key type: ES256 for ECDSA P-256 and EdDSA for Ed25519.
+// If keyID is empty, the RFC 7638 thumbprint of the key is used.
+func NewJWTSigner(privateKeyPEM []byte, keyID, issuer string) (*JWTSigner, error) {
+	privateKey, err := jwk.ParseKey(privateKeyPEM, jwk.WithPEM(true))
+	if err != nil {
+		return nil, errors.Errorf("failed to parse JWT private key: %w", err)
+	}
+
+	algorithm, err := signatureAlgorithm(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyID == "" {
+		thumbprint, err := privateKey.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, errors.Errorf("failed to compute key thumbprint: %w", err)
+		}
+
+		keyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	}
+
+	for key, value := range map[string]any{
+		jwk.KeyIDKey:     keyID,
+		jwk.AlgorithmKey: algorithm,
+		jwk.KeyUsageKey:  jwk.ForSignature,
+	} {
+		if err := privateKey.Set(key, value); err != nil {
+			return nil, errors.Errorf("failed to set %s on JWT key: %w", key, err)
+		}
+	}
+
+	publicKey, err := jwk.PublicKeyOf(privateKey)
+	if err != nil {
+		return nil, errors.Errorf("failed to derive JWT public key: %w", err)
+	}
+
+	publicKeys := jwk.NewSet()
+	if err := publicKeys.AddKey(publicKey); err != nil {
+		return nil, errors.Errorf("failed to build JWT key set: %w", err)
+	}
+
+	return &JWTSigner{
+		privateKey: privateKey,
+		publicKeys: publicKeys,
+		algorithm:  algorithm,
+		issuer:     issuer,
+	}, nil
+}
+
+// Sign creates a signed JWT with the standard claims sub, iss, iat, exp and jti.
+func (s *JWTSigner) Sign(
+	subject string,
+	principalType PrincipalType,
+	ttl time.Duration,
+) (*AnonToken, error) {
+	if subject == "" {
+		return nil, errors.Errorf("subject cannot be empty")
+	}
+
+	tokenID, err := uuid.NewV7()
+	if err != nil {
+		return nil, errors.Errorf("failed to generate token ID: %w", err)
+	}
+
+	issuedAt := time.Now().Truncate(time.Second)
+	expiresAt := issuedAt.Add(ttl)
+
+	token, err := jwt.NewBuilder().
+		Subject(subject).
+		Issuer(s.issuer).
+		IssuedAt(issuedAt).
+		Expiration(expiresAt).
+		JwtID(tokenID.String()).
+		Claim(PrincipalTypeClaim, string(principalType)).
+		Build()
+	if err != nil {
+		return nil, errors.Errorf("failed to build JWT: %w", err)
+	}
+
+	signed, err := jwt.Sign(token, jwt.WithKey(s.algorithm, s.privateKey))
+	if err != nil {
+		return nil, errors.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return &AnonToken{
+		AnonID:        subject,
+		PrincipalType: principalType,
+		ExpiresAt:     expiresAt,
+		Token:         string(signed),
+	}, nil
+}
+
+// Verify verifies a JWT issued by this signer and returns its subject.
+func (s *JWTSigner) Verify(token string) (*AnonToken, error) {
+	parsed, err := jwt.Parse(
+		[]byte(token),
+		jwt.WithKeySet(s.publicKeys),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(s.issuer),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to verify JWT: %w", err)
+	}
+
+	subject, hasSubject := parsed.Subject()
+	if !hasSubject || subject == "" {
+		return nil, errors.Errorf("missing subject")
+	}
+
+	expiresAt, hasExpiration := parsed.Expiration()
+	if !hasExpiration {
+		return nil, errors.Errorf("missing expiration")
+	}
+
+	var principalType string
+	if err := parsed.Get(PrincipalTypeClaim, &principalType); err != nil {
+		return nil, errors.Errorf("missing principal type: %w", err)
+	}
+
+	switch PrincipalType(principalType) {
+	case PrincipalTypeAnonymous, PrincipalTypeUser:
+	default:
+		return nil, errors.Errorf("invalid principal type: %s", principalType)
+	}
+
+	return &AnonToken{
+		AnonID:        subject,
+		PrincipalType: PrincipalType(principalType),
+		ExpiresAt:     expiresAt,
+		Token:         token,
+	}, nil
+}
+
+// PublicKeys returns the JWK set for verifying tokens issued by this signer.
+func (s *JWTSigner) PublicKeys() jwk.Set {
+	return s.publicKeys
+}
+
+// signatureAlgorithm returns the JWS algorithm for a private key.
+func signatureAlgorithm(key jwk.Key) (jwa.SignatureAlgorithm, error) {
+	var raw any
+	if err := jwk.Export(key, &raw); err != nil {
+		return jwa.EmptySignatureAlgorithm(), errors.Errorf("failed to export JWT key: %w", err)
+	}
+
+	switch privateKey := raw.(type) {
+	case *ecdsa.PrivateKey:
+		if privateKey.Curve != elliptic.P256() {
+			return jwa.EmptySignatureAlgorithm(), ErrUnsupportedJWTKey
+		}
+
+		return jwa.ES256(), nil
+	case ed25519.PrivateKey:
+		return jwa.EdDSA(), nil
+	default:
+		return jwa.EmptySignatureAlgorithm(), ErrUnsupportedJWTKey
+	}
+}
+
+// isJWT reports whether a token is a JWS in compact serialization.
+// HMAC tokens start with a plain key ID, whereas a JWS starts with a JSON header.
+func isJWT(token string) bool {
+	header, _, found := strings.Cut(token, ".")
+	if !found {
+		return false
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		return false
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(decoded, &fields); err != nil {
+		return false
+	}
+
+	_, hasAlgorithm := fields["alg"]
+
+	return hasAlgorithm
+}
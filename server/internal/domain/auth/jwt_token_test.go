@@ -0,0 +1,271 @@
+package auth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/yashikota/scene-hunter/server/internal/domain/auth"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const testIssuer = "scene-hunter-test"
+
+// mustEncodePEM encodes a private key as PKCS #8 PEM.
+func mustEncodePEM(t *testing.T, key any) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+// mustGenerateECDSA generates an ECDSA private key on the given curve.
+func mustGenerateECDSA(t *testing.T, curve elliptic.Curve) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ECDSA key: %v", err)
+	}
+
+	return mustEncodePEM(t, key)
+}
+
+// mustGenerateEd25519 generates an Ed25519 private key.
+func mustGenerateEd25519(t *testing.T) []byte {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+
+	return mustEncodePEM(t, key)
+}
+
+// mustNewJWTSigner creates a JWTSigner or fails the test.
+func mustNewJWTSigner(t *testing.T, privateKeyPEM []byte, keyID string) *auth.JWTSigner {
+	t.Helper()
+
+	signer, err := auth.NewJWTSigner(privateKeyPEM, keyID, testIssuer)
+	if err != nil {
+		t.Fatalf("NewJWTSigner() error = %v, want nil", err)
+	}
+
+	return signer
+}
+
+func TestNewJWTSigner(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+
+	tests := map[string]struct {
+		privateKeyPEM []byte
+		wantErr       bool
+		wantErrIs     error
+	}{
+		"ECDSA P-256": {mustGenerateECDSA(t, elliptic.P256()), false, nil},
+		"Ed25519":     {mustGenerateEd25519(t), false, nil},
+		"ECDSA P-384": {mustGenerateECDSA(t, elliptic.P384()), true, auth.ErrUnsupportedJWTKey},
+		"RSA":         {mustEncodePEM(t, rsaKey), true, auth.ErrUnsupportedJWTKey},
+		"not a PEM":   {[]byte("not a key"), true, nil},
+		"empty":       {[]byte{}, true, nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := auth.NewJWTSigner(testCase.privateKeyPEM, "", testIssuer)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("NewJWTSigner() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErrIs != nil && !errors.Is(err, testCase.wantErrIs) {
+				t.Errorf("NewJWTSigner() error = %v, want %v", err, testCase.wantErrIs)
+			}
+		})
+	}
+}
+
+// TestJWTSigner_Sign verifies issued JWTs the way an external service would, using only the JWKS.
+func TestJWTSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		privateKeyPEM []byte
+		keyID         string
+		principalType auth.PrincipalType
+		wantAlgorithm jwa.SignatureAlgorithm
+	}{
+		"ES256 anonymous": {
+			mustGenerateECDSA(t, elliptic.P256()),
+			"es-key",
+			auth.PrincipalTypeAnonymous,
+			jwa.ES256(),
+		},
+		"EdDSA user": {
+			mustGenerateEd25519(t),
+			"ed-key",
+			auth.PrincipalTypeUser,
+			jwa.EdDSA(),
+		},
+		"thumbprint key ID": {
+			mustGenerateEd25519(t),
+			"",
+			auth.PrincipalTypeUser,
+			jwa.EdDSA(),
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			signer := mustNewJWTSigner(t, testCase.privateKeyPEM, testCase.keyID)
+
+			token, err := signer.Sign(testAnonID, testCase.principalType, time.Minute)
+			if err != nil {
+				t.Fatalf("Sign() error = %v, want nil", err)
+			}
+
+			publicKeys := signer.PublicKeys()
+			if publicKeys.Len() != 1 {
+				t.Fatalf("PublicKeys() has %d keys, want 1", publicKeys.Len())
+			}
+
+			publicKey, _ := publicKeys.Key(0)
+
+			algorithm, _ := publicKey.Algorithm()
+			if algorithm.String() != testCase.wantAlgorithm.String() {
+				t.Errorf("JWK alg = %v, want %v", algorithm, testCase.wantAlgorithm)
+			}
+
+			keyID, _ := publicKey.KeyID()
+			if testCase.keyID != "" && keyID != testCase.keyID {
+				t.Errorf("JWK kid = %v, want %v", keyID, testCase.keyID)
+			}
+
+			parsed, err := jwt.Parse(
+				[]byte(token.Token),
+				jwt.WithKeySet(publicKeys),
+				jwt.WithValidate(true),
+				jwt.WithIssuer(testIssuer),
+			)
+			if err != nil {
+				t.Fatalf("jwt.Parse() error = %v, want nil", err)
+			}
+
+			subject, _ := parsed.Subject()
+			if subject != testAnonID {
+				t.Errorf("sub = %v, want %v", subject, testAnonID)
+			}
+
+			tokenID, _ := parsed.JwtID()
+			if tokenID == "" {
+				t.Error("jti is empty")
+			}
+
+			issuedAt, _ := parsed.IssuedAt()
+			expiresAt, _ := parsed.Expiration()
+
+			if expiresAt.Sub(issuedAt) != time.Minute {
+				t.Errorf("exp - iat = %v, want %v", expiresAt.Sub(issuedAt), time.Minute)
+			}
+
+			var principalType string
+			if err := parsed.Get(auth.PrincipalTypeClaim, &principalType); err != nil {
+				t.Fatalf("failed to get principal type: %v", err)
+			}
+
+			if auth.PrincipalType(principalType) != testCase.principalType {
+				t.Errorf("principal_type = %v, want %v", principalType, testCase.principalType)
+			}
+		})
+	}
+}
+
+func TestTokenSigner_VerifyAnonToken_Formats(t *testing.T) {
+	t.Parallel()
+
+	keyring, err := auth.NewKeyring("k1", map[string][]byte{"k1": []byte(secret1)})
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v, want nil", err)
+	}
+
+	jwtSigner := mustNewJWTSigner(t, mustGenerateECDSA(t, elliptic.P256()), "es-key")
+	otherJWTSigner := mustNewJWTSigner(t, mustGenerateEd25519(t), "other-key")
+
+	hmacOnly := auth.NewTokenSigner(keyring)
+	hmacIssuer := auth.NewTokenSignerWithJWT(keyring, jwtSigner, false)
+	jwtIssuer := auth.NewTokenSignerWithJWT(keyring, jwtSigner, true)
+	otherJWTIssuer := auth.NewTokenSignerWithJWT(keyring, otherJWTSigner, true)
+
+	tests := map[string]struct {
+		signer            *auth.TokenSigner
+		verifier          *auth.TokenSigner
+		wantPrincipalType auth.PrincipalType
+		wantErr           bool
+		wantErrIs         error
+	}{
+		"HMAC token accepted by HMAC-only verifier": {hmacOnly, hmacOnly, "", false, nil},
+		"HMAC token accepted by JWT verifier":       {hmacOnly, jwtIssuer, "", false, nil},
+		"JWT accepted by JWT verifier": {
+			jwtIssuer, hmacIssuer, auth.PrincipalTypeUser, false, nil,
+		},
+		"JWT rejected when JWT is disabled": {jwtIssuer, hmacOnly, "", true, auth.ErrJWTDisabled},
+		"JWT signed by unknown key":         {otherJWTIssuer, jwtIssuer, "", true, nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := testCase.signer.SignUserToken(testAnonID, time.Minute)
+			if err != nil {
+				t.Fatalf("SignUserToken() error = %v, want nil", err)
+			}
+
+			got, err := testCase.verifier.VerifyAnonToken(token.Token)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("VerifyAnonToken() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr {
+				if testCase.wantErrIs != nil && !errors.Is(err, testCase.wantErrIs) {
+					t.Errorf("VerifyAnonToken() error = %v, want %v", err, testCase.wantErrIs)
+				}
+
+				return
+			}
+
+			if got.AnonID != testAnonID {
+				t.Errorf("VerifyAnonToken() AnonID = %v, want %v", got.AnonID, testAnonID)
+			}
+
+			if got.PrincipalType != testCase.wantPrincipalType {
+				t.Errorf(
+					"VerifyAnonToken() PrincipalType = %v, want %v",
+					got.PrincipalType,
+					testCase.wantPrincipalType,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"regexp"
+
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// keyIDPattern restricts key IDs to characters that are safe inside a token.
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+var (
+	// ErrEmptyKeyring is returned when a keyring has no keys.
+	ErrEmptyKeyring = errors.New("keyring must contain at least one key")
+	// ErrInvalidKeyID is returned when a key ID contains unsupported characters.
+	ErrInvalidKeyID = errors.New("invalid key ID: only letters, digits, '_' and '-' are allowed")
+	// ErrEmptyKey is returned when a key has no secret.
+	ErrEmptyKey = errors.New("key secret cannot be empty")
+	// ErrSigningKeyNotFound is returned when the signing key is not in the keyring.
+	ErrSigningKeyNotFound = errors.New("signing key not found in keyring")
+	// ErrUnknownKeyID is returned when a token references a key that is not in the keyring.
+	ErrUnknownKeyID = errors.New("unknown key ID")
+)
+
+// Keyring holds the HMAC keys used for tokens.
+// New tokens are signed with a single signing key, while every key in the ring is accepted
+// for verification so that tokens signed by a retired key stay valid until they expire.
+type Keyring struct {
+	signingKeyID string
+	keys         map[string][]byte
+}
+
+// NewKeyring creates a new Keyring with the given signing key ID and verification keys.
+func NewKeyring(signingKeyID string, keys map[string][]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyKeyring
+	}
+
+	ringKeys := make(map[string][]byte, len(keys))
+
+	for keyID, secret := range keys {
+		if !keyIDPattern.MatchString(keyID) {
+			return nil, errors.Errorf("%w: %q", ErrInvalidKeyID, keyID)
+		}
+
+		if len(secret) == 0 {
+			return nil, errors.Errorf("%w: %q", ErrEmptyKey, keyID)
+		}
+
+		ringKeys[keyID] = secret
+	}
+
+	if _, ok := ringKeys[signingKeyID]; !ok {
+		return nil, errors.Errorf("%w: %q", ErrSigningKeyNotFound, signingKeyID)
+	}
+
+	return &Keyring{
+		signingKeyID: signingKeyID,
+		keys:         ringKeys,
+	}, nil
+}
+
+// SigningKeyID returns the ID of the key used to sign new tokens.
+func (k *Keyring) SigningKeyID() string {
+	return k.signingKeyID
+}
+
+// signingKey returns the key used to sign new tokens.
+func (k *Keyring) signingKey() []byte {
+	return k.keys[k.signingKeyID]
+}
+
+// verificationKey returns the key with the given ID.
+func (k *Keyring) verificationKey(keyID string) ([]byte, error) {
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("%w: %q", ErrUnknownKeyID, keyID)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,118 @@
+package game
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+var (
+	// ErrNotHuntersTurn is returned when a final photo is picked outside the hunters' turn.
+	ErrNotHuntersTurn = errors.New("not in hunters' turn")
+	// ErrNoAttemptsLeft is returned when a hunter submits after deciding their final photo
+	// or using up their attempts.
+	ErrNoAttemptsLeft = errors.New("no attempts left in the round")
+	// ErrAttemptNotFound is returned when a hunter picks an attempt they have not made.
+	ErrAttemptNotFound = errors.New("attempt not found")
+	// ErrFinalAlreadyPicked is returned when a hunter picks a final photo a second time.
+	ErrFinalAlreadyPicked = errors.New("final photo has already been picked")
+)
+
+// Attempts returns the photos of a hunter, or of a team in team mode, in the order they were submitted.
+func (r *Round) Attempts(hunterID uuid.UUID) []*HunterSubmission {
+	attempts := make([]*HunterSubmission, 0)
+
+	for _, submission := range r.HunterSubmissions {
+		if submission.hunterID() == hunterID {
+			attempts = append(attempts, submission)
+		}
+	}
+
+	return attempts
+}
+
+// CanSubmit checks that a hunter, or a team in team mode, may submit another photo.
+func (r *Round) CanSubmit(hunterID uuid.UUID, attemptLimit int) error {
+	attempts := r.Attempts(hunterID)
+	if len(attempts) >= attemptLimit || slices.ContainsFunc(attempts, (*HunterSubmission).isFinal) {
+		return ErrNoAttemptsLeft
+	}
+
+	return nil
+}
+
+// PickFinal lets userID make attempt the final photo of a hunter, or of a team in team mode.
+// The hunter cannot submit again once the final photo is picked.
+func (r *Round) PickFinal(userID, hunterID uuid.UUID, attempt int) error {
+	if r.TurnStatus != TurnStatusHunters {
+		return ErrNotHuntersTurn
+	}
+
+	attempts := r.Attempts(hunterID)
+	if slices.ContainsFunc(attempts, (*HunterSubmission).isFinal) {
+		return ErrFinalAlreadyPicked
+	}
+
+	if attempt < 1 || attempt > len(attempts) {
+		return errors.Errorf("%w: %d", ErrAttemptNotFound, attempt)
+	}
+
+	final := attempts[attempt-1]
+	final.Final = true
+
+	r.emit(EventFinalPhotoPicked, userID, photoPayload(
+		final.ImageID,
+		final.Attempt,
+		final.SubmittedAtSeconds,
+		final.Final,
+	))
+
+	return nil
+}
+
+// DecidedSubmissions returns the final photo of each hunter, or each team in team mode,
+// that has decided one, in the order of their first attempts.
+func (r *Round) DecidedSubmissions() []*HunterSubmission {
+	return slices.DeleteFunc(r.FinalSubmissions(), func(submission *HunterSubmission) bool {
+		return !submission.isFinal()
+	})
+}
+
+// FinalSubmissions returns the photo each hunter, or each team in team mode, is ranked by:
+// the final photo if one is decided, otherwise the latest attempt.
+// Hunters are in the order of their first attempts.
+func (r *Round) FinalSubmissions() []*HunterSubmission {
+	finals := make([]*HunterSubmission, 0, len(r.HunterSubmissions))
+	indexes := make(map[uuid.UUID]int, len(r.HunterSubmissions))
+
+	for _, submission := range r.HunterSubmissions {
+		index, ok := indexes[submission.hunterID()]
+		if !ok {
+			indexes[submission.hunterID()] = len(finals)
+			finals = append(finals, submission)
+
+			continue
+		}
+
+		// 決定済みの写真は後の試行で置き換えない
+		if !finals[index].isFinal() {
+			finals[index] = submission
+		}
+	}
+
+	return finals
+}
+
+// RankedHunterIDs returns the hunters, or the teams in team mode, who have a photo to rank
+// in the order of FinalSubmissions.
+func (r *Round) RankedHunterIDs() []uuid.UUID {
+	finals := r.FinalSubmissions()
+
+	hunterIDs := make([]uuid.UUID, len(finals))
+	for index, submission := range finals {
+		hunterIDs[index] = submission.hunterID()
+	}
+
+	return hunterIDs
+}
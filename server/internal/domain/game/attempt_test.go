@@ -0,0 +1,226 @@
+package game_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newHuntingRound returns a round in the hunters' turn.
+func newHuntingRound(t *testing.T) *game.Round {
+	t.Helper()
+
+	round := newReviewRound(t)
+
+	err := round.ReleaseHints()
+	if err != nil {
+		t.Fatalf("ReleaseHints() failed: %v", err)
+	}
+
+	return round
+}
+
+// submitAttempt submits a photo of hunterID to round and returns it.
+func submitAttempt(
+	t *testing.T,
+	round *game.Round,
+	hunterID uuid.UUID,
+	attemptLimit int,
+) (*game.HunterSubmission, error) {
+	t.Helper()
+
+	submission, err := game.NewHunterSubmission(
+		hunterID,
+		uuid.NewString(),
+		10,
+		game.DefaultTurnSeconds,
+	)
+	if err != nil {
+		t.Fatalf("NewHunterSubmission() failed: %v", err)
+	}
+
+	err = round.AddHunterSubmission(submission, attemptLimit)
+	if err != nil {
+		return nil, errors.Errorf("failed to add submission: %w", err)
+	}
+
+	return submission, nil
+}
+
+func TestRound_AddHunterSubmission_Attempts(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		attemptLimit int
+		submissions  int
+		wantFinal    bool
+		wantErr      error
+	}{
+		"one attempt":        {1, 1, true, nil},
+		"attempts left":      {3, 2, false, nil},
+		"last attempt":       {3, 3, true, nil},
+		"no attempts left":   {1, 2, true, game.ErrNoAttemptsLeft},
+		"over attempt limit": {3, 4, true, game.ErrNoAttemptsLeft},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newHuntingRound(t)
+			hunterID := uuid.New()
+
+			var err error
+			for range testCase.submissions {
+				_, err = submitAttempt(t, round, hunterID, testCase.attemptLimit)
+			}
+
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("AddHunterSubmission() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			attempts := round.Attempts(hunterID)
+			for index, attempt := range attempts {
+				if attempt.Attempt != index+1 {
+					t.Errorf("attempt %d numbered %d", index+1, attempt.Attempt)
+				}
+			}
+
+			last := attempts[len(attempts)-1]
+			if last.Final != testCase.wantFinal {
+				t.Errorf("last attempt final = %v, want %v", last.Final, testCase.wantFinal)
+			}
+
+			if round.CheckAllHuntersSubmitted(1) != testCase.wantFinal {
+				t.Errorf("CheckAllHuntersSubmitted() = %v, want %v",
+					round.CheckAllHuntersSubmitted(1), testCase.wantFinal)
+			}
+		})
+	}
+}
+
+func TestRound_PickFinal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		attempt int
+		wantErr error
+	}{
+		"first attempt":  {1, nil},
+		"latest attempt": {2, nil},
+		"not made":       {3, game.ErrAttemptNotFound},
+		"zero":           {0, game.ErrAttemptNotFound},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newHuntingRound(t)
+			hunterID := uuid.New()
+
+			for range 2 {
+				_, err := submitAttempt(t, round, hunterID, 3)
+				if err != nil {
+					t.Fatalf("AddHunterSubmission() failed: %v", err)
+				}
+			}
+
+			err := round.PickFinal(hunterID, hunterID, testCase.attempt)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("PickFinal() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			// 決定した後は提出も選び直しもできない
+			_, err = submitAttempt(t, round, hunterID, 3)
+			if !errors.Is(err, game.ErrNoAttemptsLeft) {
+				t.Errorf("AddHunterSubmission() after PickFinal() error = %v, want %v",
+					err, game.ErrNoAttemptsLeft)
+			}
+
+			err = round.PickFinal(hunterID, hunterID, testCase.attempt)
+			if !errors.Is(err, game.ErrFinalAlreadyPicked) {
+				t.Errorf("PickFinal() twice error = %v, want %v", err, game.ErrFinalAlreadyPicked)
+			}
+
+			finals := round.FinalSubmissions()
+			if len(finals) != 1 || finals[0].Attempt != testCase.attempt {
+				t.Errorf("FinalSubmissions() = %v, want attempt %d", finals, testCase.attempt)
+			}
+		})
+	}
+}
+
+func TestRound_CheckAllHuntersSubmitted_CountsEachHunterOnce(t *testing.T) {
+	t.Parallel()
+
+	round := newHuntingRound(t)
+	first, second := uuid.New(), uuid.New()
+
+	// 1人が何度提出しても、全員の提出とはみなさない
+	for range 2 {
+		_, err := submitAttempt(t, round, first, 3)
+		if err != nil {
+			t.Fatalf("AddHunterSubmission() failed: %v", err)
+		}
+	}
+
+	err := round.PickFinal(first, first, 1)
+	if err != nil {
+		t.Fatalf("PickFinal() failed: %v", err)
+	}
+
+	if round.CheckAllHuntersSubmitted(2) {
+		t.Error("CheckAllHuntersSubmitted() = true before the second hunter submitted")
+	}
+
+	latest, err := submitAttempt(t, round, second, 3)
+	if err != nil {
+		t.Fatalf("AddHunterSubmission() failed: %v", err)
+	}
+
+	// 決定していない人は最新の写真で評価される
+	finals := round.FinalSubmissions()
+	if len(finals) != 2 || finals[0].Attempt != 1 || finals[1] != latest {
+		t.Errorf(
+			"FinalSubmissions() = %v, want the first hunter's pick and the latest photo",
+			finals,
+		)
+	}
+
+	if round.CheckAllHuntersSubmitted(2) {
+		t.Error("CheckAllHuntersSubmitted() = true before the second hunter picked")
+	}
+
+	// 親に見せるのは決定した写真だけ
+	decided := round.DecidedSubmissions()
+	if len(decided) != 1 || decided[0] != finals[0] {
+		t.Errorf("DecidedSubmissions() = %v, want only the first hunter's pick", decided)
+	}
+
+	err = round.PickFinal(second, second, 1)
+	if err != nil {
+		t.Fatalf("PickFinal() failed: %v", err)
+	}
+
+	if !round.CheckAllHuntersSubmitted(2) {
+		t.Error("CheckAllHuntersSubmitted() = false after every hunter picked")
+	}
+}
+
+func TestRound_PickFinal_NotHuntersTurn(t *testing.T) {
+	t.Parallel()
+
+	round := newReviewRound(t)
+
+	err := round.PickFinal(uuid.New(), uuid.New(), 1)
+	if !errors.Is(err, game.ErrNotHuntersTurn) {
+		t.Errorf("PickFinal() error = %v, want %v", err, game.ErrNotHuntersTurn)
+	}
+}
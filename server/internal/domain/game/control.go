@@ -0,0 +1,160 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+var (
+	// ErrGamePaused is returned when the game is played or paused while it is paused.
+	ErrGamePaused = errors.New("game is paused")
+	// ErrGameNotPaused is returned when a game that is not paused is resumed.
+	ErrGameNotPaused = errors.New("game is not paused")
+	// ErrRoundSkipped is returned when a skipped round is played or skipped again.
+	ErrRoundSkipped = errors.New("round has been skipped")
+	// ErrRoundAlreadyRanked is returned when a round that has results is skipped.
+	ErrRoundAlreadyRanked = errors.New("round has already been ranked")
+)
+
+// Pause freezes the game at now until it is resumed.
+// The turn clock and the voting time stop, and the players cannot play meanwhile.
+func (g *Game) Pause(userID uuid.UUID, now time.Time) error {
+	if g.Status == GameStatusPaused {
+		return ErrGamePaused
+	}
+
+	if g.Status != GameStatusInProgress {
+		return ErrGameNotInProgress
+	}
+
+	err := g.apply(GameActionPause)
+	if err != nil {
+		return err
+	}
+
+	g.PausedAt = now
+	g.emit(EventGamePaused, userID, EventPayload{})
+	g.UpdatedAt = now
+
+	return nil
+}
+
+// Resume continues a paused game at now.
+// The clocks of the current round continue from where they stopped.
+func (g *Game) Resume(userID uuid.UUID, now time.Time) error {
+	if g.Status != GameStatusPaused {
+		return ErrGameNotPaused
+	}
+
+	err := g.apply(GameActionResume)
+	if err != nil {
+		return err
+	}
+
+	if round, err := g.GetCurrentRound(); err == nil {
+		round.shiftClock(now.Sub(g.PausedAt))
+	}
+
+	g.PausedAt = time.Time{}
+	g.emit(EventGameResumed, userID, EventPayload{})
+	g.UpdatedAt = now
+
+	return nil
+}
+
+// SkipRound voids the current round, which gives no points, and moves the game on:
+// the next round starts with the next player as its game master, or the game finishes
+// after the last round. A paused game stays paused.
+func (g *Game) SkipRound(userID uuid.UUID, now time.Time) error {
+	if g.Status != GameStatusInProgress && g.Status != GameStatusPaused {
+		return ErrGameNotInProgress
+	}
+
+	round, err := g.GetCurrentRound()
+	if err != nil {
+		return err
+	}
+
+	if round.Skipped {
+		return ErrRoundSkipped
+	}
+
+	if len(round.Results) > 0 {
+		return ErrRoundAlreadyRanked
+	}
+
+	err = round.skip()
+	if err != nil {
+		return err
+	}
+
+	g.emit(EventRoundSkipped, userID, EventPayload{})
+
+	if g.IsFinished() {
+		err = g.Finish()
+	} else {
+		err = g.startRound(g.nextGameMaster(round.GameMasterUserID))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	g.UpdatedAt = now
+
+	return nil
+}
+
+// nextGameMaster returns the player who joined after gameMasterUserID, or the first player
+// after the last one. Players whose accounts have been deleted are passed over.
+func (g *Game) nextGameMaster(gameMasterUserID uuid.UUID) uuid.UUID {
+	current := slices.IndexFunc(g.Players, func(player *Player) bool {
+		return player.UserID == gameMasterUserID
+	})
+
+	for offset := 1; offset <= len(g.Players); offset++ {
+		player := g.Players[(current+offset)%len(g.Players)]
+		if !player.Anonymized {
+			return player.UserID
+		}
+	}
+
+	return gameMasterUserID
+}
+
+// PlayingRound returns the current round for the players to play,
+// which they cannot while the game is paused or after the round is skipped.
+func (g *Game) PlayingRound() (*Round, error) {
+	if g.Status == GameStatusPaused {
+		return nil, ErrGamePaused
+	}
+
+	round, err := g.GetCurrentRound()
+	if err != nil {
+		return nil, err
+	}
+
+	if round.Skipped {
+		return nil, ErrRoundSkipped
+	}
+
+	return round, nil
+}
+
+// UpdateTurnClock sets the elapsed seconds of the hunters' turn of the current round at now.
+// The clock does not move while the game is paused.
+func (g *Game) UpdateTurnClock(now time.Time) {
+	round, err := g.GetCurrentRound()
+	if err != nil || round.TurnStatus != TurnStatusHunters || round.TurnStartedAt.IsZero() {
+		return
+	}
+
+	if g.Status == GameStatusPaused {
+		now = g.PausedAt
+	}
+
+	round.UpdateTurnElapsedSeconds(int(now.Sub(round.TurnStartedAt).Seconds()))
+}
@@ -0,0 +1,326 @@
+package game_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newHuntingGame returns a game of three players whose first round is in the hunters' turn,
+// which started at startedAt.
+func newHuntingGame(t *testing.T, startedAt time.Time) (*game.Game, *game.Round) {
+	t.Helper()
+
+	gameSession := newSceneGame(t, false)
+
+	round, err := gameSession.GetCurrentRound()
+	if err != nil {
+		t.Fatalf("GetCurrentRound() failed: %v", err)
+	}
+
+	err = round.StartHintGeneration(uuid.NewString())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = round.StartHintReview([]*game.Hint{{HintNumber: 1, Text: "hint"}})
+	if err != nil {
+		t.Fatalf("StartHintReview() failed: %v", err)
+	}
+
+	err = round.ReleaseHints()
+	if err != nil {
+		t.Fatalf("ReleaseHints() failed: %v", err)
+	}
+
+	round.TurnStartedAt = startedAt
+
+	return gameSession, round
+}
+
+// rankFirst completes round, which is in the hunters' turn or waiting for the ranking,
+// with hunterID ranked first.
+func rankFirst(t *testing.T, round *game.Round, hunterID uuid.UUID) {
+	t.Helper()
+
+	if round.TurnStatus == game.TurnStatusHunters {
+		err := round.StartWaitingForSelection()
+		if err != nil {
+			t.Fatalf("StartWaitingForSelection() failed: %v", err)
+		}
+	}
+
+	result, err := game.NewRoundResult(hunterID, 1)
+	if err != nil {
+		t.Fatalf("NewRoundResult() failed: %v", err)
+	}
+
+	err = round.SetResults([]*game.RoundResult{result})
+	if err != nil {
+		t.Fatalf("SetResults() failed: %v", err)
+	}
+}
+
+func TestGame_Pause(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prepare func(t *testing.T, gameSession *game.Game)
+		wantErr error
+	}{
+		"in progress": {func(*testing.T, *game.Game) {}, nil},
+		"paused": {
+			func(t *testing.T, gameSession *game.Game) {
+				t.Helper()
+
+				err := gameSession.Pause(gameSession.Players[0].UserID, time.Now())
+				if err != nil {
+					t.Fatalf("Pause() failed: %v", err)
+				}
+			},
+			game.ErrGamePaused,
+		},
+		"game over": {
+			func(t *testing.T, gameSession *game.Game) {
+				t.Helper()
+
+				err := gameSession.Finish()
+				if err != nil {
+					t.Fatalf("Finish() failed: %v", err)
+				}
+			},
+			game.ErrGameNotInProgress,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession := newSceneGame(t, false)
+			testCase.prepare(t, gameSession)
+
+			err := gameSession.Pause(gameSession.Players[0].UserID, time.Now())
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("Pause() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil && gameSession.Status != game.GameStatusPaused {
+				t.Errorf("Pause() status = %v, want %v", gameSession.Status, game.GameStatusPaused)
+			}
+		})
+	}
+
+	waiting := newWaitingGame(t, game.MinPlayers)
+
+	err := waiting.Pause(waiting.Players[0].UserID, time.Now())
+	if !errors.Is(err, game.ErrGameNotInProgress) {
+		t.Errorf("Pause() before the start error = %v, want %v", err, game.ErrGameNotInProgress)
+	}
+}
+
+func TestGame_PauseFreezesTurnClock(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+	adminID := uuid.New()
+	gameSession, round := newHuntingGame(t, startedAt)
+	gameSession.TakeEvents()
+
+	err := gameSession.Pause(adminID, startedAt.Add(20*time.Second))
+	if err != nil {
+		t.Fatalf("Pause() failed: %v", err)
+	}
+
+	// 一時停止中は時計が進まない
+	gameSession.UpdateTurnClock(startedAt.Add(time.Minute))
+
+	if round.TurnElapsedSeconds != 20 {
+		t.Errorf("TurnElapsedSeconds while paused = %d, want 20", round.TurnElapsedSeconds)
+	}
+
+	_, err = gameSession.PlayingRound()
+	if !errors.Is(err, game.ErrGamePaused) {
+		t.Errorf("PlayingRound() while paused error = %v, want %v", err, game.ErrGamePaused)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[1].UserID)
+	if !errors.Is(err, game.ErrGamePaused) {
+		t.Errorf("StartRound() while paused error = %v, want %v", err, game.ErrGamePaused)
+	}
+
+	err = gameSession.Resume(adminID, startedAt.Add(90*time.Second))
+	if err != nil {
+		t.Fatalf("Resume() failed: %v", err)
+	}
+
+	gameSession.UpdateTurnClock(startedAt.Add(100 * time.Second))
+
+	if round.TurnElapsedSeconds != 30 {
+		t.Errorf("TurnElapsedSeconds after resume = %d, want 30", round.TurnElapsedSeconds)
+	}
+
+	err = gameSession.Resume(adminID, startedAt.Add(100*time.Second))
+	if !errors.Is(err, game.ErrGameNotPaused) {
+		t.Errorf("Resume() of a running game error = %v, want %v", err, game.ErrGameNotPaused)
+	}
+
+	wantEvents := []game.EventType{game.EventGamePaused, game.EventGameResumed}
+
+	events := gameSession.TakeEvents()
+	if len(events) != len(wantEvents) {
+		t.Fatalf("TakeEvents() = %d events, want %d", len(events), len(wantEvents))
+	}
+
+	for index, event := range events {
+		if event.Type != wantEvents[index] || event.UserID != adminID || event.RoundNumber != 1 {
+			t.Errorf("events[%d] = %+v, want %v by the admin in round 1",
+				index, event, wantEvents[index])
+		}
+	}
+}
+
+func TestGame_PauseExtendsVoting(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	endsAt := now.Add(time.Minute)
+	gameSession, round := newVotingGame(t, endsAt)
+
+	err := gameSession.Pause(uuid.New(), now)
+	if err != nil {
+		t.Fatalf("Pause() failed: %v", err)
+	}
+
+	err = gameSession.Resume(uuid.New(), now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Resume() failed: %v", err)
+	}
+
+	if !round.VotingEndsAt.Equal(endsAt.Add(time.Hour)) {
+		t.Errorf("VotingEndsAt = %v, want %v", round.VotingEndsAt, endsAt.Add(time.Hour))
+	}
+}
+
+func TestGame_SkipRound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prepare func(t *testing.T, gameSession *game.Game, round *game.Round)
+		wantErr error
+	}{
+		"hunting": {func(*testing.T, *game.Game, *game.Round) {}, nil},
+		"paused": {
+			func(t *testing.T, gameSession *game.Game, _ *game.Round) {
+				t.Helper()
+
+				err := gameSession.Pause(uuid.New(), time.Now())
+				if err != nil {
+					t.Fatalf("Pause() failed: %v", err)
+				}
+			},
+			nil,
+		},
+		"ranked": {
+			func(t *testing.T, gameSession *game.Game, round *game.Round) {
+				t.Helper()
+
+				rankFirst(t, round, gameSession.Players[1].UserID)
+			},
+			game.ErrRoundAlreadyRanked,
+		},
+		"game over": {
+			func(t *testing.T, gameSession *game.Game, _ *game.Round) {
+				t.Helper()
+
+				err := gameSession.Finish()
+				if err != nil {
+					t.Fatalf("Finish() failed: %v", err)
+				}
+			},
+			game.ErrGameNotInProgress,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession, round := newHuntingGame(t, time.Now())
+			testCase.prepare(t, gameSession, round)
+			status := gameSession.Status
+
+			err := gameSession.SkipRound(uuid.New(), time.Now())
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("SkipRound() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			if !round.Skipped || len(round.Results) != 0 || gameSession.Status != status {
+				t.Errorf("SkipRound() skipped = %v with %d results and status %v, want %v",
+					round.Skipped, len(round.Results), gameSession.Status, status)
+			}
+
+			types := takeEventTypes(gameSession)
+			if !slices.Contains(types, game.EventRoundSkipped) {
+				t.Errorf("events = %v, want %v", types, game.EventRoundSkipped)
+			}
+		})
+	}
+}
+
+func TestGame_SkipRound_MovesOn(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+
+	err := gameSession.SkipRound(uuid.New(), time.Now())
+	if err != nil {
+		t.Fatalf("SkipRound() failed: %v", err)
+	}
+
+	if round.IsVoting() || round.TurnStatus == game.TurnStatusHunters {
+		t.Errorf("skipped round is still played in turn %v", round.TurnStatus)
+	}
+
+	// 次のプレイヤーが親になって次のラウンドが始まる
+	next, err := gameSession.PlayingRound()
+	if err != nil {
+		t.Fatalf("PlayingRound() after skip failed: %v", err)
+	}
+
+	if next.RoundNumber != 2 || next.GameMasterUserID != gameSession.Players[1].UserID ||
+		next.TurnStatus != game.TurnStatusGameMaster {
+		t.Errorf("round after skip = %d by %v in %v, want 2 by %v in %v",
+			next.RoundNumber, next.GameMasterUserID, next.TurnStatus,
+			gameSession.Players[1].UserID, game.TurnStatusGameMaster)
+	}
+
+	// 最後のラウンドを飛ばすとゲームが終わる
+	err = gameSession.SkipRound(uuid.New(), time.Now())
+	if err != nil {
+		t.Fatalf("SkipRound() of the last round failed: %v", err)
+	}
+
+	if !next.Skipped || gameSession.Status != game.GameStatusFinished {
+		t.Errorf("last round skipped = %v with game %v, want skipped and %v",
+			next.Skipped, gameSession.Status, game.GameStatusFinished)
+	}
+
+	for _, player := range gameSession.Players {
+		if player.TotalPoints != 0 {
+			t.Errorf(
+				"player %s has %d points after a skipped round",
+				player.Name,
+				player.TotalPoints,
+			)
+		}
+	}
+}
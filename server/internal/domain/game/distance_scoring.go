@@ -0,0 +1,133 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// DistanceScoring is how the distance of the hunters' photos from the game master's photo
+// counts in the ranking of a round.
+type DistanceScoring int
+
+const (
+	// DistanceScoringOff ranks hunters only by the game master's ranking.
+	DistanceScoringOff DistanceScoring = iota
+	// DistanceScoringTiebreak ranks hunters who share a rank by the distance of their photos.
+	DistanceScoringTiebreak
+	// DistanceScoringPoints gives extra points to hunters whose photos were taken nearby.
+	DistanceScoringPoints
+)
+
+// Distances within which the photo of a hunter earns extra points under DistanceScoringPoints.
+const (
+	NearDistanceMeters   = 50
+	MiddleDistanceMeters = 200
+	FarDistanceMeters    = 1000
+)
+
+// Extra points for the distance of the photo of a hunter under DistanceScoringPoints.
+const (
+	NearDistancePoints   = 3
+	MiddleDistancePoints = 2
+	FarDistancePoints    = 1
+)
+
+// ScoreDistances applies the distance scoring rule to the game master's rankings,
+// keyed by user ID or by team ID in team mode.
+// It returns the rankings with ties broken by distance under DistanceScoringTiebreak,
+// and the extra points of each hunter under DistanceScoringPoints.
+// Hunters whose distance is unknown keep their rank and get no extra points.
+func (r *Round) ScoreDistances(
+	rule DistanceScoring,
+	rankings map[uuid.UUID]int,
+) (map[uuid.UUID]int, map[uuid.UUID]int) {
+	points := make(map[uuid.UUID]int)
+
+	switch rule {
+	case DistanceScoringTiebreak:
+		return r.breakTiesByDistance(rankings), points
+	case DistanceScoringPoints:
+		for hunterID := range rankings {
+			distance, ok := r.FinalDistance(hunterID)
+			if ok {
+				points[hunterID] = distancePoints(distance)
+			}
+		}
+	case DistanceScoringOff:
+	}
+
+	return rankings, points
+}
+
+// breakTiesByDistance ranks hunters who share a rank from that rank by the distance of their
+// photos, nearest first. Hunters with the same or an unknown distance stay tied.
+func (r *Round) breakTiesByDistance(rankings map[uuid.UUID]int) map[uuid.UUID]int {
+	type rankedHunter struct {
+		id       uuid.UUID
+		rank     int
+		distance float64
+		known    bool
+	}
+
+	hunters := make([]rankedHunter, 0, len(rankings))
+
+	for hunterID, rank := range rankings {
+		distance, known := r.FinalDistance(hunterID)
+		hunters = append(hunters, rankedHunter{hunterID, rank, distance, known})
+	}
+
+	// 距離の分からないハンターは同順位の最後に並べる
+	compareDistance := func(left, right rankedHunter) int {
+		switch {
+		case left.known && right.known:
+			return cmp.Compare(left.distance, right.distance)
+		case left.known:
+			return -1
+		case right.known:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	slices.SortFunc(hunters, func(left, right rankedHunter) int {
+		return cmp.Or(cmp.Compare(left.rank, right.rank), compareDistance(left, right))
+	})
+
+	broken := make(map[uuid.UUID]int, len(rankings))
+	groupStart := 0
+
+	for index, hunter := range hunters {
+		if index == 0 || hunters[index-1].rank != hunter.rank {
+			groupStart = index
+		}
+
+		switch {
+		case index == groupStart:
+			broken[hunter.id] = hunter.rank
+		case compareDistance(hunters[index-1], hunter) == 0:
+			broken[hunter.id] = broken[hunters[index-1].id]
+		default:
+			// 同順位の中で何番目かだけ順位を下げる
+			broken[hunter.id] = min(hunter.rank+index-groupStart, MaxRank)
+		}
+	}
+
+	return broken
+}
+
+// distancePoints returns the extra points of a photo taken distance meters away.
+func distancePoints(distance float64) int {
+	switch {
+	case distance <= NearDistanceMeters:
+		return NearDistancePoints
+	case distance <= MiddleDistanceMeters:
+		return MiddleDistancePoints
+	case distance <= FarDistanceMeters:
+		return FarDistancePoints
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,230 @@
+package game
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType is what happened in an event of a game.
+// The values are stored in the event stream, so they must not change.
+type EventType string
+
+const (
+	// EventGamePaused is recorded when the admin pauses the game.
+	EventGamePaused EventType = "game_paused"
+	// EventGameResumed is recorded when the admin resumes the game.
+	EventGameResumed EventType = "game_resumed"
+	// EventRoundSkipped is recorded when the admin voids a round.
+	EventRoundSkipped EventType = "round_skipped"
+	// EventPlayerJoined is recorded when a player joins the game.
+	EventPlayerJoined EventType = "player_joined"
+	// EventRoundStarted is recorded when a round starts with its game master.
+	EventRoundStarted EventType = "round_started"
+	// EventHintsGenerated is recorded when the hints of a round are ready for review.
+	EventHintsGenerated EventType = "hints_generated"
+	// EventPhotoSubmitted is recorded when a hunter submits a photo.
+	EventPhotoSubmitted EventType = "photo_submitted"
+	// EventWinnersSelected is recorded when the photos of a round are ranked.
+	EventWinnersSelected EventType = "winners_selected"
+	// EventGameEnded is recorded when the game is finished.
+	EventGameEnded EventType = "game_ended"
+	// EventGameStarted is recorded when the admin starts the game.
+	EventGameStarted EventType = "game_started"
+	// EventTeamsChanged is recorded when the admin assigns or balances the teams.
+	EventTeamsChanged EventType = "teams_changed"
+	// EventSpectatorJoined is recorded when a spectator joins the game.
+	EventSpectatorJoined EventType = "spectator_joined"
+	// EventGameMasterPhotoSubmitted is recorded when the photo of a round is set,
+	// by the game master or from the scene pool, and its hints start being generated.
+	EventGameMasterPhotoSubmitted EventType = "game_master_photo_submitted"
+	// EventHintAttemptStarted is recorded when the background job starts an attempt
+	// to generate the hints.
+	EventHintAttemptStarted EventType = "hint_attempt_started"
+	// EventHintsProgressed is recorded when the background job has written more of the hints.
+	EventHintsProgressed EventType = "hints_progressed"
+	// EventHintAttemptFailed is recorded when an attempt to generate the hints fails.
+	EventHintAttemptFailed EventType = "hint_attempt_failed"
+	// EventHintGenerationFailed is recorded when the generation of the hints is given up.
+	EventHintGenerationFailed EventType = "hint_generation_failed"
+	// EventHintEdited is recorded when the game master rewrites a hint under review.
+	EventHintEdited EventType = "hint_edited"
+	// EventHintRegenerated is recorded when a hint under review is replaced by a new one from the AI.
+	EventHintRegenerated EventType = "hint_regenerated"
+	// EventHintsReordered is recorded when the game master reorders the hints under review.
+	EventHintsReordered EventType = "hints_reordered"
+	// EventHintsReleased is recorded when the hunters' turn starts with the hints.
+	EventHintsReleased EventType = "hints_released"
+	// EventFinalPhotoPicked is recorded when a hunter picks their final photo.
+	EventFinalPhotoPicked EventType = "final_photo_picked"
+	// EventHuntFinished is recorded when the hunters' turn ends, and voting starts
+	// when the audience ranks the photos.
+	EventHuntFinished EventType = "hunt_finished"
+	// EventVoteCast is recorded when a player or spectator votes.
+	EventVoteCast EventType = "vote_cast"
+	// EventVotingClosed is recorded when voting is closed by the game master,
+	// or by itself once everyone has voted.
+	EventVotingClosed EventType = "voting_closed"
+	// EventProximityChecked is recorded when a hunter checks how close they are.
+	EventProximityChecked EventType = "proximity_checked"
+	// EventPlayerAnonymized is recorded when a player or spectator deletes their account.
+	// The event is caused by their anonymous ID.
+	EventPlayerAnonymized EventType = "player_anonymized"
+)
+
+// Event is an entry in the event stream of a game, which is the only record of its history.
+// Every action that changes a game records an event. Its payload holds what the action set,
+// such as the photo, the hints or the ranking, and is empty when the type tells everything.
+// The location of a photo, the ballots of a vote and the turn clock are not recorded.
+type Event struct {
+	// ID is the position of the event in the event stream, or empty until it is stored.
+	ID   string    `json:"id,omitempty"`
+	Type EventType `json:"type"`
+	// UserID is who caused the event, or uuid.Nil when no one did.
+	UserID uuid.UUID `json:"userId"`
+	// RoundNumber is the current round when the event occurred, or 0 before the first round.
+	RoundNumber int          `json:"roundNumber,omitempty"`
+	OccurredAt  time.Time    `json:"occurredAt"`
+	Payload     EventPayload `json:"payload,omitzero"`
+}
+
+// EventPayload holds what an event set. At most one field is set, depending on the type.
+type EventPayload struct {
+	// Photo is set for EventGameMasterPhotoSubmitted, EventPhotoSubmitted and EventFinalPhotoPicked.
+	Photo *PhotoPayload `json:"photo,omitempty"`
+	// Hints is set for EventHintsGenerated, EventHintsReordered and EventHintsReleased with
+	// every hint, and for EventHintEdited and EventHintRegenerated with the changed one.
+	Hints []*Hint `json:"hints,omitempty"`
+	// HintAttempt is set for EventHintAttemptStarted, EventHintsProgressed and EventHintAttemptFailed.
+	HintAttempt *HintAttemptPayload `json:"hintAttempt,omitempty"`
+	// Results is set for EventWinnersSelected with the ranks and points awarded.
+	Results []*RoundResult `json:"results,omitempty"`
+	// Voting is set for EventVotingClosed.
+	Voting *VotingPayload `json:"voting,omitempty"`
+	// Proximity is set for EventProximityChecked.
+	Proximity *ProximityPayload `json:"proximity,omitempty"`
+	// Teams is set for EventTeamsChanged with the new teams, or empty in individual mode.
+	Teams []*Team `json:"teams,omitempty"`
+}
+
+// PhotoPayload is a photo of the game master or of a hunter.
+type PhotoPayload struct {
+	ImageID string `json:"imageId"`
+	// Attempt is the attempt of the hunter, or 0 for the photo of the game master.
+	Attempt            int  `json:"attempt,omitempty"`
+	SubmittedAtSeconds int  `json:"submittedAtSeconds,omitempty"`
+	Final              bool `json:"final,omitempty"`
+}
+
+// HintAttemptPayload is the progress of an attempt to generate the hints.
+type HintAttemptPayload struct {
+	Attempt   int `json:"attempt"`
+	Generated int `json:"generated"`
+	// Message is why the attempt failed.
+	Message string `json:"message,omitempty"`
+}
+
+// VotingPayload is how many ballots were cast when voting closed.
+type VotingPayload struct {
+	Ballots int `json:"ballots"`
+}
+
+// ProximityPayload is how close a hunter got.
+type ProximityPayload struct {
+	Proximity Proximity `json:"proximity"`
+}
+
+// TakeEvents returns the events of the changes made since the game was loaded or last taken
+// and forgets them. The events of the rounds come first, as changes of the game follow them.
+// The events have no time until they are stored.
+func (g *Game) TakeEvents() []*Event {
+	var events []*Event
+
+	for _, round := range g.Rounds {
+		events = append(events, round.pendingEvents...)
+		round.pendingEvents = nil
+	}
+
+	events = append(events, g.pendingEvents...)
+	g.pendingEvents = nil
+
+	return events
+}
+
+// ShowsPayloadOf reports whether the players may see the payload of event.
+// The payloads of the round being played are hidden until it is completed,
+// as they hold the hints under review and the photos of the hunters.
+func (g *Game) ShowsPayloadOf(event *Event) bool {
+	if g.Status == GameStatusFinished || event.RoundNumber != g.CurrentRound {
+		return true
+	}
+
+	round, err := g.GetCurrentRound()
+
+	return err != nil || round.TurnStatus == TurnStatusCompleted
+}
+
+// WithoutPayload returns a copy of the event with an empty payload.
+func (e *Event) WithoutPayload() *Event {
+	event := *e
+	event.Payload = EventPayload{}
+
+	return &event
+}
+
+// emit adds an event caused by userID in the current round to the pending events of the game.
+func (g *Game) emit(eventType EventType, userID uuid.UUID, payload EventPayload) {
+	g.pendingEvents = append(
+		g.pendingEvents,
+		newPendingEvent(eventType, userID, g.CurrentRound, payload),
+	)
+}
+
+// emit adds an event caused by userID to the pending events of the round.
+func (r *Round) emit(eventType EventType, userID uuid.UUID, payload EventPayload) {
+	r.pendingEvents = append(
+		r.pendingEvents,
+		newPendingEvent(eventType, userID, r.RoundNumber, payload),
+	)
+}
+
+// newPendingEvent creates an event that has not been stored yet.
+func newPendingEvent(
+	eventType EventType,
+	userID uuid.UUID,
+	roundNumber int,
+	payload EventPayload,
+) *Event {
+	return &Event{
+		ID:          "",
+		Type:        eventType,
+		UserID:      userID,
+		RoundNumber: roundNumber,
+		OccurredAt:  time.Time{},
+		Payload:     payload,
+	}
+}
+
+// hintsPayload is the payload of the hints of an event, copied as they may still change.
+func hintsPayload(hints ...*Hint) EventPayload {
+	return EventPayload{Hints: clonePointers(hints)}
+}
+
+// photoPayload is the payload of the photo of an event.
+func photoPayload(imageID string, attempt, submittedAtSeconds int, final bool) EventPayload {
+	return EventPayload{Photo: &PhotoPayload{
+		ImageID:            imageID,
+		Attempt:            attempt,
+		SubmittedAtSeconds: submittedAtSeconds,
+		Final:              final,
+	}}
+}
+
+// hintAttemptPayload is the payload of the attempt of the hint generation of an event.
+func (r *Round) hintAttemptPayload(message string) EventPayload {
+	return EventPayload{HintAttempt: &HintAttemptPayload{
+		Attempt:   r.HintGeneration.Attempts,
+		Generated: r.HintGeneration.Generated,
+		Message:   message,
+	}}
+}
@@ -0,0 +1,477 @@
+package game_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// takeEventTypes returns the types of the events taken from gameSession.
+func takeEventTypes(gameSession *game.Game) []game.EventType {
+	events := gameSession.TakeEvents()
+
+	types := make([]game.EventType, len(events))
+	for index, event := range events {
+		types[index] = event.Type
+	}
+
+	return types
+}
+
+// generatingGame returns a game whose first round is generating its hints,
+// with the events so far taken.
+func generatingGame(t *testing.T) *game.Game {
+	t.Helper()
+
+	gameSession := newSceneGame(t, false)
+
+	err := gameSession.Rounds[0].StartHintGeneration(uuid.NewString())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	gameSession.TakeEvents()
+
+	return gameSession
+}
+
+func TestGame_TakeEvents(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, game.MinPlayers)
+	gameMasterID := gameSession.Players[0].UserID
+	hunterID := gameSession.Players[1].UserID
+
+	steps := []struct {
+		name   string
+		change func() error
+		want   []game.EventType
+	}{
+		{
+			"join",
+			func() error { return nil },
+			[]game.EventType{
+				game.EventPlayerJoined,
+				game.EventPlayerJoined,
+				game.EventPlayerJoined,
+			},
+		},
+		{
+			"start",
+			func() error { return gameSession.Start(gameMasterID) },
+			[]game.EventType{game.EventGameStarted},
+		},
+		{
+			"start round",
+			func() error { return gameSession.StartRound(gameMasterID) },
+			[]game.EventType{game.EventRoundStarted},
+		},
+		{
+			"submit the game master's photo",
+			func() error { return gameSession.Rounds[0].StartHintGeneration(uuid.NewString()) },
+			[]game.EventType{game.EventGameMasterPhotoSubmitted},
+		},
+		{
+			"start generating hints",
+			func() error { return gameSession.Rounds[0].StartHintAttempt(1) },
+			[]game.EventType{game.EventHintAttemptStarted},
+		},
+		{
+			"write a hint",
+			func() error { return gameSession.Rounds[0].UpdateHintProgress(1, 2) },
+			[]game.EventType{game.EventHintsProgressed},
+		},
+		{
+			"generate hints",
+			func() error {
+				return gameSession.Rounds[0].StartHintReview(
+					[]*game.Hint{{HintNumber: 1, Text: "first"}, {HintNumber: 2, Text: "second"}},
+				)
+			},
+			[]game.EventType{game.EventHintsGenerated},
+		},
+		{
+			"edit a hint",
+			func() error { return gameSession.Rounds[0].EditHint(1, "edited") },
+			[]game.EventType{game.EventHintEdited},
+		},
+		{
+			"regenerate a hint",
+			func() error { return gameSession.Rounds[0].ReplaceHint(2, "regenerated") },
+			[]game.EventType{game.EventHintRegenerated},
+		},
+		{
+			"reorder hints",
+			func() error { return gameSession.Rounds[0].ReorderHints([]int{2, 1}) },
+			[]game.EventType{game.EventHintsReordered},
+		},
+		{
+			"release hints",
+			func() error { return gameSession.Rounds[0].ReleaseHints() },
+			[]game.EventType{game.EventHintsReleased},
+		},
+		{
+			"check proximity",
+			func() error {
+				gameSession.Rounds[0].SetGameMasterLocation(&game.Location{
+					Latitude:  35,
+					Longitude: 139,
+				})
+
+				_, err := gameSession.Rounds[0].CheckProximity(hunterID, &game.Location{
+					Latitude:  35.1,
+					Longitude: 139,
+				})
+				if err != nil {
+					return errors.Errorf("failed to check proximity: %w", err)
+				}
+
+				return nil
+			},
+			[]game.EventType{game.EventProximityChecked},
+		},
+		{
+			"submit a hunter's photo",
+			func() error {
+				return gameSession.Rounds[0].AddHunterSubmission(
+					&game.HunterSubmission{UserID: hunterID, ImageID: uuid.NewString()},
+					3,
+				)
+			},
+			[]game.EventType{game.EventPhotoSubmitted},
+		},
+		{
+			"pick the final photo",
+			func() error { return gameSession.Rounds[0].PickFinal(hunterID, hunterID, 1) },
+			[]game.EventType{game.EventFinalPhotoPicked},
+		},
+		{
+			"spectator joins",
+			func() error {
+				spectator, err := game.NewSpectator(uuid.New(), "spectator")
+				if err != nil {
+					return errors.Errorf("failed to create spectator: %w", err)
+				}
+
+				return gameSession.AddSpectator(spectator)
+			},
+			[]game.EventType{game.EventSpectatorJoined},
+		},
+		{
+			"pause",
+			func() error { return gameSession.Pause(gameMasterID, time.Now()) },
+			[]game.EventType{game.EventGamePaused},
+		},
+		{
+			"resume",
+			func() error { return gameSession.Resume(gameMasterID, time.Now()) },
+			[]game.EventType{game.EventGameResumed},
+		},
+		{
+			"finish the hunt",
+			func() error { return gameSession.Rounds[0].StartVoting(time.Now().Add(time.Minute)) },
+			[]game.EventType{game.EventHuntFinished},
+		},
+		{
+			"vote",
+			func() error { return gameSession.Rounds[0].CastVote(gameMasterID, time.Now()) },
+			[]game.EventType{game.EventVoteCast},
+		},
+		{
+			"close voting",
+			func() error { return gameSession.Rounds[0].CloseVoting(gameMasterID, time.Now(), 1, 1) },
+			[]game.EventType{game.EventVotingClosed},
+		},
+		{
+			"rank",
+			func() error {
+				rankFirst(t, gameSession.Rounds[0], hunterID)
+
+				return nil
+			},
+			[]game.EventType{game.EventWinnersSelected},
+		},
+		{
+			"delete an account",
+			func() error {
+				_, err := gameSession.AnonymizePlayer(hunterID)
+				if err != nil {
+					return errors.Errorf("failed to anonymize player: %w", err)
+				}
+
+				return nil
+			},
+			[]game.EventType{game.EventPlayerAnonymized},
+		},
+		{
+			"end",
+			func() error { return gameSession.Finish() },
+			[]game.EventType{game.EventGameEnded},
+		},
+	}
+
+	// 各手順は前の手順の後のゲームを変更するため、順に確認する
+	for _, step := range steps {
+		err := step.change()
+		if err != nil {
+			t.Fatalf("%s: change failed: %v", step.name, err)
+		}
+
+		got := takeEventTypes(gameSession)
+		if !slices.Equal(got, step.want) {
+			t.Errorf("%s: TakeEvents() = %v, want %v", step.name, got, step.want)
+		}
+	}
+}
+
+func TestGame_TakeEvents_Details(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+	gameSession.TakeEvents()
+
+	hunterID := gameSession.Players[1].UserID
+	rankFirst(t, round, hunterID)
+
+	err := gameSession.Finish()
+	if err != nil {
+		t.Fatalf("Finish() failed: %v", err)
+	}
+
+	events := gameSession.TakeEvents()
+	want := []*game.Event{
+		{Type: game.EventHuntFinished, UserID: uuid.Nil, RoundNumber: 1},
+		{Type: game.EventWinnersSelected, UserID: round.GameMasterUserID, RoundNumber: 1},
+		{Type: game.EventGameEnded, UserID: uuid.Nil, RoundNumber: 1},
+	}
+
+	if len(events) != len(want) {
+		t.Fatalf("TakeEvents() = %d events, want %d", len(events), len(want))
+	}
+
+	for index, event := range events {
+		// 時刻とIDは保存するときに付く
+		if event.Type != want[index].Type || event.UserID != want[index].UserID ||
+			event.RoundNumber != want[index].RoundNumber || event.ID != "" ||
+			!event.OccurredAt.IsZero() {
+			t.Errorf("TakeEvents()[%d] = %+v, want %+v", index, event, want[index])
+		}
+	}
+
+	// 順位の結果は出来事に写され、後から変わらない
+	results := events[1].Payload.Results
+	round.Results[0].Points = 0
+
+	if len(results) != 1 || results[0].UserID != hunterID || results[0].Rank != 1 ||
+		results[0].Points == 0 {
+		t.Errorf(
+			"Payload.Results = %+v, want the first rank of %v with its points",
+			results,
+			hunterID,
+		)
+	}
+
+	if events[0].Payload.Results != nil || events[2].Payload.Results != nil {
+		t.Errorf("results of %v and %v = %+v and %+v, want none",
+			events[0].Type, events[2].Type, events[0].Payload.Results, events[2].Payload.Results)
+	}
+}
+
+func TestEvent_JSON(t *testing.T) {
+	t.Parallel()
+
+	event := &game.Event{
+		ID:          "1-0",
+		Type:        game.EventPhotoSubmitted,
+		UserID:      uuid.New(),
+		RoundNumber: 1,
+		OccurredAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Payload: game.EventPayload{Photo: &game.PhotoPayload{
+			ImageID:            "image",
+			Attempt:            2,
+			SubmittedAtSeconds: 30,
+			Final:              true,
+		}},
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+
+	// 種類はストリームに残るため、番号ではなく名前で保存する
+	var stored map[string]any
+
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+
+	if stored["type"] != "photo_submitted" {
+		t.Errorf("stored type = %v, want photo_submitted", stored["type"])
+	}
+
+	var loaded game.Event
+
+	err = json.Unmarshal(data, &loaded)
+	if err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+
+	if loaded.Type != event.Type || loaded.Payload.Photo == nil ||
+		*loaded.Payload.Photo != *event.Payload.Photo {
+		t.Errorf("loaded event = %+v, want %+v", loaded, event)
+	}
+}
+
+func TestGame_ShowsPayloadOf(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+	earlier := &game.Event{Type: game.EventTeamsChanged, RoundNumber: 0}
+	current := &game.Event{Type: game.EventHintsReleased, RoundNumber: 1}
+
+	if !gameSession.ShowsPayloadOf(earlier) {
+		t.Error("ShowsPayloadOf() of an event before the round = false, want true")
+	}
+
+	// 進行中のラウンドのヒントや写真は、ラウンドが終わるまで見せない
+	if gameSession.ShowsPayloadOf(current) {
+		t.Error("ShowsPayloadOf() of the round being played = true, want false")
+	}
+
+	rankFirst(t, round, gameSession.Players[1].UserID)
+
+	if !gameSession.ShowsPayloadOf(current) {
+		t.Error("ShowsPayloadOf() of a completed round = false, want true")
+	}
+}
+
+func TestGame_TakeEvents_Causes(t *testing.T) {
+	t.Parallel()
+
+	// change はゲームを変更し、出来事を起こしたはずのユーザーを返す
+	tests := map[string]struct {
+		change   func(t *testing.T) (*game.Game, uuid.UUID)
+		wantType game.EventType
+	}{
+		"balance teams": {
+			func(t *testing.T) (*game.Game, uuid.UUID) {
+				t.Helper()
+
+				gameSession := newWaitingGame(t, 4)
+				adminID := gameSession.Players[0].UserID
+				gameSession.TakeEvents()
+
+				err := gameSession.BalanceTeams(adminID, 2)
+				if err != nil {
+					t.Fatalf("BalanceTeams() failed: %v", err)
+				}
+
+				return gameSession, adminID
+			},
+			game.EventTeamsChanged,
+		},
+		"fail an attempt": {
+			func(t *testing.T) (*game.Game, uuid.UUID) {
+				t.Helper()
+
+				gameSession := generatingGame(t)
+
+				err := gameSession.Rounds[0].RecordHintError(1, "error")
+				if err != nil {
+					t.Fatalf("RecordHintError() failed: %v", err)
+				}
+
+				return gameSession, uuid.Nil
+			},
+			game.EventHintAttemptFailed,
+		},
+		"give up generating hints": {
+			func(t *testing.T) (*game.Game, uuid.UUID) {
+				t.Helper()
+
+				gameSession := generatingGame(t)
+
+				err := gameSession.Rounds[0].FailHintGeneration()
+				if err != nil {
+					t.Fatalf("FailHintGeneration() failed: %v", err)
+				}
+
+				return gameSession, uuid.Nil
+			},
+			game.EventHintGenerationFailed,
+		},
+		"rank by the votes": {
+			func(t *testing.T) (*game.Game, uuid.UUID) {
+				t.Helper()
+
+				gameSession, round := newVotingGame(t, time.Now().Add(time.Minute))
+				gameSession.TakeEvents()
+				rankFirst(t, round, gameSession.Players[1].UserID)
+
+				return gameSession, uuid.Nil
+			},
+			game.EventWinnersSelected,
+		},
+		"delete an account": {
+			func(t *testing.T) (*game.Game, uuid.UUID) {
+				t.Helper()
+
+				gameSession, _ := newHuntingGame(t, time.Now())
+				gameSession.TakeEvents()
+
+				anonymousID, err := gameSession.AnonymizePlayer(gameSession.Players[1].UserID)
+				if err != nil {
+					t.Fatalf("AnonymizePlayer() failed: %v", err)
+				}
+
+				return gameSession, anonymousID
+			},
+			game.EventPlayerAnonymized,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession, wantUserID := testCase.change(t)
+
+			events := gameSession.TakeEvents()
+			if len(events) != 1 || events[0].Type != testCase.wantType ||
+				events[0].UserID != wantUserID {
+				t.Errorf("TakeEvents() = %+v, want one event %v by %v",
+					events, testCase.wantType, wantUserID)
+			}
+		})
+	}
+}
+
+func TestGame_TakeEvents_NotStored(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, game.MinPlayers)
+
+	data, err := json.Marshal(gameSession)
+	if err != nil {
+		t.Fatalf("Marshal() failed: %v", err)
+	}
+
+	var loaded game.Game
+
+	err = json.Unmarshal(data, &loaded)
+	if err != nil {
+		t.Fatalf("Unmarshal() failed: %v", err)
+	}
+
+	// 読み込んだゲームには、保存前の変更の出来事は残らない
+	if events := loaded.TakeEvents(); len(events) != 0 {
+		t.Errorf("TakeEvents() of a loaded game = %d events, want 0", len(events))
+	}
+}
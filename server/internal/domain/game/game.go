@@ -19,6 +19,8 @@ const (
 	GameStatusInProgress
 	// GameStatusFinished represents game has ended.
 	GameStatusFinished
+	// GameStatusPaused represents game is paused by the admin.
+	GameStatusPaused
 )
 
 const (
@@ -30,6 +32,9 @@ const (
 	MinRounds = 1
 	// MaxRounds is the maximum number of rounds.
 	MaxRounds = 5
+	// AnonymizedPlayerName is the display name used for players who deleted their account.
+	//nolint:gosmopolitan // Japanese text is required for the game
+	AnonymizedPlayerName = "退会済み"
 )
 
 var (
@@ -61,12 +66,39 @@ type Game struct {
 	CurrentRound int        `json:"currentRound"`
 	Players      []*Player  `json:"players"`
 	Rounds       []*Round   `json:"rounds"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	UpdatedAt    time.Time  `json:"updatedAt"`
+	// HintSettings is zero for games stored before hints had settings.
+	HintSettings HintSettings `json:"hintSettings"`
+	// Settings is zero for games stored before games had settings.
+	Settings GameSettings `json:"settings"`
+	// Teams is empty unless the players compete as teams.
+	Teams []*Team `json:"teams,omitempty"`
+	// ScenePool is true when rounds use scenes uploaded to the room
+	// instead of photos taken by the game master.
+	ScenePool bool `json:"scenePool,omitempty"`
+	// Spectators watch the game without playing.
+	Spectators []*Spectator `json:"spectators,omitempty"`
+	// PausedAt is when the game was paused, or zero unless it is paused.
+	PausedAt time.Time `json:"pausedAt,omitzero"`
+	// Version counts the times the game has been updated in storage.
+	// It is used to detect updates made since the game was read.
+	Version   int64     `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// pendingEvents are the events of the changes that have not been stored yet.
+	pendingEvents []*Event
 }
 
 // NewGame creates a new Game.
-func NewGame(roomID uuid.UUID, totalRounds int, gameMasterUserID uuid.UUID) (*Game, error) {
+// With scenePool the rounds use scenes uploaded to the room instead of game master photos.
+func NewGame(
+	roomID uuid.UUID,
+	totalRounds int,
+	gameMasterUserID uuid.UUID,
+	hintSettings HintSettings,
+	settings GameSettings,
+	scenePool bool,
+) (*Game, error) {
 	if totalRounds < MinRounds || totalRounds > MaxRounds {
 		return nil, ErrInvalidTotalRounds
 	}
@@ -80,8 +112,16 @@ func NewGame(roomID uuid.UUID, totalRounds int, gameMasterUserID uuid.UUID) (*Ga
 		CurrentRound: 0,
 		Players:      make([]*Player, 0),
 		Rounds:       make([]*Round, 0),
+		HintSettings: hintSettings,
+		Settings:     settings,
+		Teams:        nil,
+		ScenePool:    scenePool,
+		Spectators:   nil,
+		PausedAt:     time.Time{},
 		CreatedAt:    now,
 		UpdatedAt:    now,
+
+		pendingEvents: nil,
 	}, nil
 }
 
@@ -102,7 +142,19 @@ func (g *Game) AddPlayer(player *Player) error {
 		}
 	}
 
+	// 観戦者はそのままプレイヤーにはなれない
+	if g.IsSpectator(player.UserID) {
+		return ErrPlayerAlreadyExists
+	}
+
 	g.Players = append(g.Players, player)
+
+	// チーム戦では途中参加者を人数の少ないチームに入れる
+	if g.IsTeamMode() {
+		g.addToSmallestTeam(player.UserID)
+	}
+
+	g.emit(EventPlayerJoined, player.UserID, EventPayload{})
 	g.UpdatedAt = time.Now()
 
 	return nil
@@ -119,8 +171,8 @@ func (g *Game) GetPlayer(userID uuid.UUID) (*Player, error) {
 	return nil, ErrPlayerNotFound
 }
 
-// Start starts the game.
-func (g *Game) Start() error {
+// Start lets userID start the game.
+func (g *Game) Start(userID uuid.UUID) error {
 	if g.Status != GameStatusWaiting {
 		return ErrGameAlreadyStarted
 	}
@@ -129,14 +181,29 @@ func (g *Game) Start() error {
 		return ErrNotEnoughPlayers
 	}
 
-	g.Status = GameStatusInProgress
+	err := g.apply(GameActionStart)
+	if err != nil {
+		return err
+	}
+
+	g.emit(EventGameStarted, userID, EventPayload{})
 	g.UpdatedAt = time.Now()
 
 	return nil
 }
 
-// StartRound starts a new round.
+// StartRound starts a new round once the current round is completed.
+// A waiting game has to be started first.
 func (g *Game) StartRound(gameMasterUserID uuid.UUID) error {
+	if g.Status == GameStatusWaiting {
+		return errors.Errorf("%w: cannot start a round of a game that is %s",
+			ErrInvalidGameTransition, g.Status)
+	}
+
+	if g.Status == GameStatusPaused {
+		return ErrGamePaused
+	}
+
 	if g.Status != GameStatusInProgress {
 		return ErrGameNotInProgress
 	}
@@ -145,17 +212,15 @@ func (g *Game) StartRound(gameMasterUserID uuid.UUID) error {
 		return ErrAllRoundsCompleted
 	}
 
-	g.CurrentRound++
-
-	round, err := NewRound(g.CurrentRound, gameMasterUserID)
-	if err != nil {
-		return err
+	if current, err := g.GetCurrentRound(); err == nil && !current.IsCompleted() {
+		return ErrRoundNotCompleted
 	}
 
-	g.Rounds = append(g.Rounds, round)
-	g.UpdatedAt = time.Now()
+	if g.IsSpectator(gameMasterUserID) {
+		return ErrSpectatorCannotPlay
+	}
 
-	return nil
+	return g.startRound(gameMasterUserID)
 }
 
 // GetCurrentRound returns the current round.
@@ -167,13 +232,19 @@ func (g *Game) GetCurrentRound() (*Round, error) {
 	return g.Rounds[g.CurrentRound-1], nil
 }
 
-// Finish finishes the game.
+// Finish finishes a game that has started.
 func (g *Game) Finish() error {
 	if g.Status == GameStatusFinished {
 		return ErrGameAlreadyFinished
 	}
 
-	g.Status = GameStatusFinished
+	err := g.apply(GameActionFinish)
+	if err != nil {
+		return err
+	}
+
+	g.PausedAt = time.Time{}
+	g.emit(EventGameEnded, uuid.Nil, EventPayload{})
 	g.UpdatedAt = time.Now()
 
 	return nil
@@ -212,3 +283,86 @@ func (g *Game) UpdatePlayerPoints(userID uuid.UUID, points int) error {
 
 	return nil
 }
+
+// AnonymizePlayer replaces every reference to the given user with a new anonymous ID
+// and returns it. It is used when a user deletes their account so that other players'
+// history stays intact. Spectators are removed instead, and the events they caused keep
+// only the anonymous ID.
+// Returns uuid.Nil if the user did not take part in the game.
+func (g *Game) AnonymizePlayer(userID uuid.UUID) (uuid.UUID, error) {
+	spectator := g.removeSpectator(userID)
+
+	player, err := g.GetPlayer(userID)
+	if err != nil && !errors.Is(err, ErrPlayerNotFound) {
+		return uuid.Nil, err
+	}
+
+	if player == nil && !spectator {
+		return uuid.Nil, nil
+	}
+
+	anonymousID, err := uuid.NewV7()
+	if err != nil {
+		return uuid.Nil, errors.Errorf("failed to generate anonymous ID: %w", err)
+	}
+
+	if player != nil {
+		player.UserID = anonymousID
+		player.Name = AnonymizedPlayerName
+		player.Anonymized = true
+		player.Disconnect()
+	}
+
+	for _, team := range g.Teams {
+		for index, memberID := range team.MemberIDs {
+			if memberID == userID {
+				team.MemberIDs[index] = anonymousID
+			}
+		}
+	}
+
+	for _, round := range g.Rounds {
+		round.replaceUserID(userID, anonymousID)
+	}
+
+	g.emit(EventPlayerAnonymized, anonymousID, EventPayload{})
+	g.UpdatedAt = time.Now()
+
+	return anonymousID, nil
+}
+
+// ImageIDsByPlayer returns the IDs of all images the given user submitted in this game,
+// both as game master and as hunter.
+func (g *Game) ImageIDsByPlayer(userID uuid.UUID) []string {
+	imageIDs := make([]string, 0)
+
+	for _, round := range g.Rounds {
+		if round.GameMasterUserID == userID && round.GameMasterImageID != "" {
+			imageIDs = append(imageIDs, round.GameMasterImageID)
+		}
+
+		for _, submission := range round.HunterSubmissions {
+			if submission.UserID == userID {
+				imageIDs = append(imageIDs, submission.ImageID)
+			}
+		}
+	}
+
+	return imageIDs
+}
+
+// startRound adds the next round with gameMasterUserID as its game master.
+func (g *Game) startRound(gameMasterUserID uuid.UUID) error {
+	g.CurrentRound++
+
+	round, err := NewRound(g.CurrentRound, gameMasterUserID)
+	if err != nil {
+		return err
+	}
+
+	g.Rounds = append(g.Rounds, round)
+	g.emit(EventRoundStarted, gameMasterUserID, EventPayload{})
+	g.UpdatedAt = time.Now()
+
+	return nil
+}
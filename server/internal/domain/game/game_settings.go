@@ -0,0 +1,254 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// HintSchedule is how the hints of a round are released during the hunters' turn.
+type HintSchedule int
+
+const (
+	// HintScheduleLinear releases the first hint at once and then one every interval.
+	HintScheduleLinear HintSchedule = iota + 1
+	// HintScheduleCustom releases each hint at its own offset from the start of the turn.
+	HintScheduleCustom
+)
+
+const (
+	// DefaultTurnSeconds is the length of the hunters' turn when none is set.
+	DefaultTurnSeconds = 60
+	// MinTurnSeconds is the shortest hunters' turn.
+	MinTurnSeconds = 30
+	// MaxTurnSeconds is the longest hunters' turn, long enough for outdoor games.
+	MaxTurnSeconds = 600
+	// DefaultHintCount is the number of hints in a round when none is set.
+	DefaultHintCount = 5
+	// MinHintCount is the fewest hints in a round.
+	MinHintCount = 1
+	// MaxHintCount is the most hints in a round.
+	MaxHintCount = 10
+	// DefaultHintIntervalSeconds is the time between hints of a linear schedule when none is set.
+	DefaultHintIntervalSeconds = 10
+	// DefaultAttemptLimit is how many photos a hunter may submit in a round when none is set.
+	DefaultAttemptLimit = 1
+	// MaxAttemptLimit is the most photos a hunter may submit in a round.
+	MaxAttemptLimit = 5
+	// MaxSpectatorDelaySeconds is the longest delay of the game state shown to spectators.
+	MaxSpectatorDelaySeconds = 300
+	// DefaultVotingSeconds is the time the audience has to vote when none is set.
+	DefaultVotingSeconds = 60
+	// MinVotingSeconds is the shortest time the audience has to vote.
+	MinVotingSeconds = 15
+	// MaxVotingSeconds is the longest time the audience has to vote.
+	MaxVotingSeconds = 300
+)
+
+var (
+	// ErrInvalidTurnDuration is returned when the hunters' turn is too short or too long.
+	ErrInvalidTurnDuration = errors.New("invalid turn duration: must be between 30 and 600 seconds")
+	// ErrInvalidHintCount is returned when a round would have too few or too many hints.
+	ErrInvalidHintCount = errors.New("invalid hint count: must be between 1 and 10")
+	// ErrInvalidHintSchedule is returned when hints would not all be released during the turn.
+	ErrInvalidHintSchedule = errors.New("invalid hint schedule")
+	// ErrInvalidAttemptLimit is returned when hunters would have too few or too many attempts.
+	ErrInvalidAttemptLimit = errors.New("invalid attempt limit: must be between 1 and 5")
+	// ErrInvalidDistanceScoring is returned when the distance scoring rule is unknown.
+	ErrInvalidDistanceScoring = errors.New("invalid distance scoring")
+	// ErrInvalidSpectatorDelay is returned when the spectator delay is negative or too long.
+	ErrInvalidSpectatorDelay = errors.New(
+		"invalid spectator delay: must be between 0 and 300 seconds",
+	)
+	// ErrInvalidRankingMode is returned when the ranking mode is unknown.
+	ErrInvalidRankingMode = errors.New("invalid ranking mode")
+	// ErrInvalidVotingDuration is returned when the voting time is too short or too long.
+	ErrInvalidVotingDuration = errors.New(
+		"invalid voting duration: must be between 15 and 300 seconds",
+	)
+)
+
+// GameSettings configures the turns of a game.
+type GameSettings struct {
+	// TurnSeconds is the length of the hunters' turn.
+	TurnSeconds int `json:"turnSeconds"`
+	// HintCount is the number of hints in a round.
+	HintCount    int          `json:"hintCount"`
+	HintSchedule HintSchedule `json:"hintSchedule"`
+	// HintIntervalSeconds is the time between hints of a linear schedule.
+	HintIntervalSeconds int `json:"hintIntervalSeconds,omitempty"`
+	// HintOffsetsSeconds are the release times of the hints of a custom schedule,
+	// counted from the start of the turn. The first is always 0.
+	HintOffsetsSeconds []int `json:"hintOffsetsSeconds,omitempty"`
+	// AttemptLimit is how many photos each hunter, or each team in team mode, may submit in a round.
+	// It is zero for games stored before hunters had several attempts.
+	AttemptLimit int `json:"attemptLimit,omitempty"`
+	// DistanceScoring is how the distance of the hunters' photos counts in the ranking.
+	DistanceScoring DistanceScoring `json:"distanceScoring,omitempty"`
+	// SpectatorDelaySeconds is how far behind the game spectators see it, so that they
+	// cannot pass on what they see to the players. Spectators see the game live when it is zero.
+	SpectatorDelaySeconds int `json:"spectatorDelaySeconds,omitempty"`
+	// RankingMode is who ranks the hunters' photos.
+	RankingMode RankingMode `json:"rankingMode,omitempty"`
+	// VotingSeconds is the time the audience has to vote under RankingAudience.
+	// It is zero for games stored before the audience could vote.
+	VotingSeconds int `json:"votingSeconds,omitempty"`
+}
+
+// DefaultGameSettings returns a 60-second turn with 5 hints released every 10 seconds
+// and one photo for each hunter.
+func DefaultGameSettings() GameSettings {
+	return GameSettings{
+		TurnSeconds:           DefaultTurnSeconds,
+		HintCount:             DefaultHintCount,
+		HintSchedule:          HintScheduleLinear,
+		HintIntervalSeconds:   DefaultHintIntervalSeconds,
+		HintOffsetsSeconds:    nil,
+		AttemptLimit:          DefaultAttemptLimit,
+		DistanceScoring:       DistanceScoringOff,
+		SpectatorDelaySeconds: 0,
+		RankingMode:           RankingGameMaster,
+		VotingSeconds:         DefaultVotingSeconds,
+	}
+}
+
+// NewGameSettings validates the requested game settings and fills in the defaults.
+// Hints are released at HintOffsetsSeconds if it is not empty, otherwise every HintIntervalSeconds,
+// so the requested HintSchedule is ignored. A zero turn, hint count, interval, attempt limit or
+// voting time uses the default, and a custom schedule without a hint count has one hint for each offset.
+func NewGameSettings(requested GameSettings) (GameSettings, error) {
+	defaults := DefaultGameSettings()
+	settings := GameSettings{
+		TurnSeconds:           cmp.Or(requested.TurnSeconds, defaults.TurnSeconds),
+		HintCount:             cmp.Or(requested.HintCount, defaults.HintCount),
+		HintSchedule:          HintScheduleLinear,
+		HintIntervalSeconds:   cmp.Or(requested.HintIntervalSeconds, defaults.HintIntervalSeconds),
+		HintOffsetsSeconds:    nil,
+		AttemptLimit:          cmp.Or(requested.AttemptLimit, defaults.AttemptLimit),
+		DistanceScoring:       requested.DistanceScoring,
+		SpectatorDelaySeconds: requested.SpectatorDelaySeconds,
+		RankingMode:           requested.RankingMode,
+		VotingSeconds:         cmp.Or(requested.VotingSeconds, defaults.VotingSeconds),
+	}
+
+	if len(requested.HintOffsetsSeconds) > 0 {
+		settings.HintSchedule = HintScheduleCustom
+		settings.HintCount = cmp.Or(requested.HintCount, len(requested.HintOffsetsSeconds))
+		settings.HintIntervalSeconds = 0
+		settings.HintOffsetsSeconds = slices.Clone(requested.HintOffsetsSeconds)
+	}
+
+	err := settings.validate()
+	if err != nil {
+		return GameSettings{}, err
+	}
+
+	return settings, nil
+}
+
+// OrDefault returns the default settings for games stored before games had settings,
+// and fills in the settings added since the game was stored.
+func (s GameSettings) OrDefault() GameSettings {
+	if s.TurnSeconds == 0 {
+		return DefaultGameSettings()
+	}
+
+	s.AttemptLimit = cmp.Or(s.AttemptLimit, DefaultAttemptLimit)
+	s.VotingSeconds = cmp.Or(s.VotingSeconds, DefaultVotingSeconds)
+
+	return s
+}
+
+// HintReleaseSeconds returns when hint hintNumber is released, counted from the start of the turn.
+func (s GameSettings) HintReleaseSeconds(hintNumber int) int {
+	if s.HintSchedule == HintScheduleCustom {
+		return s.HintOffsetsSeconds[hintNumber-1]
+	}
+
+	return (hintNumber - 1) * s.HintIntervalSeconds
+}
+
+// ReleasedHintCount returns how many hints have been released after elapsedSeconds of the turn.
+func (s GameSettings) ReleasedHintCount(elapsedSeconds int) int {
+	released := 0
+
+	for hintNumber := 1; hintNumber <= s.HintCount; hintNumber++ {
+		if s.HintReleaseSeconds(hintNumber) > elapsedSeconds {
+			break
+		}
+
+		released++
+	}
+
+	return released
+}
+
+// validate checks that the turn, hint count, attempt limit, distance scoring, spectator delay
+// and voting are valid and that every hint is released in order during the turn.
+func (s GameSettings) validate() error {
+	if s.TurnSeconds < MinTurnSeconds || s.TurnSeconds > MaxTurnSeconds {
+		return ErrInvalidTurnDuration
+	}
+
+	if s.HintCount < MinHintCount || s.HintCount > MaxHintCount {
+		return ErrInvalidHintCount
+	}
+
+	if s.AttemptLimit < 1 || s.AttemptLimit > MaxAttemptLimit {
+		return ErrInvalidAttemptLimit
+	}
+
+	if s.DistanceScoring < DistanceScoringOff || s.DistanceScoring > DistanceScoringPoints {
+		return ErrInvalidDistanceScoring
+	}
+
+	if s.SpectatorDelaySeconds < 0 || s.SpectatorDelaySeconds > MaxSpectatorDelaySeconds {
+		return ErrInvalidSpectatorDelay
+	}
+
+	if s.RankingMode < RankingGameMaster || s.RankingMode > RankingAudience {
+		return ErrInvalidRankingMode
+	}
+
+	if s.VotingSeconds < MinVotingSeconds || s.VotingSeconds > MaxVotingSeconds {
+		return ErrInvalidVotingDuration
+	}
+
+	switch s.HintSchedule {
+	case HintScheduleLinear:
+		if s.HintIntervalSeconds < 1 {
+			return errors.Errorf("%w: interval must be positive", ErrInvalidHintSchedule)
+		}
+	case HintScheduleCustom:
+		if len(s.HintOffsetsSeconds) != s.HintCount {
+			return errors.Errorf(
+				"%w: %d offsets for %d hints",
+				ErrInvalidHintSchedule,
+				len(s.HintOffsetsSeconds),
+				s.HintCount,
+			)
+		}
+
+		if s.HintOffsetsSeconds[0] != 0 {
+			return errors.Errorf("%w: first hint must be released at 0", ErrInvalidHintSchedule)
+		}
+
+		for index := 1; index < len(s.HintOffsetsSeconds); index++ {
+			if s.HintOffsetsSeconds[index] <= s.HintOffsetsSeconds[index-1] {
+				return errors.Errorf("%w: offsets must increase", ErrInvalidHintSchedule)
+			}
+		}
+	default:
+		return errors.Errorf("%w: unknown schedule %d", ErrInvalidHintSchedule, s.HintSchedule)
+	}
+
+	if s.HintReleaseSeconds(s.HintCount) >= s.TurnSeconds {
+		return errors.Errorf(
+			"%w: last hint must be released before the turn ends",
+			ErrInvalidHintSchedule,
+		)
+	}
+
+	return nil
+}
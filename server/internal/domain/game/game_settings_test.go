@@ -0,0 +1,253 @@
+package game_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+func TestNewGameSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		turnSeconds  int
+		hintCount    int
+		interval     int
+		offsets      []int
+		wantSchedule game.HintSchedule
+		wantCount    int
+		wantErr      error
+	}{
+		"defaults":           {0, 0, 0, nil, game.HintScheduleLinear, game.DefaultHintCount, nil},
+		"long linear":        {300, 10, 25, nil, game.HintScheduleLinear, 10, nil},
+		"one hint":           {30, 1, 0, nil, game.HintScheduleLinear, 1, nil},
+		"custom":             {120, 0, 0, []int{0, 15, 60}, game.HintScheduleCustom, 3, nil},
+		"custom with count":  {120, 3, 0, []int{0, 15, 60}, game.HintScheduleCustom, 3, nil},
+		"short turn":         {29, 0, 0, nil, 0, 0, game.ErrInvalidTurnDuration},
+		"long turn":          {601, 0, 0, nil, 0, 0, game.ErrInvalidTurnDuration},
+		"too many hints":     {0, 11, 1, nil, 0, 0, game.ErrInvalidHintCount},
+		"negative count":     {0, -1, 0, nil, 0, 0, game.ErrInvalidHintCount},
+		"negative interval":  {0, 0, -10, nil, 0, 0, game.ErrInvalidHintSchedule},
+		"linear after turn":  {60, 5, 15, nil, 0, 0, game.ErrInvalidHintSchedule},
+		"offsets for count":  {120, 4, 0, []int{0, 15, 60}, 0, 0, game.ErrInvalidHintSchedule},
+		"late first offset":  {120, 0, 0, []int{5, 15}, 0, 0, game.ErrInvalidHintSchedule},
+		"unordered offsets":  {120, 0, 0, []int{0, 30, 30}, 0, 0, game.ErrInvalidHintSchedule},
+		"offset at turn end": {60, 0, 0, []int{0, 60}, 0, 0, game.ErrInvalidHintSchedule},
+		"too many offsets": {
+			600,
+			0,
+			0,
+			[]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			0,
+			0,
+			game.ErrInvalidHintCount,
+		},
+		"interval and custom": {120, 0, 20, []int{0, 90}, game.HintScheduleCustom, 2, nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings, err := game.NewGameSettings(game.GameSettings{
+				TurnSeconds:         testCase.turnSeconds,
+				HintCount:           testCase.hintCount,
+				HintIntervalSeconds: testCase.interval,
+				HintOffsetsSeconds:  testCase.offsets,
+			})
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewGameSettings() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			if settings.HintSchedule != testCase.wantSchedule ||
+				settings.HintCount != testCase.wantCount {
+				t.Errorf("NewGameSettings() = %v with %d hints, want %v with %d hints",
+					settings.HintSchedule, settings.HintCount,
+					testCase.wantSchedule, testCase.wantCount)
+			}
+		})
+	}
+}
+
+func TestNewGameSettings_AttemptLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		attemptLimit int
+		want         int
+		wantErr      error
+	}{
+		"default":  {0, game.DefaultAttemptLimit, nil},
+		"several":  {3, 3, nil},
+		"max":      {game.MaxAttemptLimit, game.MaxAttemptLimit, nil},
+		"too many": {game.MaxAttemptLimit + 1, 0, game.ErrInvalidAttemptLimit},
+		"negative": {-1, 0, game.ErrInvalidAttemptLimit},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings, err := game.NewGameSettings(game.GameSettings{
+				AttemptLimit: testCase.attemptLimit,
+			})
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewGameSettings() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if settings.AttemptLimit != testCase.want {
+				t.Errorf("NewGameSettings() attempt limit = %d, want %d",
+					settings.AttemptLimit, testCase.want)
+			}
+		})
+	}
+
+	// 試行回数がない頃に保存されたゲームは1回
+	stored := game.DefaultGameSettings()
+	stored.AttemptLimit = 0
+
+	if limit := stored.OrDefault().AttemptLimit; limit != game.DefaultAttemptLimit {
+		t.Errorf("OrDefault() attempt limit = %d, want %d", limit, game.DefaultAttemptLimit)
+	}
+}
+
+func TestNewGameSettings_SpectatorDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		delaySeconds int
+		wantErr      error
+	}{
+		"live":     {0, nil},
+		"delayed":  {30, nil},
+		"max":      {game.MaxSpectatorDelaySeconds, nil},
+		"too long": {game.MaxSpectatorDelaySeconds + 1, game.ErrInvalidSpectatorDelay},
+		"negative": {-1, game.ErrInvalidSpectatorDelay},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings, err := game.NewGameSettings(game.GameSettings{
+				SpectatorDelaySeconds: testCase.delaySeconds,
+			})
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewGameSettings() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil && settings.SpectatorDelaySeconds != testCase.delaySeconds {
+				t.Errorf("NewGameSettings() spectator delay = %d, want %d",
+					settings.SpectatorDelaySeconds, testCase.delaySeconds)
+			}
+		})
+	}
+}
+
+func TestNewGameSettings_Voting(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		rankingMode   game.RankingMode
+		votingSeconds int
+		want          int
+		wantErr       error
+	}{
+		"game master": {game.RankingGameMaster, 0, game.DefaultVotingSeconds, nil},
+		"audience":    {game.RankingAudience, 0, game.DefaultVotingSeconds, nil},
+		"custom time": {game.RankingAudience, 120, 120, nil},
+		"min":         {game.RankingAudience, game.MinVotingSeconds, game.MinVotingSeconds, nil},
+		"too short": {
+			game.RankingAudience,
+			game.MinVotingSeconds - 1,
+			0,
+			game.ErrInvalidVotingDuration,
+		},
+		"too long": {
+			game.RankingAudience,
+			game.MaxVotingSeconds + 1,
+			0,
+			game.ErrInvalidVotingDuration,
+		},
+		"unknown mode": {game.RankingAudience + 1, 0, 0, game.ErrInvalidRankingMode},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings, err := game.NewGameSettings(game.GameSettings{
+				RankingMode:   testCase.rankingMode,
+				VotingSeconds: testCase.votingSeconds,
+			})
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewGameSettings() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil && settings.VotingSeconds != testCase.want {
+				t.Errorf("NewGameSettings() voting seconds = %d, want %d",
+					settings.VotingSeconds, testCase.want)
+			}
+		})
+	}
+
+	// 投票がない頃に保存されたゲームは既定の投票時間
+	stored := game.DefaultGameSettings()
+	stored.VotingSeconds = 0
+
+	if seconds := stored.OrDefault().VotingSeconds; seconds != game.DefaultVotingSeconds {
+		t.Errorf("OrDefault() voting seconds = %d, want %d", seconds, game.DefaultVotingSeconds)
+	}
+}
+
+func TestGameSettings_ReleasedHintCount(t *testing.T) {
+	t.Parallel()
+
+	custom, err := game.NewGameSettings(game.GameSettings{
+		TurnSeconds:        120,
+		HintOffsetsSeconds: []int{0, 15, 60},
+	})
+	if err != nil {
+		t.Fatalf("NewGameSettings() failed: %v", err)
+	}
+
+	tests := map[string]struct {
+		settings       game.GameSettings
+		elapsedSeconds []int
+		want           []int
+	}{
+		"default": {
+			game.DefaultGameSettings(),
+			[]int{0, 9, 10, 40, 60},
+			[]int{1, 1, 2, 5, 5},
+		},
+		"custom":          {custom, []int{0, 14, 15, 59, 60, 120}, []int{1, 1, 2, 2, 3, 3}},
+		"stored settings": {game.GameSettings{}.OrDefault(), []int{0, 25}, []int{1, 3}},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := make([]int, 0, len(testCase.elapsedSeconds))
+			for _, elapsed := range testCase.elapsedSeconds {
+				got = append(got, testCase.settings.ReleasedHintCount(elapsed))
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf(
+					"ReleasedHintCount(%v) = %v, want %v",
+					testCase.elapsedSeconds,
+					got,
+					testCase.want,
+				)
+			}
+		})
+	}
+}
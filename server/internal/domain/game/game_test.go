@@ -0,0 +1,105 @@
+package game_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+)
+
+func TestGame_AnonymizePlayer(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+	hunterID := gameSession.Players[1].UserID
+
+	err := round.AddHunterSubmission(
+		&game.HunterSubmission{UserID: hunterID, ImageID: uuid.NewString()},
+		1,
+	)
+	if err != nil {
+		t.Fatalf("AddHunterSubmission() failed: %v", err)
+	}
+
+	rankFirst(t, round, hunterID)
+
+	anonymousID, err := gameSession.AnonymizePlayer(hunterID)
+	if err != nil {
+		t.Fatalf("AnonymizePlayer() failed: %v", err)
+	}
+
+	_, err = gameSession.GetPlayer(hunterID)
+	if err == nil {
+		t.Error("GetPlayer() found the deleted user after AnonymizePlayer()")
+	}
+
+	player := gameSession.Players[1]
+	if player.UserID != anonymousID {
+		t.Errorf("anonymized player ID = %v, want the returned ID %v", player.UserID, anonymousID)
+	}
+
+	if player.Name != game.AnonymizedPlayerName || !player.Anonymized || player.IsConnected {
+		t.Errorf("anonymized player = %+v, want an anonymized and disconnected placeholder", player)
+	}
+
+	// 他のプレイヤーの履歴を保つため、提出と結果は匿名のIDで残る
+	if round.HunterSubmissions[0].UserID != player.UserID ||
+		round.Results[0].UserID != player.UserID {
+		t.Errorf("round references %v and %v, want the anonymous ID %v",
+			round.HunterSubmissions[0].UserID, round.Results[0].UserID, player.UserID)
+	}
+
+	if len(gameSession.ImageIDsByPlayer(hunterID)) != 0 {
+		t.Error("ImageIDsByPlayer() of the deleted user is not empty after AnonymizePlayer()")
+	}
+}
+
+func TestGame_AnonymizePlayer_NotInGame(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, game.MinPlayers)
+
+	anonymousID, err := gameSession.AnonymizePlayer(uuid.New())
+	if err != nil || anonymousID != uuid.Nil {
+		t.Errorf("AnonymizePlayer() = %v, %v, want uuid.Nil, nil", anonymousID, err)
+	}
+}
+
+func TestGame_ImageIDsByPlayer(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+	gameMasterID := round.GameMasterUserID
+	hunterID := gameSession.Players[1].UserID
+	hunterImageID := uuid.NewString()
+
+	err := round.AddHunterSubmission(
+		&game.HunterSubmission{UserID: hunterID, ImageID: hunterImageID},
+		1,
+	)
+	if err != nil {
+		t.Fatalf("AddHunterSubmission() failed: %v", err)
+	}
+
+	tests := map[string]struct {
+		userID uuid.UUID
+		want   []string
+	}{
+		"game master's photo": {gameMasterID, []string{round.GameMasterImageID}},
+		"hunter's photo":      {hunterID, []string{hunterImageID}},
+		"no photos":           {gameSession.Players[2].UserID, []string{}},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := gameSession.ImageIDsByPlayer(testCase.userID)
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("ImageIDsByPlayer() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
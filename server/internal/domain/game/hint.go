@@ -2,8 +2,12 @@ package game
 
 import "github.com/yashikota/scene-hunter/server/internal/util/errors"
 
-// ErrInvalidHintNumber is returned when a hint number is invalid.
-var ErrInvalidHintNumber = errors.New("invalid hint number: must be between 1 and 5")
+var (
+	// ErrInvalidHintNumber is returned when a hint number is invalid.
+	ErrInvalidHintNumber = errors.New("invalid hint number: must be between 1 and the hint count")
+	// ErrEmptyHintText is returned when a hint has no text.
+	ErrEmptyHintText = errors.New("hint text is empty")
+)
 
 // Hint represents a single hint for hunters.
 type Hint struct {
@@ -11,9 +15,9 @@ type Hint struct {
 	Text       string `json:"text"`
 }
 
-// NewHint creates a new Hint.
-func NewHint(hintNumber int, text string) (*Hint, error) {
-	if hintNumber < 1 || hintNumber > 5 {
+// NewHint creates hint hintNumber of a round with hintCount hints.
+func NewHint(hintNumber, hintCount int, text string) (*Hint, error) {
+	if hintNumber < 1 || hintNumber > hintCount {
 		return nil, ErrInvalidHintNumber
 	}
 
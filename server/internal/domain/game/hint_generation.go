@@ -0,0 +1,134 @@
+package game
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrNotGeneratingHints is returned when hint generation is updated after it has ended.
+var ErrNotGeneratingHints = errors.New("hints are not being generated")
+
+// HintJob is a request to generate the hints of a round in the background.
+type HintJob struct {
+	// ID identifies the job in the queue. It is set when the job is received.
+	ID          string    `json:"-"`
+	RoomID      uuid.UUID `json:"roomId"`
+	RoundNumber int       `json:"roundNumber"`
+	// ImageID is the game master's photo the hints are written for.
+	// A job for an older photo is dropped when the game master submits another one.
+	ImageID   string `json:"imageId"`
+	ImagePath string `json:"imagePath"`
+	// Attempt is 1 for the first run and grows each time the job is retried.
+	Attempt int `json:"attempt"`
+	// NotBefore is when a retried job may run, so that a failing AI is not asked again at once.
+	NotBefore time.Time `json:"notBefore,omitzero"`
+	// Deliveries is how many times the queue has handed out the job, counting this time.
+	// It is set when the job is received.
+	Deliveries int `json:"-"`
+}
+
+// HintGeneration is the progress of the background job that generates the hints of a round.
+type HintGeneration struct {
+	// Generated is how many of the hints of the round have been written in the current attempt.
+	Generated int `json:"generated"`
+	// Attempts is how many times the job has started.
+	Attempts int `json:"attempts"`
+	// Errors are the failures of the attempts, oldest first.
+	Errors []*HintGenerationError `json:"errors"`
+}
+
+// HintGenerationError records a failed attempt to generate hints.
+type HintGenerationError struct {
+	Attempt  int       `json:"attempt"`
+	Message  string    `json:"message"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// StartHintGeneration sets the game master's photo and waits for its hints
+// to be generated in the background. Earlier hints and progress are discarded.
+func (r *Round) StartHintGeneration(imageID string) error {
+	if imageID == "" {
+		return ErrGameMasterImageNotSet
+	}
+
+	err := r.apply(RoundActionSubmitPhoto)
+	if err != nil {
+		return err
+	}
+
+	r.GameMasterImageID = imageID
+	r.Hints = make([]*Hint, 0)
+	r.HintGeneration = &HintGeneration{
+		Generated: 0,
+		Attempts:  0,
+		Errors:    make([]*HintGenerationError, 0),
+	}
+	r.emit(EventGameMasterPhotoSubmitted, r.GameMasterUserID, photoPayload(imageID, 0, 0, false))
+
+	return nil
+}
+
+// IsGeneratingHintsFor reports whether the round waits for the hints of the photo imageID.
+func (r *Round) IsGeneratingHintsFor(imageID string) bool {
+	return r.TurnStatus == TurnStatusGeneratingHints && r.GameMasterImageID == imageID
+}
+
+// StartHintAttempt records that an attempt to generate the hints has started.
+func (r *Round) StartHintAttempt(attempt int) error {
+	if r.TurnStatus != TurnStatusGeneratingHints {
+		return ErrNotGeneratingHints
+	}
+
+	r.HintGeneration.Attempts = attempt
+	r.HintGeneration.Generated = 0
+	r.emit(EventHintAttemptStarted, uuid.Nil, r.hintAttemptPayload(""))
+
+	return nil
+}
+
+// UpdateHintProgress records how many of hintCount hints have been written in the current attempt.
+func (r *Round) UpdateHintProgress(generated, hintCount int) error {
+	if r.TurnStatus != TurnStatusGeneratingHints {
+		return ErrNotGeneratingHints
+	}
+
+	r.HintGeneration.Generated = min(generated, hintCount)
+	r.emit(EventHintsProgressed, uuid.Nil, r.hintAttemptPayload(""))
+
+	return nil
+}
+
+// RecordHintError records a failed attempt to generate the hints.
+func (r *Round) RecordHintError(attempt int, message string) error {
+	if r.TurnStatus != TurnStatusGeneratingHints {
+		return ErrNotGeneratingHints
+	}
+
+	r.HintGeneration.Errors = append(r.HintGeneration.Errors, &HintGenerationError{
+		Attempt:  attempt,
+		Message:  message,
+		FailedAt: time.Now(),
+	})
+	r.emit(EventHintAttemptFailed, uuid.Nil, r.hintAttemptPayload(message))
+
+	return nil
+}
+
+// FailHintGeneration gives up generating the hints and lets the game master submit a photo again,
+// or pick another scene in a scene pool game. The error records are kept so that the game master can see why.
+func (r *Round) FailHintGeneration() error {
+	if r.TurnStatus != TurnStatusGeneratingHints {
+		return ErrNotGeneratingHints
+	}
+
+	err := r.apply(RoundActionFailHints)
+	if err != nil {
+		return err
+	}
+
+	r.emit(EventHintGenerationFailed, uuid.Nil, EventPayload{})
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package game_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newGeneratingRound returns a round waiting for the hints of the photo imageID.
+func newGeneratingRound(t *testing.T, imageID string) *game.Round {
+	t.Helper()
+
+	round, err := game.NewRound(1, uuid.New())
+	if err != nil {
+		t.Fatalf("NewRound() failed: %v", err)
+	}
+
+	err = round.StartHintGeneration(imageID)
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	return round
+}
+
+func TestRound_StartHintGeneration(t *testing.T) {
+	t.Parallel()
+
+	round := newReviewRound(t)
+	imageID := uuid.NewString()
+
+	err := round.StartHintGeneration(imageID)
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	if round.TurnStatus != game.TurnStatusGeneratingHints {
+		t.Errorf(
+			"StartHintGeneration() turn status = %v, want %v",
+			round.TurnStatus,
+			game.TurnStatusGeneratingHints,
+		)
+	}
+
+	// 前の写真のヒントは捨てる
+	if len(round.Hints) != 0 {
+		t.Errorf("StartHintGeneration() hints = %v, want none", hintTexts(t, round.Hints))
+	}
+
+	if !round.IsGeneratingHintsFor(imageID) {
+		t.Errorf("IsGeneratingHintsFor(%q) = false, want true", imageID)
+	}
+
+	if round.IsGeneratingHintsFor(uuid.NewString()) {
+		t.Error("IsGeneratingHintsFor() another photo = true, want false")
+	}
+
+	if len(round.ReleasedHints()) != 0 {
+		t.Errorf(
+			"ReleasedHints() while generating = %d hints, want none",
+			len(round.ReleasedHints()),
+		)
+	}
+
+	err = round.StartHintGeneration("")
+	if !errors.Is(err, game.ErrGameMasterImageNotSet) {
+		t.Errorf("StartHintGeneration() without photo error = %v, want %v",
+			err, game.ErrGameMasterImageNotSet)
+	}
+}
+
+func TestRound_HintGenerationProgress(t *testing.T) {
+	t.Parallel()
+
+	round := newGeneratingRound(t, uuid.NewString())
+
+	err := round.StartHintAttempt(1)
+	if err != nil {
+		t.Fatalf("StartHintAttempt() failed: %v", err)
+	}
+
+	err = round.UpdateHintProgress(3, game.DefaultHintCount)
+	if err != nil {
+		t.Fatalf("UpdateHintProgress() failed: %v", err)
+	}
+
+	err = round.RecordHintError(1, "AI service timed out")
+	if err != nil {
+		t.Fatalf("RecordHintError() failed: %v", err)
+	}
+
+	// 次の試行では数え直す
+	err = round.StartHintAttempt(2)
+	if err != nil {
+		t.Fatalf("StartHintAttempt() failed: %v", err)
+	}
+
+	if round.HintGeneration.Attempts != 2 || round.HintGeneration.Generated != 0 {
+		t.Errorf(
+			"StartHintAttempt() attempts = %d, generated = %d, want 2, 0",
+			round.HintGeneration.Attempts,
+			round.HintGeneration.Generated,
+		)
+	}
+
+	err = round.UpdateHintProgress(game.DefaultHintCount+1, game.DefaultHintCount)
+	if err != nil {
+		t.Fatalf("UpdateHintProgress() failed: %v", err)
+	}
+
+	if round.HintGeneration.Generated != game.DefaultHintCount {
+		t.Errorf("UpdateHintProgress() generated = %d, want %d",
+			round.HintGeneration.Generated, game.DefaultHintCount)
+	}
+
+	if len(round.HintGeneration.Errors) != 1 || round.HintGeneration.Errors[0].Attempt != 1 {
+		t.Fatalf(
+			"RecordHintError() errors = %v, want one for attempt 1",
+			round.HintGeneration.Errors,
+		)
+	}
+
+	if round.HintGeneration.Errors[0].FailedAt.IsZero() {
+		t.Error("RecordHintError() failed at is zero")
+	}
+}
+
+func TestRound_FinishHintGeneration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		finish     func(round *game.Round) error
+		wantStatus game.TurnStatus
+	}{
+		"hints generated": {
+			func(round *game.Round) error {
+				return round.StartHintReview([]*game.Hint{{HintNumber: 1, Text: "1"}})
+			},
+			game.TurnStatusReviewingHints,
+		},
+		"given up": {
+			func(round *game.Round) error {
+				return round.FailHintGeneration()
+			},
+			game.TurnStatusGameMaster,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newGeneratingRound(t, uuid.NewString())
+
+			err := round.RecordHintError(1, "hint generation failed")
+			if err != nil {
+				t.Fatalf("RecordHintError() failed: %v", err)
+			}
+
+			err = testCase.finish(round)
+			if err != nil {
+				t.Fatalf("finish failed: %v", err)
+			}
+
+			if round.TurnStatus != testCase.wantStatus {
+				t.Errorf("turn status = %v, want %v", round.TurnStatus, testCase.wantStatus)
+			}
+
+			// 失敗の記録は残す
+			if len(round.HintGeneration.Errors) != 1 {
+				t.Errorf("errors = %d, want 1", len(round.HintGeneration.Errors))
+			}
+
+			// 終わった後の更新は受け付けない
+			for name, err := range map[string]error{
+				"StartHintAttempt":   round.StartHintAttempt(2),
+				"UpdateHintProgress": round.UpdateHintProgress(1, game.DefaultHintCount),
+				"RecordHintError":    round.RecordHintError(2, "hint generation failed"),
+				"FailHintGeneration": round.FailHintGeneration(),
+			} {
+				if !errors.Is(err, game.ErrNotGeneratingHints) {
+					t.Errorf("%s() after finish error = %v, want %v",
+						name, err, game.ErrNotGeneratingHints)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package game
+
+import "github.com/yashikota/scene-hunter/server/internal/util/errors"
+
+// Language is the language hints are written in.
+type Language string
+
+const (
+	// LanguageJapanese writes hints in Japanese.
+	LanguageJapanese Language = "ja"
+	// LanguageEnglish writes hints in English.
+	LanguageEnglish Language = "en"
+	// DefaultLanguage is used when no language is set.
+	DefaultLanguage = LanguageJapanese
+)
+
+// Difficulty controls how specific the hints of a round are.
+type Difficulty int
+
+const (
+	// DifficultyEasy makes the last hints nearly identify the place.
+	DifficultyEasy Difficulty = iota + 1
+	// DifficultyNormal describes features that can be checked while searching.
+	DifficultyNormal
+	// DifficultyHard avoids text and names that identify the place.
+	DifficultyHard
+	// DefaultDifficulty is used when no difficulty is set.
+	DefaultDifficulty = DifficultyNormal
+)
+
+var (
+	// ErrUnsupportedLanguage is returned when hints cannot be written in a language.
+	ErrUnsupportedLanguage = errors.New("unsupported hint language")
+	// ErrInvalidDifficulty is returned when a difficulty is unknown.
+	ErrInvalidDifficulty = errors.New("invalid hint difficulty")
+)
+
+// HintSettings configures how the hints of a game are generated.
+type HintSettings struct {
+	Language   Language   `json:"language"`
+	Difficulty Difficulty `json:"difficulty"`
+}
+
+// NewHintSettings creates hint settings.
+// An empty language and a zero difficulty use the defaults.
+func NewHintSettings(language string, difficulty Difficulty) (HintSettings, error) {
+	settings := HintSettings{
+		Language:   Language(language),
+		Difficulty: difficulty,
+	}.OrDefault()
+
+	switch settings.Language {
+	case LanguageJapanese, LanguageEnglish:
+	default:
+		return HintSettings{}, errors.Errorf("%w: %s", ErrUnsupportedLanguage, language)
+	}
+
+	switch settings.Difficulty {
+	case DifficultyEasy, DifficultyNormal, DifficultyHard:
+	default:
+		return HintSettings{}, errors.Errorf("%w: %d", ErrInvalidDifficulty, difficulty)
+	}
+
+	return settings, nil
+}
+
+// OrDefault fills unset settings with the defaults,
+// for example for games stored before hints had settings.
+func (s HintSettings) OrDefault() HintSettings {
+	if s.Language == "" {
+		s.Language = DefaultLanguage
+	}
+
+	if s.Difficulty == 0 {
+		s.Difficulty = DefaultDifficulty
+	}
+
+	return s
+}
@@ -7,7 +7,7 @@ import (
 
 // ErrInvalidSubmittedAtSeconds is returned when submitted_at_seconds is invalid.
 var ErrInvalidSubmittedAtSeconds = errors.New(
-	"invalid submitted_at_seconds: must be between 0 and 60",
+	"invalid submitted_at_seconds: must be within the turn",
 )
 
 // HunterSubmission represents a hunter's photo submission.
@@ -15,15 +15,25 @@ type HunterSubmission struct {
 	UserID             uuid.UUID `json:"userId"`
 	ImageID            string    `json:"imageId"`
 	SubmittedAtSeconds int       `json:"submittedAtSeconds"`
+	// TeamID is the team the photo was submitted for in team mode.
+	TeamID uuid.UUID `json:"teamId,omitzero"`
+	// Attempt numbers the photos of a hunter, or of a team in team mode, from 1.
+	// It is zero for photos stored before hunters had several attempts.
+	Attempt int `json:"attempt,omitempty"`
+	// Final is true for the photo the hunter picked to be ranked.
+	Final bool `json:"final,omitempty"`
+	// Location is where the photo was taken, if known. It is never shown to other players.
+	Location *Location `json:"location,omitempty"`
 }
 
-// NewHunterSubmission creates a new HunterSubmission.
+// NewHunterSubmission creates a new HunterSubmission
+// submitted submittedAtSeconds into a turn of turnSeconds.
 func NewHunterSubmission(
 	userID uuid.UUID,
 	imageID string,
-	submittedAtSeconds int,
+	submittedAtSeconds, turnSeconds int,
 ) (*HunterSubmission, error) {
-	if submittedAtSeconds < 0 || submittedAtSeconds > 60 {
+	if submittedAtSeconds < 0 || submittedAtSeconds > turnSeconds {
 		return nil, ErrInvalidSubmittedAtSeconds
 	}
 
@@ -35,5 +45,24 @@ func NewHunterSubmission(
 		UserID:             userID,
 		ImageID:            imageID,
 		SubmittedAtSeconds: submittedAtSeconds,
+		TeamID:             uuid.Nil,
+		Attempt:            0,
+		Final:              false,
+		Location:           nil,
 	}, nil
 }
+
+// hunterID returns the team the photo was submitted for in team mode, otherwise its hunter.
+func (s *HunterSubmission) hunterID() uuid.UUID {
+	if s.TeamID != uuid.Nil {
+		return s.TeamID
+	}
+
+	return s.UserID
+}
+
+// isFinal reports whether the photo is ranked.
+// Photos stored before hunters had several attempts were the only attempt.
+func (s *HunterSubmission) isFinal() bool {
+	return s.Final || s.Attempt == 0
+}
@@ -0,0 +1,132 @@
+package game
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// Proximity tells a hunter whether they moved closer to where the game master's photo was taken.
+type Proximity int
+
+const (
+	// ProximityUnknown is given for the first distance of a hunter in a round,
+	// or when a location is missing.
+	ProximityUnknown Proximity = iota
+	// ProximityWarmer is given when the hunter is closer than at their previous check.
+	ProximityWarmer
+	// ProximityColder is given when the hunter is farther than at their previous check.
+	ProximityColder
+	// ProximitySame is given when the hunter moved less than proximityMarginMeters.
+	ProximitySame
+)
+
+const (
+	// earthRadiusMeters is the mean radius of the earth used for distances.
+	earthRadiusMeters = 6_371_000
+	// proximityMarginMeters is the change in distance treated as not moving,
+	// so that GPS noise does not flip between warmer and colder.
+	proximityMarginMeters = 10
+)
+
+var (
+	// ErrInvalidLocation is returned when a latitude or longitude is out of range.
+	ErrInvalidLocation = errors.New(
+		"invalid location: latitude must be between -90 and 90 and longitude between -180 and 180",
+	)
+	// ErrNoGameMasterLocation is returned when the location of the game master's photo is unknown.
+	ErrNoGameMasterLocation = errors.New("location of the game master's photo is unknown")
+)
+
+// Location is where a photo was taken, in degrees.
+// Locations are never shown to other players.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// NewLocation creates a new Location.
+func NewLocation(latitude, longitude float64) (*Location, error) {
+	if math.IsNaN(latitude) || math.IsNaN(longitude) ||
+		latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return nil, ErrInvalidLocation
+	}
+
+	return &Location{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// DistanceMeters returns the great-circle distance to other by the haversine formula.
+func (l *Location) DistanceMeters(other *Location) float64 {
+	lat1 := l.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	deltaLat := lat2 - lat1
+	deltaLng := (other.Longitude - l.Longitude) * math.Pi / 180
+
+	haversine := math.Pow(math.Sin(deltaLat/2), 2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(deltaLng/2), 2)
+
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(min(haversine, 1)))
+}
+
+// SetGameMasterLocation records where the game master's photo was taken.
+// It is nil when the location is unknown.
+func (r *Round) SetGameMasterLocation(location *Location) {
+	r.GameMasterLocation = location
+}
+
+// CheckProximity measures how far a hunter at location is from the game master's photo
+// and tells whether they are warmer or colder than at their previous check in the round.
+func (r *Round) CheckProximity(userID uuid.UUID, location *Location) (Proximity, error) {
+	if r.TurnStatus != TurnStatusHunters {
+		return ProximityUnknown, ErrNotHuntersTurn
+	}
+
+	if r.GameMasterLocation == nil {
+		return ProximityUnknown, ErrNoGameMasterLocation
+	}
+
+	distance := location.DistanceMeters(r.GameMasterLocation)
+
+	previous, checked := r.HunterDistances[userID]
+	if r.HunterDistances == nil {
+		r.HunterDistances = make(map[uuid.UUID]float64)
+	}
+
+	r.HunterDistances[userID] = distance
+
+	proximity := ProximitySame
+
+	switch {
+	case !checked:
+		proximity = ProximityUnknown
+	case distance < previous-proximityMarginMeters:
+		proximity = ProximityWarmer
+	case distance > previous+proximityMarginMeters:
+		proximity = ProximityColder
+	}
+
+	r.emit(
+		EventProximityChecked,
+		userID,
+		EventPayload{Proximity: &ProximityPayload{Proximity: proximity}},
+	)
+
+	return proximity, nil
+}
+
+// FinalDistance returns how far the final photo of a hunter, or of a team in team mode,
+// was taken from the game master's photo. It returns false when either location is unknown.
+func (r *Round) FinalDistance(hunterID uuid.UUID) (float64, bool) {
+	if r.GameMasterLocation == nil {
+		return 0, false
+	}
+
+	for _, submission := range r.FinalSubmissions() {
+		if submission.hunterID() == hunterID && submission.Location != nil {
+			return submission.Location.DistanceMeters(r.GameMasterLocation), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,256 @@
+package game_test
+
+import (
+	"maps"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newLocatedRound returns a round in the hunters' turn whose game master's photo was taken at
+// latitude 35 and longitude 139, and where each hunter submitted a photo taken north of it
+// by the given latitude degrees. Hunters without an offset submitted a photo without a location.
+func newLocatedRound(
+	t *testing.T,
+	offsets map[uuid.UUID]float64,
+	hunters ...uuid.UUID,
+) *game.Round {
+	t.Helper()
+
+	round := newHuntingRound(t)
+	round.SetGameMasterLocation(&game.Location{Latitude: 35, Longitude: 139})
+
+	for _, hunterID := range hunters {
+		submission, err := submitAttempt(t, round, hunterID, 1)
+		if err != nil {
+			t.Fatalf("AddHunterSubmission() failed: %v", err)
+		}
+
+		offset, ok := offsets[hunterID]
+		if ok {
+			submission.Location = &game.Location{Latitude: 35 + offset, Longitude: 139}
+		}
+	}
+
+	return round
+}
+
+func TestNewLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		latitude  float64
+		longitude float64
+		wantErr   error
+	}{
+		"tokyo":          {35.681, 139.767, nil},
+		"corners":        {-90, 180, nil},
+		"north of pole":  {90.1, 0, game.ErrInvalidLocation},
+		"west of range":  {0, -180.1, game.ErrInvalidLocation},
+		"not a number":   {math.NaN(), 0, game.ErrInvalidLocation},
+		"nan longitude":  {0, math.NaN(), game.ErrInvalidLocation},
+		"south of range": {-91, 0, game.ErrInvalidLocation},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := game.NewLocation(testCase.latitude, testCase.longitude)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("NewLocation() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocation_DistanceMeters(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		from *game.Location
+		to   *game.Location
+		want float64
+	}{
+		"same place":       {&game.Location{35, 139}, &game.Location{35, 139}, 0},
+		"one degree north": {&game.Location{0, 0}, &game.Location{1, 0}, 111_195},
+		"one degree east":  {&game.Location{0, 0}, &game.Location{0, 1}, 111_195},
+		"across the date":  {&game.Location{0, 179.5}, &game.Location{0, -179.5}, 111_195},
+		"tokyo to osaka": {
+			&game.Location{35.681, 139.767},
+			&game.Location{34.702, 135.496},
+			403_000,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.from.DistanceMeters(testCase.to)
+			// 1km以内の誤差は許容する
+			if math.Abs(got-testCase.want) > 1000 {
+				t.Errorf("DistanceMeters() = %.0f, want about %.0f", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRound_CheckProximity(t *testing.T) {
+	t.Parallel()
+
+	// 0.001度は約111m
+	tests := map[string]struct {
+		offsets []float64
+		want    []game.Proximity
+	}{
+		"first check": {[]float64{0.01}, []game.Proximity{game.ProximityUnknown}},
+		"warmer": {
+			[]float64{0.01, 0.005},
+			[]game.Proximity{game.ProximityUnknown, game.ProximityWarmer},
+		},
+		"colder": {
+			[]float64{0.005, 0.01},
+			[]game.Proximity{game.ProximityUnknown, game.ProximityColder},
+		},
+		"gps noise": {
+			[]float64{0.005, 0.00505},
+			[]game.Proximity{game.ProximityUnknown, game.ProximitySame},
+		},
+		"back and forth": {
+			[]float64{0.01, 0.005, 0.008},
+			[]game.Proximity{game.ProximityUnknown, game.ProximityWarmer, game.ProximityColder},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newLocatedRound(t, nil)
+			userID := uuid.New()
+
+			for index, offset := range testCase.offsets {
+				got, err := round.CheckProximity(
+					userID,
+					&game.Location{Latitude: 35 + offset, Longitude: 139},
+				)
+				if err != nil {
+					t.Fatalf("CheckProximity() failed: %v", err)
+				}
+
+				if got != testCase.want[index] {
+					t.Errorf(
+						"CheckProximity() #%d = %v, want %v",
+						index+1,
+						got,
+						testCase.want[index],
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestRound_CheckProximity_Errors(t *testing.T) {
+	t.Parallel()
+
+	location := &game.Location{Latitude: 35, Longitude: 139}
+
+	reviewing := newReviewRound(t)
+	reviewing.SetGameMasterLocation(location)
+
+	_, err := reviewing.CheckProximity(uuid.New(), location)
+	if !errors.Is(err, game.ErrNotHuntersTurn) {
+		t.Errorf(
+			"CheckProximity() before the hunt error = %v, want %v",
+			err,
+			game.ErrNotHuntersTurn,
+		)
+	}
+
+	_, err = newHuntingRound(t).CheckProximity(uuid.New(), location)
+	if !errors.Is(err, game.ErrNoGameMasterLocation) {
+		t.Errorf("CheckProximity() without location error = %v, want %v",
+			err, game.ErrNoGameMasterLocation)
+	}
+}
+
+func TestRound_ScoreDistances(t *testing.T) {
+	t.Parallel()
+
+	near, middle, far, unknown := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	// 約11m、約111m、約556m北で撮影された写真
+	offsets := map[uuid.UUID]float64{near: 0.0001, middle: 0.001, far: 0.005}
+	tied := map[uuid.UUID]int{near: 2, middle: 2, far: 2, unknown: 2}
+
+	tests := map[string]struct {
+		rule         game.DistanceScoring
+		rankings     map[uuid.UUID]int
+		wantRankings map[uuid.UUID]int
+		wantPoints   map[uuid.UUID]int
+	}{
+		"off": {game.DistanceScoringOff, tied, tied, map[uuid.UUID]int{}},
+		"tiebreak": {
+			game.DistanceScoringTiebreak,
+			tied,
+			map[uuid.UUID]int{near: 2, middle: 3, far: 4, unknown: 5},
+			map[uuid.UUID]int{},
+		},
+		"tiebreak keeps ranks": {
+			game.DistanceScoringTiebreak,
+			map[uuid.UUID]int{far: 1, near: 2, middle: 3, unknown: 3},
+			map[uuid.UUID]int{far: 1, near: 2, middle: 3, unknown: 4},
+			map[uuid.UUID]int{},
+		},
+		"points": {
+			game.DistanceScoringPoints,
+			tied,
+			tied,
+			map[uuid.UUID]int{
+				near:   game.NearDistancePoints,
+				middle: game.MiddleDistancePoints,
+				far:    game.FarDistancePoints,
+			},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newLocatedRound(t, offsets, near, middle, far, unknown)
+
+			rankings, points := round.ScoreDistances(testCase.rule, testCase.rankings)
+			if !maps.Equal(rankings, testCase.wantRankings) {
+				t.Errorf("ScoreDistances() rankings = %v, want %v", rankings, testCase.wantRankings)
+			}
+
+			if !maps.Equal(points, testCase.wantPoints) {
+				t.Errorf("ScoreDistances() points = %v, want %v", points, testCase.wantPoints)
+			}
+		})
+	}
+}
+
+func TestRound_ScoreDistances_SameDistanceStaysTied(t *testing.T) {
+	t.Parallel()
+
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	offsets := map[uuid.UUID]float64{first: 0.001, second: 0.001, third: 0.002}
+
+	round := newLocatedRound(t, offsets, first, second, third)
+
+	rankings, _ := round.ScoreDistances(
+		game.DistanceScoringTiebreak,
+		map[uuid.UUID]int{first: 1, second: 1, third: 1},
+	)
+
+	want := map[uuid.UUID]int{first: 1, second: 1, third: 3}
+	if !maps.Equal(rankings, want) {
+		t.Errorf("ScoreDistances() rankings = %v, want %v", rankings, want)
+	}
+}
@@ -0,0 +1,231 @@
+package game
+
+import (
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// GameAction is something that moves a game to another status.
+type GameAction int
+
+const (
+	// GameActionStart starts the game with the first round.
+	GameActionStart GameAction = iota + 1
+	// GameActionPause pauses the game.
+	GameActionPause
+	// GameActionResume resumes a paused game.
+	GameActionResume
+	// GameActionFinish ends the game.
+	GameActionFinish
+)
+
+// RoundAction is something that moves a round to another turn status.
+type RoundAction int
+
+const (
+	// RoundActionSubmitPhoto sets the photo of the round, or replaces it, and generates its hints.
+	RoundActionSubmitPhoto RoundAction = iota + 1
+	// RoundActionFinishHints lets the game master review the generated hints.
+	RoundActionFinishHints
+	// RoundActionFailHints gives up generating the hints and lets the game master submit a photo again.
+	RoundActionFailHints
+	// RoundActionReleaseHints shows the hints to the hunters and starts their turn.
+	RoundActionReleaseHints
+	// RoundActionFinishHunt ends the hunters' turn so that the photos can be ranked.
+	RoundActionFinishHunt
+	// RoundActionRank completes the round with the results of the ranking.
+	RoundActionRank
+	// RoundActionSkip completes the round without results.
+	RoundActionSkip
+)
+
+var (
+	// ErrInvalidGameTransition is returned when an action is not allowed in the status of a game.
+	ErrInvalidGameTransition = errors.New("invalid game transition")
+	// ErrInvalidRoundTransition is returned when an action is not allowed in the turn status of a round.
+	ErrInvalidRoundTransition = errors.New("invalid round transition")
+	// ErrRoundNotCompleted is returned when a round starts before the current round is completed.
+	ErrRoundNotCompleted = errors.New("current round is not completed")
+)
+
+// gameTransitions returns the status a game moves to for each action allowed in each status.
+// A finished game cannot change.
+func gameTransitions() map[GameStatus]map[GameAction]GameStatus {
+	return map[GameStatus]map[GameAction]GameStatus{
+		GameStatusWaiting: {
+			GameActionStart: GameStatusInProgress,
+		},
+		GameStatusInProgress: {
+			GameActionPause:  GameStatusPaused,
+			GameActionFinish: GameStatusFinished,
+		},
+		GameStatusPaused: {
+			GameActionResume: GameStatusInProgress,
+			GameActionFinish: GameStatusFinished,
+		},
+		GameStatusFinished: {},
+	}
+}
+
+// roundTransitions returns the turn status a round moves to for each action allowed
+// in each turn status. A completed round cannot change.
+func roundTransitions() map[TurnStatus]map[RoundAction]TurnStatus {
+	return map[TurnStatus]map[RoundAction]TurnStatus{
+		TurnStatusGameMaster: {
+			RoundActionSubmitPhoto: TurnStatusGeneratingHints,
+			RoundActionSkip:        TurnStatusCompleted,
+		},
+		TurnStatusGeneratingHints: {
+			RoundActionSubmitPhoto: TurnStatusGeneratingHints,
+			RoundActionFinishHints: TurnStatusReviewingHints,
+			RoundActionFailHints:   TurnStatusGameMaster,
+			RoundActionSkip:        TurnStatusCompleted,
+		},
+		TurnStatusReviewingHints: {
+			RoundActionSubmitPhoto:  TurnStatusGeneratingHints,
+			RoundActionReleaseHints: TurnStatusHunters,
+			RoundActionSkip:         TurnStatusCompleted,
+		},
+		TurnStatusHunters: {
+			RoundActionFinishHunt: TurnStatusWaitingForSelection,
+			RoundActionSkip:       TurnStatusCompleted,
+		},
+		TurnStatusWaitingForSelection: {
+			RoundActionRank: TurnStatusCompleted,
+			RoundActionSkip: TurnStatusCompleted,
+		},
+		TurnStatusCompleted: {},
+	}
+}
+
+// NextGameStatus returns the status a game in status from moves to by action.
+func NextGameStatus(from GameStatus, action GameAction) (GameStatus, error) {
+	next, ok := gameTransitions()[from][action]
+	if !ok {
+		return from, errors.Errorf("%w: cannot %s a game that is %s",
+			ErrInvalidGameTransition, action, from)
+	}
+
+	return next, nil
+}
+
+// NextTurnStatus returns the turn status a round in turn status from moves to by action.
+func NextTurnStatus(from TurnStatus, action RoundAction) (TurnStatus, error) {
+	next, ok := roundTransitions()[from][action]
+	if !ok {
+		return from, errors.Errorf("%w: cannot %s in a round that is %s",
+			ErrInvalidRoundTransition, action, from)
+	}
+
+	return next, nil
+}
+
+// CanApply checks that action is allowed in the current turn status of the round.
+func (r *Round) CanApply(action RoundAction) error {
+	_, err := NextTurnStatus(r.TurnStatus, action)
+
+	return err
+}
+
+// IsCompleted reports whether the round has been ranked or skipped.
+// Rounds stored before rounds were completed count as completed once they have results.
+func (r *Round) IsCompleted() bool {
+	return r.TurnStatus == TurnStatusCompleted || len(r.Results) > 0
+}
+
+// String returns the name of the game status.
+func (s GameStatus) String() string {
+	switch s {
+	case GameStatusWaiting:
+		return "waiting"
+	case GameStatusInProgress:
+		return "in progress"
+	case GameStatusFinished:
+		return "finished"
+	case GameStatusPaused:
+		return "paused"
+	default:
+		return "unknown"
+	}
+}
+
+// String returns the name of the turn status.
+func (s TurnStatus) String() string {
+	switch s {
+	case TurnStatusGameMaster:
+		return "in the game master's turn"
+	case TurnStatusHunters:
+		return "in the hunters' turn"
+	case TurnStatusWaitingForSelection:
+		return "waiting for the ranking"
+	case TurnStatusReviewingHints:
+		return "reviewing hints"
+	case TurnStatusGeneratingHints:
+		return "generating hints"
+	case TurnStatusCompleted:
+		return "completed"
+	default:
+		return "unknown"
+	}
+}
+
+// String returns the name of the game action.
+func (a GameAction) String() string {
+	switch a {
+	case GameActionStart:
+		return "start"
+	case GameActionPause:
+		return "pause"
+	case GameActionResume:
+		return "resume"
+	case GameActionFinish:
+		return "finish"
+	default:
+		return "change"
+	}
+}
+
+// String returns the name of the round action.
+func (a RoundAction) String() string {
+	switch a {
+	case RoundActionSubmitPhoto:
+		return "submit a photo"
+	case RoundActionFinishHints:
+		return "finish the hints"
+	case RoundActionFailHints:
+		return "fail the hints"
+	case RoundActionReleaseHints:
+		return "release the hints"
+	case RoundActionFinishHunt:
+		return "finish the hunt"
+	case RoundActionRank:
+		return "rank the photos"
+	case RoundActionSkip:
+		return "skip"
+	default:
+		return "change"
+	}
+}
+
+// apply moves the game to the status action leads to.
+func (g *Game) apply(action GameAction) error {
+	next, err := NextGameStatus(g.Status, action)
+	if err != nil {
+		return err
+	}
+
+	g.Status = next
+
+	return nil
+}
+
+// apply moves the round to the turn status action leads to.
+func (r *Round) apply(action RoundAction) error {
+	next, err := NextTurnStatus(r.TurnStatus, action)
+	if err != nil {
+		return err
+	}
+
+	r.TurnStatus = next
+
+	return nil
+}
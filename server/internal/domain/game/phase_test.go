@@ -0,0 +1,301 @@
+package game_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+func TestNextGameStatus(t *testing.T) {
+	t.Parallel()
+
+	// 表にない組み合わせはすべて不正な遷移
+	allowed := map[game.GameStatus]map[game.GameAction]game.GameStatus{
+		game.GameStatusWaiting: {
+			game.GameActionStart: game.GameStatusInProgress,
+		},
+		game.GameStatusInProgress: {
+			game.GameActionPause:  game.GameStatusPaused,
+			game.GameActionFinish: game.GameStatusFinished,
+		},
+		game.GameStatusPaused: {
+			game.GameActionResume: game.GameStatusInProgress,
+			game.GameActionFinish: game.GameStatusFinished,
+		},
+	}
+
+	statuses := []game.GameStatus{
+		game.GameStatusWaiting,
+		game.GameStatusInProgress,
+		game.GameStatusPaused,
+		game.GameStatusFinished,
+	}
+	actions := []game.GameAction{
+		game.GameActionStart,
+		game.GameActionPause,
+		game.GameActionResume,
+		game.GameActionFinish,
+	}
+
+	for _, from := range statuses {
+		for _, action := range actions {
+			t.Run(from.String()+"/"+action.String(), func(t *testing.T) {
+				t.Parallel()
+
+				want, ok := allowed[from][action]
+
+				next, err := game.NextGameStatus(from, action)
+				if !ok {
+					if !errors.Is(err, game.ErrInvalidGameTransition) || next != from {
+						t.Errorf("NextGameStatus() = %v, %v, want %v, %v",
+							next, err, from, game.ErrInvalidGameTransition)
+					}
+
+					return
+				}
+
+				if err != nil || next != want {
+					t.Errorf("NextGameStatus() = %v, %v, want %v", next, err, want)
+				}
+			})
+		}
+	}
+}
+
+func TestNextTurnStatus(t *testing.T) {
+	t.Parallel()
+
+	// 表にない組み合わせはすべて不正な遷移
+	allowed := map[game.TurnStatus]map[game.RoundAction]game.TurnStatus{
+		game.TurnStatusGameMaster: {
+			game.RoundActionSubmitPhoto: game.TurnStatusGeneratingHints,
+			game.RoundActionSkip:        game.TurnStatusCompleted,
+		},
+		game.TurnStatusGeneratingHints: {
+			game.RoundActionSubmitPhoto: game.TurnStatusGeneratingHints,
+			game.RoundActionFinishHints: game.TurnStatusReviewingHints,
+			game.RoundActionFailHints:   game.TurnStatusGameMaster,
+			game.RoundActionSkip:        game.TurnStatusCompleted,
+		},
+		game.TurnStatusReviewingHints: {
+			game.RoundActionSubmitPhoto:  game.TurnStatusGeneratingHints,
+			game.RoundActionReleaseHints: game.TurnStatusHunters,
+			game.RoundActionSkip:         game.TurnStatusCompleted,
+		},
+		game.TurnStatusHunters: {
+			game.RoundActionFinishHunt: game.TurnStatusWaitingForSelection,
+			game.RoundActionSkip:       game.TurnStatusCompleted,
+		},
+		game.TurnStatusWaitingForSelection: {
+			game.RoundActionRank: game.TurnStatusCompleted,
+			game.RoundActionSkip: game.TurnStatusCompleted,
+		},
+	}
+
+	statuses := []game.TurnStatus{
+		game.TurnStatusGameMaster,
+		game.TurnStatusGeneratingHints,
+		game.TurnStatusReviewingHints,
+		game.TurnStatusHunters,
+		game.TurnStatusWaitingForSelection,
+		game.TurnStatusCompleted,
+	}
+	actions := []game.RoundAction{
+		game.RoundActionSubmitPhoto,
+		game.RoundActionFinishHints,
+		game.RoundActionFailHints,
+		game.RoundActionReleaseHints,
+		game.RoundActionFinishHunt,
+		game.RoundActionRank,
+		game.RoundActionSkip,
+	}
+
+	for _, from := range statuses {
+		for _, action := range actions {
+			t.Run(from.String()+"/"+action.String(), func(t *testing.T) {
+				t.Parallel()
+
+				want, ok := allowed[from][action]
+
+				next, err := game.NextTurnStatus(from, action)
+				if !ok {
+					if !errors.Is(err, game.ErrInvalidRoundTransition) || next != from {
+						t.Errorf("NextTurnStatus() = %v, %v, want %v, %v",
+							next, err, from, game.ErrInvalidRoundTransition)
+					}
+
+					return
+				}
+
+				if err != nil || next != want {
+					t.Errorf("NextTurnStatus() = %v, %v, want %v", next, err, want)
+				}
+			})
+		}
+	}
+}
+
+func TestRound_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+
+	// ハンターのターン中はゲームマスターが写真を撮り直せない
+	err := round.StartHintGeneration(uuid.NewString())
+	if !errors.Is(err, game.ErrInvalidRoundTransition) {
+		t.Errorf("StartHintGeneration() in the hunters' turn error = %v, want %v",
+			err, game.ErrInvalidRoundTransition)
+	}
+
+	result, err := game.NewRoundResult(gameSession.Players[1].UserID, 1)
+	if err != nil {
+		t.Fatalf("NewRoundResult() failed: %v", err)
+	}
+
+	err = round.SetResults([]*game.RoundResult{result})
+	if !errors.Is(err, game.ErrInvalidRoundTransition) {
+		t.Errorf("SetResults() in the hunters' turn error = %v, want %v",
+			err, game.ErrInvalidRoundTransition)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[1].UserID)
+	if !errors.Is(err, game.ErrRoundNotCompleted) {
+		t.Errorf("StartRound() before the round is completed error = %v, want %v",
+			err, game.ErrRoundNotCompleted)
+	}
+
+	rankFirst(t, round, gameSession.Players[1].UserID)
+
+	if !round.IsCompleted() || round.TurnStatus != game.TurnStatusCompleted {
+		t.Fatalf(
+			"round is %v after the ranking, want %v",
+			round.TurnStatus,
+			game.TurnStatusCompleted,
+		)
+	}
+
+	err = round.SetResults(nil)
+	if !errors.Is(err, game.ErrInvalidRoundTransition) {
+		t.Errorf("SetResults() twice error = %v, want %v", err, game.ErrInvalidRoundTransition)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[1].UserID)
+	if err != nil {
+		t.Fatalf("StartRound() after the ranking failed: %v", err)
+	}
+}
+
+func TestGame_StartRound_NotStarted(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, game.MinPlayers)
+
+	// ラウンドを始めてもゲームは開始されない
+	err := gameSession.StartRound(gameSession.Players[0].UserID)
+	if !errors.Is(err, game.ErrInvalidGameTransition) {
+		t.Fatalf("StartRound() of a waiting game error = %v, want %v",
+			err, game.ErrInvalidGameTransition)
+	}
+
+	if gameSession.Status != game.GameStatusWaiting || len(gameSession.Rounds) != 0 {
+		t.Errorf("game is %v with %d rounds, want %v with no rounds",
+			gameSession.Status, len(gameSession.Rounds), game.GameStatusWaiting)
+	}
+
+	err = gameSession.Start(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Errorf("StartRound() after Start() failed: %v", err)
+	}
+}
+
+func TestRound_IsCompleted_StoredRound(t *testing.T) {
+	t.Parallel()
+
+	// 完了状態がない頃に保存されたラウンドは結果があれば完了
+	round := &game.Round{
+		RoundNumber: 1,
+		TurnStatus:  game.TurnStatusWaitingForSelection,
+		Results:     []*game.RoundResult{{UserID: uuid.New(), Rank: 1}},
+	}
+
+	if !round.IsCompleted() {
+		t.Error("IsCompleted() = false for a stored round with results")
+	}
+}
+
+func TestGame_Finish(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prepare func(t *testing.T, gameSession *game.Game)
+		wantErr error
+	}{
+		"not started": {
+			func(*testing.T, *game.Game) {},
+			game.ErrInvalidGameTransition,
+		},
+		"started": {
+			func(t *testing.T, gameSession *game.Game) {
+				t.Helper()
+
+				err := gameSession.Start(gameSession.Players[0].UserID)
+				if err != nil {
+					t.Fatalf("Start() failed: %v", err)
+				}
+			},
+			nil,
+		},
+		"on hold": {
+			func(t *testing.T, gameSession *game.Game) {
+				t.Helper()
+
+				err := gameSession.Start(gameSession.Players[0].UserID)
+				if err != nil {
+					t.Fatalf("Start() failed: %v", err)
+				}
+
+				err = gameSession.Pause(gameSession.Players[0].UserID, time.Now())
+				if err != nil {
+					t.Fatalf("Pause() failed: %v", err)
+				}
+			},
+			nil,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession := newWaitingGame(t, game.MinPlayers)
+			testCase.prepare(t, gameSession)
+
+			err := gameSession.Finish()
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("Finish() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil &&
+				(gameSession.Status != game.GameStatusFinished || !gameSession.PausedAt.IsZero()) {
+				t.Errorf(
+					"Finish() status = %v, want %v",
+					gameSession.Status,
+					game.GameStatusFinished,
+				)
+			}
+
+			err = gameSession.Finish()
+			if testCase.wantErr == nil && !errors.Is(err, game.ErrGameAlreadyFinished) {
+				t.Errorf("Finish() twice error = %v, want %v", err, game.ErrGameAlreadyFinished)
+			}
+		})
+	}
+}
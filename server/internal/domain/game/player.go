@@ -20,6 +20,8 @@ type Player struct {
 	IsAdmin      bool      `json:"isAdmin"`
 	TotalPoints  int       `json:"totalPoints"`
 	IsConnected  bool      `json:"isConnected"`
+	// Anonymized is set once the player's account has been deleted.
+	Anonymized bool `json:"anonymized,omitempty"`
 }
 
 // NewPlayer creates a new Player.
@@ -35,6 +37,7 @@ func NewPlayer(userID uuid.UUID, name string, isGameMaster, isAdmin bool) (*Play
 		IsAdmin:      isAdmin,
 		TotalPoints:  0,
 		IsConnected:  true,
+		Anonymized:   false,
 	}, nil
 }
 
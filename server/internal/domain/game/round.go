@@ -1,6 +1,9 @@
 package game
 
 import (
+	"maps"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
@@ -15,6 +18,12 @@ const (
 	TurnStatusHunters
 	// TurnStatusWaitingForSelection represents waiting for game master to select winners.
 	TurnStatusWaitingForSelection
+	// TurnStatusReviewingHints represents game master reviewing hints before hunters see them.
+	TurnStatusReviewingHints
+	// TurnStatusGeneratingHints represents hints being generated in the background.
+	TurnStatusGeneratingHints
+	// TurnStatusCompleted represents a round that has been ranked or skipped.
+	TurnStatusCompleted
 )
 
 var (
@@ -22,6 +31,10 @@ var (
 	ErrInvalidRoundNumber = errors.New("invalid round number")
 	// ErrGameMasterImageNotSet is returned when game master image is not set.
 	ErrGameMasterImageNotSet = errors.New("game master image not set")
+	// ErrNotReviewingHints is returned when hints are changed outside of the review.
+	ErrNotReviewingHints = errors.New("hints are not under review")
+	// ErrInvalidHintOrder is returned when a hint order is not a permutation of the hints.
+	ErrInvalidHintOrder = errors.New("invalid hint order: must list every hint number once")
 )
 
 // Round represents a single round in the game.
@@ -34,6 +47,25 @@ type Round struct {
 	Results            []*RoundResult      `json:"results"`
 	TurnStatus         TurnStatus          `json:"turnStatus"`
 	TurnElapsedSeconds int                 `json:"turnElapsedSeconds"`
+	// HintGeneration is the progress of the hint generation of the current photo.
+	HintGeneration *HintGeneration `json:"hintGeneration,omitempty"`
+	// GameMasterLocation is where the game master's photo was taken, if known.
+	// It is never shown to the hunters.
+	GameMasterLocation *Location `json:"gameMasterLocation,omitempty"`
+	// HunterDistances is the distance of each hunter from the game master's photo
+	// at their latest proximity check.
+	HunterDistances map[uuid.UUID]float64 `json:"hunterDistances,omitempty"`
+	// VotingEndsAt is when the audience stops voting on the hunters' photos.
+	// It is zero unless the audience ranks the photos.
+	VotingEndsAt time.Time `json:"votingEndsAt,omitzero"`
+	// TurnStartedAt is when the hunters' turn started, moved later by the time the game was paused.
+	// It is zero before the hunters' turn and for rounds stored before the server kept the time.
+	TurnStartedAt time.Time `json:"turnStartedAt,omitzero"`
+	// Skipped is true when the admin voided the round, which gives no points.
+	Skipped bool `json:"skipped,omitempty"`
+
+	// pendingEvents are the events of the changes that have not been stored yet.
+	pendingEvents []*Event
 }
 
 // NewRound creates a new Round.
@@ -68,41 +100,181 @@ func (r *Round) SetHints(hints []*Hint) {
 	r.Hints = hints
 }
 
+// StartHintReview sets the generated hints and lets the game master review them
+// before they are released to hunters.
+func (r *Round) StartHintReview(hints []*Hint) error {
+	if r.GameMasterImageID == "" {
+		return ErrGameMasterImageNotSet
+	}
+
+	err := r.apply(RoundActionFinishHints)
+	if err != nil {
+		return err
+	}
+
+	if r.HintGeneration != nil {
+		r.HintGeneration.Generated = len(hints)
+	}
+
+	r.Hints = hints
+	r.emit(EventHintsGenerated, r.GameMasterUserID, hintsPayload(hints...))
+
+	return nil
+}
+
+// EditHint replaces the text of a hint under review with the game master's.
+func (r *Round) EditHint(hintNumber int, text string) error {
+	return r.setHintText(hintNumber, text, EventHintEdited)
+}
+
+// ReplaceHint replaces the text of a hint under review with one the AI has regenerated.
+func (r *Round) ReplaceHint(hintNumber int, text string) error {
+	return r.setHintText(hintNumber, text, EventHintRegenerated)
+}
+
+// ReorderHints reorders the hints under review.
+// order lists the current hint numbers in their new order, and hints are renumbered from 1.
+func (r *Round) ReorderHints(order []int) error {
+	if r.TurnStatus != TurnStatusReviewingHints {
+		return ErrNotReviewingHints
+	}
+
+	if len(order) != len(r.Hints) {
+		return ErrInvalidHintOrder
+	}
+
+	byNumber := make(map[int]*Hint, len(r.Hints))
+	for _, hint := range r.Hints {
+		byNumber[hint.HintNumber] = hint
+	}
+
+	hints := make([]*Hint, 0, len(order))
+
+	for _, hintNumber := range order {
+		hint, ok := byNumber[hintNumber]
+		if !ok {
+			return ErrInvalidHintOrder
+		}
+
+		// 同じ番号を二度指定できないように使用済みのヒントを取り除く
+		delete(byNumber, hintNumber)
+
+		hints = append(hints, hint)
+	}
+
+	for index, hint := range hints {
+		hint.HintNumber = index + 1
+	}
+
+	r.Hints = hints
+	r.emit(EventHintsReordered, r.GameMasterUserID, hintsPayload(hints...))
+
+	return nil
+}
+
+// ReleaseHints ends the review and starts the hunters' turn.
+func (r *Round) ReleaseHints() error {
+	if r.TurnStatus != TurnStatusReviewingHints {
+		return ErrNotReviewingHints
+	}
+
+	return r.StartHuntersTurn()
+}
+
+// ReleasedHints returns the hints hunters may see.
+// Hints are hidden until the game master releases them.
+func (r *Round) ReleasedHints() []*Hint {
+	if r.TurnStatus == TurnStatusReviewingHints || r.TurnStatus == TurnStatusGeneratingHints {
+		return []*Hint{}
+	}
+
+	return r.Hints
+}
+
 // StartHuntersTurn starts the hunters' turn.
 func (r *Round) StartHuntersTurn() error {
 	if r.GameMasterImageID == "" {
 		return ErrGameMasterImageNotSet
 	}
 
-	r.TurnStatus = TurnStatusHunters
+	err := r.apply(RoundActionReleaseHints)
+	if err != nil {
+		return err
+	}
+
 	r.TurnElapsedSeconds = 0
+	r.TurnStartedAt = time.Now()
+	r.emit(EventHintsReleased, r.GameMasterUserID, hintsPayload(r.Hints...))
 
 	return nil
 }
 
-// AddHunterSubmission adds a hunter's photo submission to the round.
-func (r *Round) AddHunterSubmission(submission *HunterSubmission) {
+// AddHunterSubmission adds a hunter's photo submission to the round as their next attempt.
+// The attempt that uses up attemptLimit becomes the final photo.
+func (r *Round) AddHunterSubmission(submission *HunterSubmission, attemptLimit int) error {
+	hunterID := submission.hunterID()
+
+	err := r.CanSubmit(hunterID, attemptLimit)
+	if err != nil {
+		return err
+	}
+
+	submission.Attempt = len(r.Attempts(hunterID)) + 1
+	submission.Final = submission.Attempt >= attemptLimit
 	r.HunterSubmissions = append(r.HunterSubmissions, submission)
+	r.emit(EventPhotoSubmitted, submission.UserID, photoPayload(
+		submission.ImageID,
+		submission.Attempt,
+		submission.SubmittedAtSeconds,
+		submission.Final,
+	))
+
+	return nil
 }
 
-// CheckAllHuntersSubmitted checks if all hunters have submitted their photos.
+// CheckAllHuntersSubmitted checks if all hunters have decided their final photos.
+// Each hunter, or each team in team mode, is counted once however many attempts they made.
 func (r *Round) CheckAllHuntersSubmitted(totalHunters int) bool {
-	return len(r.HunterSubmissions) >= totalHunters
-}
+	finished := make(map[uuid.UUID]struct{}, totalHunters)
 
-// StartWaitingForSelection transitions to waiting for game master selection.
-func (r *Round) StartWaitingForSelection() {
-	r.TurnStatus = TurnStatusWaitingForSelection
+	for _, submission := range r.HunterSubmissions {
+		if submission.isFinal() {
+			finished[submission.hunterID()] = struct{}{}
+		}
+	}
+
+	return len(finished) >= totalHunters
 }
 
-// AddResult adds a result to the round.
-func (r *Round) AddResult(result *RoundResult) {
-	r.Results = append(r.Results, result)
+// StartWaitingForSelection ends the hunters' turn and waits for the photos to be ranked.
+func (r *Round) StartWaitingForSelection() error {
+	err := r.apply(RoundActionFinishHunt)
+	if err != nil {
+		return err
+	}
+
+	r.emit(EventHuntFinished, uuid.Nil, EventPayload{})
+
+	return nil
 }
 
-// SetResults sets all results at once (used when game master selects winners).
-func (r *Round) SetResults(results []*RoundResult) {
+// SetResults completes the round with the results of the ranking.
+func (r *Round) SetResults(results []*RoundResult) error {
+	// 投票で決まった順位はゲームマスターが選んだものではない
+	selectedBy := r.GameMasterUserID
+	if r.IsVoting() {
+		selectedBy = uuid.Nil
+	}
+
+	err := r.apply(RoundActionRank)
+	if err != nil {
+		return err
+	}
+
 	r.Results = results
+	r.emit(EventWinnersSelected, selectedBy, EventPayload{Results: clonePointers(results)})
+
+	return nil
 }
 
 // UpdateTurnElapsedSeconds updates the elapsed seconds in the current turn.
@@ -110,11 +282,133 @@ func (r *Round) UpdateTurnElapsedSeconds(seconds int) {
 	r.TurnElapsedSeconds = seconds
 }
 
-// GetHintsUpToTime returns hints that should be visible at the given elapsed time.
-// First hint is visible at 0 seconds, then one more every 10 seconds.
-func (r *Round) GetHintsUpToTime(elapsedSeconds int) []*Hint {
-	// First hint at 0s, second at 10s, third at 20s, fourth at 30s, fifth at 40s
-	numHints := min((elapsedSeconds/10)+1, len(r.Hints))
+// GetHintsUpToTime returns hints that should be visible at the given elapsed time
+// under the hint schedule of settings.
+func (r *Round) GetHintsUpToTime(elapsedSeconds int, settings GameSettings) []*Hint {
+	numHints := min(settings.ReleasedHintCount(elapsedSeconds), len(r.Hints))
 
 	return r.Hints[:numHints]
 }
+
+// shiftClock moves the start of the hunters' turn and the end of the voting later by paused,
+// so that the time the game was paused does not count.
+func (r *Round) shiftClock(paused time.Duration) {
+	if r.TurnStatus == TurnStatusHunters && !r.TurnStartedAt.IsZero() {
+		r.TurnStartedAt = r.TurnStartedAt.Add(paused)
+	}
+
+	if r.IsVoting() {
+		r.VotingEndsAt = r.VotingEndsAt.Add(paused)
+	}
+}
+
+// skip voids the round. Hunters can no longer submit and pending hints are dropped.
+func (r *Round) skip() error {
+	err := r.apply(RoundActionSkip)
+	if err != nil {
+		return err
+	}
+
+	r.Skipped = true
+	r.Results = make([]*RoundResult, 0)
+
+	return nil
+}
+
+// replaceUserID rewrites every reference to oldID in the round with newID
+// and drops the locations of oldID's photos.
+func (r *Round) replaceUserID(oldID, newID uuid.UUID) {
+	if r.GameMasterUserID == oldID {
+		r.GameMasterUserID = newID
+		r.GameMasterLocation = nil
+	}
+
+	for _, submission := range r.HunterSubmissions {
+		if submission.UserID == oldID {
+			submission.UserID = newID
+			// 位置情報は個人情報のため残さない
+			submission.Location = nil
+		}
+	}
+
+	delete(r.HunterDistances, oldID)
+
+	for _, result := range r.Results {
+		if result.UserID == oldID {
+			result.UserID = newID
+		}
+	}
+}
+
+// setHintText replaces the text of a hint under review and records eventType
+// as caused by the game master.
+func (r *Round) setHintText(hintNumber int, text string, eventType EventType) error {
+	if r.TurnStatus != TurnStatusReviewingHints {
+		return ErrNotReviewingHints
+	}
+
+	if text == "" {
+		return ErrEmptyHintText
+	}
+
+	for _, hint := range r.Hints {
+		if hint.HintNumber == hintNumber {
+			hint.Text = text
+
+			r.emit(eventType, r.GameMasterUserID, hintsPayload(hint))
+
+			return nil
+		}
+	}
+
+	return ErrInvalidHintNumber
+}
+
+// clone returns a deep copy of the round without its pending events.
+func (r *Round) clone() *Round {
+	cloned := *r
+	cloned.Hints = clonePointers(r.Hints)
+	cloned.Results = clonePointers(r.Results)
+	cloned.GameMasterLocation = clonePointer(r.GameMasterLocation)
+	cloned.HunterDistances = maps.Clone(r.HunterDistances)
+	cloned.pendingEvents = nil
+
+	cloned.HunterSubmissions = clonePointers(r.HunterSubmissions)
+	for _, submission := range cloned.HunterSubmissions {
+		submission.Location = clonePointer(submission.Location)
+	}
+
+	if r.HintGeneration != nil {
+		generation := *r.HintGeneration
+		generation.Errors = clonePointers(generation.Errors)
+		cloned.HintGeneration = &generation
+	}
+
+	return &cloned
+}
+
+// clonePointer returns a pointer to a copy of *value, or nil when value is nil.
+func clonePointer[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+
+	cloned := *value
+
+	return &cloned
+}
+
+// clonePointers returns a slice of pointers to copies of the values of items.
+// It is nil when items is nil.
+func clonePointers[T any](items []*T) []*T {
+	if items == nil {
+		return nil
+	}
+
+	cloned := make([]*T, len(items))
+	for index, item := range items {
+		cloned[index] = clonePointer(item)
+	}
+
+	return cloned
+}
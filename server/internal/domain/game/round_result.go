@@ -8,6 +8,9 @@ import (
 // ErrInvalidRank is returned when a rank is invalid.
 var ErrInvalidRank = errors.New("invalid rank: must be between 1 and 20")
 
+// MaxRank is the lowest rank the game master can give.
+const MaxRank = 20
+
 // Point values for each rank.
 const (
 	FirstPlacePoints  = 5
@@ -21,23 +24,41 @@ type RoundResult struct {
 	UserID uuid.UUID `json:"userId"`
 	Rank   int       `json:"rank"`   // Rank assigned by game master (1st, 2nd, 3rd, etc.)
 	Points int       `json:"points"` // Points awarded based on rank
+	// TeamID is the ranked team in team mode, where UserID is not set.
+	TeamID uuid.UUID `json:"teamId,omitzero"`
+	// DistancePoints are the extra points for the distance of the photo, included in Points.
+	DistancePoints int `json:"distancePoints,omitempty"`
 }
 
 // NewRoundResult creates a new RoundResult.
 func NewRoundResult(userID uuid.UUID, rank int) (*RoundResult, error) {
-	if rank < 1 || rank > 20 {
+	if rank < 1 || rank > MaxRank {
 		return nil, ErrInvalidRank
 	}
 
 	points := calculatePoints(rank)
 
 	return &RoundResult{
-		UserID: userID,
-		Rank:   rank,
-		Points: points,
+		UserID:         userID,
+		Rank:           rank,
+		Points:         points,
+		TeamID:         uuid.Nil,
+		DistancePoints: 0,
 	}, nil
 }
 
+// NewTeamRoundResult creates a new RoundResult for a team.
+func NewTeamRoundResult(teamID uuid.UUID, rank int) (*RoundResult, error) {
+	result, err := NewRoundResult(uuid.Nil, rank)
+	if err != nil {
+		return nil, err
+	}
+
+	result.TeamID = teamID
+
+	return result, nil
+}
+
 // calculatePoints calculates points based on rank.
 func calculatePoints(rank int) int {
 	switch rank {
@@ -51,3 +72,9 @@ func calculatePoints(rank int) int {
 		return DefaultPoints
 	}
 }
+
+// AddDistancePoints adds extra points for the distance of the photo.
+func (r *RoundResult) AddDistancePoints(points int) {
+	r.DistancePoints += points
+	r.Points += points
+}
@@ -0,0 +1,183 @@
+package game_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const editedText = "edited"
+
+// newReviewRound returns a round whose hints "1" to "5" are under review.
+func newReviewRound(t *testing.T) *game.Round {
+	t.Helper()
+
+	round, err := game.NewRound(1, uuid.New())
+	if err != nil {
+		t.Fatalf("NewRound() failed: %v", err)
+	}
+
+	hints := make([]*game.Hint, 0, game.DefaultHintCount)
+	for _, text := range []string{"1", "2", "3", "4", "5"} {
+		hints = append(hints, &game.Hint{HintNumber: len(hints) + 1, Text: text})
+	}
+
+	err = round.StartHintGeneration(uuid.NewString())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = round.StartHintReview(hints)
+	if err != nil {
+		t.Fatalf("StartHintReview() failed: %v", err)
+	}
+
+	return round
+}
+
+// hintTexts returns the texts of hints, checking that they are numbered from 1.
+func hintTexts(t *testing.T, hints []*game.Hint) []string {
+	t.Helper()
+
+	texts := make([]string, 0, len(hints))
+
+	for index, hint := range hints {
+		if hint.HintNumber != index+1 {
+			t.Errorf("hint %d has number %d", index, hint.HintNumber)
+		}
+
+		texts = append(texts, hint.Text)
+	}
+
+	return texts
+}
+
+func TestRound_ReorderHints(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		order   []int
+		want    []string
+		wantErr error
+	}{
+		"reversed":   {[]int{5, 4, 3, 2, 1}, []string{"5", "4", "3", "2", "1"}, nil},
+		"same order": {[]int{1, 2, 3, 4, 5}, []string{"1", "2", "3", "4", "5"}, nil},
+		"duplicated": {
+			[]int{1, 1, 3, 4, 5},
+			[]string{"1", "2", "3", "4", "5"},
+			game.ErrInvalidHintOrder,
+		},
+		"missing": {
+			[]int{1, 2, 3, 4},
+			[]string{"1", "2", "3", "4", "5"},
+			game.ErrInvalidHintOrder,
+		},
+		"unknown number": {
+			[]int{1, 2, 3, 4, 6},
+			[]string{"1", "2", "3", "4", "5"},
+			game.ErrInvalidHintOrder,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newReviewRound(t)
+
+			err := round.ReorderHints(testCase.order)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("ReorderHints() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			got := hintTexts(t, round.Hints)
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("ReorderHints() hints = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRound_EditHint(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hintNumber int
+		text       string
+		wantErr    error
+	}{
+		editedText:       {2, editedText, nil},
+		"empty text":     {2, "", game.ErrEmptyHintText},
+		"unknown number": {6, editedText, game.ErrInvalidHintNumber},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			round := newReviewRound(t)
+
+			err := round.EditHint(testCase.hintNumber, testCase.text)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("EditHint() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if err == nil && round.Hints[testCase.hintNumber-1].Text != testCase.text {
+				t.Errorf(
+					"EditHint() text = %q, want %q",
+					round.Hints[testCase.hintNumber-1].Text,
+					testCase.text,
+				)
+			}
+		})
+	}
+}
+
+func TestRound_ReleaseHints(t *testing.T) {
+	t.Parallel()
+
+	round := newReviewRound(t)
+
+	// レビュー中のヒントはハンターに見せない
+	if len(round.ReleasedHints()) != 0 {
+		t.Fatalf(
+			"ReleasedHints() during review = %v, want none",
+			hintTexts(t, round.ReleasedHints()),
+		)
+	}
+
+	err := round.ReleaseHints()
+	if err != nil {
+		t.Fatalf("ReleaseHints() failed: %v", err)
+	}
+
+	if round.TurnStatus != game.TurnStatusHunters {
+		t.Errorf(
+			"ReleaseHints() turn status = %v, want %v",
+			round.TurnStatus,
+			game.TurnStatusHunters,
+		)
+	}
+
+	if len(round.ReleasedHints()) != game.DefaultHintCount {
+		t.Errorf(
+			"ReleasedHints() = %d hints, want %d",
+			len(round.ReleasedHints()),
+			game.DefaultHintCount,
+		)
+	}
+
+	// 公開後は編集できない
+	for name, err := range map[string]error{
+		"EditHint":     round.EditHint(1, editedText),
+		"ReorderHints": round.ReorderHints([]int{5, 4, 3, 2, 1}),
+		"ReleaseHints": round.ReleaseHints(),
+	} {
+		if !errors.Is(err, game.ErrNotReviewingHints) {
+			t.Errorf("%s() after release error = %v, want %v", name, err, game.ErrNotReviewingHints)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package game
+
+import (
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+var (
+	// ErrNotScenePool is returned when a scene is picked in a game with game master photos.
+	ErrNotScenePool = errors.New("game does not use a scene pool")
+	// ErrSceneNotInPool is returned when the chosen scene is not an unused scene of the pool.
+	ErrSceneNotInPool = errors.New("scene is not in the pool or already used")
+	// ErrScenePoolEmpty is returned when every scene of the pool has been used.
+	ErrScenePoolEmpty = errors.New("no unused scenes left in the pool")
+	// ErrScenePicked is returned when the scene of the round has already been picked.
+	ErrScenePicked = errors.New("scene of the round has already been picked")
+)
+
+// UnusedScenes returns the image IDs of pool that no round has used yet, in the order of pool.
+func (g *Game) UnusedScenes(pool []string) []string {
+	return slices.DeleteFunc(slices.Clone(pool), func(imageID string) bool {
+		return slices.ContainsFunc(g.Rounds, func(round *Round) bool {
+			return round.GameMasterImageID == imageID
+		})
+	})
+}
+
+// WaitsForScene reports whether the current round of a scene pool game waits for its scene
+// to be picked, also when the hints of the scene picked before could not be generated.
+func (g *Game) WaitsForScene() bool {
+	if !g.ScenePool || g.Status != GameStatusInProgress {
+		return false
+	}
+
+	round, err := g.GetCurrentRound()
+
+	return err == nil && round.TurnStatus == TurnStatusGameMaster
+}
+
+// PickScene picks the scene of the current round from the unused scenes of pool.
+// The scene chosen by the game master is used if not empty, otherwise one is picked at random.
+// Its hints are generated at once, so the round skips the game master's turn.
+// A scene whose hints could not be generated counts as used, so another one is picked again.
+func (g *Game) PickScene(pool []string, chosen string) (string, error) {
+	if !g.ScenePool {
+		return "", ErrNotScenePool
+	}
+
+	round, err := g.GetCurrentRound()
+	if err != nil {
+		return "", err
+	}
+
+	if round.TurnStatus != TurnStatusGameMaster {
+		return "", ErrScenePicked
+	}
+
+	unused := g.UnusedScenes(pool)
+	if len(unused) == 0 {
+		return "", ErrScenePoolEmpty
+	}
+
+	scene := chosen
+	if scene == "" {
+		//nolint:gosec // 出題の抽選に暗号学的な乱数は不要
+		scene = unused[rand.IntN(len(unused))]
+	} else if !slices.Contains(unused, scene) {
+		return "", errors.Errorf("%w: %s", ErrSceneNotInPool, scene)
+	}
+
+	err = round.StartHintGeneration(scene)
+	if err != nil {
+		return "", err
+	}
+
+	g.UpdatedAt = time.Now()
+
+	return scene, nil
+}
@@ -0,0 +1,181 @@
+package game_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newSceneGame returns a game of three players that has started its first round.
+func newSceneGame(t *testing.T, scenePool bool) *game.Game {
+	t.Helper()
+
+	gameSession, err := game.NewGame(uuid.New(), 2, uuid.New(), game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), scenePool)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for _, name := range []string{"a", "b", "c"} {
+		player, err := game.NewPlayer(uuid.New(), name, false, false)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	err = gameSession.Start(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	return gameSession
+}
+
+func TestGame_PickScene(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		scenePool bool
+		pool      []string
+		chosen    string
+		want      []string
+		wantErr   error
+	}{
+		"chosen":          {true, []string{"s1", "s2"}, "s2", []string{"s2"}, nil},
+		"random":          {true, []string{"s1", "s2"}, "", []string{"s1", "s2"}, nil},
+		"unknown scene":   {true, []string{"s1", "s2"}, "s3", nil, game.ErrSceneNotInPool},
+		"empty pool":      {true, nil, "", nil, game.ErrScenePoolEmpty},
+		"not a pool game": {false, []string{"s1"}, "s1", nil, game.ErrNotScenePool},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession := newSceneGame(t, testCase.scenePool)
+
+			scene, err := gameSession.PickScene(testCase.pool, testCase.chosen)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("PickScene() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			if !slices.Contains(testCase.want, scene) {
+				t.Errorf("PickScene() = %q, want one of %v", scene, testCase.want)
+			}
+
+			// 親の撮影を飛ばしてヒントの作成を待つ
+			round, err := gameSession.GetCurrentRound()
+			if err != nil {
+				t.Fatalf("GetCurrentRound() failed: %v", err)
+			}
+
+			if !round.IsGeneratingHintsFor(scene) {
+				t.Errorf("round is in %v for %q, want generating hints for %q",
+					round.TurnStatus, round.GameMasterImageID, scene)
+			}
+		})
+	}
+}
+
+func TestGame_PickScene_UsesEachSceneOnce(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newSceneGame(t, true)
+	pool := []string{"s1", "s2"}
+
+	first, err := gameSession.PickScene(pool, "")
+	if err != nil {
+		t.Fatalf("PickScene() failed: %v", err)
+	}
+
+	_, err = gameSession.PickScene(pool, "")
+	if !errors.Is(err, game.ErrScenePicked) {
+		t.Errorf("PickScene() twice error = %v, want %v", err, game.ErrScenePicked)
+	}
+
+	// 飛ばすと次のラウンドが始まる
+	err = gameSession.SkipRound(gameSession.Players[0].UserID, time.Now())
+	if err != nil {
+		t.Fatalf("SkipRound() failed: %v", err)
+	}
+
+	unused := gameSession.UnusedScenes(pool)
+	if len(unused) != 1 || unused[0] == first {
+		t.Fatalf("UnusedScenes() = %v, want the scene other than %q", unused, first)
+	}
+
+	_, err = gameSession.PickScene(pool, first)
+	if !errors.Is(err, game.ErrSceneNotInPool) {
+		t.Errorf("PickScene(%q) error = %v, want %v", first, err, game.ErrSceneNotInPool)
+	}
+
+	second, err := gameSession.PickScene(pool, "")
+	if err != nil {
+		t.Fatalf("PickScene() failed: %v", err)
+	}
+
+	if second != unused[0] {
+		t.Errorf("PickScene() = %q, want %q", second, unused[0])
+	}
+}
+
+func TestGame_PickScene_AfterFailedHints(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newSceneGame(t, true)
+	pool := []string{"s1", "s2"}
+
+	first, err := gameSession.PickScene(pool, "")
+	if err != nil {
+		t.Fatalf("PickScene() failed: %v", err)
+	}
+
+	if gameSession.WaitsForScene() {
+		t.Fatal("WaitsForScene() while generating hints = true, want false")
+	}
+
+	round, err := gameSession.GetCurrentRound()
+	if err != nil {
+		t.Fatalf("GetCurrentRound() failed: %v", err)
+	}
+
+	err = round.FailHintGeneration()
+	if err != nil {
+		t.Fatalf("FailHintGeneration() failed: %v", err)
+	}
+
+	// ヒントを作れなかったシーンの代わりに、同じラウンドで別のシーンを選ぶ
+	if !gameSession.WaitsForScene() {
+		t.Fatal("WaitsForScene() after the failure = false, want true")
+	}
+
+	second, err := gameSession.PickScene(pool, "")
+	if err != nil {
+		t.Fatalf("PickScene() again failed: %v", err)
+	}
+
+	if second == first || !round.IsGeneratingHintsFor(second) {
+		t.Errorf("PickScene() again = %q in %v, want the scene other than %q",
+			second, round.TurnStatus, first)
+	}
+}
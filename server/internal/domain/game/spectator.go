@@ -0,0 +1,100 @@
+package game
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// MaxSpectators is the maximum number of spectators of a game.
+const MaxSpectators = 50
+
+var (
+	// ErrTooManySpectators is returned when a game has no room for another spectator.
+	ErrTooManySpectators = errors.New("too many spectators: maximum 50 spectators allowed")
+	// ErrSpectatorCannotPlay is returned when a spectator tries to take part in the game.
+	ErrSpectatorCannotPlay = errors.New("spectators cannot play")
+)
+
+// Spectator is someone who watches a game without playing.
+// Spectators do not count as players or hunters.
+type Spectator struct {
+	UserID uuid.UUID `json:"userId"`
+	Name   string    `json:"name"`
+}
+
+// NewSpectator creates a new Spectator.
+func NewSpectator(userID uuid.UUID, name string) (*Spectator, error) {
+	if name == "" || len(name) > 20 {
+		return nil, ErrInvalidPlayerName
+	}
+
+	return &Spectator{
+		UserID: userID,
+		Name:   name,
+	}, nil
+}
+
+// AddSpectator adds a spectator to the game.
+// Unlike players, spectators may join a game that has already started.
+func (g *Game) AddSpectator(spectator *Spectator) error {
+	if g.Status == GameStatusFinished {
+		return ErrGameAlreadyFinished
+	}
+
+	if len(g.Spectators) >= MaxSpectators {
+		return ErrTooManySpectators
+	}
+
+	_, err := g.GetPlayer(spectator.UserID)
+	if err == nil || g.IsSpectator(spectator.UserID) {
+		return ErrPlayerAlreadyExists
+	}
+
+	g.Spectators = append(g.Spectators, spectator)
+	g.emit(EventSpectatorJoined, spectator.UserID, EventPayload{})
+	g.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// IsSpectator reports whether the user watches the game as a spectator.
+func (g *Game) IsSpectator(userID uuid.UUID) bool {
+	return slices.ContainsFunc(g.Spectators, func(spectator *Spectator) bool {
+		return spectator.UserID == userID
+	})
+}
+
+// SpectatorView returns a copy of the game as spectators see it, whose rounds can be changed
+// without changing the game. The hunters' photos of a round are hidden until they are ranked
+// or put to the vote.
+func (g *Game) SpectatorView() *Game {
+	view := *g
+	view.Rounds = make([]*Round, len(g.Rounds))
+
+	for index, round := range g.Rounds {
+		view.Rounds[index] = round.clone()
+
+		if len(round.Results) == 0 && !round.IsVoting() {
+			view.Rounds[index].HunterSubmissions = []*HunterSubmission{}
+		}
+	}
+
+	return &view
+}
+
+// removeSpectator removes the user from the spectators and reports whether they were one.
+func (g *Game) removeSpectator(userID uuid.UUID) bool {
+	if !g.IsSpectator(userID) {
+		return false
+	}
+
+	g.Spectators = slices.DeleteFunc(g.Spectators, func(spectator *Spectator) bool {
+		return spectator.UserID == userID
+	})
+	g.UpdatedAt = time.Now()
+
+	return true
+}
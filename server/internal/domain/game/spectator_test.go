@@ -0,0 +1,258 @@
+package game_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// addSpectator adds a new spectator to gameSession and returns their user ID.
+func addSpectator(t *testing.T, gameSession *game.Game) (uuid.UUID, error) {
+	t.Helper()
+
+	spectator, err := game.NewSpectator(uuid.New(), "spectator")
+	if err != nil {
+		t.Fatalf("NewSpectator() failed: %v", err)
+	}
+
+	err = gameSession.AddSpectator(spectator)
+	if err != nil {
+		return uuid.Nil, errors.Errorf("failed to add spectator: %w", err)
+	}
+
+	return spectator.UserID, nil
+}
+
+func TestGame_AddSpectator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prepare func(t *testing.T, gameSession *game.Game) uuid.UUID
+		wantErr error
+	}{
+		"waiting": {
+			func(*testing.T, *game.Game) uuid.UUID { return uuid.New() },
+			nil,
+		},
+		"in progress": {
+			func(t *testing.T, gameSession *game.Game) uuid.UUID {
+				t.Helper()
+
+				err := gameSession.Start(gameSession.Players[0].UserID)
+				if err != nil {
+					t.Fatalf("Start() failed: %v", err)
+				}
+
+				return uuid.New()
+			},
+			nil,
+		},
+		"finished": {
+			func(t *testing.T, gameSession *game.Game) uuid.UUID {
+				t.Helper()
+
+				err := gameSession.Start(gameSession.Players[0].UserID)
+				if err != nil {
+					t.Fatalf("Start() failed: %v", err)
+				}
+
+				err = gameSession.Finish()
+				if err != nil {
+					t.Fatalf("Finish() failed: %v", err)
+				}
+
+				return uuid.New()
+			},
+			game.ErrGameAlreadyFinished,
+		},
+		"player": {
+			func(_ *testing.T, gameSession *game.Game) uuid.UUID {
+				return gameSession.Players[1].UserID
+			},
+			game.ErrPlayerAlreadyExists,
+		},
+		"already watching": {
+			func(t *testing.T, gameSession *game.Game) uuid.UUID {
+				t.Helper()
+
+				userID, err := addSpectator(t, gameSession)
+				if err != nil {
+					t.Fatalf("AddSpectator() failed: %v", err)
+				}
+
+				return userID
+			},
+			game.ErrPlayerAlreadyExists,
+		},
+		"full": {
+			func(t *testing.T, gameSession *game.Game) uuid.UUID {
+				t.Helper()
+
+				for range game.MaxSpectators {
+					_, err := addSpectator(t, gameSession)
+					if err != nil {
+						t.Fatalf("AddSpectator() failed: %v", err)
+					}
+				}
+
+				return uuid.New()
+			},
+			game.ErrTooManySpectators,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession := newWaitingGame(t, game.MinPlayers)
+			userID := testCase.prepare(t, gameSession)
+
+			spectator, err := game.NewSpectator(userID, "spectator")
+			if err != nil {
+				t.Fatalf("NewSpectator() failed: %v", err)
+			}
+
+			err = gameSession.AddSpectator(spectator)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("AddSpectator() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil && !gameSession.IsSpectator(userID) {
+				t.Error("IsSpectator() = false after AddSpectator()")
+			}
+		})
+	}
+}
+
+func TestGame_SpectatorsDoNotPlay(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, game.MinPlayers-1)
+
+	spectatorIDs := make([]uuid.UUID, 0, game.MaxPlayers)
+
+	// 観戦者はプレイヤーの人数に数えない
+	for range game.MaxPlayers {
+		spectatorID, err := addSpectator(t, gameSession)
+		if err != nil {
+			t.Fatalf("AddSpectator() failed: %v", err)
+		}
+
+		spectatorIDs = append(spectatorIDs, spectatorID)
+	}
+
+	err := gameSession.Start(gameSession.Players[0].UserID)
+	if !errors.Is(err, game.ErrNotEnoughPlayers) {
+		t.Fatalf("Start() error = %v, want %v", err, game.ErrNotEnoughPlayers)
+	}
+
+	player, err := game.NewPlayer(spectatorIDs[0], "spectator", false, false)
+	if err != nil {
+		t.Fatalf("NewPlayer() failed: %v", err)
+	}
+
+	err = gameSession.AddPlayer(player)
+	if !errors.Is(err, game.ErrPlayerAlreadyExists) {
+		t.Errorf("AddPlayer() of a spectator error = %v, want %v", err, game.ErrPlayerAlreadyExists)
+	}
+
+	player, err = game.NewPlayer(uuid.New(), "player"+strconv.Itoa(game.MinPlayers), false, false)
+	if err != nil {
+		t.Fatalf("NewPlayer() failed: %v", err)
+	}
+
+	err = gameSession.AddPlayer(player)
+	if err != nil {
+		t.Fatalf("AddPlayer() failed: %v", err)
+	}
+
+	err = gameSession.Start(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(spectatorIDs[0])
+	if !errors.Is(err, game.ErrSpectatorCannotPlay) {
+		t.Fatalf("StartRound() with a spectator error = %v, want %v",
+			err, game.ErrSpectatorCannotPlay)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	round, err := gameSession.GetCurrentRound()
+	if err != nil {
+		t.Fatalf("GetCurrentRound() failed: %v", err)
+	}
+
+	if hunters := gameSession.CountHunters(round); hunters != game.MinPlayers-1 {
+		t.Errorf("CountHunters() = %d, want %d", hunters, game.MinPlayers-1)
+	}
+}
+
+func TestGame_SpectatorView(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+
+	round.HunterSubmissions = append(round.HunterSubmissions, &game.HunterSubmission{
+		UserID:  gameSession.Players[1].UserID,
+		ImageID: uuid.NewString(),
+	})
+
+	// 順位が決まるまでハンターの写真は見せない
+	view := gameSession.SpectatorView()
+	if len(view.Rounds[0].HunterSubmissions) != 0 {
+		t.Errorf("SpectatorView() shows %d photos before the ranking",
+			len(view.Rounds[0].HunterSubmissions))
+	}
+
+	if len(round.HunterSubmissions) != 1 {
+		t.Fatalf("SpectatorView() changed the game: %d photos", len(round.HunterSubmissions))
+	}
+
+	rankFirst(t, round, gameSession.Players[1].UserID)
+
+	view = gameSession.SpectatorView()
+	if len(view.Rounds[0].HunterSubmissions) != 1 {
+		t.Fatalf("SpectatorView() shows %d photos after the ranking, want 1",
+			len(view.Rounds[0].HunterSubmissions))
+	}
+
+	// 観戦者向けの写しを変えてもゲームは変わらない
+	view.Rounds[0].HunterSubmissions[0].ImageID = ""
+	view.Rounds[0].Results[0].Points = 0
+
+	if round.HunterSubmissions[0].ImageID == "" || round.Results[0].Points == 0 {
+		t.Error("changing SpectatorView() changed the photos or results of the game")
+	}
+}
+
+func TestGame_AnonymizePlayer_RemovesSpectator(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, game.MinPlayers)
+
+	spectatorID, err := addSpectator(t, gameSession)
+	if err != nil {
+		t.Fatalf("AddSpectator() failed: %v", err)
+	}
+
+	anonymousID, err := gameSession.AnonymizePlayer(spectatorID)
+	if err != nil {
+		t.Fatalf("AnonymizePlayer() failed: %v", err)
+	}
+
+	if anonymousID == uuid.Nil || gameSession.IsSpectator(spectatorID) ||
+		len(gameSession.Spectators) != 0 {
+		t.Errorf("AnonymizePlayer() = %v, spectators = %d, want the spectator removed",
+			anonymousID, len(gameSession.Spectators))
+	}
+}
@@ -0,0 +1,265 @@
+package game
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	// MinTeams is the minimum number of teams in team mode.
+	MinTeams = 2
+	// MaxTeams is the maximum number of teams, so that every team can have two players.
+	MaxTeams = MaxPlayers / 2
+	// maxTeamNameLength is the longest team name in bytes, like player names.
+	maxTeamNameLength = 20
+)
+
+var (
+	// ErrInvalidTeamCount is returned when there are too few or too many teams.
+	ErrInvalidTeamCount = errors.New("invalid team count: must be between 2 and 10")
+	// ErrInvalidTeamName is returned when a team name is invalid.
+	ErrInvalidTeamName = errors.New("invalid team name")
+	// ErrEmptyTeam is returned when a team has no members.
+	ErrEmptyTeam = errors.New("team has no members")
+	// ErrPlayerNotInTeam is returned when a player of a team game is not in exactly one team.
+	ErrPlayerNotInTeam = errors.New("every player must be in exactly one team")
+	// ErrTeamNotFound is returned when a team is not found.
+	ErrTeamNotFound = errors.New("team not found")
+)
+
+// Team is a group of players who hunt together in team mode.
+// A team is ranked by one photo per round, and its points are also credited to its members.
+type Team struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	MemberIDs   []uuid.UUID `json:"memberIds"`
+	TotalPoints int         `json:"totalPoints"`
+}
+
+// NewTeam creates a new Team.
+func NewTeam(name string, memberIDs []uuid.UUID) (*Team, error) {
+	if name == "" || len(name) > maxTeamNameLength {
+		return nil, ErrInvalidTeamName
+	}
+
+	if len(memberIDs) == 0 {
+		return nil, ErrEmptyTeam
+	}
+
+	teamID, err := uuid.NewV7()
+	if err != nil {
+		return nil, errors.Errorf("failed to generate team ID: %w", err)
+	}
+
+	return &Team{
+		ID:          teamID,
+		Name:        name,
+		MemberIDs:   slices.Clone(memberIDs),
+		TotalPoints: 0,
+	}, nil
+}
+
+// HasMember reports whether the user is a member of the team.
+func (t *Team) HasMember(userID uuid.UUID) bool {
+	return slices.Contains(t.MemberIDs, userID)
+}
+
+// IsTeamMode reports whether the players compete as teams.
+func (g *Game) IsTeamMode() bool {
+	return len(g.Teams) > 0
+}
+
+// SetTeams lets userID replace the teams of the game. Every player must be in exactly one team.
+// No teams returns the game to individual mode.
+func (g *Game) SetTeams(userID uuid.UUID, teams []*Team) error {
+	if g.Status != GameStatusWaiting {
+		return ErrGameAlreadyStarted
+	}
+
+	if len(teams) == 0 {
+		g.Teams = nil
+		g.emit(EventTeamsChanged, userID, EventPayload{Teams: clonePointers(g.Teams)})
+		g.UpdatedAt = time.Now()
+
+		return nil
+	}
+
+	if len(teams) < MinTeams || len(teams) > MaxTeams {
+		return ErrInvalidTeamCount
+	}
+
+	members := 0
+
+	for _, team := range teams {
+		for _, memberID := range team.MemberIDs {
+			_, err := g.GetPlayer(memberID)
+			if err != nil {
+				return errors.Errorf("%w: %s", err, memberID)
+			}
+		}
+
+		members += len(team.MemberIDs)
+	}
+
+	// 全員がどれか1つのチームにだけ入っていることを確かめる
+	for _, player := range g.Players {
+		count := 0
+
+		for _, team := range teams {
+			if team.HasMember(player.UserID) {
+				count++
+			}
+		}
+
+		if count != 1 {
+			return ErrPlayerNotInTeam
+		}
+	}
+
+	if members != len(g.Players) {
+		return ErrPlayerNotInTeam
+	}
+
+	g.Teams = teams
+	g.emit(EventTeamsChanged, userID, EventPayload{Teams: clonePointers(g.Teams)})
+	g.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// BalanceTeams lets userID split the players into count teams whose sizes differ by at most one.
+// Players are dealt to the teams in the order they joined.
+func (g *Game) BalanceTeams(userID uuid.UUID, count int) error {
+	if count < MinTeams || count > MaxTeams {
+		return ErrInvalidTeamCount
+	}
+
+	if len(g.Players) < count {
+		return ErrNotEnoughPlayers
+	}
+
+	members := make([][]uuid.UUID, count)
+	for index, player := range g.Players {
+		members[index%count] = append(members[index%count], player.UserID)
+	}
+
+	teams := make([]*Team, 0, count)
+
+	for index, memberIDs := range members {
+		team, err := NewTeam(fmt.Sprintf("Team %d", index+1), memberIDs)
+		if err != nil {
+			return err
+		}
+
+		teams = append(teams, team)
+	}
+
+	return g.SetTeams(userID, teams)
+}
+
+// GetTeam returns a team by ID.
+func (g *Game) GetTeam(teamID uuid.UUID) (*Team, error) {
+	for _, team := range g.Teams {
+		if team.ID == teamID {
+			return team, nil
+		}
+	}
+
+	return nil, ErrTeamNotFound
+}
+
+// TeamOf returns the team of a player.
+func (g *Game) TeamOf(userID uuid.UUID) (*Team, error) {
+	for _, team := range g.Teams {
+		if team.HasMember(userID) {
+			return team, nil
+		}
+	}
+
+	return nil, ErrTeamNotFound
+}
+
+// CountHunters returns how many photos the round waits for:
+// one for each hunter, or in team mode one for each team with a hunter.
+// The game master of the round does not hunt.
+func (g *Game) CountHunters(round *Round) int {
+	if !g.IsTeamMode() {
+		return len(g.Players) - 1
+	}
+
+	count := 0
+
+	for _, team := range g.Teams {
+		if slices.ContainsFunc(team.MemberIDs, func(memberID uuid.UUID) bool {
+			return memberID != round.GameMasterUserID
+		}) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// AddTeamSubmission adds the photo of a team to the round as the team's next attempt.
+// The team shares its attempts, whoever of its members takes the photos.
+func (r *Round) AddTeamSubmission(
+	teamID uuid.UUID,
+	submission *HunterSubmission,
+	attemptLimit int,
+) error {
+	submission.TeamID = teamID
+
+	return r.AddHunterSubmission(submission, attemptLimit)
+}
+
+// UpdateTeamPoints credits points to a team and to its members who hunted in the round.
+func (g *Game) UpdateTeamPoints(teamID uuid.UUID, points int, round *Round) error {
+	team, err := g.GetTeam(teamID)
+	if err != nil {
+		return err
+	}
+
+	team.TotalPoints += points
+
+	for _, memberID := range team.MemberIDs {
+		if memberID == round.GameMasterUserID {
+			continue
+		}
+
+		player, err := g.GetPlayer(memberID)
+		if err != nil {
+			return err
+		}
+
+		player.AddPoints(points)
+	}
+
+	g.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// GetFinalTeamRankings returns teams sorted by total points (descending).
+func (g *Game) GetFinalTeamRankings() []*Team {
+	rankings := slices.Clone(g.Teams)
+
+	slices.SortStableFunc(rankings, func(a, b *Team) int {
+		return cmp.Compare(b.TotalPoints, a.TotalPoints)
+	})
+
+	return rankings
+}
+
+// addToSmallestTeam puts a player who joins a team game into the team with the fewest members.
+func (g *Game) addToSmallestTeam(userID uuid.UUID) {
+	smallest := slices.MinFunc(g.Teams, func(a, b *Team) int {
+		return cmp.Compare(len(a.MemberIDs), len(b.MemberIDs))
+	})
+
+	smallest.MemberIDs = append(smallest.MemberIDs, userID)
+}
@@ -0,0 +1,292 @@
+package game_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newWaitingGame returns a game waiting to start with players players.
+func newWaitingGame(t *testing.T, players int) *game.Game {
+	t.Helper()
+
+	gameSession, err := game.NewGame(uuid.New(), 1, uuid.New(), game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index := range players {
+		player, err := game.NewPlayer(
+			uuid.New(),
+			"player"+strconv.Itoa(index),
+			index == 0,
+			index == 0,
+		)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	return gameSession
+}
+
+// newTeam returns a team of the players at indexes of gameSession.
+func newTeam(t *testing.T, gameSession *game.Game, name string, indexes ...int) *game.Team {
+	t.Helper()
+
+	memberIDs := make([]uuid.UUID, 0, len(indexes))
+	for _, index := range indexes {
+		memberIDs = append(memberIDs, gameSession.Players[index].UserID)
+	}
+
+	team, err := game.NewTeam(name, memberIDs)
+	if err != nil {
+		t.Fatalf("NewTeam() failed: %v", err)
+	}
+
+	return team
+}
+
+func TestGame_SetTeams(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		members [][]int
+		wantErr error
+	}{
+		"two teams":        {[][]int{{0, 1}, {2, 3}}, nil},
+		"uneven teams":     {[][]int{{0, 1, 2}, {3}}, nil},
+		"no teams":         {nil, nil},
+		"one team":         {[][]int{{0, 1, 2, 3}}, game.ErrInvalidTeamCount},
+		"player left out":  {[][]int{{0, 1}, {2}}, game.ErrPlayerNotInTeam},
+		"player twice":     {[][]int{{0, 1, 2}, {2, 3}}, game.ErrPlayerNotInTeam},
+		"duplicate member": {[][]int{{0, 1, 1}, {2, 3}}, game.ErrPlayerNotInTeam},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession := newWaitingGame(t, 4)
+
+			teams := make([]*game.Team, 0, len(testCase.members))
+			for index, indexes := range testCase.members {
+				teams = append(
+					teams,
+					newTeam(t, gameSession, "team"+strconv.Itoa(index), indexes...),
+				)
+			}
+
+			err := gameSession.SetTeams(gameSession.Players[0].UserID, teams)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("SetTeams() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			wantTeamMode := testCase.wantErr == nil && len(testCase.members) > 0
+			if gameSession.IsTeamMode() != wantTeamMode {
+				t.Errorf("IsTeamMode() = %v, want %v", gameSession.IsTeamMode(), wantTeamMode)
+			}
+		})
+	}
+}
+
+func TestGame_SetTeams_UnknownPlayer(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, 3)
+
+	stranger, err := game.NewTeam("strangers", []uuid.UUID{uuid.New()})
+	if err != nil {
+		t.Fatalf("NewTeam() failed: %v", err)
+	}
+
+	err = gameSession.SetTeams(
+		gameSession.Players[0].UserID,
+		[]*game.Team{newTeam(t, gameSession, "players", 0, 1, 2), stranger},
+	)
+	if !errors.Is(err, game.ErrPlayerNotFound) {
+		t.Errorf("SetTeams() error = %v, want %v", err, game.ErrPlayerNotFound)
+	}
+}
+
+func TestGame_BalanceTeams(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		players   int
+		teamCount int
+		wantSizes []int
+		wantErr   error
+	}{
+		"even":             {6, 2, []int{3, 3}, nil},
+		"uneven":           {7, 3, []int{3, 2, 2}, nil},
+		"one per team":     {3, 3, []int{1, 1, 1}, nil},
+		"more teams":       {3, 4, nil, game.ErrNotEnoughPlayers},
+		"one team":         {3, 1, nil, game.ErrInvalidTeamCount},
+		"too many teams":   {20, game.MaxTeams + 1, nil, game.ErrInvalidTeamCount},
+		"max teams of two": {20, game.MaxTeams, []int{2, 2, 2, 2, 2, 2, 2, 2, 2, 2}, nil},
+		"two players":      {2, 2, []int{1, 1}, nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession := newWaitingGame(t, testCase.players)
+
+			err := gameSession.BalanceTeams(gameSession.Players[0].UserID, testCase.teamCount)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("BalanceTeams() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			sizes := make([]int, 0, len(gameSession.Teams))
+			for _, team := range gameSession.Teams {
+				sizes = append(sizes, len(team.MemberIDs))
+			}
+
+			if !slices.Equal(sizes, testCase.wantSizes) {
+				t.Errorf("team sizes = %v, want %v", sizes, testCase.wantSizes)
+			}
+		})
+	}
+}
+
+func TestGame_AddPlayer_TeamMode(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, 3)
+
+	err := gameSession.SetTeams(gameSession.Players[0].UserID, []*game.Team{
+		newTeam(t, gameSession, "large", 0, 1),
+		newTeam(t, gameSession, "small", 2),
+	})
+	if err != nil {
+		t.Fatalf("SetTeams() failed: %v", err)
+	}
+
+	// 途中参加者は人数の少ないチームに入る
+	player, err := game.NewPlayer(uuid.New(), "late", false, false)
+	if err != nil {
+		t.Fatalf("NewPlayer() failed: %v", err)
+	}
+
+	err = gameSession.AddPlayer(player)
+	if err != nil {
+		t.Fatalf("AddPlayer() failed: %v", err)
+	}
+
+	team, err := gameSession.TeamOf(player.UserID)
+	if err != nil {
+		t.Fatalf("TeamOf() failed: %v", err)
+	}
+
+	if team.Name != "small" {
+		t.Errorf("TeamOf() = %q, want %q", team.Name, "small")
+	}
+}
+
+func TestGame_TeamRound(t *testing.T) {
+	t.Parallel()
+
+	gameSession := newWaitingGame(t, 5)
+
+	// 親(0)のいるチームにも、親以外のメンバーがいれば探す番がある
+	err := gameSession.SetTeams(gameSession.Players[0].UserID, []*game.Team{
+		newTeam(t, gameSession, "red", 0, 1),
+		newTeam(t, gameSession, "blue", 2, 3, 4),
+	})
+	if err != nil {
+		t.Fatalf("SetTeams() failed: %v", err)
+	}
+
+	err = gameSession.Start(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(gameSession.Players[0].UserID)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	round, err := gameSession.GetCurrentRound()
+	if err != nil {
+		t.Fatalf("GetCurrentRound() failed: %v", err)
+	}
+
+	if hunters := gameSession.CountHunters(round); hunters != 2 {
+		t.Errorf("CountHunters() = %d, want 2", hunters)
+	}
+
+	red, blue := gameSession.Teams[0], gameSession.Teams[1]
+
+	for _, submitted := range []struct {
+		team    *game.Team
+		wantErr error
+	}{
+		{blue, nil},
+		{blue, game.ErrNoAttemptsLeft},
+		{red, nil},
+	} {
+		submission, err := game.NewHunterSubmission(
+			submitted.team.MemberIDs[1],
+			uuid.NewString(),
+			10,
+			game.DefaultTurnSeconds,
+		)
+		if err != nil {
+			t.Fatalf("NewHunterSubmission() failed: %v", err)
+		}
+
+		err = round.AddTeamSubmission(submitted.team.ID, submission, game.DefaultAttemptLimit)
+		if !errors.Is(err, submitted.wantErr) {
+			t.Errorf("AddTeamSubmission(%s) error = %v, want %v",
+				submitted.team.Name, err, submitted.wantErr)
+		}
+	}
+
+	if !round.CheckAllHuntersSubmitted(gameSession.CountHunters(round)) {
+		t.Error("CheckAllHuntersSubmitted() = false, want true")
+	}
+
+	err = gameSession.UpdateTeamPoints(red.ID, game.FirstPlacePoints, round)
+	if err != nil {
+		t.Fatalf("UpdateTeamPoints() failed: %v", err)
+	}
+
+	err = gameSession.UpdateTeamPoints(blue.ID, game.SecondPlacePoints, round)
+	if err != nil {
+		t.Fatalf("UpdateTeamPoints() failed: %v", err)
+	}
+
+	// 親は自分のチームの得点を受け取らない
+	wantPoints := []int{
+		0,
+		game.FirstPlacePoints,
+		game.SecondPlacePoints,
+		game.SecondPlacePoints,
+		game.SecondPlacePoints,
+	}
+	for index, player := range gameSession.Players {
+		if player.TotalPoints != wantPoints[index] {
+			t.Errorf("player %d points = %d, want %d", index, player.TotalPoints, wantPoints[index])
+		}
+	}
+
+	rankings := gameSession.GetFinalTeamRankings()
+	if len(rankings) != 2 || rankings[0] != red || rankings[1] != blue {
+		t.Errorf("GetFinalTeamRankings() = %v, want [red blue]", rankings)
+	}
+}
@@ -0,0 +1,34 @@
+package game
+
+import "github.com/google/uuid"
+
+// RevealsPhotos reports whether everyone in the game can see the photos of the round.
+// Until the round is ranked, skipped or put to the vote, only its game master can.
+func (r *Round) RevealsPhotos() bool {
+	return r.IsCompleted() || r.IsVoting()
+}
+
+// CanSeeRoundPhoto reports whether the user can see the photos taken in a round.
+// Photos of rounds that do not exist are hidden.
+func (g *Game) CanSeeRoundPhoto(userID uuid.UUID, roundNumber int) bool {
+	for _, round := range g.Rounds {
+		if round.RoundNumber == roundNumber {
+			return round.RevealsPhotos() || round.GameMasterUserID == userID
+		}
+	}
+
+	return false
+}
+
+// CanSeeScene reports whether the user can see a scene of the room's pool.
+// A scene is hidden until the round that uses it reveals its photos, except from
+// the game master of that round.
+func (g *Game) CanSeeScene(userID uuid.UUID, imageID string) bool {
+	for _, round := range g.Rounds {
+		if round.GameMasterImageID == imageID {
+			return round.RevealsPhotos() || round.GameMasterUserID == userID
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+package game_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+)
+
+func TestGame_CanSeeRoundPhoto(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ranked     bool
+		viewer     func(gameSession *game.Game) uuid.UUID
+		round      int
+		wantCanSee bool
+	}{
+		"game master in the hunters' turn": {
+			false,
+			func(gameSession *game.Game) uuid.UUID { return gameSession.Rounds[0].GameMasterUserID },
+			1,
+			true,
+		},
+		"hunter in the hunters' turn": {
+			false,
+			func(gameSession *game.Game) uuid.UUID { return gameSession.Players[1].UserID },
+			1,
+			false,
+		},
+		"hunter after the ranking": {
+			true,
+			func(gameSession *game.Game) uuid.UUID { return gameSession.Players[1].UserID },
+			1,
+			true,
+		},
+		"round not started": {
+			true,
+			func(gameSession *game.Game) uuid.UUID { return gameSession.Rounds[0].GameMasterUserID },
+			2,
+			false,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession, round := newHuntingGame(t, time.Now())
+			if testCase.ranked {
+				rankFirst(t, round, gameSession.Players[1].UserID)
+			}
+
+			got := gameSession.CanSeeRoundPhoto(testCase.viewer(gameSession), testCase.round)
+			if got != testCase.wantCanSee {
+				t.Errorf("CanSeeRoundPhoto() = %v, want %v", got, testCase.wantCanSee)
+			}
+		})
+	}
+}
+
+func TestGame_CanSeeScene(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+	hunterID := gameSession.Players[1].UserID
+
+	if gameSession.CanSeeScene(hunterID, round.GameMasterImageID) {
+		t.Error("CanSeeScene() = true for a hunter in the hunters' turn, want false")
+	}
+
+	if !gameSession.CanSeeScene(round.GameMasterUserID, round.GameMasterImageID) {
+		t.Error("CanSeeScene() = false for the game master, want true")
+	}
+
+	if gameSession.CanSeeScene(round.GameMasterUserID, uuid.NewString()) {
+		t.Error("CanSeeScene() = true for an unused scene, want false")
+	}
+
+	rankFirst(t, round, hunterID)
+
+	if !gameSession.CanSeeScene(hunterID, round.GameMasterImageID) {
+		t.Error("CanSeeScene() = false for a hunter after the ranking, want true")
+	}
+}
@@ -0,0 +1,203 @@
+package game
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// RankingMode is who ranks the hunters' photos of a round.
+type RankingMode int
+
+const (
+	// RankingGameMaster lets the game master rank the photos.
+	RankingGameMaster RankingMode = iota
+	// RankingAudience ranks the photos by the votes of the players and spectators
+	// who did not take them.
+	RankingAudience
+)
+
+var (
+	// ErrNotVoting is returned when votes are handled while the round is not open for voting.
+	ErrNotVoting = errors.New("round is not open for voting")
+	// ErrVotingClosed is returned when a vote arrives after the voting time.
+	ErrVotingClosed = errors.New("voting time is over")
+	// ErrVotingOpen is returned when voting is closed before everyone has voted or the time is over.
+	ErrVotingOpen = errors.New("voting is still open")
+	// ErrNotVoter is returned when the user has no photo they can vote on.
+	ErrNotVoter = errors.New("user cannot vote in the round")
+	// ErrInvalidBallot is returned when a ballot does not rank every photo the voter can vote on once.
+	ErrInvalidBallot = errors.New(
+		"invalid ballot: must rank every photo the voter can vote on once",
+	)
+	// ErrAlreadyVoted is returned when a user votes a second time in a round.
+	ErrAlreadyVoted = errors.New("user has already voted in the round")
+	// ErrAudienceRanking is returned when the game master ranks photos the audience ranks.
+	ErrAudienceRanking = errors.New("photos are ranked by the audience")
+)
+
+// Ballot is a voter's ranking of the hunters' photos of a round, best first.
+// Photos are identified by their hunter, or by their team in team mode.
+type Ballot struct {
+	VoterID uuid.UUID   `json:"voterId"`
+	Ranking []uuid.UUID `json:"ranking"`
+}
+
+// NewBallot creates the ballot of a voter, who must rank each of candidates once.
+func NewBallot(voterID uuid.UUID, ranking, candidates []uuid.UUID) (*Ballot, error) {
+	sorted := slices.Clone(ranking)
+	slices.SortFunc(sorted, compareUUID)
+
+	expected := slices.Clone(candidates)
+	slices.SortFunc(expected, compareUUID)
+
+	if !slices.Equal(sorted, expected) {
+		return nil, ErrInvalidBallot
+	}
+
+	return &Ballot{
+		VoterID: voterID,
+		Ranking: slices.Clone(ranking),
+	}, nil
+}
+
+// StartVoting ends the hunters' turn and opens the round for voting until endsAt.
+func (r *Round) StartVoting(endsAt time.Time) error {
+	err := r.StartWaitingForSelection()
+	if err != nil {
+		return err
+	}
+
+	r.VotingEndsAt = endsAt
+
+	return nil
+}
+
+// IsVoting reports whether the audience is ranking the photos of the round.
+func (r *Round) IsVoting() bool {
+	return r.TurnStatus == TurnStatusWaitingForSelection &&
+		!r.VotingEndsAt.IsZero() &&
+		len(r.Results) == 0 &&
+		!r.Skipped
+}
+
+// CastVote accepts a vote of voterID arriving at now.
+// The ballot itself is stored apart from the game.
+func (r *Round) CastVote(voterID uuid.UUID, now time.Time) error {
+	if !r.IsVoting() {
+		return ErrNotVoting
+	}
+
+	if now.After(r.VotingEndsAt) {
+		return ErrVotingClosed
+	}
+
+	r.emit(EventVoteCast, voterID, EventPayload{})
+
+	return nil
+}
+
+// CloseVoting lets userID close voting at now, which is allowed once every voter has voted
+// or the voting time is over. userID is uuid.Nil when voting closes by itself.
+// The round stays open for voting until it is ranked by the votes.
+func (r *Round) CloseVoting(userID uuid.UUID, now time.Time, ballots, voters int) error {
+	if !r.IsVoting() {
+		return ErrNotVoting
+	}
+
+	if ballots < voters && !now.After(r.VotingEndsAt) {
+		return ErrVotingOpen
+	}
+
+	r.emit(EventVotingClosed, userID, EventPayload{Voting: &VotingPayload{Ballots: ballots}})
+
+	return nil
+}
+
+// BallotCandidates returns the photos a player or spectator votes on in the round,
+// which are all final photos but their own, or their team's in team mode.
+func (g *Game) BallotCandidates(round *Round, voterID uuid.UUID) ([]uuid.UUID, error) {
+	_, err := g.GetPlayer(voterID)
+	if err != nil && !g.IsSpectator(voterID) {
+		return nil, ErrNotVoter
+	}
+
+	ownID := voterID
+	if team, err := g.TeamOf(voterID); err == nil {
+		ownID = team.ID
+	}
+
+	candidates := slices.DeleteFunc(round.RankedHunterIDs(), func(hunterID uuid.UUID) bool {
+		return hunterID == ownID
+	})
+
+	if len(candidates) == 0 {
+		return nil, ErrNotVoter
+	}
+
+	return candidates, nil
+}
+
+// CountVoters returns how many players and spectators can vote in the round.
+func (g *Game) CountVoters(round *Round) int {
+	voters := 0
+
+	for _, player := range g.Players {
+		if _, err := g.BallotCandidates(round, player.UserID); err == nil {
+			voters++
+		}
+	}
+
+	for _, spectator := range g.Spectators {
+		if _, err := g.BallotCandidates(round, spectator.UserID); err == nil {
+			voters++
+		}
+	}
+
+	return voters
+}
+
+// BordaRanks ranks candidates by the Borda count of ballots.
+// On a ballot ranking n photos the first gets n-1 points and the last none.
+// Candidates with the same points share a rank.
+func BordaRanks(candidates []uuid.UUID, ballots []*Ballot) map[uuid.UUID]int {
+	scores := make(map[uuid.UUID]int, len(candidates))
+	for _, candidate := range candidates {
+		scores[candidate] = 0
+	}
+
+	for _, ballot := range ballots {
+		for position, candidate := range ballot.Ranking {
+			if _, ok := scores[candidate]; ok {
+				scores[candidate] += len(ballot.Ranking) - 1 - position
+			}
+		}
+	}
+
+	ordered := slices.Clone(candidates)
+	slices.SortStableFunc(ordered, func(left, right uuid.UUID) int {
+		return cmp.Compare(scores[right], scores[left])
+	})
+
+	ranks := make(map[uuid.UUID]int, len(ordered))
+
+	for index, candidate := range ordered {
+		if index > 0 && scores[candidate] == scores[ordered[index-1]] {
+			ranks[candidate] = ranks[ordered[index-1]]
+
+			continue
+		}
+
+		ranks[candidate] = min(index+1, MaxRank)
+	}
+
+	return ranks
+}
+
+// compareUUID orders UUIDs by their bytes.
+func compareUUID(left, right uuid.UUID) int {
+	return slices.Compare(left[:], right[:])
+}
@@ -0,0 +1,220 @@
+package game_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newVotingGame returns a game of three players whose first round is open for voting until endsAt,
+// with photos of the two hunters.
+func newVotingGame(t *testing.T, endsAt time.Time) (*game.Game, *game.Round) {
+	t.Helper()
+
+	gameSession, round := newHuntingGame(t, time.Now())
+
+	for _, player := range gameSession.Players[1:] {
+		round.HunterSubmissions = append(round.HunterSubmissions, &game.HunterSubmission{
+			UserID:  player.UserID,
+			ImageID: uuid.NewString(),
+		})
+	}
+
+	err := round.StartVoting(endsAt)
+	if err != nil {
+		t.Fatalf("StartVoting() failed: %v", err)
+	}
+
+	return gameSession, round
+}
+
+func TestNewBallot(t *testing.T) {
+	t.Parallel()
+
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	candidates := []uuid.UUID{first, second, third}
+
+	tests := map[string]struct {
+		ranking []uuid.UUID
+		wantErr error
+	}{
+		"in order":  {[]uuid.UUID{first, second, third}, nil},
+		"reordered": {[]uuid.UUID{third, first, second}, nil},
+		"missing":   {[]uuid.UUID{first, second}, game.ErrInvalidBallot},
+		"duplicate": {[]uuid.UUID{first, first, second}, game.ErrInvalidBallot},
+		"unknown":   {[]uuid.UUID{first, second, uuid.New()}, game.ErrInvalidBallot},
+		"extra":     {[]uuid.UUID{first, second, third, uuid.New()}, game.ErrInvalidBallot},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ballot, err := game.NewBallot(uuid.New(), testCase.ranking, candidates)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewBallot() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr == nil && !slices.Equal(ballot.Ranking, testCase.ranking) {
+				t.Errorf("NewBallot() ranking = %v, want %v", ballot.Ranking, testCase.ranking)
+			}
+		})
+	}
+}
+
+func TestBordaRanks(t *testing.T) {
+	t.Parallel()
+
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	candidates := []uuid.UUID{first, second, third}
+
+	tests := map[string]struct {
+		rankings [][]uuid.UUID
+		want     map[uuid.UUID]int
+	}{
+		"no votes": {
+			nil,
+			map[uuid.UUID]int{first: 1, second: 1, third: 1},
+		},
+		"agreed": {
+			[][]uuid.UUID{{first, second, third}, {first, second, third}},
+			map[uuid.UUID]int{first: 1, second: 2, third: 3},
+		},
+		"majority": {
+			[][]uuid.UUID{{second, first, third}, {second, third, first}, {first, second, third}},
+			map[uuid.UUID]int{first: 2, second: 1, third: 3},
+		},
+		"tied": {
+			[][]uuid.UUID{{first, second, third}, {second, first, third}},
+			map[uuid.UUID]int{first: 1, second: 1, third: 3},
+		},
+		"partial ballots": {
+			// 自分の写真を除いた投票は候補が少ない
+			[][]uuid.UUID{{second, third}, {first, third}, {first, second}},
+			map[uuid.UUID]int{first: 1, second: 2, third: 3},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ballots := make([]*game.Ballot, len(testCase.rankings))
+			for index, ranking := range testCase.rankings {
+				ballots[index] = &game.Ballot{VoterID: uuid.New(), Ranking: ranking}
+			}
+
+			ranks := game.BordaRanks(candidates, ballots)
+			for candidate, want := range testCase.want {
+				if ranks[candidate] != want {
+					t.Errorf("BordaRanks() rank = %d, want %d", ranks[candidate], want)
+				}
+			}
+		})
+	}
+}
+
+func TestGame_BallotCandidates(t *testing.T) {
+	t.Parallel()
+
+	gameSession, round := newVotingGame(t, time.Now().Add(time.Minute))
+
+	spectatorID, err := addSpectator(t, gameSession)
+	if err != nil {
+		t.Fatalf("AddSpectator() failed: %v", err)
+	}
+
+	gameMasterID := gameSession.Players[0].UserID
+	hunterID := gameSession.Players[1].UserID
+	otherHunterID := gameSession.Players[2].UserID
+
+	tests := map[string]struct {
+		voterID uuid.UUID
+		want    []uuid.UUID
+		wantErr error
+	}{
+		"game master": {gameMasterID, []uuid.UUID{hunterID, otherHunterID}, nil},
+		"hunter":      {hunterID, []uuid.UUID{otherHunterID}, nil},
+		"spectator":   {spectatorID, []uuid.UUID{hunterID, otherHunterID}, nil},
+		"outsider":    {uuid.New(), nil, game.ErrNotVoter},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			candidates, err := gameSession.BallotCandidates(round, testCase.voterID)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("BallotCandidates() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if !slices.Equal(candidates, testCase.want) {
+				t.Errorf("BallotCandidates() = %v, want %v", candidates, testCase.want)
+			}
+		})
+	}
+
+	if voters := gameSession.CountVoters(round); voters != 4 {
+		t.Errorf("CountVoters() = %d, want 4", voters)
+	}
+}
+
+func TestRound_CloseVoting(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := map[string]struct {
+		endsAt       time.Time
+		ballots      int
+		ranked       bool
+		wantVoteErr  error
+		wantCloseErr error
+	}{
+		"open":           {now.Add(time.Minute), 1, false, nil, game.ErrVotingOpen},
+		"everyone voted": {now.Add(time.Minute), 3, false, nil, nil},
+		"time over":      {now.Add(-time.Second), 1, false, game.ErrVotingClosed, nil},
+		"ranked":         {now.Add(time.Minute), 3, true, game.ErrNotVoting, game.ErrNotVoting},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gameSession, round := newVotingGame(t, testCase.endsAt)
+
+			if testCase.ranked {
+				rankFirst(t, round, gameSession.Players[1].UserID)
+			}
+
+			voterID := gameSession.Players[0].UserID
+
+			err := round.CastVote(voterID, now)
+			if !errors.Is(err, testCase.wantVoteErr) {
+				t.Errorf("CastVote() error = %v, want %v", err, testCase.wantVoteErr)
+			}
+
+			err = round.CloseVoting(voterID, now, testCase.ballots, 3)
+			if !errors.Is(err, testCase.wantCloseErr) {
+				t.Errorf("CloseVoting() error = %v, want %v", err, testCase.wantCloseErr)
+			}
+		})
+	}
+}
+
+func TestGame_SpectatorView_Voting(t *testing.T) {
+	t.Parallel()
+
+	gameSession, _ := newVotingGame(t, time.Now().Add(time.Minute))
+
+	// 観戦者も投票するため、投票中は写真を見せる
+	view := gameSession.SpectatorView()
+	if len(view.Rounds[0].HunterSubmissions) != 2 {
+		t.Errorf("SpectatorView() shows %d photos while voting, want 2",
+			len(view.Rounds[0].HunterSubmissions))
+	}
+}
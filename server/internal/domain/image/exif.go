@@ -0,0 +1,243 @@
+package image
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// GPS is the position where a photo was taken, in degrees.
+type GPS struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Orientation is the EXIF orientation of a photo (TIFF tag 0x0112).
+// Phones store the sensor image as-is and record how it must be rotated for display.
+type Orientation int
+
+const (
+	// OrientationNormal needs no transformation.
+	OrientationNormal Orientation = 1
+	// OrientationFlipHorizontal is mirrored left to right.
+	OrientationFlipHorizontal Orientation = 2
+	// OrientationRotate180 is upside down.
+	OrientationRotate180 Orientation = 3
+	// OrientationFlipVertical is mirrored top to bottom.
+	OrientationFlipVertical Orientation = 4
+	// OrientationTranspose is mirrored along the top-left to bottom-right diagonal.
+	OrientationTranspose Orientation = 5
+	// OrientationRotate90 must be rotated 90 degrees clockwise.
+	OrientationRotate90 Orientation = 6
+	// OrientationTransverse is mirrored along the top-right to bottom-left diagonal.
+	OrientationTransverse Orientation = 7
+	// OrientationRotate270 must be rotated 90 degrees counterclockwise.
+	OrientationRotate270 Orientation = 8
+)
+
+const (
+	jpegMarkerPrefix   = 0xFF
+	jpegMarkerSOI      = 0xD8
+	jpegMarkerAPP1     = 0xE1
+	jpegMarkerSOS      = 0xDA
+	exifOrientationTag = 0x0112
+	exifGPSInfoTag     = 0x8825
+	gpsLatitudeRefTag  = 0x0001
+	gpsLatitudeTag     = 0x0002
+	gpsLongitudeRefTag = 0x0003
+	gpsLongitudeTag    = 0x0004
+	tiffTypeRational   = 5
+	tiffRationalSize   = 8
+	tiffHeaderSize     = 8
+	ifdEntrySize       = 12
+	// exifHeader prefixes the TIFF structure inside a JPEG APP1 segment.
+	exifHeader = "Exif\x00\x00"
+)
+
+// ReadOrientation returns the EXIF orientation of JPEG data.
+// It returns OrientationNormal when the data has no valid orientation tag.
+func ReadOrientation(data []byte) Orientation {
+	tiff := readEXIF(data)
+
+	order := tiffOrder(tiff)
+	if order == nil {
+		return OrientationNormal
+	}
+
+	entry := ifdEntry(tiff, order, int(order.Uint32(tiff[4:8])), exifOrientationTag)
+	if entry == nil {
+		return OrientationNormal
+	}
+
+	// SHORT型の値はvalueフィールドの先頭2バイトに格納される
+	orientation := Orientation(order.Uint16(entry[8:10]))
+	if orientation < OrientationNormal || orientation > OrientationRotate270 {
+		return OrientationNormal
+	}
+
+	return orientation
+}
+
+// ReadGPS returns the EXIF GPS position of JPEG data.
+// It returns false when the data has no valid GPS position.
+// The position must be read before Normalize, which drops it.
+func ReadGPS(data []byte) (GPS, bool) {
+	tiff := readEXIF(data)
+
+	order := tiffOrder(tiff)
+	if order == nil {
+		return GPS{}, false
+	}
+
+	pointer := ifdEntry(tiff, order, int(order.Uint32(tiff[4:8])), exifGPSInfoTag)
+	if pointer == nil {
+		return GPS{}, false
+	}
+
+	gpsOffset := int(order.Uint32(pointer[8:12]))
+
+	latitude, found := readGPSCoordinate(
+		tiff,
+		order,
+		gpsOffset,
+		gpsLatitudeRefTag,
+		gpsLatitudeTag,
+		'S',
+	)
+	if !found || latitude < -90 || latitude > 90 {
+		return GPS{}, false
+	}
+
+	longitude, found := readGPSCoordinate(
+		tiff,
+		order,
+		gpsOffset,
+		gpsLongitudeRefTag,
+		gpsLongitudeTag,
+		'W',
+	)
+	if !found || longitude < -180 || longitude > 180 {
+		return GPS{}, false
+	}
+
+	return GPS{Latitude: latitude, Longitude: longitude}, true
+}
+
+// readEXIF returns the TIFF structure of the EXIF segment of JPEG data, or nil if there is none.
+func readEXIF(data []byte) []byte {
+	if len(data) < 2 || data[0] != jpegMarkerPrefix || data[1] != jpegMarkerSOI {
+		return nil
+	}
+
+	offset := 2
+
+	for offset+4 <= len(data) {
+		if data[offset] != jpegMarkerPrefix {
+			return nil
+		}
+
+		marker := data[offset+1]
+		if marker == jpegMarkerSOS {
+			// 画像データ以降にメタデータは存在しない
+			return nil
+		}
+
+		segmentLength := int(binary.BigEndian.Uint16(data[offset+2 : offset+4]))
+
+		segmentEnd := offset + 2 + segmentLength
+		if segmentLength < 2 || segmentEnd > len(data) {
+			return nil
+		}
+
+		segment := data[offset+4 : segmentEnd]
+		if marker == jpegMarkerAPP1 && bytes.HasPrefix(segment, []byte(exifHeader)) {
+			return segment[len(exifHeader):]
+		}
+
+		offset = segmentEnd
+	}
+
+	return nil
+}
+
+// tiffOrder returns the byte order of a TIFF structure, or nil if it is not one.
+func tiffOrder(tiff []byte) binary.ByteOrder {
+	if len(tiff) < tiffHeaderSize {
+		return nil
+	}
+
+	switch string(tiff[:2]) {
+	case "II":
+		return binary.LittleEndian
+	case "MM":
+		return binary.BigEndian
+	default:
+		return nil
+	}
+}
+
+// ifdEntry returns the entry of tag in the IFD at ifdOffset of a TIFF structure,
+// or nil if the IFD is invalid or has no such entry.
+func ifdEntry(tiff []byte, order binary.ByteOrder, ifdOffset int, tag uint16) []byte {
+	if ifdOffset < tiffHeaderSize || ifdOffset+2 > len(tiff) {
+		return nil
+	}
+
+	entryCount := int(order.Uint16(tiff[ifdOffset : ifdOffset+2]))
+
+	for i := range entryCount {
+		entry := ifdOffset + 2 + i*ifdEntrySize
+		if entry+ifdEntrySize > len(tiff) {
+			return nil
+		}
+
+		if order.Uint16(tiff[entry:entry+2]) == tag {
+			return tiff[entry : entry+ifdEntrySize]
+		}
+	}
+
+	return nil
+}
+
+// readGPSCoordinate reads a latitude or longitude from the GPS IFD at gpsOffset.
+// The coordinate is negative when its reference is negativeRef, such as 'S' for latitudes.
+func readGPSCoordinate(
+	tiff []byte,
+	order binary.ByteOrder,
+	gpsOffset int,
+	refTag, valueTag uint16,
+	negativeRef byte,
+) (float64, bool) {
+	ref := ifdEntry(tiff, order, gpsOffset, refTag)
+	value := ifdEntry(tiff, order, gpsOffset, valueTag)
+
+	if ref == nil || value == nil ||
+		order.Uint16(value[2:4]) != tiffTypeRational || order.Uint32(value[4:8]) != 3 {
+		return 0, false
+	}
+
+	// 度・分・秒の3つのRATIONALは、valueフィールドが指す位置に格納される
+	start := int(order.Uint32(value[8:12]))
+	if start < tiffHeaderSize || start+3*tiffRationalSize > len(tiff) {
+		return 0, false
+	}
+
+	coordinate := 0.0
+
+	for index, divisor := range []float64{1, 60, 3600} {
+		rational := tiff[start+index*tiffRationalSize : start+(index+1)*tiffRationalSize]
+
+		denominator := order.Uint32(rational[4:8])
+		if denominator == 0 {
+			return 0, false
+		}
+
+		coordinate += float64(order.Uint32(rational[0:4])) / float64(denominator) / divisor
+	}
+
+	// ASCII型の参照はvalueフィールドの先頭に格納される
+	if ref[8] == negativeRef {
+		coordinate = -coordinate
+	}
+
+	return coordinate, true
+}
@@ -0,0 +1,208 @@
+package image
+
+import (
+	"bytes"
+	"cmp"
+	"image"
+	"image/color"
+	"slices"
+
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// Color is a basic color name found in a photo.
+type Color string
+
+// Basic colors of a photo.
+const (
+	ColorBlack  Color = "black"
+	ColorWhite  Color = "white"
+	ColorGray   Color = "gray"
+	ColorRed    Color = "red"
+	ColorOrange Color = "orange"
+	ColorBrown  Color = "brown"
+	ColorYellow Color = "yellow"
+	ColorGreen  Color = "green"
+	ColorBlue   Color = "blue"
+	ColorPurple Color = "purple"
+	ColorPink   Color = "pink"
+)
+
+const (
+	// featureSamples is how many pixels are sampled along the longer side of a photo.
+	featureSamples = 64
+	// maxDominantColors is how many of the most common colors are reported.
+	maxDominantColors = 3
+	// skyRatio is the share of sky-like pixels in the upper third of an outdoor photo.
+	skyRatio = 0.35
+	// plantRatio is the share of green pixels in an outdoor photo without visible sky.
+	plantRatio = 0.3
+)
+
+// Features are simple features of a photo found without AI.
+type Features struct {
+	// DominantColors are the most common colors, most common first.
+	DominantColors []Color
+	// TopColor and BottomColor are the most common colors of the upper and lower thirds.
+	TopColor    Color
+	BottomColor Color
+	// Brightness is the average brightness of the pixels from 0 (black) to 1 (white).
+	Brightness float64
+	// Outdoor is a guess from visible sky and plants.
+	Outdoor bool
+}
+
+// ExtractFeatures finds the colors and brightness of a photo and guesses whether it is outdoors.
+func ExtractFeatures(data []byte) (*Features, error) {
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := decoded.Bounds()
+	if bounds.Empty() {
+		return nil, ErrEmptyData
+	}
+
+	step := max(1, max(bounds.Dx(), bounds.Dy())/featureSamples)
+
+	all := make(map[Color]int)
+	top := make(map[Color]int)
+	bottom := make(map[Color]int)
+
+	var (
+		luminance float64
+		samples   int
+		topTotal  int
+		sky       int
+	)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		row := 3 * (y - bounds.Min.Y) / bounds.Dy()
+
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			name, value := classify(decoded.At(x, y))
+
+			all[name]++
+			samples++
+			luminance += value
+
+			switch row {
+			case 0:
+				top[name]++
+				topTotal++
+
+				if isSky(name, value) {
+					sky++
+				}
+			case 2:
+				bottom[name]++
+			}
+		}
+	}
+
+	dominant := mostCommon(all)
+
+	return &Features{
+		DominantColors: dominant[:min(len(dominant), maxDominantColors)],
+		TopColor:       first(mostCommon(top), dominant[0]),
+		BottomColor:    first(mostCommon(bottom), dominant[0]),
+		Brightness:     luminance / float64(samples),
+		Outdoor: float64(sky) > skyRatio*float64(topTotal) ||
+			float64(all[ColorGreen]) > plantRatio*float64(samples),
+	}, nil
+}
+
+// classify returns the basic color name and the brightness (0 to 1) of a pixel.
+func classify(pixel color.Color) (Color, float64) {
+	red, green, blue, _ := pixel.RGBA()
+	high := float64(max(red, green, blue)) / 0xffff
+	low := float64(min(red, green, blue)) / 0xffff
+
+	if high < 0.2 {
+		return ColorBlack, high
+	}
+
+	if high-low < 0.15*high {
+		if high > 0.8 {
+			return ColorWhite, high
+		}
+
+		return ColorGray, high
+	}
+
+	hue := hueOf(float64(red), float64(green), float64(blue))
+
+	switch {
+	case hue < 15 || hue >= 345:
+		return ColorRed, high
+	case hue < 45 && high < 0.6:
+		return ColorBrown, high
+	case hue < 40:
+		return ColorOrange, high
+	case hue < 70:
+		return ColorYellow, high
+	case hue < 170:
+		return ColorGreen, high
+	case hue < 260:
+		return ColorBlue, high
+	case hue < 290:
+		return ColorPurple, high
+	default:
+		return ColorPink, high
+	}
+}
+
+// hueOf returns the hue of a color in degrees.
+func hueOf(red, green, blue float64) float64 {
+	high := max(red, green, blue)
+	chroma := high - min(red, green, blue)
+
+	var hue float64
+
+	switch high {
+	case red:
+		hue = (green - blue) / chroma
+	case green:
+		hue = (blue-red)/chroma + 2
+	default:
+		hue = (red-green)/chroma + 4
+	}
+
+	hue *= 60
+	if hue < 0 {
+		hue += 360
+	}
+
+	return hue
+}
+
+// isSky reports whether a pixel looks like clear or cloudy sky.
+// Cloudy sky must be almost pure white so that white walls and ceilings are not taken for it.
+func isSky(name Color, brightness float64) bool {
+	return (name == ColorBlue && brightness > 0.5) || (name == ColorWhite && brightness > 0.9)
+}
+
+// mostCommon returns the colors by count, most common first.
+// Colors with the same count are ordered by name so that the result is deterministic.
+func mostCommon(counts map[Color]int) []Color {
+	colors := make([]Color, 0, len(counts))
+	for name := range counts {
+		colors = append(colors, name)
+	}
+
+	slices.SortFunc(colors, func(a, b Color) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+
+	return colors
+}
+
+// first returns the first color, or fallback when there is none.
+func first(colors []Color, fallback Color) Color {
+	if len(colors) == 0 {
+		return fallback
+	}
+
+	return colors[0]
+}
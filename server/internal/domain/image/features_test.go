@@ -0,0 +1,137 @@
+package image_test
+
+import (
+	goimage "image"
+	"image/color"
+	"slices"
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+)
+
+// bandedImage returns an image with top, middle and bottom colors in horizontal thirds.
+func bandedImage(top, middle, bottom color.RGBA) goimage.Image {
+	const size = 90
+
+	img := goimage.NewRGBA(goimage.Rect(0, 0, size, size))
+
+	for y := range size {
+		band := []color.RGBA{top, middle, bottom}[3*y/size]
+		for x := range size {
+			img.Set(x, y, band)
+		}
+	}
+
+	return img
+}
+
+func TestExtractFeatures(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sky   = color.RGBA{R: 135, G: 206, B: 235, A: 255}
+		grass = color.RGBA{R: 40, G: 160, B: 40, A: 255}
+		road  = color.RGBA{R: 128, G: 128, B: 128, A: 255}
+		wall  = color.RGBA{R: 220, G: 220, B: 220, A: 255}
+		floor = color.RGBA{R: 100, G: 60, B: 30, A: 255}
+		night = color.RGBA{R: 20, G: 20, B: 30, A: 255}
+		cloud = color.RGBA{R: 250, G: 250, B: 250, A: 255}
+	)
+
+	tests := map[string]struct {
+		img            goimage.Image
+		wantColors     []image.Color
+		wantTop        image.Color
+		wantBottom     image.Color
+		wantBrightness float64
+		wantOutdoor    bool
+	}{
+		"blue sky over a road and grass": {
+			bandedImage(sky, road, grass),
+			[]image.Color{image.ColorBlue, image.ColorGray, image.ColorGreen},
+			image.ColorBlue,
+			image.ColorGreen,
+			(235.0 + 128 + 160) / 3 / 255,
+			true,
+		},
+		"cloudy sky over a road": {
+			bandedImage(cloud, road, road),
+			[]image.Color{image.ColorGray, image.ColorWhite},
+			image.ColorWhite,
+			image.ColorGray,
+			(250.0 + 128 + 128) / 3 / 255,
+			true,
+		},
+		"white walls and a wooden floor": {
+			bandedImage(wall, wall, floor),
+			[]image.Color{image.ColorWhite, image.ColorBrown},
+			image.ColorWhite,
+			image.ColorBrown,
+			(220.0 + 220 + 100) / 3 / 255,
+			false,
+		},
+		"dark room": {
+			bandedImage(night, night, night),
+			[]image.Color{image.ColorBlack},
+			image.ColorBlack,
+			image.ColorBlack,
+			30.0 / 255,
+			false,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			features, err := image.ExtractFeatures(mustEncodePNG(t, testCase.img))
+			if err != nil {
+				t.Fatalf("ExtractFeatures() failed: %v", err)
+			}
+
+			if !slices.Equal(features.DominantColors, testCase.wantColors) {
+				t.Errorf(
+					"ExtractFeatures() colors = %v, want %v",
+					features.DominantColors,
+					testCase.wantColors,
+				)
+			}
+
+			if features.TopColor != testCase.wantTop ||
+				features.BottomColor != testCase.wantBottom {
+				t.Errorf(
+					"ExtractFeatures() top, bottom = %s, %s, want %s, %s",
+					features.TopColor,
+					features.BottomColor,
+					testCase.wantTop,
+					testCase.wantBottom,
+				)
+			}
+
+			if diff := features.Brightness - testCase.wantBrightness; diff < -0.01 || diff > 0.01 {
+				t.Errorf(
+					"ExtractFeatures() brightness = %v, want %v",
+					features.Brightness,
+					testCase.wantBrightness,
+				)
+			}
+
+			if features.Outdoor != testCase.wantOutdoor {
+				t.Errorf(
+					"ExtractFeatures() outdoor = %v, want %v",
+					features.Outdoor,
+					testCase.wantOutdoor,
+				)
+			}
+		})
+	}
+}
+
+func TestExtractFeatures_InvalidImage(t *testing.T) {
+	t.Parallel()
+
+	_, err := image.ExtractFeatures([]byte("not an image"))
+	if err == nil {
+		t.Error("ExtractFeatures() error = nil, want an error")
+	}
+}
@@ -14,6 +14,12 @@ import (
 // MaxImageSize は画像の最大サイズ（10MB）.
 const MaxImageSize = 10 * 1024 * 1024
 
+const (
+	contentTypeJPEG = "image/jpeg"
+	contentTypePNG  = "image/png"
+	contentTypeWebP = "image/webp"
+)
+
 var (
 	// ErrEmptyData is returned when image data is empty.
 	ErrEmptyData = errors.New("image data is empty")
@@ -109,12 +115,18 @@ func (i *Image) Reader() *bytes.Reader {
 	return bytes.NewReader(i.Data)
 }
 
+// Extension returns the file extension for the given content type.
+// It returns an empty string for unsupported content types.
+func Extension(contentType string) string {
+	return getExtension(contentType)
+}
+
 // getExtension returns the file extension for the given content type.
 func getExtension(contentType string) string {
 	extensions := map[string]string{
-		"image/jpeg": "jpg",
-		"image/png":  "png",
-		"image/webp": "webp",
+		contentTypeJPEG: "jpg",
+		contentTypePNG:  "png",
+		contentTypeWebP: "webp",
 	}
 
 	return extensions[contentType]
@@ -123,9 +135,9 @@ func getExtension(contentType string) string {
 // isValidContentType checks if the content type is supported.
 func isValidContentType(contentType string) bool {
 	supportedTypes := []string{
-		"image/jpeg",
-		"image/png",
-		"image/webp",
+		contentTypeJPEG,
+		contentTypePNG,
+		contentTypeWebP,
 	}
 
 	return slices.Contains(supportedTypes, contentType)
@@ -138,18 +150,18 @@ func verifyImageFormat(data []byte, contentType string) error {
 	}
 
 	switch contentType {
-	case "image/jpeg":
+	case contentTypeJPEG:
 		// JPEG magic bytes: FF D8 FF
 		if data[0] != 0xFF || data[1] != 0xD8 || data[2] != 0xFF {
 			return ErrInvalidJPEG
 		}
-	case "image/png":
+	case contentTypePNG:
 		// PNG magic bytes: 89 50 4E 47 0D 0A 1A 0A
 		pngHeader := []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A}
 		if !bytes.Equal(data[:8], pngHeader) {
 			return ErrInvalidPNG
 		}
-	case "image/webp":
+	case contentTypeWebP:
 		// WebP magic bytes: RIFF xxxx WEBP
 		if !bytes.Equal(data[:4], []byte("RIFF")) || !bytes.Equal(data[8:12], []byte("WEBP")) {
 			return ErrInvalidWebP
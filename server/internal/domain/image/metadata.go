@@ -0,0 +1,67 @@
+package image
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Role represents why an image was uploaded.
+type Role int
+
+const (
+	// RoleUpload is an image uploaded through ImageService outside of a round.
+	RoleUpload Role = iota + 1
+	// RoleGameMaster is the photo the game master took for a round.
+	RoleGameMaster
+	// RoleHunter is a photo a hunter submitted in a round.
+	RoleHunter
+	// RoleScene is a scene the admin added to the room's pool before the game.
+	RoleScene
+)
+
+// Metadata is the catalog entry of a stored image.
+type Metadata struct {
+	ID          uuid.UUID `json:"id"`
+	RoomID      uuid.UUID `json:"roomId"`
+	Path        string    `json:"path"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	UploaderID  uuid.UUID `json:"uploaderId"`
+	RoundNumber int       `json:"roundNumber"`
+	Role        Role      `json:"role"`
+	CreatedAt   time.Time `json:"createdAt"`
+	// GPS is where the photo was taken, read from the upload before its metadata was removed.
+	// It is nil if the upload had no position, and it is never stored in the catalog.
+	GPS *GPS `json:"-"`
+}
+
+// NewMetadata creates the catalog entry of an image stored in a room.
+// The round number is 0 for images uploaded outside of a round, and the uploader is
+// uuid.Nil when unknown.
+func NewMetadata(
+	roomID uuid.UUID,
+	img *Image,
+	uploaderID uuid.UUID,
+	roundNumber int,
+	role Role,
+	createdAt time.Time,
+) *Metadata {
+	return &Metadata{
+		ID:          img.ID,
+		RoomID:      roomID,
+		Path:        PathFromRoomID(roomID, img.ID, img.ContentType),
+		ContentType: img.ContentType,
+		Size:        int64(len(img.Data)),
+		UploaderID:  uploaderID,
+		RoundNumber: roundNumber,
+		Role:        role,
+		CreatedAt:   createdAt,
+		GPS:         nil,
+	}
+}
+
+// ThumbnailPath returns the storage path of a thumbnail of the image.
+func (m *Metadata) ThumbnailPath(size ThumbnailSize) string {
+	return ThumbnailPath(m.Path, size)
+}
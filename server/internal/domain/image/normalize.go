@@ -0,0 +1,230 @@
+package image
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png" // register PNG decoder
+
+	"github.com/anthonynsimon/bild/transform"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	_ "golang.org/x/image/webp" // register WebP decoder
+)
+
+// NormalizedContentType is the content type of every normalized image and thumbnail.
+const NormalizedContentType = contentTypeJPEG
+
+var (
+	// ErrInvalidNormalizeOptions is returned when a dimension, quality or pixel limit is not
+	// positive.
+	ErrInvalidNormalizeOptions = errors.New("invalid normalize options")
+	// ErrTooManyPixels is returned when an image declares more pixels than allowed.
+	ErrTooManyPixels = errors.New("image has too many pixels")
+)
+
+// NormalizeOptions configures the upload normalization pipeline.
+type NormalizeOptions struct {
+	// MaxDimension is the maximum width and height of a stored image in pixels.
+	MaxDimension int
+	// Quality is the JPEG quality (1-100) of a stored image.
+	Quality int
+	// ThumbnailDimensions is the maximum width and height of each thumbnail size.
+	ThumbnailDimensions ThumbnailDimensions
+	// ThumbnailQuality is the JPEG quality (1-100) of every thumbnail.
+	ThumbnailQuality int
+	// MaxPixels is the maximum width × height of an uploaded image.
+	// A small file can declare huge dimensions, so larger images are rejected before decoding.
+	MaxPixels int
+}
+
+// Normalized is an uploaded image after normalization, with a thumbnail of every size.
+type Normalized struct {
+	Image      *Image
+	Thumbnails map[ThumbnailSize][]byte
+}
+
+// Normalize validates an uploaded image, applies its EXIF orientation, downscales it
+// to opts.MaxDimension and re-encodes it as JPEG.
+// Re-encoding drops all metadata such as the GPS position of the photo.
+// The returned image keeps the ID and room code of img.
+func Normalize(img *Image, opts NormalizeOptions) (*Normalized, error) {
+	if opts.MaxDimension <= 0 || opts.Quality <= 0 ||
+		!opts.ThumbnailDimensions.valid() || opts.ThumbnailQuality <= 0 || opts.MaxPixels <= 0 {
+		return nil, ErrInvalidNormalizeOptions
+	}
+
+	err := img.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := decode(img.Data, opts.MaxPixels)
+	if err != nil {
+		return nil, err
+	}
+
+	// 縮小してから回転することで、巨大な画像のピクセル操作を避ける
+	orientation := OrientationNormal
+	if img.ContentType == contentTypeJPEG {
+		orientation = ReadOrientation(img.Data)
+	}
+
+	normalized := applyOrientation(fit(decoded, opts.MaxDimension), orientation)
+
+	data, err := encodeJPEG(normalized, opts.Quality)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnails := make(map[ThumbnailSize][]byte, len(ThumbnailSizes()))
+
+	for _, size := range ThumbnailSizes() {
+		thumbnails[size], err = encodeJPEG(
+			fit(normalized, opts.ThumbnailDimensions.Of(size)),
+			opts.ThumbnailQuality,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Normalized{
+		Image: &Image{
+			ID:          img.ID,
+			RoomCode:    img.RoomCode,
+			ContentType: NormalizedContentType,
+			Data:        data,
+		},
+		Thumbnails: thumbnails,
+	}, nil
+}
+
+// GenerateThumbnail decodes image data and encodes a JPEG thumbnail that fits in maxDimension.
+// It is used for thumbnails that were not generated on upload.
+// Images with more than maxPixels pixels are rejected before decoding.
+func GenerateThumbnail(data []byte, maxDimension, quality, maxPixels int) ([]byte, error) {
+	decoded, err := decode(data, maxPixels)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeJPEG(fit(decoded, maxDimension), quality)
+}
+
+// decode decodes image data after checking from its header that it has at most maxPixels
+// pixels, so that a small file declaring huge dimensions cannot exhaust memory.
+func decode(data []byte, maxPixels int) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Errorf("failed to decode image header: %w", err)
+	}
+
+	pixels := int64(config.Width) * int64(config.Height)
+	if pixels > int64(maxPixels) {
+		return nil, errors.Errorf(
+			"%w: %dx%d is more than %d pixels",
+			ErrTooManyPixels,
+			config.Width,
+			config.Height,
+			maxPixels,
+		)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Errorf("failed to decode image: %w", err)
+	}
+
+	return decoded, nil
+}
+
+// fit downscales an image to fit in maxDimension while keeping its aspect ratio.
+// Images that already fit are only converted to RGBA.
+func fit(img image.Image, maxDimension int) *image.RGBA {
+	bounds := img.Bounds()
+	width := bounds.Dx()
+	height := bounds.Dy()
+
+	if width <= maxDimension && height <= maxDimension {
+		rgba := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+
+		return rgba
+	}
+
+	// 極端な縦横比の場合でも最低1ピクセルを保証
+	if width > height {
+		height = max(height*maxDimension/width, 1)
+		width = maxDimension
+	} else {
+		width = max(width*maxDimension/height, 1)
+		height = maxDimension
+	}
+
+	return transform.Resize(img, width, height, transform.Linear)
+}
+
+// applyOrientation transforms an image so that it is displayed upright.
+func applyOrientation(src *image.RGBA, orientation Orientation) *image.RGBA {
+	if orientation == OrientationNormal {
+		return src
+	}
+
+	width := src.Bounds().Dx()
+	height := src.Bounds().Dy()
+
+	// 90度回転を含む場合は幅と高さが入れ替わる
+	dstWidth, dstHeight := width, height
+	if orientation >= OrientationTranspose {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := range dstHeight {
+		for x := range dstWidth {
+			srcX, srcY := sourcePoint(orientation, x, y, width, height)
+			srcOffset := src.PixOffset(srcX+src.Rect.Min.X, srcY+src.Rect.Min.Y)
+			dstOffset := dst.PixOffset(x, y)
+			copy(dst.Pix[dstOffset:dstOffset+4], src.Pix[srcOffset:srcOffset+4])
+		}
+	}
+
+	return dst
+}
+
+// sourcePoint maps a pixel of the upright image to the pixel of the stored image.
+func sourcePoint(orientation Orientation, dstX, dstY, width, height int) (int, int) {
+	switch orientation {
+	case OrientationNormal:
+	case OrientationFlipHorizontal:
+		return width - 1 - dstX, dstY
+	case OrientationRotate180:
+		return width - 1 - dstX, height - 1 - dstY
+	case OrientationFlipVertical:
+		return dstX, height - 1 - dstY
+	case OrientationTranspose:
+		return dstY, dstX
+	case OrientationRotate90:
+		return dstY, height - 1 - dstX
+	case OrientationTransverse:
+		return width - 1 - dstY, height - 1 - dstX
+	case OrientationRotate270:
+		return width - 1 - dstY, dstX
+	}
+
+	return dstX, dstY
+}
+
+// encodeJPEG encodes an image as JPEG without any metadata.
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality})
+	if err != nil {
+		return nil, errors.Errorf("failed to encode image: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,650 @@
+package image_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	goimage "image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	contentTypeJPEG = "image/jpeg"
+	contentTypePNG  = "image/png"
+	testRoomCode    = "123456"
+	testImagePath   = "game/room/image.jpg"
+	testMaxPixels   = 5_000_000
+)
+
+// testOptions returns the normalize options used in tests.
+func testOptions() image.NormalizeOptions {
+	return image.NormalizeOptions{
+		MaxDimension:        1000,
+		Quality:             85,
+		ThumbnailDimensions: testThumbnailDimensions(),
+		ThumbnailQuality:    75,
+		MaxPixels:           testMaxPixels,
+	}
+}
+
+// testThumbnailDimensions returns the thumbnail dimensions used in tests.
+func testThumbnailDimensions() image.ThumbnailDimensions {
+	return image.ThumbnailDimensions{Small: 160, Medium: 540, Large: 1080}
+}
+
+// halfRedImage returns an image whose left half is red and right half is blue.
+func halfRedImage(width, height int) goimage.Image {
+	img := goimage.NewRGBA(goimage.Rect(0, 0, width, height))
+
+	for y := range height {
+		for x := range width {
+			if x < width/2 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	return img
+}
+
+// mustEncodeJPEG encodes an image as JPEG or fails the test.
+func mustEncodeJPEG(t *testing.T, img goimage.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatalf("failed to encode JPEG: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// mustEncodePNG encodes an image as PNG or fails the test.
+func mustEncodePNG(t *testing.T, img goimage.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("failed to encode PNG: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// withDeclaredSize returns a PNG of a single pixel whose header declares width × height pixels.
+// Decoding it in full would allocate memory for every declared pixel.
+func withDeclaredSize(t *testing.T, width, height uint32) []byte {
+	t.Helper()
+
+	data := mustEncodePNG(t, goimage.NewRGBA(goimage.Rect(0, 0, 1, 1)))
+
+	// IHDRチャンクはシグネチャ(8バイト)の後にあり、長さと種類の後に幅と高さが続く
+	const ihdrData = 8 + 4 + 4
+
+	binary.BigEndian.PutUint32(data[ihdrData:], width)
+	binary.BigEndian.PutUint32(data[ihdrData+4:], height)
+	binary.BigEndian.PutUint32(data[ihdrData+13:], crc32.ChecksumIEEE(data[ihdrData-4:ihdrData+13]))
+
+	return data
+}
+
+// withExif inserts an APP1 segment with an orientation and a GPS IFD pointer after the SOI marker.
+func withExif(data []byte, orientation image.Orientation, order binary.AppendByteOrder) []byte {
+	tiff := make([]byte, 0, 64)
+
+	if order == binary.AppendByteOrder(binary.LittleEndian) {
+		tiff = append(tiff, 'I', 'I')
+	} else {
+		tiff = append(tiff, 'M', 'M')
+	}
+
+	tiff = order.AppendUint16(tiff, 42)
+	tiff = order.AppendUint32(tiff, 8)
+	tiff = order.AppendUint16(tiff, 2)
+
+	// GPSInfo (0x8825, LONG)
+	tiff = order.AppendUint16(tiff, 0x8825)
+	tiff = order.AppendUint16(tiff, 4)
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint32(tiff, 0)
+
+	// Orientation (0x0112, SHORT)
+	tiff = order.AppendUint16(tiff, 0x0112)
+	tiff = order.AppendUint16(tiff, 3)
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint16(tiff, uint16(orientation))
+	tiff = order.AppendUint16(tiff, 0)
+
+	tiff = order.AppendUint32(tiff, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, segment...)
+
+	return append(result, data[2:]...)
+}
+
+// withGPS inserts an APP1 segment with a GPS position after the SOI marker.
+// Each coordinate is degrees, minutes and seconds whose denominators are denominator.
+func withGPS(
+	data []byte,
+	latitudeRef, longitudeRef byte,
+	latitude, longitude [3]uint32,
+	denominator uint32,
+) []byte {
+	order := binary.BigEndian
+
+	const (
+		gpsIFDOffset  = 26
+		latitudeStart = 80
+	)
+
+	tiff := []byte{'M', 'M'}
+	tiff = order.AppendUint16(tiff, 42)
+	tiff = order.AppendUint32(tiff, 8)
+
+	// IFD0: GPSInfo (0x8825, LONG)
+	tiff = order.AppendUint16(tiff, 1)
+	tiff = order.AppendUint16(tiff, 0x8825)
+	tiff = order.AppendUint16(tiff, 4)
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint32(tiff, gpsIFDOffset)
+	tiff = order.AppendUint32(tiff, 0)
+
+	// GPS IFD: reference (ASCII) and value (3 RATIONAL) of the latitude and the longitude
+	tiff = order.AppendUint16(tiff, 4)
+
+	for index, ref := range []byte{latitudeRef, longitudeRef} {
+		tiff = order.AppendUint16(tiff, uint16(2*index+1))
+		tiff = order.AppendUint16(tiff, 2)
+		tiff = order.AppendUint32(tiff, 2)
+		tiff = append(tiff, ref, 0, 0, 0)
+
+		tiff = order.AppendUint16(tiff, uint16(2*index+2))
+		tiff = order.AppendUint16(tiff, 5)
+		tiff = order.AppendUint32(tiff, 3)
+		tiff = order.AppendUint32(tiff, uint32(latitudeStart+24*index))
+	}
+
+	tiff = order.AppendUint32(tiff, 0)
+
+	for _, coordinate := range [][3]uint32{latitude, longitude} {
+		for _, part := range coordinate {
+			tiff = order.AppendUint32(tiff, part*denominator)
+			tiff = order.AppendUint32(tiff, denominator)
+		}
+	}
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	result := append([]byte{}, data[:2]...)
+	result = append(result, segment...)
+
+	return append(result, data[2:]...)
+}
+
+// mustDecode decodes image data or fails the test.
+func mustDecode(t *testing.T, data []byte) goimage.Image {
+	t.Helper()
+
+	img, _, err := goimage.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to decode image: %v", err)
+	}
+
+	return img
+}
+
+// isRed reports whether a pixel is closer to red than to blue.
+func isRed(img goimage.Image, x, y int) bool {
+	red, _, blue, _ := img.At(x, y).RGBA()
+
+	return red > blue
+}
+
+func TestReadOrientation(t *testing.T) {
+	t.Parallel()
+
+	plain := mustEncodeJPEG(t, halfRedImage(16, 8))
+
+	tests := map[string]struct {
+		data []byte
+		want image.Orientation
+	}{
+		"little endian": {
+			withExif(plain, image.OrientationRotate90, binary.LittleEndian),
+			image.OrientationRotate90,
+		},
+		"big endian": {
+			withExif(plain, image.OrientationRotate270, binary.BigEndian),
+			image.OrientationRotate270,
+		},
+		"no exif":      {plain, image.OrientationNormal},
+		"out of range": {withExif(plain, 9, binary.BigEndian), image.OrientationNormal},
+		"truncated": {
+			withExif(plain, image.OrientationRotate90, binary.BigEndian)[:20],
+			image.OrientationNormal,
+		},
+		"not a JPEG": {mustEncodePNG(t, halfRedImage(16, 8)), image.OrientationNormal},
+		"empty":      {[]byte{}, image.OrientationNormal},
+		"SOI only":   {[]byte{0xFF, 0xD8}, image.OrientationNormal},
+		"invalid segment size": {
+			[]byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF},
+			image.OrientationNormal,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := image.ReadOrientation(testCase.data)
+			if got != testCase.want {
+				t.Errorf("ReadOrientation() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestReadGPS(t *testing.T) {
+	t.Parallel()
+
+	plain := mustEncodeJPEG(t, halfRedImage(16, 8))
+	shibuya := [3]uint32{35, 39, 36}
+	tokyoLongitude := [3]uint32{139, 42, 0}
+
+	tests := map[string]struct {
+		data   []byte
+		want   image.GPS
+		wantOK bool
+	}{
+		"north east": {
+			withGPS(plain, 'N', 'E', shibuya, tokyoLongitude, 1),
+			image.GPS{Latitude: 35.66, Longitude: 139.7},
+			true,
+		},
+		"south west": {
+			withGPS(plain, 'S', 'W', shibuya, tokyoLongitude, 100),
+			image.GPS{Latitude: -35.66, Longitude: -139.7},
+			true,
+		},
+		"zero denominator": {
+			withGPS(plain, 'N', 'E', shibuya, tokyoLongitude, 0),
+			image.GPS{},
+			false,
+		},
+		"out of range": {
+			withGPS(plain, 'N', 'E', [3]uint32{91, 0, 0}, tokyoLongitude, 1),
+			image.GPS{},
+			false,
+		},
+		"no GPS IFD": {
+			withExif(plain, image.OrientationRotate90, binary.BigEndian),
+			image.GPS{},
+			false,
+		},
+		"no exif": {plain, image.GPS{}, false},
+		"truncated": {
+			withGPS(plain, 'N', 'E', shibuya, tokyoLongitude, 1)[:60],
+			image.GPS{},
+			false,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := image.ReadGPS(testCase.data)
+			if ok != testCase.wantOK ||
+				math.Abs(got.Latitude-testCase.want.Latitude) > 1e-9 ||
+				math.Abs(got.Longitude-testCase.want.Longitude) > 1e-9 {
+				t.Errorf("ReadGPS() = %v, %v, want %v, %v", got, ok, testCase.want, testCase.wantOK)
+			}
+		})
+	}
+}
+
+// TestNormalize_Orientation rotates a 64x32 image whose left half is red
+// and checks where the red half ends up.
+func TestNormalize_Orientation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		orientation image.Orientation
+		wantWidth   int
+		wantHeight  int
+		wantRedAt   goimage.Point
+	}{
+		"normal":          {image.OrientationNormal, 64, 32, goimage.Pt(8, 16)},
+		"flip horizontal": {image.OrientationFlipHorizontal, 64, 32, goimage.Pt(56, 16)},
+		"rotate 180":      {image.OrientationRotate180, 64, 32, goimage.Pt(56, 16)},
+		"flip vertical":   {image.OrientationFlipVertical, 64, 32, goimage.Pt(8, 16)},
+		"transpose":       {image.OrientationTranspose, 32, 64, goimage.Pt(16, 8)},
+		"rotate 90":       {image.OrientationRotate90, 32, 64, goimage.Pt(16, 8)},
+		"transverse":      {image.OrientationTransverse, 32, 64, goimage.Pt(16, 56)},
+		"rotate 270":      {image.OrientationRotate270, 32, 64, goimage.Pt(16, 56)},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := withExif(
+				mustEncodeJPEG(t, halfRedImage(64, 32)),
+				testCase.orientation,
+				binary.BigEndian,
+			)
+
+			img := &image.Image{
+				ID:          uuid.New(),
+				RoomCode:    testRoomCode,
+				ContentType: contentTypeJPEG,
+				Data:        data,
+			}
+
+			normalized, err := image.Normalize(img, testOptions())
+			if err != nil {
+				t.Fatalf("Normalize() error = %v, want nil", err)
+			}
+
+			if bytes.Contains(normalized.Image.Data, []byte("Exif")) {
+				t.Error("Normalize() kept EXIF metadata")
+			}
+
+			if image.ReadOrientation(normalized.Image.Data) != image.OrientationNormal {
+				t.Error("Normalize() output has an orientation tag")
+			}
+
+			decoded := mustDecode(t, normalized.Image.Data)
+
+			bounds := decoded.Bounds()
+			if bounds.Dx() != testCase.wantWidth || bounds.Dy() != testCase.wantHeight {
+				t.Fatalf(
+					"Normalize() size = %dx%d, want %dx%d",
+					bounds.Dx(),
+					bounds.Dy(),
+					testCase.wantWidth,
+					testCase.wantHeight,
+				)
+			}
+
+			if !isRed(decoded, testCase.wantRedAt.X, testCase.wantRedAt.Y) {
+				t.Errorf("Normalize() pixel at %v is not red", testCase.wantRedAt)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		contentType         string
+		data                []byte
+		options             image.NormalizeOptions
+		wantWidth           int
+		wantHeight          int
+		wantThumbnailWidth  int
+		wantThumbnailHeight int
+		wantErr             error
+	}{
+		"downscale landscape": {
+			contentTypeJPEG,
+			mustEncodeJPEG(t, halfRedImage(4000, 1000)),
+			testOptions(),
+			1000, 250, 540, 135, nil,
+		},
+		"downscale portrait": {
+			contentTypeJPEG,
+			mustEncodeJPEG(t, halfRedImage(1000, 2000)),
+			testOptions(),
+			500, 1000, 270, 540, nil,
+		},
+		"small image is kept": {
+			contentTypeJPEG,
+			mustEncodeJPEG(t, halfRedImage(300, 200)),
+			testOptions(),
+			300, 200, 300, 200, nil,
+		},
+		"PNG is re-encoded as JPEG": {
+			contentTypePNG,
+			mustEncodePNG(t, halfRedImage(2000, 2000)),
+			testOptions(),
+			1000, 1000, 540, 540, nil,
+		},
+		"content type mismatch": {
+			contentTypePNG,
+			mustEncodeJPEG(t, halfRedImage(16, 16)),
+			testOptions(),
+			0, 0, 0, 0, image.ErrInvalidPNG,
+		},
+		"invalid options": {
+			contentTypeJPEG,
+			mustEncodeJPEG(t, halfRedImage(16, 16)),
+			image.NormalizeOptions{
+				MaxDimension:        0,
+				Quality:             85,
+				ThumbnailDimensions: testThumbnailDimensions(),
+				ThumbnailQuality:    75,
+				MaxPixels:           testMaxPixels,
+			},
+			0,
+			0,
+			0,
+			0,
+			image.ErrInvalidNormalizeOptions,
+		},
+		"too many pixels": {
+			contentTypePNG,
+			withDeclaredSize(t, 100_000, 100_000),
+			testOptions(),
+			0, 0, 0, 0, image.ErrTooManyPixels,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			img := &image.Image{
+				ID:          uuid.New(),
+				RoomCode:    testRoomCode,
+				ContentType: testCase.contentType,
+				Data:        testCase.data,
+			}
+
+			normalized, err := image.Normalize(img, testCase.options)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("Normalize() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			if normalized.Image.ID != img.ID || normalized.Image.RoomCode != img.RoomCode {
+				t.Error("Normalize() changed the image ID or room code")
+			}
+
+			if normalized.Image.ContentType != image.NormalizedContentType {
+				t.Errorf(
+					"Normalize() content type = %v, want %v",
+					normalized.Image.ContentType,
+					image.NormalizedContentType,
+				)
+			}
+
+			decoded := mustDecode(t, normalized.Image.Data)
+			if decoded.Bounds().Dx() != testCase.wantWidth ||
+				decoded.Bounds().Dy() != testCase.wantHeight {
+				t.Errorf(
+					"Normalize() size = %v, want %dx%d",
+					decoded.Bounds().Size(),
+					testCase.wantWidth,
+					testCase.wantHeight,
+				)
+			}
+
+			if len(normalized.Thumbnails) != len(image.ThumbnailSizes()) {
+				t.Errorf(
+					"Normalize() generated %d thumbnails, want %d",
+					len(normalized.Thumbnails),
+					len(image.ThumbnailSizes()),
+				)
+			}
+
+			thumbnail := mustDecode(t, normalized.Thumbnails[image.ThumbnailSizeMedium])
+			if thumbnail.Bounds().Dx() != testCase.wantThumbnailWidth ||
+				thumbnail.Bounds().Dy() != testCase.wantThumbnailHeight {
+				t.Errorf(
+					"Normalize() thumbnail size = %v, want %dx%d",
+					thumbnail.Bounds().Size(),
+					testCase.wantThumbnailWidth,
+					testCase.wantThumbnailHeight,
+				)
+			}
+		})
+	}
+}
+
+func TestGenerateThumbnail(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data       []byte
+		wantWidth  int
+		wantHeight int
+		wantErr    error
+	}{
+		"JPEG":            {mustEncodeJPEG(t, halfRedImage(1080, 720)), 540, 360, nil},
+		"PNG":             {mustEncodePNG(t, halfRedImage(100, 50)), 100, 50, nil},
+		"not image":       {[]byte("not an image"), 0, 0, goimage.ErrFormat},
+		"too many pixels": {withDeclaredSize(t, 100_000, 100_000), 0, 0, image.ErrTooManyPixels},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			thumbnail, err := image.GenerateThumbnail(testCase.data, 540, 75, testMaxPixels)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("GenerateThumbnail() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			decoded := mustDecode(t, thumbnail)
+			if decoded.Bounds().Dx() != testCase.wantWidth ||
+				decoded.Bounds().Dy() != testCase.wantHeight {
+				t.Errorf(
+					"GenerateThumbnail() size = %v, want %dx%d",
+					decoded.Bounds().Size(),
+					testCase.wantWidth,
+					testCase.wantHeight,
+				)
+			}
+		})
+	}
+}
+
+func TestThumbnailPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path string
+		size image.ThumbnailSize
+		want string
+	}{
+		"JPEG image": {
+			testImagePath,
+			image.ThumbnailSizeMedium,
+			"game/room/image.thumb.jpg",
+		},
+		"PNG image": {
+			"game/room/image.png",
+			image.ThumbnailSizeMedium,
+			"game/room/image.png.thumb.jpg",
+		},
+		"no suffix": {
+			"images/room/image",
+			image.ThumbnailSizeMedium,
+			"images/room/image.thumb.jpg",
+		},
+		"small": {
+			testImagePath,
+			image.ThumbnailSizeSmall,
+			"game/room/image.thumb.small.jpg",
+		},
+		"large": {
+			testImagePath,
+			image.ThumbnailSizeLarge,
+			"game/room/image.thumb.large.jpg",
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := image.ThumbnailPath(testCase.path, testCase.size)
+			if got != testCase.want {
+				t.Errorf("ThumbnailPath() = %v, want %v", got, testCase.want)
+			}
+
+			if !image.IsThumbnailPath(got) {
+				t.Errorf("IsThumbnailPath(%v) = false, want true", got)
+			}
+
+			if image.IsThumbnailPath(testCase.path) {
+				t.Errorf("IsThumbnailPath(%v) = true, want false", testCase.path)
+			}
+		})
+	}
+}
+
+func TestThumbnailDimensions_Of(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		size image.ThumbnailSize
+		want int
+	}{
+		"small":   {image.ThumbnailSizeSmall, 160},
+		"medium":  {image.ThumbnailSizeMedium, 540},
+		"large":   {image.ThumbnailSizeLarge, 1080},
+		"unknown": {image.ThumbnailSize(0), 540},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testThumbnailDimensions().Of(testCase.size)
+			if got != testCase.want {
+				t.Errorf("Of() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
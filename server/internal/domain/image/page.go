@@ -0,0 +1,134 @@
+package image
+
+import (
+	"bytes"
+	"encoding/base64"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	// DefaultPageSize is the number of images in a page when no size is requested.
+	DefaultPageSize = 50
+	// MaxPageSize is the largest number of images in a page.
+	MaxPageSize = 100
+)
+
+// ErrInvalidPageToken is returned when a page token was not issued by PageImages.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Page is a page of images, oldest first.
+type Page struct {
+	Images []*Metadata
+	// NextPageToken continues after the last image of the page.
+	// It is empty on the last page.
+	NextPageToken string
+}
+
+// SortImages sorts images oldest first.
+// Images stored at the same time are ordered by ID so that pages are stable.
+func SortImages(images []*Metadata) {
+	slices.SortFunc(images, compareImages)
+}
+
+// SinceImages returns the images stored after since. A zero since keeps every image.
+func SinceImages(images []*Metadata, since time.Time) []*Metadata {
+	if since.IsZero() {
+		return images
+	}
+
+	return slices.DeleteFunc(slices.Clone(images), func(metadata *Metadata) bool {
+		return !metadata.CreatedAt.After(since)
+	})
+}
+
+// PageImages returns up to pageSize images stored after since, continuing after pageToken.
+// images must be sorted by SortImages. A pageSize of 0 uses DefaultPageSize.
+func PageImages(
+	images []*Metadata,
+	since time.Time,
+	pageToken string,
+	pageSize int,
+) (*Page, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	pageSize = min(pageSize, MaxPageSize)
+	images = SinceImages(images, since)
+
+	if pageToken != "" {
+		cursor, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		start, _ := slices.BinarySearchFunc(images, cursor, compareImages)
+		// トークンの画像が削除されていても続きから返す
+		if start < len(images) && compareImages(images[start], cursor) == 0 {
+			start++
+		}
+
+		images = images[start:]
+	}
+
+	if len(images) <= pageSize {
+		return &Page{Images: images, NextPageToken: ""}, nil
+	}
+
+	images = images[:pageSize]
+
+	return &Page{
+		Images:        images,
+		NextPageToken: encodePageToken(images[len(images)-1]),
+	}, nil
+}
+
+// compareImages orders images by creation time and then by ID.
+func compareImages(a, b *Metadata) int {
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+
+	return bytes.Compare(a.ID[:], b.ID[:])
+}
+
+// encodePageToken encodes the position of an image as an opaque page token.
+func encodePageToken(metadata *Metadata) string {
+	raw := strconv.FormatInt(metadata.CreatedAt.UnixNano(), 10) + "." + metadata.ID.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodePageToken decodes the position encoded by encodePageToken.
+func decodePageToken(token string) (*Metadata, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", ErrInvalidPageToken, err)
+	}
+
+	nanos, rawID, found := strings.Cut(string(raw), ".")
+	if !found {
+		return nil, ErrInvalidPageToken
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", ErrInvalidPageToken, err)
+	}
+
+	imageID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", ErrInvalidPageToken, err)
+	}
+
+	return &Metadata{
+		ID:        imageID,
+		CreatedAt: time.Unix(0, unixNano).UTC(),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package image_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// testImages returns count images stored one minute apart, oldest first.
+func testImages(count int) []*image.Metadata {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	images := make([]*image.Metadata, 0, count)
+
+	for index := range count {
+		images = append(images, &image.Metadata{
+			ID:        uuid.New(),
+			CreatedAt: base.Add(time.Duration(index) * time.Minute),
+		})
+	}
+
+	image.SortImages(images)
+
+	return images
+}
+
+// imageIDs returns the IDs of images.
+func imageIDs(images []*image.Metadata) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(images))
+	for _, metadata := range images {
+		ids = append(ids, metadata.ID)
+	}
+
+	return ids
+}
+
+func TestPageImages(t *testing.T) {
+	t.Parallel()
+
+	images := testImages(5)
+
+	tests := map[string]struct {
+		since    time.Time
+		pageSize int
+		want     [][]*image.Metadata
+	}{
+		"single page":   {time.Time{}, 0, [][]*image.Metadata{images}},
+		"two per page":  {time.Time{}, 2, [][]*image.Metadata{images[:2], images[2:4], images[4:]}},
+		"exact pages":   {time.Time{}, 5, [][]*image.Metadata{images}},
+		"since":         {images[1].CreatedAt, 2, [][]*image.Metadata{images[2:4], images[4:]}},
+		"since latest":  {images[4].CreatedAt, 2, [][]*image.Metadata{{}}},
+		"over max size": {time.Time{}, image.MaxPageSize + 1, [][]*image.Metadata{images}},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pageToken := ""
+
+			for index, want := range testCase.want {
+				page, err := image.PageImages(images, testCase.since, pageToken, testCase.pageSize)
+				if err != nil {
+					t.Fatalf("PageImages() page %d failed: %v", index, err)
+				}
+
+				got := imageIDs(page.Images)
+				if len(got) != len(want) {
+					t.Fatalf("PageImages() page %d = %v, want %v", index, got, imageIDs(want))
+				}
+
+				for position, id := range imageIDs(want) {
+					if got[position] != id {
+						t.Fatalf("PageImages() page %d = %v, want %v", index, got, imageIDs(want))
+					}
+				}
+
+				isLast := index == len(testCase.want)-1
+				if (page.NextPageToken == "") != isLast {
+					t.Fatalf(
+						"PageImages() page %d next token = %q, last = %v",
+						index,
+						page.NextPageToken,
+						isLast,
+					)
+				}
+
+				pageToken = page.NextPageToken
+			}
+		})
+	}
+}
+
+func TestPageImages_DeletedCursor(t *testing.T) {
+	t.Parallel()
+
+	images := testImages(4)
+
+	page, err := image.PageImages(images, time.Time{}, "", 2)
+	if err != nil {
+		t.Fatalf("PageImages() failed: %v", err)
+	}
+
+	// 前のページの最後の画像が削除されても続きから返す
+	remaining := slices.Delete(slices.Clone(images), 1, 2)
+
+	page, err = image.PageImages(remaining, time.Time{}, page.NextPageToken, 2)
+	if err != nil {
+		t.Fatalf("PageImages() failed: %v", err)
+	}
+
+	if len(page.Images) != 2 || page.Images[0].ID != images[2].ID {
+		t.Errorf("PageImages() = %v, want %v", imageIDs(page.Images), imageIDs(images[2:]))
+	}
+}
+
+func TestPageImages_InvalidToken(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pageToken string
+	}{
+		"not base64":   {"!!!"},
+		"no separator": {"MTIz"},
+		"bad time":     {"eC4wMDAwMDAwMC0wMDAwLTAwMDAtMDAwMC0wMDAwMDAwMDAwMDA"},
+		"bad ID":       {"MTIzLm5vdC1hLXV1aWQ"},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := image.PageImages(testImages(2), time.Time{}, testCase.pageToken, 1)
+			if !errors.Is(err, image.ErrInvalidPageToken) {
+				t.Errorf("PageImages() error = %v, want %v", err, image.ErrInvalidPageToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package image
+
+import (
+	"strings"
+)
+
+// thumbnailSuffix is appended to the path of an image to store its medium thumbnail.
+const thumbnailSuffix = ".thumb.jpg"
+
+// ThumbnailSize selects one of the thumbnails stored for every image.
+type ThumbnailSize int
+
+const (
+	// ThumbnailSizeSmall is a thumbnail for dense grids.
+	ThumbnailSizeSmall ThumbnailSize = iota + 1
+	// ThumbnailSizeMedium is the default thumbnail.
+	ThumbnailSizeMedium
+	// ThumbnailSizeLarge is a thumbnail for previews.
+	ThumbnailSizeLarge
+)
+
+// ThumbnailSizes returns all thumbnail sizes, smallest first.
+func ThumbnailSizes() []ThumbnailSize {
+	return []ThumbnailSize{ThumbnailSizeSmall, ThumbnailSizeMedium, ThumbnailSizeLarge}
+}
+
+// String returns the name of the size used in storage paths.
+func (s ThumbnailSize) String() string {
+	switch s {
+	case ThumbnailSizeSmall:
+		return "small"
+	case ThumbnailSizeMedium:
+		return "medium"
+	case ThumbnailSizeLarge:
+		return "large"
+	}
+
+	return "unknown"
+}
+
+// ThumbnailDimensions is the maximum width and height in pixels of each thumbnail size.
+type ThumbnailDimensions struct {
+	Small  int
+	Medium int
+	Large  int
+}
+
+// Of returns the maximum dimension of a thumbnail size.
+// Unknown sizes use the medium dimension.
+func (d ThumbnailDimensions) Of(size ThumbnailSize) int {
+	switch size {
+	case ThumbnailSizeSmall:
+		return d.Small
+	case ThumbnailSizeLarge:
+		return d.Large
+	case ThumbnailSizeMedium:
+	}
+
+	return d.Medium
+}
+
+// valid reports whether every dimension is positive.
+func (d ThumbnailDimensions) valid() bool {
+	return d.Small > 0 && d.Medium > 0 && d.Large > 0
+}
+
+// ThumbnailPath returns the storage path of a thumbnail stored next to an image.
+// The medium thumbnail keeps the path used before thumbnails had sizes.
+func ThumbnailPath(path string, size ThumbnailSize) string {
+	if ext := Extension(NormalizedContentType); strings.HasSuffix(path, "."+ext) {
+		path = strings.TrimSuffix(path, "."+ext)
+	}
+
+	if size == ThumbnailSizeMedium {
+		return path + thumbnailSuffix
+	}
+
+	return path + ".thumb." + size.String() + ".jpg"
+}
+
+// IsThumbnailPath reports whether a storage path points to a thumbnail.
+func IsThumbnailPath(path string) bool {
+	if strings.HasSuffix(path, thumbnailSuffix) {
+		return true
+	}
+
+	for _, size := range ThumbnailSizes() {
+		if strings.HasSuffix(path, ".thumb."+size.String()+".jpg") {
+			return true
+		}
+	}
+
+	return false
+}
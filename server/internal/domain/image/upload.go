@@ -0,0 +1,190 @@
+package image
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding"
+	"fmt"
+	"hash"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+var (
+	// ErrOffsetMismatch is returned when a chunked upload is resumed from a wrong offset.
+	ErrOffsetMismatch = errors.New("upload offset does not match received bytes")
+	// ErrChecksumMismatch is returned when the SHA-256 of a chunked upload does not match.
+	ErrChecksumMismatch = errors.New("upload checksum does not match")
+	// ErrUploadCompleted is returned when chunks are sent to a completed upload.
+	ErrUploadCompleted = errors.New("upload already completed")
+)
+
+// PendingUpload is an upload that a client sends directly to blob storage, either with a
+// presigned URL or in chunks. It becomes an image once the uploaded object has been
+// validated.
+type PendingUpload struct {
+	ID          uuid.UUID `json:"id"`
+	RoomID      uuid.UUID `json:"roomId"`
+	UploaderID  uuid.UUID `json:"uploaderId"`
+	ContentType string    `json:"contentType"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	// ReceivedBytes is the number of bytes received in chunks so far.
+	ReceivedBytes int64 `json:"receivedBytes"`
+	// Parts is the number of parts stored for a chunked upload.
+	// Each upload stream stores one part.
+	Parts int `json:"parts"`
+	// HashState is the SHA-256 state of the received bytes, so that a resumed upload
+	// can be verified without reading the stored parts again.
+	HashState []byte `json:"hashState,omitempty"`
+	// Completed reports whether all chunks have been received and verified.
+	Completed bool `json:"completed"`
+}
+
+// NewPendingUpload creates an upload of an image of the given content type into a room.
+// The uploader is uuid.Nil when unknown.
+func NewPendingUpload(
+	roomID uuid.UUID,
+	uploaderID uuid.UUID,
+	contentType string,
+	expiresAt time.Time,
+) (*PendingUpload, error) {
+	if !isValidContentType(contentType) {
+		return nil, errors.Errorf("%w: %s", ErrUnsupportedContentType, contentType)
+	}
+
+	uploadID, err := uuid.NewV7()
+	if err != nil {
+		return nil, errors.Errorf("failed to generate upload ID: %w", err)
+	}
+
+	return &PendingUpload{
+		ID:          uploadID,
+		RoomID:      roomID,
+		UploaderID:  uploaderID,
+		ContentType: contentType,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// StagingPath returns the storage path the client uploads to.
+// Staged objects live outside of "game/" until they are validated.
+func (u *PendingUpload) StagingPath() string {
+	return filepath.Join("uploads", u.RoomID.String(), u.ID.String())
+}
+
+// PartPath returns the storage path of a part of a chunked upload.
+func (u *PendingUpload) PartPath(index int) string {
+	return fmt.Sprintf("%s.part%d", u.StagingPath(), index)
+}
+
+// PartPaths returns the storage paths of all stored parts in order.
+func (u *PendingUpload) PartPaths() []string {
+	paths := make([]string, 0, u.Parts)
+	for i := range u.Parts {
+		paths = append(paths, u.PartPath(i))
+	}
+
+	return paths
+}
+
+// Resume checks that chunks can be appended from offset.
+func (u *PendingUpload) Resume(offset int64) error {
+	if u.Completed {
+		return ErrUploadCompleted
+	}
+
+	if offset != u.ReceivedBytes {
+		return errors.Errorf(
+			"%w: offset=%d, received=%d",
+			ErrOffsetMismatch,
+			offset,
+			u.ReceivedBytes,
+		)
+	}
+
+	return nil
+}
+
+// RemainingBytes returns how many more bytes can be appended before the size limit.
+func (u *PendingUpload) RemainingBytes() int64 {
+	return MaxImageSize - u.ReceivedBytes
+}
+
+// Hash returns a SHA-256 hash that has already consumed the received bytes.
+func (u *PendingUpload) Hash() (hash.Hash, error) {
+	hasher := sha256.New()
+	if len(u.HashState) == 0 {
+		return hasher, nil
+	}
+
+	unmarshaler, ok := hasher.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return nil, errors.New("sha256 hash does not support restoring its state")
+	}
+
+	err := unmarshaler.UnmarshalBinary(u.HashState)
+	if err != nil {
+		return nil, errors.Errorf("failed to restore upload hash: %w", err)
+	}
+
+	return hasher, nil
+}
+
+// AddPart records a stored part of size bytes. hasher must have consumed the part.
+func (u *PendingUpload) AddPart(size int64, hasher hash.Hash) error {
+	if size > u.RemainingBytes() {
+		return errors.Errorf("%w: %d bytes", ErrSizeTooLarge, u.ReceivedBytes+size)
+	}
+
+	marshaler, ok := hasher.(encoding.BinaryMarshaler)
+	if !ok {
+		return errors.New("hash does not support saving its state")
+	}
+
+	state, err := marshaler.MarshalBinary()
+	if err != nil {
+		return errors.Errorf("failed to save upload hash: %w", err)
+	}
+
+	u.ReceivedBytes += size
+	u.Parts++
+	u.HashState = state
+
+	return nil
+}
+
+// Finish verifies the SHA-256 of all received bytes and marks the upload as completed.
+func (u *PendingUpload) Finish(checksum []byte) error {
+	if u.ReceivedBytes == 0 {
+		return ErrEmptyData
+	}
+
+	hasher, err := u.Hash()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hasher.Sum(nil), checksum) {
+		return ErrChecksumMismatch
+	}
+
+	u.Completed = true
+
+	return nil
+}
+
+// ValidateSize checks the size of the uploaded object before it is downloaded.
+func (u *PendingUpload) ValidateSize(size int64) error {
+	if size <= 0 {
+		return ErrEmptyData
+	}
+
+	if size > MaxImageSize {
+		return errors.Errorf("%w: %d bytes", ErrSizeTooLarge, size)
+	}
+
+	return nil
+}
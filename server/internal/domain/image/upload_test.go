@@ -0,0 +1,205 @@
+package image_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+func TestNewPendingUpload(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		contentType string
+		wantErr     error
+	}{
+		"jpeg":         {"image/jpeg", nil},
+		"png":          {"image/png", nil},
+		"webp":         {"image/webp", nil},
+		"gif":          {"image/gif", image.ErrUnsupportedContentType},
+		"empty":        {"", image.ErrUnsupportedContentType},
+		"not an image": {"application/pdf", image.ErrUnsupportedContentType},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			roomID := uuid.New()
+			expiresAt := time.Date(2025, 1, 1, 0, 15, 0, 0, time.UTC)
+
+			upload, err := image.NewPendingUpload(roomID, uuid.Nil, testCase.contentType, expiresAt)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("NewPendingUpload() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if testCase.wantErr != nil {
+				return
+			}
+
+			wantPath := "uploads/" + roomID.String() + "/" + upload.ID.String()
+			if upload.StagingPath() != wantPath {
+				t.Errorf("StagingPath() = %v, want %v", upload.StagingPath(), wantPath)
+			}
+
+			if !upload.ExpiresAt.Equal(expiresAt) {
+				t.Errorf("ExpiresAt = %v, want %v", upload.ExpiresAt, expiresAt)
+			}
+		})
+	}
+}
+
+func TestPendingUpload_ValidateSize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		size    int64
+		wantErr error
+	}{
+		"one byte":     {1, nil},
+		"maximum size": {image.MaxImageSize, nil},
+		"zero bytes":   {0, image.ErrEmptyData},
+		"too large":    {image.MaxImageSize + 1, image.ErrSizeTooLarge},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upload, err := image.NewPendingUpload(
+				uuid.New(),
+				uuid.Nil,
+				"image/jpeg",
+				time.Now().UTC(),
+			)
+			if err != nil {
+				t.Fatalf("NewPendingUpload() error = %v", err)
+			}
+
+			err = upload.ValidateSize(testCase.size)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("ValidateSize() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPendingUpload_Resume(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		received  int64
+		completed bool
+		offset    int64
+		wantErr   error
+	}{
+		"new upload":        {0, false, 0, nil},
+		"resume":            {1024, false, 1024, nil},
+		"offset behind":     {1024, false, 512, image.ErrOffsetMismatch},
+		"offset ahead":      {1024, false, 2048, image.ErrOffsetMismatch},
+		"already completed": {1024, true, 1024, image.ErrUploadCompleted},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upload := newTestUpload(t)
+			upload.ReceivedBytes = testCase.received
+			upload.Completed = testCase.completed
+
+			err := upload.Resume(testCase.offset)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("Resume() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPendingUpload_Finish(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("chunked upload sent over a flaky network")
+	checksum := sha256.Sum256(data)
+	otherChecksum := sha256.Sum256([]byte("other data"))
+
+	tests := map[string]struct {
+		parts    [][]byte
+		checksum []byte
+		wantErr  error
+	}{
+		"single part":       {[][]byte{data}, checksum[:], nil},
+		"resumed parts":     {[][]byte{data[:10], data[10:25], data[25:]}, checksum[:], nil},
+		"checksum mismatch": {[][]byte{data}, otherChecksum[:], image.ErrChecksumMismatch},
+		"missing part":      {[][]byte{data[:10]}, checksum[:], image.ErrChecksumMismatch},
+		"no data":           {nil, checksum[:], image.ErrEmptyData},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upload := newTestUpload(t)
+
+			// 各パートは保存済みのハッシュの状態から再開する
+			for _, part := range testCase.parts {
+				hasher, err := upload.Hash()
+				if err != nil {
+					t.Fatalf("Hash() error = %v", err)
+				}
+
+				_, _ = hasher.Write(part)
+
+				err = upload.AddPart(int64(len(part)), hasher)
+				if err != nil {
+					t.Fatalf("AddPart() error = %v", err)
+				}
+			}
+
+			err := upload.Finish(testCase.checksum)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("Finish() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if upload.Completed != (testCase.wantErr == nil) {
+				t.Errorf("Completed = %v, want %v", upload.Completed, testCase.wantErr == nil)
+			}
+
+			if upload.Parts != len(testCase.parts) {
+				t.Errorf("Parts = %d, want %d", upload.Parts, len(testCase.parts))
+			}
+		})
+	}
+}
+
+func TestPendingUpload_AddPart_TooLarge(t *testing.T) {
+	t.Parallel()
+
+	upload := newTestUpload(t)
+	upload.ReceivedBytes = image.MaxImageSize - 1
+
+	err := upload.AddPart(2, sha256.New())
+	if !errors.Is(err, image.ErrSizeTooLarge) {
+		t.Errorf("AddPart() error = %v, want %v", err, image.ErrSizeTooLarge)
+	}
+
+	if upload.ReceivedBytes != image.MaxImageSize-1 {
+		t.Errorf("ReceivedBytes = %d, want %d", upload.ReceivedBytes, image.MaxImageSize-1)
+	}
+}
+
+// newTestUpload creates a JPEG upload into a new room.
+func newTestUpload(t *testing.T) *image.PendingUpload {
+	t.Helper()
+
+	upload, err := image.NewPendingUpload(uuid.New(), uuid.Nil, "image/jpeg", time.Now().UTC())
+	if err != nil {
+		t.Fatalf("NewPendingUpload() error = %v", err)
+	}
+
+	return upload
+}
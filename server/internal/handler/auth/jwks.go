@@ -0,0 +1,38 @@
+// Package auth provides HTTP handlers for authentication metadata.
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	domainauth "github.com/yashikota/scene-hunter/server/internal/domain/auth"
+)
+
+// jwksCacheControl lets clients cache the key set while keeping key rotation reasonably fast.
+const jwksCacheControl = "public, max-age=300"
+
+// JWKSHandler serves the public keys for verifying JWT access tokens.
+type JWKSHandler struct {
+	tokenSigner *domainauth.TokenSigner
+}
+
+// NewJWKSHandler creates a new JWKS handler.
+func NewJWKSHandler(tokenSigner *domainauth.TokenSigner) *JWKSHandler {
+	return &JWKSHandler{
+		tokenSigner: tokenSigner,
+	}
+}
+
+// ServeHTTP writes the JWK set as JSON.
+func (h *JWKSHandler) ServeHTTP(writer http.ResponseWriter, _ *http.Request) {
+	body, err := json.Marshal(h.tokenSigner.PublicKeys())
+	if err != nil {
+		http.Error(writer, "failed to encode key set", http.StatusInternalServerError)
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/jwk-set+json")
+	writer.Header().Set("Cache-Control", jwksCacheControl)
+	_, _ = writer.Write(body)
+}
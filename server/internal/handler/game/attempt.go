@@ -0,0 +1,55 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// PickFinalPhoto picks the attempt a hunter is ranked by.
+func (h *Handler) PickFinalPhoto(
+	ctx context.Context,
+	req *scene_hunterv1.PickFinalPhotoRequest,
+) (*scene_hunterv1.PickFinalPhotoResponse, error) {
+	roomID, userID, err := parseHunter(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	allSubmitted, err := h.service.PickFinalPhoto(ctx, roomID, userID, int(req.GetAttempt()))
+	if err != nil {
+		return nil, errors.Errorf("failed to pick final photo: %w", err)
+	}
+
+	return &scene_hunterv1.PickFinalPhotoResponse{
+		AllHuntersSubmitted: allSubmitted,
+	}, nil
+}
+
+// parseHunter parses the room and user IDs of a request about a hunter's photos, location or vote
+// and verifies that the user is acting as themselves.
+func parseHunter(ctx context.Context, rawRoomID, rawUserID string) (uuid.UUID, uuid.UUID, error) {
+	roomID, err := uuid.Parse(rawRoomID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("invalid room_id: %w", err)
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("invalid user_id: %w", err)
+	}
+
+	authenticatedUserID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	if userID != authenticatedUserID {
+		return uuid.Nil, uuid.Nil, errors.New("cannot act as another hunter")
+	}
+
+	return roomID, userID, nil
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"context"
+
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// BeginGame lets the admin start a waiting game.
+func (h *Handler) BeginGame(
+	ctx context.Context,
+	req *scene_hunterv1.BeginGameRequest,
+) (*scene_hunterv1.BeginGameResponse, error) {
+	roomID, userID, err := parseAdmin(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	gameSession, err := h.service.BeginGame(ctx, roomID, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to begin game: %w", err)
+	}
+
+	return &scene_hunterv1.BeginGameResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
+
+// PauseGame lets the admin pause the game.
+func (h *Handler) PauseGame(
+	ctx context.Context,
+	req *scene_hunterv1.PauseGameRequest,
+) (*scene_hunterv1.PauseGameResponse, error) {
+	roomID, userID, err := parseAdmin(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	gameSession, err := h.service.PauseGame(ctx, roomID, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to pause game: %w", err)
+	}
+
+	return &scene_hunterv1.PauseGameResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
+
+// ResumeGame lets the admin resume a paused game.
+func (h *Handler) ResumeGame(
+	ctx context.Context,
+	req *scene_hunterv1.ResumeGameRequest,
+) (*scene_hunterv1.ResumeGameResponse, error) {
+	roomID, userID, err := parseAdmin(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	gameSession, err := h.service.ResumeGame(ctx, roomID, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to resume game: %w", err)
+	}
+
+	return &scene_hunterv1.ResumeGameResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
+
+// SkipRound lets the admin void the current round.
+func (h *Handler) SkipRound(
+	ctx context.Context,
+	req *scene_hunterv1.SkipRoundRequest,
+) (*scene_hunterv1.SkipRoundResponse, error) {
+	roomID, userID, err := parseAdmin(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	gameSession, err := h.service.SkipRound(ctx, roomID, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to skip round: %w", err)
+	}
+
+	return &scene_hunterv1.SkipRoundResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
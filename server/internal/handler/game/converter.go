@@ -1,49 +1,30 @@
 package game
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
 	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
 // convertGameToProto converts domain game to protobuf game.
 func convertGameToProto(gameObj *game.Game) *scene_hunterv1.Game {
-	pbPlayers := make([]*scene_hunterv1.Player, len(gameObj.Players))
-	for playerIndex, player := range gameObj.Players {
-		pbPlayers[playerIndex] = &scene_hunterv1.Player{
-			UserId:       player.UserID.String(),
-			Name:         player.Name,
-			IsGameMaster: player.IsGameMaster,
-			IsAdmin:      player.IsAdmin,
-			TotalPoints:  int32(player.TotalPoints),
-			IsConnected:  player.IsConnected,
-		}
-	}
+	pbPlayers := convertPlayersToProto(gameObj, gameObj.Players)
+	settings := gameObj.Settings.OrDefault()
 
 	pbRounds := make([]*scene_hunterv1.Round, len(gameObj.Rounds))
 	for roundIndex, round := range gameObj.Rounds {
-		pbHints := make([]*scene_hunterv1.Hint, len(round.Hints))
-		for hintIndex, hint := range round.Hints {
-			pbHints[hintIndex] = &scene_hunterv1.Hint{
-				HintNumber: int32(hint.HintNumber),
-				Text:       hint.Text,
-			}
-		}
-
 		pbSubmissions := make([]*scene_hunterv1.HunterSubmission, len(round.HunterSubmissions))
 		for submissionIndex, submission := range round.HunterSubmissions {
 			pbSubmissions[submissionIndex] = &scene_hunterv1.HunterSubmission{
 				UserId:             submission.UserID.String(),
 				ImageId:            submission.ImageID,
 				SubmittedAtSeconds: int32(submission.SubmittedAtSeconds),
-			}
-		}
-
-		pbResults := make([]*scene_hunterv1.RoundResult, len(round.Results))
-		for resultIndex, result := range round.Results {
-			pbResults[resultIndex] = &scene_hunterv1.RoundResult{
-				UserId: result.UserID.String(),
-				Rank:   int32(result.Rank),
-				Points: int32(result.Points),
+				TeamId:             teamIDString(submission.TeamID),
+				Attempt:            int32(submission.Attempt),
+				Final:              submission.Final,
 			}
 		}
 
@@ -51,11 +32,17 @@ func convertGameToProto(gameObj *game.Game) *scene_hunterv1.Game {
 			RoundNumber:        int32(round.RoundNumber),
 			GameMasterUserId:   round.GameMasterUserID.String(),
 			GameMasterImageId:  round.GameMasterImageID,
-			Hints:              pbHints,
+			Hints:              convertHintsToProto(round.ReleasedHints()),
 			HunterSubmissions:  pbSubmissions,
-			Results:            pbResults,
+			Results:            convertResultsToProto(round.Results),
 			TurnStatus:         convertTurnStatusToProto(round.TurnStatus),
 			TurnElapsedSeconds: int32(round.TurnElapsedSeconds),
+			HintGeneration: convertHintGenerationToProto(
+				round.HintGeneration,
+				settings.HintCount,
+			),
+			VotingEndsAt: formatVotingEndsAt(round.VotingEndsAt),
+			Skipped:      round.Skipped,
 		}
 	}
 
@@ -66,11 +53,115 @@ func convertGameToProto(gameObj *game.Game) *scene_hunterv1.Game {
 		CurrentRound: int32(gameObj.CurrentRound),
 		Players:      pbPlayers,
 		Rounds:       pbRounds,
+		Language:     string(gameObj.HintSettings.OrDefault().Language),
+		Difficulty:   convertHintDifficultyToProto(gameObj.HintSettings.OrDefault().Difficulty),
+		Teams:        convertTeamsToProto(gameObj.Teams),
+		ScenePool:    gameObj.ScenePool,
+		Settings:     convertGameSettingsToProto(settings),
+		Spectators:   convertSpectatorsToProto(gameObj.Spectators),
 		CreatedAt:    gameObj.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:    gameObj.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
+// convertResultsToProto converts domain round results to protobuf round results.
+func convertResultsToProto(results []*game.RoundResult) []*scene_hunterv1.RoundResult {
+	pbResults := make([]*scene_hunterv1.RoundResult, len(results))
+	for index, result := range results {
+		// チーム戦の結果にはユーザーIDがない
+		userID := ""
+		if result.UserID != uuid.Nil {
+			userID = result.UserID.String()
+		}
+
+		pbResults[index] = &scene_hunterv1.RoundResult{
+			UserId: userID,
+			Rank:   int32(result.Rank),
+			Points: int32(result.Points),
+			TeamId: teamIDString(result.TeamID),
+			// 距離の点数だけを返し、距離そのものは返さない
+			DistancePoints: int32(result.DistancePoints),
+		}
+	}
+
+	return pbResults
+}
+
+// convertSpectatorsToProto converts domain spectators to protobuf spectators.
+func convertSpectatorsToProto(spectators []*game.Spectator) []*scene_hunterv1.Spectator {
+	pbSpectators := make([]*scene_hunterv1.Spectator, len(spectators))
+	for index, spectator := range spectators {
+		pbSpectators[index] = &scene_hunterv1.Spectator{
+			UserId: spectator.UserID.String(),
+			Name:   spectator.Name,
+		}
+	}
+
+	return pbSpectators
+}
+
+// convertPlayersToProto converts domain players of a game to protobuf players.
+func convertPlayersToProto(gameObj *game.Game, players []*game.Player) []*scene_hunterv1.Player {
+	pbPlayers := make([]*scene_hunterv1.Player, len(players))
+	for playerIndex, player := range players {
+		teamID := ""
+		if team, err := gameObj.TeamOf(player.UserID); err == nil {
+			teamID = team.ID.String()
+		}
+
+		pbPlayers[playerIndex] = &scene_hunterv1.Player{
+			UserId:       player.UserID.String(),
+			Name:         player.Name,
+			IsGameMaster: player.IsGameMaster,
+			IsAdmin:      player.IsAdmin,
+			TotalPoints:  int32(player.TotalPoints),
+			IsConnected:  player.IsConnected,
+			TeamId:       teamID,
+		}
+	}
+
+	return pbPlayers
+}
+
+// convertHintsToProto converts domain hints to protobuf hints.
+func convertHintsToProto(hints []*game.Hint) []*scene_hunterv1.Hint {
+	pbHints := make([]*scene_hunterv1.Hint, len(hints))
+	for hintIndex, hint := range hints {
+		pbHints[hintIndex] = &scene_hunterv1.Hint{
+			HintNumber: int32(hint.HintNumber),
+			Text:       hint.Text,
+		}
+	}
+
+	return pbHints
+}
+
+// convertHintGenerationToProto converts domain hint generation progress of hintCount hints to protobuf.
+func convertHintGenerationToProto(
+	generation *game.HintGeneration,
+	hintCount int,
+) *scene_hunterv1.HintGeneration {
+	if generation == nil {
+		return nil
+	}
+
+	pbErrors := make([]*scene_hunterv1.HintGenerationError, len(generation.Errors))
+	for errorIndex, generationErr := range generation.Errors {
+		pbErrors[errorIndex] = &scene_hunterv1.HintGenerationError{
+			Attempt:  int32(generationErr.Attempt),
+			Message:  generationErr.Message,
+			FailedAt: generationErr.FailedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return &scene_hunterv1.HintGeneration{
+		GeneratedHints: int32(generation.Generated),
+		TotalHints:     int32(hintCount),
+		Attempts:       int32(generation.Attempts),
+		Errors:         pbErrors,
+	}
+}
+
 // convertGameStatusToProto converts domain game status to protobuf game status.
 func convertGameStatusToProto(status game.GameStatus) scene_hunterv1.GameStatus {
 	switch status {
@@ -80,6 +171,8 @@ func convertGameStatusToProto(status game.GameStatus) scene_hunterv1.GameStatus
 		return scene_hunterv1.GameStatus_GAME_STATUS_IN_PROGRESS
 	case game.GameStatusFinished:
 		return scene_hunterv1.GameStatus_GAME_STATUS_FINISHED
+	case game.GameStatusPaused:
+		return scene_hunterv1.GameStatus_GAME_STATUS_PAUSED
 	default:
 		return scene_hunterv1.GameStatus_GAME_STATUS_UNSPECIFIED
 	}
@@ -94,7 +187,171 @@ func convertTurnStatusToProto(status game.TurnStatus) scene_hunterv1.TurnStatus
 		return scene_hunterv1.TurnStatus_TURN_STATUS_HUNTERS
 	case game.TurnStatusWaitingForSelection:
 		return scene_hunterv1.TurnStatus_TURN_STATUS_WAITING_FOR_SELECTION
+	case game.TurnStatusReviewingHints:
+		return scene_hunterv1.TurnStatus_TURN_STATUS_REVIEWING_HINTS
+	case game.TurnStatusGeneratingHints:
+		return scene_hunterv1.TurnStatus_TURN_STATUS_GENERATING_HINTS
+	case game.TurnStatusCompleted:
+		return scene_hunterv1.TurnStatus_TURN_STATUS_COMPLETED
 	default:
 		return scene_hunterv1.TurnStatus_TURN_STATUS_UNSPECIFIED
 	}
 }
+
+// convertHintDifficultyToProto converts domain hint difficulty to protobuf hint difficulty.
+func convertHintDifficultyToProto(difficulty game.Difficulty) scene_hunterv1.HintDifficulty {
+	switch difficulty {
+	case game.DifficultyEasy:
+		return scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_EASY
+	case game.DifficultyNormal:
+		return scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_NORMAL
+	case game.DifficultyHard:
+		return scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_HARD
+	default:
+		return scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_UNSPECIFIED
+	}
+}
+
+// convertDistanceScoringToProto converts domain distance scoring to protobuf distance scoring.
+func convertDistanceScoringToProto(rule game.DistanceScoring) scene_hunterv1.DistanceScoring {
+	switch rule {
+	case game.DistanceScoringOff:
+		return scene_hunterv1.DistanceScoring_DISTANCE_SCORING_OFF
+	case game.DistanceScoringTiebreak:
+		return scene_hunterv1.DistanceScoring_DISTANCE_SCORING_TIEBREAK
+	case game.DistanceScoringPoints:
+		return scene_hunterv1.DistanceScoring_DISTANCE_SCORING_POINTS
+	default:
+		return scene_hunterv1.DistanceScoring_DISTANCE_SCORING_UNSPECIFIED
+	}
+}
+
+// convertDistanceScoringFromProto converts protobuf distance scoring to domain distance scoring.
+// Unspecified turns distance scoring off.
+func convertDistanceScoringFromProto(rule scene_hunterv1.DistanceScoring) game.DistanceScoring {
+	switch rule {
+	case scene_hunterv1.DistanceScoring_DISTANCE_SCORING_TIEBREAK:
+		return game.DistanceScoringTiebreak
+	case scene_hunterv1.DistanceScoring_DISTANCE_SCORING_POINTS:
+		return game.DistanceScoringPoints
+	case scene_hunterv1.DistanceScoring_DISTANCE_SCORING_UNSPECIFIED,
+		scene_hunterv1.DistanceScoring_DISTANCE_SCORING_OFF:
+		return game.DistanceScoringOff
+	default:
+		return game.DistanceScoringOff
+	}
+}
+
+// convertRankingModeToProto converts domain ranking mode to protobuf ranking mode.
+func convertRankingModeToProto(mode game.RankingMode) scene_hunterv1.RankingMode {
+	switch mode {
+	case game.RankingGameMaster:
+		return scene_hunterv1.RankingMode_RANKING_MODE_GAME_MASTER
+	case game.RankingAudience:
+		return scene_hunterv1.RankingMode_RANKING_MODE_AUDIENCE
+	default:
+		return scene_hunterv1.RankingMode_RANKING_MODE_UNSPECIFIED
+	}
+}
+
+// convertRankingModeFromProto converts protobuf ranking mode to domain ranking mode.
+// Unspecified lets the game master rank the photos.
+func convertRankingModeFromProto(mode scene_hunterv1.RankingMode) game.RankingMode {
+	switch mode {
+	case scene_hunterv1.RankingMode_RANKING_MODE_AUDIENCE:
+		return game.RankingAudience
+	case scene_hunterv1.RankingMode_RANKING_MODE_UNSPECIFIED,
+		scene_hunterv1.RankingMode_RANKING_MODE_GAME_MASTER:
+		return game.RankingGameMaster
+	default:
+		return game.RankingGameMaster
+	}
+}
+
+// formatVotingEndsAt formats the end of the audience's voting, or returns an empty string
+// if the audience has not voted in the round.
+func formatVotingEndsAt(endsAt time.Time) string {
+	if endsAt.IsZero() {
+		return ""
+	}
+
+	return endsAt.Format("2006-01-02T15:04:05Z07:00")
+}
+
+// convertHintSettingsFromProto converts the protobuf hint language and difficulty to
+// domain hint settings. Unspecified values use the defaults.
+func convertHintSettingsFromProto(
+	language string,
+	difficulty scene_hunterv1.HintDifficulty,
+) (game.HintSettings, error) {
+	var domainDifficulty game.Difficulty
+
+	switch difficulty {
+	case scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_EASY:
+		domainDifficulty = game.DifficultyEasy
+	case scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_NORMAL:
+		domainDifficulty = game.DifficultyNormal
+	case scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_HARD:
+		domainDifficulty = game.DifficultyHard
+	case scene_hunterv1.HintDifficulty_HINT_DIFFICULTY_UNSPECIFIED:
+	}
+
+	settings, err := game.NewHintSettings(language, domainDifficulty)
+	if err != nil {
+		return game.HintSettings{}, errors.Errorf("failed to create hint settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// convertGameSettingsToProto converts domain game settings to protobuf game settings.
+func convertGameSettingsToProto(settings game.GameSettings) *scene_hunterv1.GameSettings {
+	schedule := scene_hunterv1.HintSchedule_HINT_SCHEDULE_LINEAR
+	if settings.HintSchedule == game.HintScheduleCustom {
+		schedule = scene_hunterv1.HintSchedule_HINT_SCHEDULE_CUSTOM
+	}
+
+	offsets := make([]int32, len(settings.HintOffsetsSeconds))
+	for offsetIndex, offset := range settings.HintOffsetsSeconds {
+		offsets[offsetIndex] = int32(offset)
+	}
+
+	return &scene_hunterv1.GameSettings{
+		TurnSeconds:           int32(settings.TurnSeconds),
+		HintCount:             int32(settings.HintCount),
+		HintSchedule:          schedule,
+		HintIntervalSeconds:   int32(settings.HintIntervalSeconds),
+		HintOffsetsSeconds:    offsets,
+		AttemptLimit:          int32(settings.AttemptLimit),
+		DistanceScoring:       convertDistanceScoringToProto(settings.DistanceScoring),
+		SpectatorDelaySeconds: int32(settings.SpectatorDelaySeconds),
+		RankingMode:           convertRankingModeToProto(settings.RankingMode),
+		VotingSeconds:         int32(settings.VotingSeconds),
+	}
+}
+
+// convertGameSettingsFromProto converts the protobuf turn, hint schedule and attempts of a new game to
+// domain game settings. Unset values use the defaults.
+func convertGameSettingsFromProto(req *scene_hunterv1.StartGameRequest) (game.GameSettings, error) {
+	offsets := make([]int, len(req.GetHintOffsetsSeconds()))
+	for offsetIndex, offset := range req.GetHintOffsetsSeconds() {
+		offsets[offsetIndex] = int(offset)
+	}
+
+	settings, err := game.NewGameSettings(game.GameSettings{
+		TurnSeconds:           int(req.GetTurnSeconds()),
+		HintCount:             int(req.GetHintCount()),
+		HintIntervalSeconds:   int(req.GetHintIntervalSeconds()),
+		HintOffsetsSeconds:    offsets,
+		AttemptLimit:          int(req.GetAttemptLimit()),
+		DistanceScoring:       convertDistanceScoringFromProto(req.GetDistanceScoring()),
+		SpectatorDelaySeconds: int(req.GetSpectatorDelaySeconds()),
+		RankingMode:           convertRankingModeFromProto(req.GetRankingMode()),
+		VotingSeconds:         int(req.GetVotingSeconds()),
+	})
+	if err != nil {
+		return game.GameSettings{}, errors.Errorf("failed to create game settings: %w", err)
+	}
+
+	return settings, nil
+}
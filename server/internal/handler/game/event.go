@@ -0,0 +1,168 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// GetGameEvents returns the events of the game after the requested one to its players.
+func (h *Handler) GetGameEvents(
+	ctx context.Context,
+	req *scene_hunterv1.GetGameEventsRequest,
+) (*scene_hunterv1.GetGameEventsResponse, error) {
+	roomID, err := uuid.Parse(req.GetRoomId())
+	if err != nil {
+		return nil, errors.Errorf("invalid room_id: %w", err)
+	}
+
+	userID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	events, err := h.service.GetGameEvents(ctx, roomID, userID, req.GetAfterId())
+	if err != nil {
+		return nil, errors.Errorf("failed to get game events: %w", err)
+	}
+
+	return &scene_hunterv1.GetGameEventsResponse{
+		Events: convertEventsToProto(events),
+	}, nil
+}
+
+// convertEventsToProto converts domain game events to protobuf game events.
+func convertEventsToProto(events []*game.Event) []*scene_hunterv1.GameEvent {
+	pbEvents := make([]*scene_hunterv1.GameEvent, len(events))
+	for index, event := range events {
+		// 誰も起こしていない出来事は空にする
+		userID := ""
+		if event.UserID != uuid.Nil {
+			userID = event.UserID.String()
+		}
+
+		pbEvents[index] = &scene_hunterv1.GameEvent{
+			Type:        convertEventTypeToProto(event.Type),
+			UserId:      userID,
+			RoundNumber: int32(event.RoundNumber),
+			OccurredAt:  event.OccurredAt.Format("2006-01-02T15:04:05Z07:00"),
+			Id:          event.ID,
+		}
+		setEventPayloadToProto(pbEvents[index], event.Payload)
+	}
+
+	return pbEvents
+}
+
+// setEventPayloadToProto sets the domain payload of an event to the protobuf game event.
+func setEventPayloadToProto(pbEvent *scene_hunterv1.GameEvent, payload game.EventPayload) {
+	switch {
+	case payload.Photo != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_Photo{Photo: &scene_hunterv1.PhotoEventPayload{
+			ImageId:            payload.Photo.ImageID,
+			Attempt:            int32(payload.Photo.Attempt),
+			SubmittedAtSeconds: int32(payload.Photo.SubmittedAtSeconds),
+			Final:              payload.Photo.Final,
+		}}
+	case payload.Hints != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_Hints{Hints: &scene_hunterv1.HintsEventPayload{
+			Hints: convertHintsToProto(payload.Hints),
+		}}
+	case payload.HintAttempt != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_HintAttempt{
+			HintAttempt: &scene_hunterv1.HintAttemptEventPayload{
+				Attempt:   int32(payload.HintAttempt.Attempt),
+				Generated: int32(payload.HintAttempt.Generated),
+				Message:   payload.HintAttempt.Message,
+			},
+		}
+	case payload.Results != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_Results{
+			Results: &scene_hunterv1.ResultsEventPayload{
+				Results: convertResultsToProto(payload.Results),
+			},
+		}
+	case payload.Voting != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_Voting{
+			Voting: &scene_hunterv1.VotingEventPayload{
+				Ballots: int32(payload.Voting.Ballots),
+			},
+		}
+	case payload.Proximity != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_Proximity{
+			Proximity: &scene_hunterv1.ProximityEventPayload{
+				Proximity: convertProximityToProto(payload.Proximity.Proximity),
+			},
+		}
+	case payload.Teams != nil:
+		pbEvent.Payload = &scene_hunterv1.GameEvent_Teams{Teams: &scene_hunterv1.TeamsEventPayload{
+			Teams: convertTeamsToProto(payload.Teams),
+		}}
+	}
+}
+
+// convertEventTypeToProto converts domain event type to protobuf game event type.
+func convertEventTypeToProto(eventType game.EventType) scene_hunterv1.GameEventType {
+	switch eventType {
+	case game.EventGamePaused:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_GAME_PAUSED
+	case game.EventGameResumed:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_GAME_RESUMED
+	case game.EventRoundSkipped:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_ROUND_SKIPPED
+	case game.EventPlayerJoined:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_PLAYER_JOINED
+	case game.EventRoundStarted:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_ROUND_STARTED
+	case game.EventHintsGenerated:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINTS_GENERATED
+	case game.EventPhotoSubmitted:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_PHOTO_SUBMITTED
+	case game.EventWinnersSelected:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_WINNERS_SELECTED
+	case game.EventGameEnded:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_GAME_ENDED
+	case game.EventGameStarted:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_GAME_STARTED
+	case game.EventTeamsChanged:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_TEAMS_CHANGED
+	case game.EventSpectatorJoined:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_SPECTATOR_JOINED
+	case game.EventGameMasterPhotoSubmitted:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_GAME_MASTER_PHOTO_SUBMITTED
+	case game.EventHintAttemptStarted:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINT_ATTEMPT_STARTED
+	case game.EventHintsProgressed:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINTS_PROGRESSED
+	case game.EventHintAttemptFailed:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINT_ATTEMPT_FAILED
+	case game.EventHintGenerationFailed:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINT_GENERATION_FAILED
+	case game.EventHintEdited:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINT_EDITED
+	case game.EventHintRegenerated:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINT_REGENERATED
+	case game.EventHintsReordered:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINTS_REORDERED
+	case game.EventHintsReleased:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HINTS_RELEASED
+	case game.EventFinalPhotoPicked:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_FINAL_PHOTO_PICKED
+	case game.EventHuntFinished:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_HUNT_FINISHED
+	case game.EventVoteCast:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_VOTE_CAST
+	case game.EventVotingClosed:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_VOTING_CLOSED
+	case game.EventProximityChecked:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_PROXIMITY_CHECKED
+	case game.EventPlayerAnonymized:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_PLAYER_ANONYMIZED
+	default:
+		return scene_hunterv1.GameEventType_GAME_EVENT_TYPE_UNSPECIFIED
+	}
+}
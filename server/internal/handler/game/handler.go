@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
 	"github.com/yashikota/scene-hunter/server/internal/service"
 	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
 	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
@@ -41,12 +42,30 @@ func (h *Handler) StartGame(
 		return nil, errors.Errorf("invalid game_master_user_id: %w", err)
 	}
 
-	game, err := h.service.StartGame(ctx, roomID, int(req.GetTotalRounds()), gameMasterUserID)
+	hintSettings, err := convertHintSettingsFromProto(req.GetLanguage(), req.GetDifficulty())
+	if err != nil {
+		return nil, errors.Errorf("invalid hint settings: %w", err)
+	}
+
+	settings, err := convertGameSettingsFromProto(req)
+	if err != nil {
+		return nil, errors.Errorf("invalid game settings: %w", err)
+	}
+
+	gameSession, err := h.service.StartGame(
+		ctx,
+		roomID,
+		int(req.GetTotalRounds()),
+		gameMasterUserID,
+		hintSettings,
+		settings,
+		req.GetScenePool(),
+	)
 	if err != nil {
 		return nil, errors.Errorf("failed to start game: %w", err)
 	}
 
-	pbGame := convertGameToProto(game)
+	pbGame := convertGameToProto(gameSession)
 
 	return &scene_hunterv1.StartGameResponse{
 		Game: pbGame,
@@ -79,6 +98,17 @@ func (h *Handler) JoinGame(
 		return nil, errors.New("cannot join game as another user")
 	}
 
+	if req.GetSpectator() {
+		view, err := h.service.WatchGame(ctx, roomID, userID, req.GetName())
+		if err != nil {
+			return nil, errors.Errorf("failed to watch game: %w", err)
+		}
+
+		return &scene_hunterv1.JoinGameResponse{
+			Game: convertGameToProto(view),
+		}, nil
+	}
+
 	// Get room to check admin status
 	room, err := h.roomRepo.Get(ctx, roomID)
 	if err != nil {
@@ -133,22 +163,18 @@ func (h *Handler) SubmitGameMasterPhoto(
 	// Authorization check is done in the service layer
 	// (verifies user is the game master for current round)
 
-	imageID, hints, err := h.service.SubmitGameMasterPhoto(ctx, roomID, userID, req.GetImageData())
+	photo, err := toPhoto(req.GetImageData(), req.GetUploadId(), req.GetLocation())
 	if err != nil {
-		return nil, errors.Errorf("failed to submit game master photo: %w", err)
+		return nil, err
 	}
 
-	pbHints := make([]*scene_hunterv1.Hint, len(hints))
-	for i, hint := range hints {
-		pbHints[i] = &scene_hunterv1.Hint{
-			HintNumber: int32(hint.HintNumber),
-			Text:       hint.Text,
-		}
+	imageID, err := h.service.SubmitGameMasterPhoto(ctx, roomID, userID, photo)
+	if err != nil {
+		return nil, errors.Errorf("failed to submit game master photo: %w", err)
 	}
 
 	return &scene_hunterv1.SubmitGameMasterPhotoResponse{
 		ImageId: imageID,
-		Hints:   pbHints,
 	}, nil
 }
 
@@ -157,34 +183,32 @@ func (h *Handler) SubmitHunterPhoto(
 	ctx context.Context,
 	req *scene_hunterv1.SubmitHunterPhotoRequest,
 ) (*scene_hunterv1.SubmitHunterPhotoResponse, error) {
-	roomID, err := uuid.Parse(req.GetRoomId())
+	roomID, userID, err := parseHunter(ctx, req.GetRoomId(), req.GetUserId())
 	if err != nil {
-		return nil, errors.Errorf("invalid room_id: %w", err)
+		return nil, err
 	}
 
-	userID, err := uuid.Parse(req.GetUserId())
+	photo, err := toPhoto(req.GetImageData(), req.GetUploadId(), req.GetLocation())
 	if err != nil {
-		return nil, errors.Errorf("invalid user_id: %w", err)
+		return nil, err
 	}
 
-	imageID, allSubmitted, err := h.service.SubmitHunterPhoto(
-		ctx,
-		roomID,
-		userID,
-		req.GetImageData(),
-		int(req.GetElapsedSeconds()),
-	)
+	result, err := h.service.SubmitHunterPhoto(ctx, roomID, userID, photo)
 	if err != nil {
 		return nil, errors.Errorf("failed to submit hunter photo: %w", err)
 	}
 
 	return &scene_hunterv1.SubmitHunterPhotoResponse{
-		ImageId:             imageID,
-		AllHuntersSubmitted: allSubmitted,
+		ImageId:             result.Submission.ImageID,
+		AllHuntersSubmitted: result.AllHuntersSubmitted,
+		Attempt:             int32(result.Submission.Attempt),
+		Final:               result.Submission.Final,
+		Proximity:           convertProximityToProto(result.Proximity),
 	}, nil
 }
 
-// GetHunterPhotos returns all hunter photo submissions for the current round.
+// GetHunterPhotos returns the final photos of the hunters in the current round
+// to the game master of the round.
 func (h *Handler) GetHunterPhotos(
 	ctx context.Context,
 	req *scene_hunterv1.GetHunterPhotosRequest,
@@ -194,7 +218,13 @@ func (h *Handler) GetHunterPhotos(
 		return nil, errors.Errorf("invalid room_id: %w", err)
 	}
 
-	submissions, err := h.service.GetHunterPhotos(ctx, roomID)
+	// 順位を付ける親だけが写真を見られる
+	userID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	submissions, err := h.service.GetHunterPhotos(ctx, roomID, userID)
 	if err != nil {
 		return nil, errors.Errorf("failed to get hunter photos: %w", err)
 	}
@@ -205,6 +235,9 @@ func (h *Handler) GetHunterPhotos(
 			UserId:             sub.UserID.String(),
 			ImageId:            sub.ImageID,
 			SubmittedAtSeconds: int32(sub.SubmittedAtSeconds),
+			TeamId:             teamIDString(sub.TeamID),
+			Attempt:            int32(sub.Attempt),
+			Final:              sub.Final,
 		}
 	}
 
@@ -242,16 +275,29 @@ func (h *Handler) SelectWinners(
 	// Authorization check is done in the service layer
 	// (verifies user is the game master for current round)
 
-	// Convert rankings from proto to map
+	// Convert rankings from proto to map, keyed by team ID in team mode
 	rankings := make(map[uuid.UUID]int)
 
 	for _, rankSel := range req.GetRankings() {
-		userID, err := uuid.Parse(rankSel.GetUserId())
+		var (
+			targetID uuid.UUID
+			err      error
+		)
+
+		switch target := rankSel.GetTarget().(type) {
+		case *scene_hunterv1.RankSelection_UserId:
+			targetID, err = uuid.Parse(target.UserId)
+		case *scene_hunterv1.RankSelection_TeamId:
+			targetID, err = uuid.Parse(target.TeamId)
+		default:
+			err = errors.New("user_id or team_id is required")
+		}
+
 		if err != nil {
-			return nil, errors.Errorf("invalid user_id in rankings: %w", err)
+			return nil, errors.Errorf("invalid target in rankings: %w", err)
 		}
 
-		rankings[userID] = int(rankSel.GetRank())
+		rankings[targetID] = int(rankSel.GetRank())
 	}
 
 	game, err := h.service.SelectWinners(ctx, roomID, gameMasterUserID, rankings)
@@ -276,7 +322,13 @@ func (h *Handler) GetGameState(
 		return nil, errors.Errorf("invalid room_id: %w", err)
 	}
 
-	game, err := h.service.GetGameState(ctx, roomID)
+	// 観戦者には遅延した状態を返すため、閲覧者を確認する
+	viewerID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	game, err := h.service.GetGameState(ctx, roomID, viewerID)
 	if err != nil {
 		return nil, errors.Errorf("failed to get game state: %w", err)
 	}
@@ -303,7 +355,16 @@ func (h *Handler) StartNextRound(
 		return nil, errors.Errorf("invalid game_master_user_id: %w", err)
 	}
 
-	game, err := h.service.StartRound(ctx, roomID, gameMasterUserID)
+	// シーンプールを使うゲームでは、空ならランダムに選ぶ
+	sceneImageID := uuid.Nil
+	if req.GetSceneImageId() != "" {
+		sceneImageID, err = uuid.Parse(req.GetSceneImageId())
+		if err != nil {
+			return nil, errors.Errorf("invalid scene_image_id: %w", err)
+		}
+	}
+
+	game, err := h.service.StartRound(ctx, roomID, gameMasterUserID, sceneImageID)
 	if err != nil {
 		return nil, errors.Errorf("failed to start next round: %w", err)
 	}
@@ -332,20 +393,39 @@ func (h *Handler) EndGame(
 
 	pbGame := convertGameToProto(game)
 
-	pbRankings := make([]*scene_hunterv1.Player, len(rankings))
-	for i, player := range rankings {
-		pbRankings[i] = &scene_hunterv1.Player{
-			UserId:       player.UserID.String(),
-			Name:         player.Name,
-			IsGameMaster: player.IsGameMaster,
-			IsAdmin:      player.IsAdmin,
-			TotalPoints:  int32(player.TotalPoints),
-			IsConnected:  player.IsConnected,
+	return &scene_hunterv1.EndGameResponse{
+		Game:              pbGame,
+		FinalRankings:     convertPlayersToProto(game, rankings),
+		FinalTeamRankings: convertTeamsToProto(game.GetFinalTeamRankings()),
+	}, nil
+}
+
+// toPhoto converts the photo of a submit request and where it was taken to a game service photo.
+func toPhoto(
+	imageData []byte,
+	rawUploadID string,
+	pbLocation *scene_hunterv1.Location,
+) (gamesvc.Photo, error) {
+	// 撮影場所が送られなければ写真のGPS情報を使う
+	var location *game.Location
+
+	if pbLocation != nil {
+		converted, err := convertLocationFromProto(pbLocation)
+		if err != nil {
+			return gamesvc.Photo{}, err
 		}
+
+		location = converted
 	}
 
-	return &scene_hunterv1.EndGameResponse{
-		Game:          pbGame,
-		FinalRankings: pbRankings,
-	}, nil
+	if rawUploadID == "" {
+		return gamesvc.Photo{Data: imageData, UploadID: uuid.Nil, Location: location}, nil
+	}
+
+	uploadID, err := uuid.Parse(rawUploadID)
+	if err != nil {
+		return gamesvc.Photo{}, errors.Errorf("invalid upload_id: %w", err)
+	}
+
+	return gamesvc.Photo{Data: nil, UploadID: uploadID, Location: location}, nil
 }
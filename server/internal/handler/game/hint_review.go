@@ -0,0 +1,147 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// GetHintDraft returns the hints under review to the game master.
+func (h *Handler) GetHintDraft(
+	ctx context.Context,
+	req *scene_hunterv1.GetHintDraftRequest,
+) (*scene_hunterv1.GetHintDraftResponse, error) {
+	roomID, userID, err := parseReviewer(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	hints, err := h.service.GetHintDraft(ctx, roomID, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get hint draft: %w", err)
+	}
+
+	return &scene_hunterv1.GetHintDraftResponse{
+		Hints: convertHintsToProto(hints),
+	}, nil
+}
+
+// UpdateHint rewrites the text of a hint under review.
+func (h *Handler) UpdateHint(
+	ctx context.Context,
+	req *scene_hunterv1.UpdateHintRequest,
+) (*scene_hunterv1.UpdateHintResponse, error) {
+	roomID, userID, err := parseReviewer(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	hints, err := h.service.UpdateHint(
+		ctx,
+		roomID,
+		userID,
+		int(req.GetHintNumber()),
+		req.GetText(),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to update hint: %w", err)
+	}
+
+	return &scene_hunterv1.UpdateHintResponse{
+		Hints: convertHintsToProto(hints),
+	}, nil
+}
+
+// ReorderHints reorders the hints under review.
+func (h *Handler) ReorderHints(
+	ctx context.Context,
+	req *scene_hunterv1.ReorderHintsRequest,
+) (*scene_hunterv1.ReorderHintsResponse, error) {
+	roomID, userID, err := parseReviewer(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(req.GetHintNumbers()))
+	for index, hintNumber := range req.GetHintNumbers() {
+		order[index] = int(hintNumber)
+	}
+
+	hints, err := h.service.ReorderHints(ctx, roomID, userID, order)
+	if err != nil {
+		return nil, errors.Errorf("failed to reorder hints: %w", err)
+	}
+
+	return &scene_hunterv1.ReorderHintsResponse{
+		Hints: convertHintsToProto(hints),
+	}, nil
+}
+
+// RegenerateHint asks the AI for a new hint in place of a hint under review.
+func (h *Handler) RegenerateHint(
+	ctx context.Context,
+	req *scene_hunterv1.RegenerateHintRequest,
+) (*scene_hunterv1.RegenerateHintResponse, error) {
+	roomID, userID, err := parseReviewer(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	hints, err := h.service.RegenerateHint(ctx, roomID, userID, int(req.GetHintNumber()))
+	if err != nil {
+		return nil, errors.Errorf("failed to regenerate hint: %w", err)
+	}
+
+	return &scene_hunterv1.RegenerateHintResponse{
+		Hints: convertHintsToProto(hints),
+	}, nil
+}
+
+// ReleaseHints ends the review and starts the hunters' turn.
+func (h *Handler) ReleaseHints(
+	ctx context.Context,
+	req *scene_hunterv1.ReleaseHintsRequest,
+) (*scene_hunterv1.ReleaseHintsResponse, error) {
+	roomID, userID, err := parseReviewer(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	gameSession, err := h.service.ReleaseHints(ctx, roomID, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to release hints: %w", err)
+	}
+
+	return &scene_hunterv1.ReleaseHintsResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
+
+// parseReviewer parses the room and user IDs of a hint review request
+// and verifies that the user is reviewing as themselves.
+// Whether the user is the game master is checked in the service layer.
+func parseReviewer(ctx context.Context, rawRoomID, rawUserID string) (uuid.UUID, uuid.UUID, error) {
+	roomID, err := uuid.Parse(rawRoomID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("invalid room_id: %w", err)
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("invalid user_id: %w", err)
+	}
+
+	authenticatedUserID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	if userID != authenticatedUserID {
+		return uuid.Nil, uuid.Nil, errors.New("cannot review hints as another user")
+	}
+
+	return roomID, userID, nil
+}
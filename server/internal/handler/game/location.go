@@ -0,0 +1,64 @@
+package game
+
+import (
+	"context"
+
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// CheckProximity tells a hunter whether they are warmer or colder than at their previous check.
+func (h *Handler) CheckProximity(
+	ctx context.Context,
+	req *scene_hunterv1.CheckProximityRequest,
+) (*scene_hunterv1.CheckProximityResponse, error) {
+	roomID, userID, err := parseHunter(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetLocation() == nil {
+		return nil, errors.New("location is required")
+	}
+
+	location, err := convertLocationFromProto(req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	proximity, err := h.service.CheckProximity(ctx, roomID, userID, location)
+	if err != nil {
+		return nil, errors.Errorf("failed to check proximity: %w", err)
+	}
+
+	return &scene_hunterv1.CheckProximityResponse{
+		Proximity: convertProximityToProto(proximity),
+	}, nil
+}
+
+// convertLocationFromProto converts a protobuf location to a domain location.
+func convertLocationFromProto(location *scene_hunterv1.Location) (*game.Location, error) {
+	domainLocation, err := game.NewLocation(location.GetLatitude(), location.GetLongitude())
+	if err != nil {
+		return nil, errors.Errorf("invalid location: %w", err)
+	}
+
+	return domainLocation, nil
+}
+
+// convertProximityToProto converts domain proximity to protobuf proximity.
+func convertProximityToProto(proximity game.Proximity) scene_hunterv1.Proximity {
+	switch proximity {
+	case game.ProximityWarmer:
+		return scene_hunterv1.Proximity_PROXIMITY_WARMER
+	case game.ProximityColder:
+		return scene_hunterv1.Proximity_PROXIMITY_COLDER
+	case game.ProximitySame:
+		return scene_hunterv1.Proximity_PROXIMITY_SAME
+	case game.ProximityUnknown:
+		return scene_hunterv1.Proximity_PROXIMITY_UNSPECIFIED
+	default:
+		return scene_hunterv1.Proximity_PROXIMITY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,130 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// AssignTeams puts the players into the teams chosen by the admin.
+func (h *Handler) AssignTeams(
+	ctx context.Context,
+	req *scene_hunterv1.AssignTeamsRequest,
+) (*scene_hunterv1.AssignTeamsResponse, error) {
+	roomID, userID, err := parseAdmin(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]gamesvc.TeamAssignment, 0, len(req.GetTeams()))
+
+	for _, pbTeam := range req.GetTeams() {
+		memberIDs := make([]uuid.UUID, 0, len(pbTeam.GetMemberUserIds()))
+
+		for _, rawMemberID := range pbTeam.GetMemberUserIds() {
+			memberID, err := uuid.Parse(rawMemberID)
+			if err != nil {
+				return nil, errors.Errorf("invalid member_user_ids: %w", err)
+			}
+
+			memberIDs = append(memberIDs, memberID)
+		}
+
+		assignments = append(assignments, gamesvc.TeamAssignment{
+			Name:      pbTeam.GetName(),
+			MemberIDs: memberIDs,
+		})
+	}
+
+	gameSession, err := h.service.AssignTeams(ctx, roomID, userID, assignments)
+	if err != nil {
+		return nil, errors.Errorf("failed to assign teams: %w", err)
+	}
+
+	return &scene_hunterv1.AssignTeamsResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
+
+// BalanceTeams splits the players into teams of nearly equal size.
+func (h *Handler) BalanceTeams(
+	ctx context.Context,
+	req *scene_hunterv1.BalanceTeamsRequest,
+) (*scene_hunterv1.BalanceTeamsResponse, error) {
+	roomID, userID, err := parseAdmin(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	gameSession, err := h.service.BalanceTeams(ctx, roomID, userID, int(req.GetTeamCount()))
+	if err != nil {
+		return nil, errors.Errorf("failed to balance teams: %w", err)
+	}
+
+	return &scene_hunterv1.BalanceTeamsResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
+
+// parseAdmin parses the room and user IDs of a request to set up teams or control the game
+// and verifies that the user is acting as themselves.
+// Whether the user is the admin is checked in the service layer.
+func parseAdmin(
+	ctx context.Context,
+	rawRoomID, rawUserID string,
+) (uuid.UUID, uuid.UUID, error) {
+	roomID, err := uuid.Parse(rawRoomID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("invalid room_id: %w", err)
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("invalid user_id: %w", err)
+	}
+
+	authenticatedUserID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	if userID != authenticatedUserID {
+		return uuid.Nil, uuid.Nil, errors.New("cannot act as another user")
+	}
+
+	return roomID, userID, nil
+}
+
+// convertTeamsToProto converts domain teams to protobuf teams.
+func convertTeamsToProto(teams []*game.Team) []*scene_hunterv1.Team {
+	pbTeams := make([]*scene_hunterv1.Team, len(teams))
+	for teamIndex, team := range teams {
+		memberIDs := make([]string, len(team.MemberIDs))
+		for memberIndex, memberID := range team.MemberIDs {
+			memberIDs[memberIndex] = memberID.String()
+		}
+
+		pbTeams[teamIndex] = &scene_hunterv1.Team{
+			TeamId:        team.ID.String(),
+			Name:          team.Name,
+			MemberUserIds: memberIDs,
+			TotalPoints:   int32(team.TotalPoints),
+		}
+	}
+
+	return pbTeams
+}
+
+// teamIDString returns the string form of a team ID, or empty outside team mode.
+func teamIDString(teamID uuid.UUID) string {
+	if teamID == uuid.Nil {
+		return ""
+	}
+
+	return teamID.String()
+}
@@ -0,0 +1,72 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// SubmitVote records a player's or spectator's ranking of the photos of the current round.
+func (h *Handler) SubmitVote(
+	ctx context.Context,
+	req *scene_hunterv1.SubmitVoteRequest,
+) (*scene_hunterv1.SubmitVoteResponse, error) {
+	roomID, voterID, err := parseHunter(ctx, req.GetRoomId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	ranking := make([]uuid.UUID, len(req.GetRanking()))
+	for index, rawID := range req.GetRanking() {
+		ranking[index], err = uuid.Parse(rawID)
+		if err != nil {
+			return nil, errors.Errorf("invalid ranking: %w", err)
+		}
+	}
+
+	closed, err := h.service.SubmitVote(ctx, roomID, voterID, ranking)
+	if err != nil {
+		return nil, errors.Errorf("failed to submit vote: %w", err)
+	}
+
+	return &scene_hunterv1.SubmitVoteResponse{
+		VotingClosed: closed,
+	}, nil
+}
+
+// CloseVoting ranks the photos of the current round by the votes so far.
+func (h *Handler) CloseVoting(
+	ctx context.Context,
+	req *scene_hunterv1.CloseVotingRequest,
+) (*scene_hunterv1.CloseVotingResponse, error) {
+	roomID, err := uuid.Parse(req.GetRoomId())
+	if err != nil {
+		return nil, errors.Errorf("invalid room_id: %w", err)
+	}
+
+	gameMasterUserID, err := uuid.Parse(req.GetGameMasterUserId())
+	if err != nil {
+		return nil, errors.Errorf("invalid game_master_user_id: %w", err)
+	}
+
+	authenticatedUserID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to get authenticated user ID: %w", err)
+	}
+
+	if gameMasterUserID != authenticatedUserID {
+		return nil, errors.New("cannot close voting as another user")
+	}
+
+	gameSession, err := h.service.CloseVoting(ctx, roomID, gameMasterUserID)
+	if err != nil {
+		return nil, errors.Errorf("failed to close voting: %w", err)
+	}
+
+	return &scene_hunterv1.CloseVotingResponse{
+		Game: convertGameToProto(gameSession),
+	}, nil
+}
@@ -2,54 +2,23 @@
 package image
 
 import (
-	"bytes"
 	"context"
-	"image"
-	_ "image/gif"
-	"image/jpeg"
-	_ "image/png"
-	"io"
-	"path/filepath"
-	"strings"
-	"time"
 
 	"connectrpc.com/connect"
-	"github.com/anthonynsimon/bild/transform"
-	"github.com/google/uuid"
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
-	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
-	"github.com/yashikota/scene-hunter/server/internal/service"
-	"github.com/yashikota/scene-hunter/server/internal/util/errors"
-	_ "golang.org/x/image/webp"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
 )
 
-// ErrRoomNotFound is returned when the room is not found.
-var ErrRoomNotFound = errors.New("room not found")
-
-// ErrImageNotFound is returned when the image is not found.
-var ErrImageNotFound = errors.New("image not found")
-
-// TTL は画像の有効期限（1時間）.
-const TTL = 1 * time.Hour
-
-// ImageHandler handles image operations with infrastructure dependencies.
+// ImageHandler handles image operations.
+// Images, including game photos, are stored and looked up through the image catalog.
 type ImageHandler struct {
-	blobClient service.Blob
-	kvsClient  service.KVS
-	roomRepo   service.RoomRepository
+	service *imagesvc.Service
 }
 
 // NewImageHandler creates a new image handler.
-func NewImageHandler(
-	blobClient service.Blob,
-	kvsClient service.KVS,
-	roomRepo service.RoomRepository,
-) *ImageHandler {
+func NewImageHandler(service *imagesvc.Service) *ImageHandler {
 	return &ImageHandler{
-		blobClient: blobClient,
-		kvsClient:  kvsClient,
-		roomRepo:   roomRepo,
+		service: service,
 	}
 }
 
@@ -58,54 +27,13 @@ func (h *ImageHandler) UploadImage(
 	ctx context.Context,
 	req *connect.Request[scene_hunterv1.UploadImageRequest],
 ) (*connect.Response[scene_hunterv1.UploadImageResponse], error) {
-	// room codeからroomを取得
-	roomCodeKey := "room_code:" + req.Msg.GetRoomCode()
-
-	roomIDStr, err := h.kvsClient.Get(ctx, roomCodeKey)
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: code=%s", ErrRoomNotFound, req.Msg.GetRoomCode()),
-		)
-	}
-
-	roomID, err := uuid.Parse(roomIDStr)
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("invalid room ID: %w", err),
-		)
-	}
-
-	// 画像データの作成とバリデーション
-	img, err := domainimage.NewImage(
-		req.Msg.GetRoomCode(),
-		req.Msg.GetContentType(),
-		req.Msg.GetImageData(),
-	)
+	resp, err := h.service.UploadImage(ctx, req.Msg)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			errors.Errorf("invalid image data: %w", err),
-		)
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	// RustFSに保存（roomIDベースのパスを使用）
-	path := domainimage.PathFromRoomID(roomID, img.ID, img.ContentType)
-
-	err = h.blobClient.Put(ctx, path, img.Reader(), TTL)
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to save image: %w", err),
-		)
-	}
-
-	// レスポンス生成
-	return connect.NewResponse(&scene_hunterv1.UploadImageResponse{
-		ImageId:   img.ID.String(),
-		ImagePath: path,
-	}), nil
+	return connect.NewResponse(resp), nil
 }
 
 // GetImage retrieves an image from blob storage.
@@ -113,290 +41,119 @@ func (h *ImageHandler) GetImage(
 	ctx context.Context,
 	req *connect.Request[scene_hunterv1.GetImageRequest],
 ) (*connect.Response[scene_hunterv1.GetImageResponse], error) {
-	// Parse UUIDs
-	roomID, err := uuid.Parse(req.Msg.GetRoomId())
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			errors.Errorf("invalid room ID: %w", err),
-		)
-	}
-
-	imageID, err := uuid.Parse(req.Msg.GetImageId())
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			errors.Errorf("invalid image ID: %w", err),
-		)
-	}
-
-	// room IDの存在確認
-	exists, err := h.roomRepo.Exists(ctx, roomID)
+	resp, err := h.service.GetImage(ctx, req.Msg)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to check room existence: %w", err),
-		)
-	}
-
-	if !exists {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrRoomNotFound, roomID),
-		)
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	// 画像のパスを構築 (各contentTypeを試す)
-	var (
-		imageData   []byte
-		contentType string
-	)
-
-	supportedTypes := []string{"image/jpeg", "image/png", "image/webp"}
-
-	for _, candidateType := range supportedTypes {
-		path := domainimage.PathFromRoomID(roomID, imageID, candidateType)
-
-		reader, err := h.blobClient.Get(ctx, path)
-		if err != nil {
-			continue
-		}
-
-		defer func() {
-			_ = reader.Close()
-		}()
-
-		data, err := io.ReadAll(reader)
-		if err != nil {
-			continue
-		}
-
-		imageData = data
-		contentType = candidateType
-
-		break
-	}
-
-	if imageData == nil {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrImageNotFound, imageID),
-		)
-	}
-
-	return connect.NewResponse(&scene_hunterv1.GetImageResponse{
-		ImageData:   imageData,
-		ContentType: contentType,
-	}), nil
+	return connect.NewResponse(resp), nil
 }
 
-// ListImages lists all images in a room from blob storage.
+// ListImages lists all images in a room.
 func (h *ImageHandler) ListImages(
 	ctx context.Context,
 	req *connect.Request[scene_hunterv1.ListImagesRequest],
 ) (*connect.Response[scene_hunterv1.ListImagesResponse], error) {
-	// Parse UUID
-	roomID, err := uuid.Parse(req.Msg.GetRoomId())
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			errors.Errorf("invalid room ID: %w", err),
-		)
-	}
-
-	// room IDの存在確認
-	exists, err := h.roomRepo.Exists(ctx, roomID)
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to check room existence: %w", err),
-		)
-	}
-
-	if !exists {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrRoomNotFound, roomID),
-		)
-	}
-
-	// roomIDをprefixにして画像一覧を取得
-	prefix := filepath.Join("game", roomID.String())
-
-	objects, err := h.blobClient.List(ctx, prefix)
+	resp, err := h.service.ListImages(ctx, req.Msg)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to list images: %w", err),
-		)
-	}
-
-	// レスポンスを構築
-	images := make([]*scene_hunterv1.ImageInfo, 0, len(objects))
-
-	for _, obj := range objects {
-		// パスからimageIDを抽出
-		filename := filepath.Base(obj.Key)
-		imageIDStr := strings.TrimSuffix(filename, filepath.Ext(filename))
-
-		imageID, err := uuid.Parse(imageIDStr)
-		if err != nil {
-			// 不正なファイル名はスキップ
-			continue
-		}
-
-		images = append(images, &scene_hunterv1.ImageInfo{
-			ImageId:      imageID.String(),
-			ImagePath:    obj.Key,
-			Size:         obj.Size,
-			LastModified: timestamppb.New(obj.LastModified),
-		})
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	return connect.NewResponse(&scene_hunterv1.ListImagesResponse{
-		Images: images,
-	}), nil
+	return connect.NewResponse(resp), nil
 }
 
-// ListImageThumbnails lists all images in a room as thumbnails from blob storage.
+// ListImageThumbnails lists a page of thumbnail URLs of the images in a room.
 func (h *ImageHandler) ListImageThumbnails(
 	ctx context.Context,
 	req *connect.Request[scene_hunterv1.ListImageThumbnailsRequest],
 ) (*connect.Response[scene_hunterv1.ListImageThumbnailsResponse], error) {
-	// Parse UUID
-	roomID, err := uuid.Parse(req.Msg.GetRoomId())
+	resp, err := h.service.ListImageThumbnails(ctx, req.Msg)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			errors.Errorf("invalid room ID: %w", err),
-		)
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	// room IDの存在確認
-	exists, err := h.roomRepo.Exists(ctx, roomID)
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to check room existence: %w", err),
-		)
-	}
-
-	if !exists {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrRoomNotFound, roomID),
-		)
-	}
+	return connect.NewResponse(resp), nil
+}
 
-	// roomIDをprefixにして画像一覧を取得
-	prefix := filepath.Join("game", roomID.String())
+// StreamImageThumbnails streams the thumbnails of the images in a room.
+func (h *ImageHandler) StreamImageThumbnails(
+	ctx context.Context,
+	req *connect.Request[scene_hunterv1.StreamImageThumbnailsRequest],
+	stream *connect.ServerStream[scene_hunterv1.StreamImageThumbnailsResponse],
+) error {
+	//nolint:wrapcheck // service returns Connect errors with codes
+	return h.service.StreamImageThumbnails(ctx, req.Msg, stream)
+}
 
-	objects, err := h.blobClient.List(ctx, prefix)
+// CreateUploadURL returns a presigned URL to upload an image directly to blob storage.
+func (h *ImageHandler) CreateUploadURL(
+	ctx context.Context,
+	req *connect.Request[scene_hunterv1.CreateUploadURLRequest],
+) (*connect.Response[scene_hunterv1.CreateUploadURLResponse], error) {
+	resp, err := h.service.CreateUploadURL(ctx, req.Msg)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to list images: %w", err),
-		)
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	// レスポンスを構築
-	thumbnails := make([]*scene_hunterv1.ThumbnailInfo, 0, len(objects))
-
-	for _, obj := range objects {
-		// パスからimageIDを抽出
-		filename := filepath.Base(obj.Key)
-		imageIDStr := strings.TrimSuffix(filename, filepath.Ext(filename))
-
-		imageID, err := uuid.Parse(imageIDStr)
-		if err != nil {
-			// 不正なファイル名はスキップ
-			continue
-		}
-
-		// 画像を取得
-		reader, err := h.blobClient.Get(ctx, obj.Key)
-		if err != nil {
-			// エラーが発生した場合はスキップ
-			continue
-		}
-
-		imageData, err := io.ReadAll(reader)
-		_ = reader.Close()
-
-		if err != nil {
-			continue
-		}
-
-		// サムネイルを生成
-		thumbnailData, err := generateThumbnail(imageData)
-		if err != nil {
-			// サムネイル生成に失敗した場合はスキップ
-			continue
-		}
+	return connect.NewResponse(resp), nil
+}
 
-		thumbnails = append(thumbnails, &scene_hunterv1.ThumbnailInfo{
-			ImageId:       imageID.String(),
-			ThumbnailData: thumbnailData,
-			ContentType:   "image/jpeg",
-		})
+// CompleteUpload validates an image uploaded to a presigned URL and stores it.
+func (h *ImageHandler) CompleteUpload(
+	ctx context.Context,
+	req *connect.Request[scene_hunterv1.CompleteUploadRequest],
+) (*connect.Response[scene_hunterv1.CompleteUploadResponse], error) {
+	resp, err := h.service.CompleteUpload(ctx, req.Msg)
+	if err != nil {
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	return connect.NewResponse(&scene_hunterv1.ListImageThumbnailsResponse{
-		Thumbnails: thumbnails,
-	}), nil
+	return connect.NewResponse(resp), nil
 }
 
-const (
-	// ThumbnailMaxSize はサムネイルの最大幅（ピクセル）.
-	ThumbnailMaxSize = 540
-	// JPEGQuality はJPEG圧縮の品質（75%）.
-	JPEGQuality = 75
-)
-
-// generateThumbnail generates a thumbnail from image data.
-func generateThumbnail(imageData []byte) ([]byte, error) {
-	// 画像をデコード
-	img, _, err := image.Decode(bytes.NewReader(imageData))
+// CreateDownloadURL returns a presigned URL to download an image directly from blob storage.
+func (h *ImageHandler) CreateDownloadURL(
+	ctx context.Context,
+	req *connect.Request[scene_hunterv1.CreateDownloadURLRequest],
+) (*connect.Response[scene_hunterv1.CreateDownloadURLResponse], error) {
+	resp, err := h.service.CreateDownloadURL(ctx, req.Msg)
 	if err != nil {
-		return nil, errors.Errorf("failed to decode image: %w", err)
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	// 元の画像サイズを取得
-	bounds := img.Bounds()
-	width := bounds.Dx()
-	height := bounds.Dy()
-
-	// サムネイルサイズを計算（アスペクト比を維持）
-	var thumbnailWidth, thumbnailHeight int
-
-	if width > height {
-		thumbnailWidth = ThumbnailMaxSize
-		thumbnailHeight = max(
-			// 極端な縦横比の場合でも最低1ピクセルを保証
-			(height*ThumbnailMaxSize)/width, 1)
-	} else {
-		thumbnailHeight = ThumbnailMaxSize
-		thumbnailWidth = max(
-			// 極端な縦横比の場合でも最低1ピクセルを保証
-			(width*ThumbnailMaxSize)/height, 1)
-	}
+	return connect.NewResponse(resp), nil
+}
 
-	// 既に小さい画像の場合はリサイズしない
-	if width <= ThumbnailMaxSize && height <= ThumbnailMaxSize {
-		thumbnailWidth = width
-		thumbnailHeight = height
+// UploadPhotoStream receives an image in chunks and stores it as an upload.
+func (h *ImageHandler) UploadPhotoStream(
+	ctx context.Context,
+	stream *connect.ClientStream[scene_hunterv1.UploadPhotoStreamRequest],
+) (*connect.Response[scene_hunterv1.UploadPhotoStreamResponse], error) {
+	resp, err := h.service.UploadPhotoStream(ctx, stream)
+	if err != nil {
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	// リサイズ
-	resized := transform.Resize(img, thumbnailWidth, thumbnailHeight, transform.Linear)
-
-	// JPEGにエンコード
-	var buf bytes.Buffer
+	return connect.NewResponse(resp), nil
+}
 
-	err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: JPEGQuality})
+// GetUploadStatus returns how many bytes of a chunked upload have been received.
+func (h *ImageHandler) GetUploadStatus(
+	ctx context.Context,
+	req *connect.Request[scene_hunterv1.GetUploadStatusRequest],
+) (*connect.Response[scene_hunterv1.GetUploadStatusResponse], error) {
+	resp, err := h.service.GetUploadStatus(ctx, req.Msg)
 	if err != nil {
-		return nil, errors.Errorf("failed to encode thumbnail: %w", err)
+		//nolint:wrapcheck // service returns Connect errors with codes
+		return nil, err
 	}
 
-	return buf.Bytes(), nil
+	return connect.NewResponse(resp), nil
 }
@@ -4,6 +4,7 @@ package blob
 import (
 	"context"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -12,30 +13,67 @@ import (
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
+// presignRegion is the region used to sign presigned URLs.
+// Setting it avoids a bucket location request, which the public endpoint may not allow.
+const presignRegion = "us-east-1"
+
+// streamPartSize is the size of the parts a stream of unknown length is uploaded in,
+// which is as much of it as is held in memory at once. It is the smallest part size allowed.
+const streamPartSize = 5 * 1024 * 1024
+
 type Client struct {
-	client     *minio.Client
-	bucketName string
+	client *minio.Client
+	// presignClient signs URLs for the endpoint that clients can reach.
+	presignClient *minio.Client
+	bucketName    string
 }
 
 // NewClient creates a new Client with the specified endpoint and credentials.
+// Presigned URLs point to publicURL (e.g. "https://storage.example.com"), which is
+// the address clients use to reach the storage. When publicURL is empty, they point
+// to endpoint.
 func NewClient(
 	endpoint string,
+	publicURL string,
 	accessKey string,
 	secretKey string,
 	bucketName string,
 	useSSL bool,
 ) (service.Blob, error) {
+	creds := credentials.NewStaticV4(accessKey, secretKey, "")
+
 	minioClient, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Creds:  creds,
 		Secure: useSSL,
 	})
 	if err != nil {
 		return nil, errors.Errorf("failed to create minio client: %w", err)
 	}
 
+	presignEndpoint, presignSSL := endpoint, useSSL
+
+	if publicURL != "" {
+		parsed, err := url.Parse(publicURL)
+		if err != nil || parsed.Host == "" {
+			return nil, errors.Errorf("invalid public URL %q: %w", publicURL, err)
+		}
+
+		presignEndpoint, presignSSL = parsed.Host, parsed.Scheme == "https"
+	}
+
+	presignClient, err := minio.New(presignEndpoint, &minio.Options{
+		Creds:  creds,
+		Secure: presignSSL,
+		Region: presignRegion,
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to create minio presign client: %w", err)
+	}
+
 	return &Client{
-		client:     minioClient,
-		bucketName: bucketName,
+		client:        minioClient,
+		presignClient: presignClient,
+		bucketName:    bucketName,
 	}, nil
 }
 
@@ -72,7 +110,14 @@ func (c *Client) Name() string {
 	return "blob_storage"
 }
 
+// Put stores data as an object. The size of readers that know their length, such as
+// bytes.Reader, is sent along. Other readers are streamed in parts of streamPartSize.
 func (c *Client) Put(ctx context.Context, key string, data io.Reader, ttl time.Duration) error {
+	size := int64(-1)
+	if sized, ok := data.(interface{ Len() int }); ok {
+		size = int64(sized.Len())
+	}
+
 	// Note: TTL is not directly supported by minio-go
 	// You would need to implement lifecycle policies separately if needed
 	_, err := c.client.PutObject(
@@ -80,9 +125,10 @@ func (c *Client) Put(ctx context.Context, key string, data io.Reader, ttl time.D
 		c.bucketName,
 		key,
 		data,
-		-1, // unknown size, minio-go will handle it
+		size,
 		minio.PutObjectOptions{
 			ContentType: "application/octet-stream",
+			PartSize:    streamPartSize,
 		},
 	)
 	if err != nil {
@@ -133,6 +179,65 @@ func (c *Client) Exists(ctx context.Context, key string) (bool, error) {
 	return true, nil
 }
 
+func (c *Client) Stat(ctx context.Context, key string) (service.ObjectInfo, error) {
+	info, err := c.client.StatObject(ctx, c.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		errResponse := minio.ToErrorResponse(err)
+		if errResponse.Code == "NoSuchKey" {
+			return service.ObjectInfo{}, errors.Errorf(
+				"object %w: key=%s",
+				service.ErrNotFound,
+				key,
+			)
+		}
+
+		return service.ObjectInfo{}, errors.Errorf("failed to stat object: %w", err)
+	}
+
+	return service.ObjectInfo{
+		Key:          info.Key,
+		Size:         info.Size,
+		LastModified: info.LastModified,
+	}, nil
+}
+
+func (c *Client) PresignPut(
+	ctx context.Context,
+	key string,
+	expiry time.Duration,
+) (string, error) {
+	presignedURL, err := c.presignClient.PresignedPutObject(ctx, c.bucketName, key, expiry)
+	if err != nil {
+		return "", errors.Errorf("failed to presign upload: %w", err)
+	}
+
+	return presignedURL.String(), nil
+}
+
+func (c *Client) PresignGet(
+	ctx context.Context,
+	key string,
+	contentType string,
+	expiry time.Duration,
+) (string, error) {
+	// 保存時のcontent typeに関わらず画像として返す
+	params := url.Values{}
+	params.Set("response-content-type", contentType)
+
+	presignedURL, err := c.presignClient.PresignedGetObject(
+		ctx,
+		c.bucketName,
+		key,
+		expiry,
+		params,
+	)
+	if err != nil {
+		return "", errors.Errorf("failed to presign download: %w", err)
+	}
+
+	return presignedURL.String(), nil
+}
+
 func (c *Client) List(ctx context.Context, prefix string) ([]service.ObjectInfo, error) {
 	objectCh := c.client.ListObjects(ctx, c.bucketName, minio.ListObjectsOptions{
 		Prefix:    prefix,
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/testcontainers/testcontainers-go/modules/minio"
 	"github.com/yashikota/scene-hunter/server/internal/infra/blob"
 	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
 // setupMinio はテスト用のMinIOコンテナをセットアップする.
@@ -33,7 +38,14 @@ func setupMinio(ctx context.Context, t *testing.T) (string, func()) {
 	// Retry up to 10 times with 1 second delay between attempts
 	var client service.Blob
 	for range 10 {
-		client, err = blob.NewClient(connString, "minioadmin", "minioadmin", "test-bucket", false)
+		client, err = blob.NewClient(
+			connString,
+			"",
+			"minioadmin",
+			"minioadmin",
+			"test-bucket",
+			false,
+		)
 		if err == nil {
 			err = client.Ping(ctx)
 			if err == nil {
@@ -69,7 +81,7 @@ func TestNewClient(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v, want nil", err)
 	}
@@ -83,7 +95,7 @@ func TestNewClient(t *testing.T) {
 func TestNewClient_InvalidEndpoint(t *testing.T) {
 	t.Parallel()
 
-	_, err := blob.NewClient("invalid:endpoint:format", "key", "secret", "bucket", false)
+	_, err := blob.NewClient("invalid:endpoint:format", "", "key", "secret", "bucket", false)
 	if err == nil {
 		t.Error("NewClient() with invalid endpoint should return error")
 	}
@@ -98,7 +110,7 @@ func TestClient_Ping(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
@@ -134,6 +146,7 @@ func TestClient_Put_Get(t *testing.T) {
 
 			client, err := blob.NewClient(
 				endpoint,
+				"",
 				"minioadmin",
 				"minioadmin",
 				"test-bucket",
@@ -199,7 +212,7 @@ func TestClient_Get_NonExistentKey(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
@@ -224,7 +237,7 @@ func TestClient_Delete(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
@@ -279,7 +292,7 @@ func TestClient_Delete_NonExistentKey(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
@@ -320,6 +333,7 @@ func TestClient_Exists(t *testing.T) {
 
 			client, err := blob.NewClient(
 				endpoint,
+				"",
 				"minioadmin",
 				"minioadmin",
 				"test-bucket",
@@ -364,7 +378,7 @@ func TestClient_List(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
@@ -418,7 +432,7 @@ func TestClient_UpdateExistingKey(t *testing.T) {
 	endpoint, cleanup := setupMinio(ctx, t)
 	defer cleanup()
 
-	client, err := blob.NewClient(endpoint, "minioadmin", "minioadmin", "test-bucket", false)
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
 	if err != nil {
 		t.Fatalf("NewClient() error = %v", err)
 	}
@@ -482,3 +496,256 @@ func TestClient_UpdateExistingKey(t *testing.T) {
 		t.Errorf("Get() second content = %v, want %v", string(got), content2)
 	}
 }
+
+// TestClient_Stat はオブジェクトのメタデータ取得をテストする.
+func TestClient_Stat(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	endpoint, cleanup := setupMinio(ctx, t)
+	defer cleanup()
+
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	err = client.Ping(ctx)
+	if err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	content := "stat test content"
+
+	err = client.Put(ctx, "stat-test-key", bytes.NewReader([]byte(content)), 0)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	info, err := client.Stat(ctx, "stat-test-key")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+
+	if info.Size != int64(len(content)) {
+		t.Errorf("Stat() size = %d, want %d", info.Size, len(content))
+	}
+
+	_, err = client.Stat(ctx, "non-existent-key")
+	if !errors.Is(err, service.ErrNotFound) {
+		t.Errorf("Stat() with non-existent key error = %v, want %v", err, service.ErrNotFound)
+	}
+}
+
+// TestClient_PresignedURLs は署名付きURLでアップロードとダウンロードができることをテストする.
+func TestClient_PresignedURLs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	endpoint, cleanup := setupMinio(ctx, t)
+	defer cleanup()
+
+	client, err := blob.NewClient(endpoint, "", "minioadmin", "minioadmin", "test-bucket", false)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	err = client.Ping(ctx)
+	if err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	key := "presigned-test-key"
+	content := "presigned test content"
+
+	uploadURL, err := client.PresignPut(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatalf("PresignPut() error = %v", err)
+	}
+
+	// 認証情報なしで署名付きURLにアップロード
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		uploadURL,
+		strings.NewReader(content),
+	)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("PUT presigned URL error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("PUT presigned URL status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	downloadURL, err := client.PresignGet(ctx, key, "image/jpeg", time.Minute)
+	if err != nil {
+		t.Fatalf("PresignGet() error = %v", err)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET presigned URL error = %v", err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	if string(got) != content {
+		t.Errorf("GET presigned URL content = %v, want %v", string(got), content)
+	}
+
+	if resp.Header.Get("Content-Type") != "image/jpeg" {
+		t.Errorf(
+			"GET presigned URL content type = %v, want image/jpeg",
+			resp.Header.Get("Content-Type"),
+		)
+	}
+}
+
+// TestNewClient_PublicURL は署名付きURLが公開URLを指すことをテストする.
+func TestNewClient_PublicURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		publicURL string
+		wantURL   string
+		wantErr   bool
+	}{
+		"no public URL": {"", "http://localhost:9000/bucket/key?", false},
+		"https public URL": {
+			"https://storage.example.com",
+			"https://storage.example.com/bucket/key?",
+			false,
+		},
+		"public URL with port": {
+			"http://127.0.0.1:9100",
+			"http://127.0.0.1:9100/bucket/key?",
+			false,
+		},
+		"public URL without host": {"storage", "", true},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := blob.NewClient(
+				"localhost:9000",
+				testCase.publicURL,
+				"key",
+				"secret",
+				"bucket",
+				false,
+			)
+			if testCase.wantErr {
+				if err == nil {
+					t.Error("NewClient() error = nil, want error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			// 署名はローカルで計算されるためストレージへの接続は不要
+			got, err := client.PresignPut(context.Background(), "key", time.Minute)
+			if err != nil {
+				t.Fatalf("PresignPut() error = %v", err)
+			}
+
+			if !strings.HasPrefix(got, testCase.wantURL) {
+				t.Errorf("PresignPut() = %v, want prefix %v", got, testCase.wantURL)
+			}
+		})
+	}
+}
+
+// fakeS3 はマルチパートアップロードを受け付けて本文を読み捨てるだけのS3互換サーバー.
+func fakeS3(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(
+		http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = io.Copy(io.Discard, req.Body)
+
+			query := req.URL.Query()
+
+			switch {
+			case query.Has("location"):
+				_, _ = io.WriteString(writer, `<LocationConstraint></LocationConstraint>`)
+			case query.Has("uploads"):
+				_, _ = io.WriteString(writer, `<InitiateMultipartUploadResult>`+
+					`<UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+			case req.Method == http.MethodPost:
+				_, _ = io.WriteString(writer, `<CompleteMultipartUploadResult>`+
+					`<Bucket>bucket</Bucket><ETag>"etag"</ETag></CompleteMultipartUploadResult>`)
+			default:
+				writer.Header().Set("ETag", `"etag"`)
+			}
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// zeros は割り当てなしに0を返し続けるReader.
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+
+	return len(p), nil
+}
+
+// TestClient_Put_Stream は長さが不明なストリームを全体を保持せずに保存することをテストする.
+//
+//nolint:paralleltest // measures the memory allocated by the whole process
+func TestClient_Put_Stream(t *testing.T) {
+	const streamSize = 64 * 1024 * 1024
+
+	client, err := blob.NewClient(fakeS3(t), "", "key", "secret", "bucket", false)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+
+	err = client.Put(context.Background(), "stream", io.LimitReader(zeros{}, streamSize), 0)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	runtime.ReadMemStats(&after)
+
+	// パートの大きさ程度しか割り当てない
+	allocated := after.TotalAlloc - before.TotalAlloc
+	if allocated >= streamSize/2 {
+		t.Errorf("Put() of %d bytes allocated %d bytes, want less than half", streamSize, allocated)
+	}
+}
@@ -14,6 +14,7 @@ type Querier interface {
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentity, error)
 	DeleteUser(ctx context.Context, arg DeleteUserParams) error
+	DeleteUserIdentitiesByUserID(ctx context.Context, userID uuid.UUID) error
 	DeleteUserIdentity(ctx context.Context, id uuid.UUID) error
 	GetUserByCode(ctx context.Context, code string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
@@ -56,6 +56,16 @@ func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentity
 	return i, err
 }
 
+const deleteUserIdentitiesByUserID = `-- name: DeleteUserIdentitiesByUserID :exec
+DELETE FROM user_identities
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserIdentitiesByUserID(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserIdentitiesByUserID, userID)
+	return err
+}
+
 const deleteUserIdentity = `-- name: DeleteUserIdentity :exec
 DELETE FROM user_identities
 WHERE id = $1
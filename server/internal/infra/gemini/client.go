@@ -2,9 +2,10 @@
 package gemini
 
 import (
+	"cmp"
 	"context"
-	"encoding/json"
 
+	"github.com/yashikota/scene-hunter/server/internal/infra/vision"
 	"github.com/yashikota/scene-hunter/server/internal/service"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 	"google.golang.org/genai"
@@ -23,7 +24,7 @@ var ErrEmptyAPIKey = errors.New("API key is required")
 var ErrEmptyModelName = errors.New("model name is required")
 
 // NewClient creates a new Gemini client.
-func NewClient(ctx context.Context, apiKey, modelName string) (service.Gemini, error) {
+func NewClient(ctx context.Context, apiKey, modelName string) (service.VisionModel, error) {
 	if apiKey == "" {
 		return nil, ErrEmptyAPIKey
 	}
@@ -50,42 +51,124 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
-// AnalyzeImage analyzes an image and returns features.
-func (c *Client) AnalyzeImage(
+// Describe analyzes an image and returns features.
+func (c *Client) Describe(
 	ctx context.Context,
-	imageData []byte,
-	mimeType string,
+	image service.VisionImage,
 	prompt string,
 ) (*service.ImageAnalysisResult, error) {
-	schema := &genai.Schema{
-		Type: "object",
-		Properties: map[string]*genai.Schema{
-			"result": {
-				Type:     "array",
-				MinItems: ptr(int64(5)),
-				MaxItems: ptr(int64(5)),
-				Items: &genai.Schema{
-					Type: "string",
-				},
+	schema := objectSchema(map[string]*genai.Schema{
+		"result": {
+			Type:     genai.TypeArray,
+			MinItems: ptr(int64(5)),
+			MaxItems: ptr(int64(5)),
+			Items: &genai.Schema{
+				Type: genai.TypeString,
 			},
 		},
-		Required:         []string{"result"},
-		PropertyOrdering: []string{"result"},
+	}, "result")
+
+	responseText, usage, err := c.generate(
+		ctx,
+		schema,
+		&genai.Part{Text: prompt},
+		imagePart(image),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	config := &genai.GenerateContentConfig{
-		ResponseMIMEType: "application/json",
-		ResponseSchema:   schema,
+	result, err := vision.DecodeDescribe(responseText)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode description: %w", err)
 	}
 
-	parts := []*genai.Part{
-		{Text: prompt},
-		{
-			InlineData: &genai.Blob{
-				Data:     imageData,
-				MIMEType: mimeType,
+	result.Usage = usage
+
+	return result, nil
+}
+
+// Compare rates how similar a candidate photo is to a reference photo.
+func (c *Client) Compare(
+	ctx context.Context,
+	reference, candidate service.VisionImage,
+) (*service.ImageComparison, error) {
+	schema := objectSchema(map[string]*genai.Schema{
+		"similarity": {
+			Type:    genai.TypeNumber,
+			Minimum: ptr(0.0),
+			Maximum: ptr(1.0),
+		},
+		"reason": {
+			Type: genai.TypeString,
+		},
+	}, "similarity", "reason")
+
+	responseText, usage, err := c.generate(
+		ctx,
+		schema,
+		&genai.Part{Text: vision.ComparePrompt},
+		imagePart(reference),
+		imagePart(candidate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison, err := vision.DecodeCompare(responseText)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode comparison: %w", err)
+	}
+
+	comparison.Usage = usage
+
+	return comparison, nil
+}
+
+// Moderate checks whether a text is safe to show to players.
+func (c *Client) Moderate(ctx context.Context, text string) (*service.ModerationResult, error) {
+	schema := objectSchema(map[string]*genai.Schema{
+		"flagged": {
+			Type: genai.TypeBoolean,
+		},
+		"categories": {
+			Type: genai.TypeArray,
+			Items: &genai.Schema{
+				Type: genai.TypeString,
 			},
 		},
+	}, "flagged", "categories")
+
+	responseText, usage, err := c.generate(
+		ctx,
+		schema,
+		&genai.Part{Text: vision.ModeratePrompt},
+		&genai.Part{Text: text},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	moderation, err := vision.DecodeModerate(responseText)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode moderation: %w", err)
+	}
+
+	moderation.Usage = usage
+
+	return moderation, nil
+}
+
+// generate sends parts to the model and returns its JSON answer that follows schema
+// with the tokens it was billed for.
+func (c *Client) generate(
+	ctx context.Context,
+	schema *genai.Schema,
+	parts ...*genai.Part,
+) (string, service.VisionUsage, error) {
+	config := &genai.GenerateContentConfig{
+		ResponseMIMEType: "application/json",
+		ResponseSchema:   schema,
 	}
 
 	result, err := c.client.Models.GenerateContent(
@@ -95,22 +178,54 @@ func (c *Client) AnalyzeImage(
 		config,
 	)
 	if err != nil {
-		return nil, errors.Errorf("failed to generate content: %w", err)
+		var apiErr genai.APIError
+		if errors.As(err, &apiErr) && vision.IsRejectedStatus(apiErr.Code) {
+			return "", service.VisionUsage{}, errors.Errorf(
+				"failed to generate content: %w: %w",
+				service.ErrVisionRequestRejected,
+				err,
+			)
+		}
+
+		return "", service.VisionUsage{}, errors.Errorf("failed to generate content: %w", err)
 	}
 
-	// Parse the JSON response
-	responseText := result.Text()
+	return result.Text(), usageOf(result, c.modelName), nil
+}
 
-	var response struct {
-		Result []string `json:"result"`
+// usageOf returns the tokens a response was billed for.
+// The requested model is reported when the response does not name its version.
+func usageOf(result *genai.GenerateContentResponse, modelName string) service.VisionUsage {
+	usage := service.VisionUsage{
+		Model:        cmp.Or(result.ModelVersion, modelName),
+		InputTokens:  0,
+		OutputTokens: 0,
 	}
 
-	err = json.Unmarshal([]byte(responseText), &response)
-	if err != nil {
-		return nil, errors.Errorf("failed to unmarshal response: %w", err)
+	if result.UsageMetadata != nil {
+		usage.InputTokens = int64(result.UsageMetadata.PromptTokenCount)
+		usage.OutputTokens = int64(result.UsageMetadata.CandidatesTokenCount)
 	}
 
-	return &service.ImageAnalysisResult{
-		Features: response.Result,
-	}, nil
+	return usage
+}
+
+// objectSchema returns the schema of a JSON object whose properties are all required.
+func objectSchema(properties map[string]*genai.Schema, order ...string) *genai.Schema {
+	return &genai.Schema{
+		Type:             genai.TypeObject,
+		Properties:       properties,
+		Required:         order,
+		PropertyOrdering: order,
+	}
+}
+
+// imagePart converts an image to an inline content part.
+func imagePart(image service.VisionImage) *genai.Part {
+	return &genai.Part{
+		InlineData: &genai.Blob{
+			Data:     image.Data,
+			MIMEType: image.MIMEType,
+		},
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -268,6 +269,162 @@ func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
 	return time.Duration(ttlSeconds) * time.Second, nil
 }
 
+// XAdd appends a message to a stream and returns its ID.
+func (c *Client) XAdd(
+	ctx context.Context,
+	stream string,
+	values map[string]string,
+) (string, error) {
+	fields := c.client.B().Xadd().Key(stream).Id("*").FieldValue()
+	for field, value := range values {
+		fields = fields.FieldValue(field, value)
+	}
+
+	id, err := c.client.Do(ctx, fields.Build()).ToString()
+	if err != nil {
+		return "", errors.Errorf("xadd failed: %w", err)
+	}
+
+	return id, nil
+}
+
+// XGroupCreate creates a consumer group that reads new messages of a stream.
+func (c *Client) XGroupCreate(ctx context.Context, stream, group string) error {
+	cmd := c.client.B().XgroupCreate().Key(stream).Group(group).Id("$").Mkstream().Build()
+
+	err := c.client.Do(ctx, cmd).Error()
+	if err != nil {
+		// 既にグループがある場合はそのまま使う
+		if strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil
+		}
+
+		return errors.Errorf("xgroup create failed: %w", err)
+	}
+
+	return nil
+}
+
+// XReadGroup reads new messages for a consumer of a group.
+func (c *Client) XReadGroup(
+	ctx context.Context,
+	stream, group, consumer string,
+	count int64,
+	block time.Duration,
+) ([]service.StreamMessage, error) {
+	cmd := c.client.B().
+		Xreadgroup().
+		Group(group, consumer).
+		Count(count).
+		Block(block.Milliseconds()).
+		Streams().
+		Key(stream).
+		Id(">").
+		Build()
+
+	streams, err := c.client.Do(ctx, cmd).AsXRead()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return []service.StreamMessage{}, nil
+		}
+
+		return nil, errors.Errorf("xreadgroup failed: %w", err)
+	}
+
+	return toStreamMessages(streams[stream]), nil
+}
+
+// XAutoClaim takes over messages that other consumers have not acknowledged for minIdle.
+func (c *Client) XAutoClaim(
+	ctx context.Context,
+	stream, group, consumer string,
+	minIdle time.Duration,
+	count int64,
+) ([]service.StreamMessage, error) {
+	cmd := c.client.B().
+		Xautoclaim().
+		Key(stream).
+		Group(group).
+		Consumer(consumer).
+		MinIdleTime(strconv.FormatInt(minIdle.Milliseconds(), 10)).
+		Start("0").
+		Count(count).
+		Build()
+
+	// 応答は次の開始ID、取得したメッセージ、削除済みIDの配列
+	reply, err := c.client.Do(ctx, cmd).ToArray()
+	if err != nil {
+		return nil, errors.Errorf("xautoclaim failed: %w", err)
+	}
+
+	if len(reply) < 2 {
+		return []service.StreamMessage{}, nil
+	}
+
+	entries, err := reply[1].AsXRange()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse xautoclaim response: %w", err)
+	}
+
+	return toStreamMessages(entries), nil
+}
+
+// XAck acknowledges messages of a group.
+func (c *Client) XAck(ctx context.Context, stream, group string, ids ...string) error {
+	cmd := c.client.B().Xack().Key(stream).Group(group).Id(ids...).Build()
+
+	err := c.client.Do(ctx, cmd).Error()
+	if err != nil {
+		return errors.Errorf("xack failed: %w", err)
+	}
+
+	return nil
+}
+
+// XDel removes messages from a stream.
+func (c *Client) XDel(ctx context.Context, stream string, ids ...string) error {
+	cmd := c.client.B().Xdel().Key(stream).Id(ids...).Build()
+
+	err := c.client.Do(ctx, cmd).Error()
+	if err != nil {
+		return errors.Errorf("xdel failed: %w", err)
+	}
+
+	return nil
+}
+
+// XRange reads messages of a stream after afterID, or from the start when it is empty.
+func (c *Client) XRange(
+	ctx context.Context,
+	stream, afterID string,
+	count int64,
+) ([]service.StreamMessage, error) {
+	// 指定したIDは含めない
+	start := "-"
+	if afterID != "" {
+		start = "(" + afterID
+	}
+
+	cmd := c.client.B().Xrange().Key(stream).Start(start).End("+").Count(count).Build()
+
+	entries, err := c.client.Do(ctx, cmd).AsXRange()
+	if err != nil {
+		return nil, errors.Errorf("xrange failed: %w", err)
+	}
+
+	return toStreamMessages(entries), nil
+}
+
+// toStreamMessages converts stream entries to messages.
+func toStreamMessages(entries []valkey.XRangeEntry) []service.StreamMessage {
+	messages := make([]service.StreamMessage, 0, len(entries))
+	for _, entry := range entries {
+		messages = append(messages, service.StreamMessage{ID: entry.ID, Values: entry.FieldValues})
+	}
+
+	return messages
+}
+
 // toString converts an interface{} to string.
 func toString(v any) string {
 	return fmt.Sprintf("%v", v)
@@ -2,6 +2,7 @@ package kvs_test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -387,3 +388,148 @@ func TestClient_UpdateExistingKey(t *testing.T) {
 		t.Errorf("Get() second value = %v, want %v", got, value2)
 	}
 }
+
+// TestClient_Stream はストリームのメッセージをグループで読み取り、確認応答できることをテストする.
+// 確認応答していないメッセージは、別のコンシューマーが引き継げることも確認する.
+func TestClient_Stream(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	addr, cleanup := setupValkey(ctx, t)
+	defer cleanup()
+
+	client, err := kvs.NewClient(addr, "")
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close()
+
+	const (
+		stream = "jobs"
+		group  = "workers"
+	)
+
+	// 2回目の作成はエラーにならない
+	for range 2 {
+		err = client.XGroupCreate(ctx, stream, group)
+		if err != nil {
+			t.Fatalf("XGroupCreate() error = %v", err)
+		}
+	}
+
+	messageID, err := client.XAdd(ctx, stream, map[string]string{"job": "1"})
+	if err != nil {
+		t.Fatalf("XAdd() error = %v", err)
+	}
+
+	messages, err := client.XReadGroup(ctx, stream, group, "first", 10, 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("XReadGroup() error = %v", err)
+	}
+
+	if len(messages) != 1 || messages[0].ID != messageID || messages[0].Values["job"] != "1" {
+		t.Fatalf("XReadGroup() = %+v, want the added message", messages)
+	}
+
+	// 新しいメッセージがなければ待ってから空で返る
+	messages, err = client.XReadGroup(ctx, stream, group, "first", 10, 100*time.Millisecond)
+	if err != nil || len(messages) != 0 {
+		t.Fatalf("XReadGroup() = %+v, %v, want no messages", messages, err)
+	}
+
+	claimed, err := client.XAutoClaim(ctx, stream, group, "second", 0, 10)
+	if err != nil {
+		t.Fatalf("XAutoClaim() error = %v", err)
+	}
+
+	if len(claimed) != 1 || claimed[0].ID != messageID {
+		t.Fatalf("XAutoClaim() = %+v, want the unacknowledged message", claimed)
+	}
+
+	err = client.XAck(ctx, stream, group, messageID)
+	if err != nil {
+		t.Fatalf("XAck() error = %v", err)
+	}
+
+	claimed, err = client.XAutoClaim(ctx, stream, group, "second", 0, 10)
+	if err != nil || len(claimed) != 0 {
+		t.Errorf("XAutoClaim() = %+v, %v, want no messages after XAck", claimed, err)
+	}
+
+	err = client.XDel(ctx, stream, messageID)
+	if err != nil {
+		t.Fatalf("XDel() error = %v", err)
+	}
+
+	messages, err = client.XRange(ctx, stream, "", 10)
+	if err != nil || len(messages) != 0 {
+		t.Errorf("XRange() = %+v, %v, want no messages after XDel", messages, err)
+	}
+}
+
+// TestClient_XRange はストリームのメッセージを指定したIDの後から順に読み取れることをテストする.
+func TestClient_XRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	addr, cleanup := setupValkey(ctx, t)
+	defer cleanup()
+
+	client, err := kvs.NewClient(addr, "")
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer client.Close()
+
+	const stream = "events"
+
+	messages, err := client.XRange(ctx, stream, "", 10)
+	if err != nil || len(messages) != 0 {
+		t.Fatalf("XRange() = %+v, %v, want no messages before XAdd", messages, err)
+	}
+
+	ids := make([]string, 3)
+	for index := range ids {
+		ids[index], err = client.XAdd(ctx, stream, map[string]string{"event": strconv.Itoa(index)})
+		if err != nil {
+			t.Fatalf("XAdd() error = %v", err)
+		}
+	}
+
+	tests := map[string]struct {
+		afterID string
+		count   int64
+		want    []string
+	}{
+		"from the start":  {"", 10, ids},
+		"after the first": {ids[0], 10, ids[1:]},
+		"after the last":  {ids[2], 10, nil},
+		"limited":         {"", 2, ids[:2]},
+	}
+
+	// 同じストリームを読むため、サブテストにせず順に確認する
+	for name, testCase := range tests {
+		messages, err := client.XRange(ctx, stream, testCase.afterID, testCase.count)
+		if err != nil {
+			t.Fatalf("%s: XRange() error = %v", name, err)
+		}
+
+		if len(messages) != len(testCase.want) {
+			t.Fatalf("%s: XRange() = %d messages, want %d", name, len(messages), len(testCase.want))
+		}
+
+		for index, message := range messages {
+			if message.ID != testCase.want[index] {
+				t.Errorf(
+					"%s: XRange()[%d] = %s, want %s",
+					name,
+					index,
+					message.ID,
+					testCase.want[index],
+				)
+			}
+		}
+	}
+}
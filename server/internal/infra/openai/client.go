@@ -0,0 +1,262 @@
+// Package openai provides a vision model client for OpenAI-compatible chat completion APIs,
+// including self-hosted model servers.
+package openai
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/yashikota/scene-hunter/server/internal/infra/vision"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// maxErrorBodySize is the longest part of an error response kept in the error message.
+const maxErrorBodySize = 1024
+
+var (
+	// ErrEmptyBaseURL is returned when base URL is empty.
+	ErrEmptyBaseURL = errors.New("base URL is required")
+	// ErrEmptyModelName is returned when model name is empty.
+	ErrEmptyModelName = errors.New("model name is required")
+	// ErrRequestFailed is returned when the API answers with an error status.
+	ErrRequestFailed = errors.New("chat completion request failed")
+	// ErrEmptyResponse is returned when the API answers without a message.
+	ErrEmptyResponse = errors.New("chat completion has no message")
+)
+
+// Client is a vision model client for an OpenAI-compatible API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+	modelName  string
+}
+
+// NewClient creates a new OpenAI-compatible client.
+// The API key may be empty for self-hosted servers that do not require one.
+func NewClient(baseURL, apiKey, modelName string) (service.VisionModel, error) {
+	if baseURL == "" {
+		return nil, ErrEmptyBaseURL
+	}
+
+	if modelName == "" {
+		return nil, ErrEmptyModelName
+	}
+
+	return &Client{
+		httpClient: &http.Client{},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		modelName:  modelName,
+	}, nil
+}
+
+// chatRequest is the body of a chat completion request.
+type chatRequest struct {
+	Model          string         `json:"model"`
+	Messages       []chatMessage  `json:"messages"`
+	ResponseFormat responseFormat `json:"response_format"`
+}
+
+type chatMessage struct {
+	Role    string        `json:"role"`
+	Content []contentPart `json:"content"`
+}
+
+// contentPart is a text or an image of a message.
+type contentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *imageURL `json:"image_url,omitempty"`
+}
+
+type imageURL struct {
+	URL string `json:"url"`
+}
+
+type responseFormat struct {
+	Type string `json:"type"`
+}
+
+// chatResponse is the body of a chat completion response.
+type chatResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int64 `json:"prompt_tokens"`
+		CompletionTokens int64 `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// Describe analyzes an image and returns features.
+func (c *Client) Describe(
+	ctx context.Context,
+	image service.VisionImage,
+	prompt string,
+) (*service.ImageAnalysisResult, error) {
+	responseText, usage, err := c.complete(
+		ctx,
+		textPart(prompt+"\n\n"+vision.DescribeFormat),
+		imagePart(image),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := vision.DecodeDescribe(responseText)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode description: %w", err)
+	}
+
+	result.Usage = usage
+
+	return result, nil
+}
+
+// Compare rates how similar a candidate photo is to a reference photo.
+func (c *Client) Compare(
+	ctx context.Context,
+	reference, candidate service.VisionImage,
+) (*service.ImageComparison, error) {
+	responseText, usage, err := c.complete(
+		ctx,
+		textPart(vision.ComparePrompt+"\n\n"+vision.CompareFormat),
+		imagePart(reference),
+		imagePart(candidate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison, err := vision.DecodeCompare(responseText)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode comparison: %w", err)
+	}
+
+	comparison.Usage = usage
+
+	return comparison, nil
+}
+
+// Moderate checks whether a text is safe to show to players.
+func (c *Client) Moderate(ctx context.Context, text string) (*service.ModerationResult, error) {
+	responseText, usage, err := c.complete(
+		ctx,
+		textPart(vision.ModeratePrompt+"\n\n"+vision.ModerateFormat),
+		textPart(text),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	moderation, err := vision.DecodeModerate(responseText)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode moderation: %w", err)
+	}
+
+	moderation.Usage = usage
+
+	return moderation, nil
+}
+
+// complete sends parts as one user message and returns the JSON answer of the model
+// with the tokens it was billed for.
+func (c *Client) complete(
+	ctx context.Context,
+	parts ...contentPart,
+) (string, service.VisionUsage, error) {
+	var none service.VisionUsage
+
+	body, err := json.Marshal(chatRequest{
+		Model:          c.modelName,
+		Messages:       []chatMessage{{Role: "user", Content: parts}},
+		ResponseFormat: responseFormat{Type: "json_object"},
+	})
+	if err != nil {
+		return "", none, errors.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseURL+"/chat/completions",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", none, errors.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", none, errors.Errorf("failed to send request: %w", err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+		err = errors.Errorf("%w: status %d: %s", ErrRequestFailed, resp.StatusCode, detail)
+		if vision.IsRejectedStatus(resp.StatusCode) {
+			return "", none, errors.Errorf("%w: %w", service.ErrVisionRequestRejected, err)
+		}
+
+		return "", none, err
+	}
+
+	var response chatResponse
+
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return "", none, errors.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(response.Choices) == 0 {
+		return "", none, ErrEmptyResponse
+	}
+
+	usage := service.VisionUsage{
+		Model:        cmp.Or(response.Model, c.modelName),
+		InputTokens:  response.Usage.PromptTokens,
+		OutputTokens: response.Usage.CompletionTokens,
+	}
+
+	return response.Choices[0].Message.Content, usage, nil
+}
+
+// textPart converts a text to a content part.
+func textPart(text string) contentPart {
+	return contentPart{Type: "text", Text: text, ImageURL: nil}
+}
+
+// imagePart converts an image to a content part with a data URL.
+func imagePart(image service.VisionImage) contentPart {
+	return contentPart{
+		Type: "image_url",
+		Text: "",
+		ImageURL: &imageURL{
+			URL: "data:" + image.MIMEType + ";base64," + base64.StdEncoding.EncodeToString(
+				image.Data,
+			),
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package openai_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/infra/openai"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	testModel   = "llava"
+	testBaseURL = "http://localhost:8000/v1"
+	testMIME    = "image/jpeg"
+	testAPIKey  = "test-key"
+)
+
+// chatServer returns a server that answers chat completions with content and status,
+// and the request bodies it received.
+func chatServer(t *testing.T, status int, content string) (*httptest.Server, *[]map[string]any) {
+	t.Helper()
+
+	requests := make([]map[string]any, 0, 1)
+
+	server := httptest.NewServer(
+		http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if request.URL.Path != "/v1/chat/completions" {
+				t.Errorf("request path = %q, want /v1/chat/completions", request.URL.Path)
+			}
+
+			if request.Header.Get("Authorization") != "Bearer "+testAPIKey {
+				t.Errorf(
+					"Authorization = %q, want the API key",
+					request.Header.Get("Authorization"),
+				)
+			}
+
+			var body map[string]any
+
+			err := json.NewDecoder(request.Body).Decode(&body)
+			if err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+
+			requests = append(requests, body)
+
+			writer.WriteHeader(status)
+
+			_ = json.NewEncoder(writer).Encode(map[string]any{
+				"model":   testModel + "-1.6",
+				"choices": []any{map[string]any{"message": map[string]any{"content": content}}},
+				"usage":   map[string]any{"prompt_tokens": 120, "completion_tokens": 30},
+			})
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		baseURL, apiKey, modelName string
+		wantErr                    error
+	}{
+		"valid":                {testBaseURL, testAPIKey, testModel, nil},
+		"without API key":      {testBaseURL, "", testModel, nil},
+		"empty base URL fails": {"", testAPIKey, testModel, openai.ErrEmptyBaseURL},
+		"empty model fails":    {testBaseURL, testAPIKey, "", openai.ErrEmptyModelName},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := openai.NewClient(testCase.baseURL, testCase.apiKey, testCase.modelName)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("NewClient() error = %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_Describe(t *testing.T) {
+	t.Parallel()
+
+	hints := []string{"a", "b", "c", "d", "e"}
+
+	answer, err := json.Marshal(map[string][]string{"result": hints})
+	if err != nil {
+		t.Fatalf("failed to marshal answer: %v", err)
+	}
+
+	server, requests := chatServer(t, http.StatusOK, string(answer))
+
+	client, err := openai.NewClient(server.URL+"/v1/", testAPIKey, testModel)
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	result, err := client.Describe(
+		context.Background(),
+		service.VisionImage{Data: []byte("photo"), MIMEType: testMIME},
+		"Write hints",
+	)
+	if err != nil {
+		t.Fatalf("Describe() failed: %v", err)
+	}
+
+	if !slices.Equal(result.Features, hints) {
+		t.Errorf("Describe() = %v, want %v", result.Features, hints)
+	}
+
+	// 課金されたトークン数と応答したモデルを返す
+	wantUsage := service.VisionUsage{Model: testModel + "-1.6", InputTokens: 120, OutputTokens: 30}
+	if result.Usage != wantUsage {
+		t.Errorf("Describe() usage = %+v, want %+v", result.Usage, wantUsage)
+	}
+
+	// 画像はデータURLで送り、JSONで答えるように依頼する
+	request, err := json.Marshal((*requests)[0])
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+
+	for _, want := range []string{
+		`"model":"llava"`,
+		`"type":"json_object"`,
+		`Write hints`,
+		`"url":"data:image/jpeg;base64,cGhvdG8="`,
+	} {
+		if !strings.Contains(string(request), want) {
+			t.Errorf("Describe() request = %s, want it to contain %s", request, want)
+		}
+	}
+}
+
+func TestClient_Moderate(t *testing.T) {
+	t.Parallel()
+
+	server, _ := chatServer(
+		t,
+		http.StatusOK,
+		"```json\n{\"flagged\": true, \"categories\": [\"personal_information\"]}\n```",
+	)
+
+	client, err := openai.NewClient(server.URL+"/v1", testAPIKey, testModel)
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	result, err := client.Moderate(context.Background(), "call 090-1234-5678")
+	if err != nil {
+		t.Fatalf("Moderate() failed: %v", err)
+	}
+
+	if !result.Flagged || !slices.Equal(result.Categories, []string{"personal_information"}) {
+		t.Errorf("Moderate() = %+v, want flagged as personal information", result)
+	}
+}
+
+func TestClient_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status       int
+		wantRejected bool
+	}{
+		"rate limited is retryable": {http.StatusTooManyRequests, false},
+		"server error is retryable": {http.StatusBadGateway, false},
+		"unauthorized is rejected":  {http.StatusUnauthorized, true},
+		"bad request is rejected":   {http.StatusBadRequest, true},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, _ := chatServer(t, testCase.status, "")
+
+			client, err := openai.NewClient(server.URL+"/v1", testAPIKey, testModel)
+			if err != nil {
+				t.Fatalf("NewClient() failed: %v", err)
+			}
+
+			_, err = client.Compare(
+				context.Background(),
+				service.VisionImage{Data: []byte("a"), MIMEType: testMIME},
+				service.VisionImage{Data: []byte("b"), MIMEType: testMIME},
+			)
+			if !errors.Is(err, openai.ErrRequestFailed) {
+				t.Errorf("Compare() error = %v, want %v", err, openai.ErrRequestFailed)
+			}
+
+			if errors.Is(err, service.ErrVisionRequestRejected) != testCase.wantRejected {
+				t.Errorf("Compare() error = %v, want rejected = %v", err, testCase.wantRejected)
+			}
+		})
+	}
+}
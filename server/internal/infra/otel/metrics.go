@@ -0,0 +1,100 @@
+package otel
+
+import (
+	"context"
+	"slices"
+
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Attribute keys of the vision metrics. They follow the OpenTelemetry
+// semantic conventions for generative AI clients where one exists.
+const (
+	attrOperation = "gen_ai.operation.name"
+	attrModel     = "gen_ai.response.model"
+	attrTokenType = "gen_ai.token.type"
+	attrOutcome   = "scene_hunter.vision.outcome"
+)
+
+// VisionMetrics records calls to a vision model as OpenTelemetry metrics.
+type VisionMetrics struct {
+	requests metric.Int64Counter
+	tokens   metric.Int64Histogram
+	duration metric.Float64Histogram
+}
+
+// NewVisionMetrics creates the vision metrics on a meter of provider.
+func NewVisionMetrics(provider metric.MeterProvider) (*VisionMetrics, error) {
+	meter := provider.Meter(ServiceName)
+
+	requests, err := meter.Int64Counter(
+		"scene_hunter.vision.requests",
+		metric.WithDescription("Number of requests to the vision model by outcome"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to create request counter: %w", err)
+	}
+
+	tokens, err := meter.Int64Histogram(
+		"gen_ai.client.token.usage",
+		metric.WithDescription("Number of input and output tokens billed per request"),
+		metric.WithUnit("{token}"),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to create token histogram: %w", err)
+	}
+
+	duration, err := meter.Float64Histogram(
+		"gen_ai.client.operation.duration",
+		metric.WithDescription("Duration of requests to the vision model"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to create duration histogram: %w", err)
+	}
+
+	return &VisionMetrics{
+		requests: requests,
+		tokens:   tokens,
+		duration: duration,
+	}, nil
+}
+
+// RecordVisionCall records a call to the vision model.
+// Tokens and latency are only recorded for calls that reached the model.
+func (m *VisionMetrics) RecordVisionCall(ctx context.Context, call service.VisionCall) {
+	attributes := []attribute.KeyValue{attribute.String(attrOperation, call.Operation)}
+
+	// 失敗した呼び出しでは応答したモデルが分からない
+	if call.Usage.Model != "" {
+		attributes = append(attributes, attribute.String(attrModel, call.Usage.Model))
+	}
+
+	m.requests.Add(ctx, 1, metric.WithAttributes(
+		with(attributes, attribute.String(attrOutcome, call.Outcome))...,
+	))
+
+	if call.Latency > 0 {
+		m.duration.Record(ctx, call.Latency.Seconds(), metric.WithAttributes(attributes...))
+	}
+
+	if call.Outcome != service.VisionOutcomeSuccess {
+		return
+	}
+
+	m.tokens.Record(ctx, call.Usage.InputTokens, metric.WithAttributes(
+		with(attributes, attribute.String(attrTokenType, "input"))...,
+	))
+	m.tokens.Record(ctx, call.Usage.OutputTokens, metric.WithAttributes(
+		with(attributes, attribute.String(attrTokenType, "output"))...,
+	))
+}
+
+// with returns attributes with one more attribute, leaving attributes unchanged.
+func with(attributes []attribute.KeyValue, extra attribute.KeyValue) []attribute.KeyValue {
+	return append(slices.Clip(attributes), extra)
+}
@@ -0,0 +1,157 @@
+package vision
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"regexp"
+	"strconv"
+	"unicode"
+
+	"github.com/yashikota/scene-hunter/server/internal/service"
+)
+
+const (
+	// fakeHintCount is how many texts the fake writes when the prompt does not ask for a number.
+	fakeHintCount = 5
+	// fakeModelName is the model reported by the fake. Its calls cost no tokens.
+	fakeModelName = "fake"
+)
+
+// fakeHintCountPattern finds how many hints a generate or repair prompt asks for.
+//
+//nolint:gosmopolitan // Japanese prompts are matched
+var fakeHintCountPattern = regexp.MustCompile(`Write (\d+) (?:more )?hints|ヒントを(\d+)個`)
+
+// Fake is a deterministic vision model for local development and tests.
+// It answers with canned hints chosen by the image and prompt, and never calls a provider.
+type Fake struct{}
+
+// NewFake creates a new fake vision model.
+func NewFake() service.VisionModel {
+	return &Fake{}
+}
+
+// Describe returns canned hints in Japanese, or in English when the prompt is English.
+// It writes as many hints as the prompt asks for, up to the number of canned hints.
+// The same image and prompt always get the same hints.
+func (f *Fake) Describe(
+	_ context.Context,
+	image service.VisionImage,
+	prompt string,
+) (*service.ImageAnalysisResult, error) {
+	hints := fakeHintsEnglish()
+	if !isASCII(prompt) {
+		hints = fakeHintsJapanese()
+	}
+
+	// 画像とプロンプトから開始位置を決め、呼び出しごとに異なるヒントを返す
+	sum := sha256.Sum256(append(bytes.Clone(image.Data), prompt...))
+	offset := int(sum[0]) % len(hints)
+
+	count := min(fakeRequestedHints(prompt), len(hints))
+
+	features := make([]string, 0, count)
+	for index := range count {
+		features = append(features, hints[(offset+index)%len(hints)])
+	}
+
+	return &service.ImageAnalysisResult{
+		Features: features,
+		Usage:    fakeUsage(),
+	}, nil
+}
+
+// Compare rates identical images as 1 and any other images as 0.5.
+func (f *Fake) Compare(
+	_ context.Context,
+	reference, candidate service.VisionImage,
+) (*service.ImageComparison, error) {
+	if bytes.Equal(reference.Data, candidate.Data) {
+		return &service.ImageComparison{
+			Similarity: 1,
+			Reason:     "the photos are identical",
+			Usage:      fakeUsage(),
+		}, nil
+	}
+
+	return &service.ImageComparison{
+		Similarity: 0.5,
+		Reason:     "the photos differ",
+		Usage:      fakeUsage(),
+	}, nil
+}
+
+// Moderate never flags a text. Content rules are checked by the hint filter instead.
+func (f *Fake) Moderate(_ context.Context, _ string) (*service.ModerationResult, error) {
+	return &service.ModerationResult{
+		Flagged:    false,
+		Categories: []string{},
+		Usage:      fakeUsage(),
+	}, nil
+}
+
+// fakeUsage returns the usage reported by the fake.
+func fakeUsage() service.VisionUsage {
+	return service.VisionUsage{Model: fakeModelName, InputTokens: 0, OutputTokens: 0}
+}
+
+// fakeRequestedHints returns how many hints prompt asks for, or fakeHintCount if it does not say.
+func fakeRequestedHints(prompt string) int {
+	match := fakeHintCountPattern.FindStringSubmatch(prompt)
+	if match == nil {
+		return fakeHintCount
+	}
+
+	count, err := strconv.Atoi(match[1] + match[2])
+	if err != nil || count < 1 {
+		return fakeHintCount
+	}
+
+	return count
+}
+
+// isASCII reports whether text has only ASCII characters.
+func isASCII(text string) bool {
+	for _, char := range text {
+		if char > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fakeHintsEnglish returns the canned English hints.
+func fakeHintsEnglish() []string {
+	return []string{
+		"The photo was taken in a bright place.",
+		"Something with a simple shape stands out in the middle.",
+		"There is a straight line running across the scene.",
+		"A wall or a floor fills part of the photo.",
+		"The main colors are calm and not very vivid.",
+		"Something made of metal reflects the light.",
+		"You can see an object that people use every day.",
+		"The photo was taken from about the height of a person's eyes.",
+		"There is a corner where two surfaces meet.",
+		"Look for a spot close to a window or a light.",
+	}
+}
+
+// fakeHintsJapanese returns the canned Japanese hints.
+//
+//nolint:gosmopolitan // Japanese text is required for the game
+func fakeHintsJapanese() []string {
+	return []string{
+		"明るい場所で撮影された写真です。",
+		"中央に単純な形のものが目立っています。",
+		"画面を横切るまっすぐな線があります。",
+		"壁か床が写真の一部を占めています。",
+		"落ち着いた色が中心で、鮮やかではありません。",
+		"金属でできたものが光を反射しています。",
+		"普段から人が使う物が写っています。",
+		"人の目線くらいの高さから撮影されています。",
+		"2つの面が交わる角があります。",
+		"窓や照明の近くを探してみてください。",
+	}
+}
@@ -0,0 +1,106 @@
+package vision_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/infra/vision"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+)
+
+const testMIME = "image/jpeg"
+
+func TestFake_Describe(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prompt      string
+		wantEnglish bool
+		wantCount   int
+	}{
+		"english prompt": {"Write 5 hints", true, 5},
+		"english repair": {"Write 3 more hints", true, 3},
+		"no count":       {"Write new hints", true, 5},
+		"too many hints": {"Write 20 hints", true, 10},
+		//nolint:gosmopolitan // Japanese prompt is checked
+		"japanese prompt": {"ヒントを5個作成してください", false, 5},
+		//nolint:gosmopolitan // Japanese prompt is checked
+		"japanese repair": {"ヒントを8個追加してください", false, 8},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model := vision.NewFake()
+			image := service.VisionImage{Data: []byte("photo"), MIMEType: testMIME}
+
+			first, err := model.Describe(context.Background(), image, testCase.prompt)
+			if err != nil {
+				t.Fatalf("Describe() failed: %v", err)
+			}
+
+			second, err := model.Describe(context.Background(), image, testCase.prompt)
+			if err != nil {
+				t.Fatalf("Describe() failed: %v", err)
+			}
+
+			if len(first.Features) != testCase.wantCount ||
+				!slices.Equal(first.Features, second.Features) {
+				t.Errorf(
+					"Describe() = %v then %v, want the same %d hints",
+					first.Features,
+					second.Features,
+					testCase.wantCount,
+				)
+			}
+
+			slices.Sort(first.Features)
+
+			if len(slices.Compact(first.Features)) != testCase.wantCount {
+				t.Errorf("Describe() = %v, want distinct hints", first.Features)
+			}
+
+			for _, feature := range first.Features {
+				isEnglish := feature[0] < 0x80
+				if isEnglish != testCase.wantEnglish {
+					t.Errorf("Describe() hint %q, want English = %v", feature, testCase.wantEnglish)
+				}
+			}
+		})
+	}
+}
+
+func TestFake_Compare(t *testing.T) {
+	t.Parallel()
+
+	reference := service.VisionImage{Data: []byte("photo"), MIMEType: testMIME}
+
+	tests := map[string]struct {
+		candidate []byte
+		want      float64
+	}{
+		"identical": {[]byte("photo"), 1},
+		"different": {[]byte("other"), 0.5},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			comparison, err := vision.NewFake().Compare(
+				context.Background(),
+				reference,
+				service.VisionImage{Data: testCase.candidate, MIMEType: testMIME},
+			)
+			if err != nil {
+				t.Fatalf("Compare() failed: %v", err)
+			}
+
+			if comparison.Similarity != testCase.want {
+				t.Errorf("Compare() similarity = %v, want %v", comparison.Similarity, testCase.want)
+			}
+		})
+	}
+}
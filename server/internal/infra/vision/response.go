@@ -0,0 +1,118 @@
+// Package vision provides the parts shared by the vision model clients of every AI provider,
+// and a fake vision model for local development and tests.
+package vision
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ComparePrompt asks for the similarity of the second photo to the first one.
+const ComparePrompt = `The first photo was taken by the game master.
+The second photo was taken by a hunter who tried to find the same place and take the same picture.
+Rate from 0 to 1 how similar the second photo is to the first in place and composition,
+and explain the rating in one short sentence.`
+
+// ModeratePrompt asks whether the text that follows it can be shown to players.
+const ModeratePrompt = `Check whether the following text can be shown to players of a photo game.
+Flag it if it contains personal information such as phone numbers, addresses or names of
+private people, text read from private documents, or offensive words.`
+
+// JSON formats asked of providers that cannot enforce a response schema.
+const (
+	DescribeFormat = `Answer only with JSON in the form {"result": ["text", ...]} with 5 texts.`
+	CompareFormat  = `Answer only with JSON in the form {"similarity": 0.5, "reason": "text"}.`
+	ModerateFormat = `Answer only with JSON in the form {"flagged": false, "categories": ["reason"]}.`
+)
+
+// DescribeResponse is the JSON answer to a describe request.
+type DescribeResponse struct {
+	Result []string `json:"result"`
+}
+
+// CompareResponse is the JSON answer to a compare request.
+type CompareResponse struct {
+	Similarity float64 `json:"similarity"`
+	Reason     string  `json:"reason"`
+}
+
+// ModerateResponse is the JSON answer to a moderate request.
+type ModerateResponse struct {
+	Flagged    bool     `json:"flagged"`
+	Categories []string `json:"categories"`
+}
+
+// DecodeDescribe decodes the answer to a describe request.
+func DecodeDescribe(text string) (*service.ImageAnalysisResult, error) {
+	var response DescribeResponse
+
+	err := decode(text, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.ImageAnalysisResult{
+		Features: response.Result,
+	}, nil
+}
+
+// DecodeCompare decodes the answer to a compare request.
+// Similarities out of range are clamped between 0 and 1.
+func DecodeCompare(text string) (*service.ImageComparison, error) {
+	var response CompareResponse
+
+	err := decode(text, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.ImageComparison{
+		Similarity: min(max(response.Similarity, 0), 1),
+		Reason:     response.Reason,
+	}, nil
+}
+
+// DecodeModerate decodes the answer to a moderate request.
+func DecodeModerate(text string) (*service.ModerationResult, error) {
+	var response ModerateResponse
+
+	err := decode(text, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.ModerationResult{
+		Flagged:    response.Flagged,
+		Categories: response.Categories,
+	}, nil
+}
+
+// decode unmarshals a JSON answer.
+// Self-hosted models often wrap JSON in a Markdown code block, which is removed first.
+func decode(text string, response any) error {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "```") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimPrefix(text, "```")
+		text = strings.TrimSuffix(strings.TrimSpace(text), "```")
+	}
+
+	err := json.Unmarshal([]byte(text), response)
+	if err != nil {
+		return errors.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
+
+// IsRejectedStatus reports whether an HTTP status of an AI provider means that the request
+// itself was rejected, such as a bad request or a wrong API key.
+// Timeouts, rate limits and server errors are temporary and may succeed when retried.
+func IsRejectedStatus(status int) bool {
+	return status >= http.StatusBadRequest && status < http.StatusInternalServerError &&
+		status != http.StatusRequestTimeout && status != http.StatusTooManyRequests
+}
@@ -0,0 +1,73 @@
+package vision_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/infra/vision"
+)
+
+func TestDecodeCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		text    string
+		want    float64
+		wantErr bool
+	}{
+		"plain JSON":    {`{"similarity": 0.8, "reason": "same door"}`, 0.8, false},
+		"code block":    {"```json\n{\"similarity\": 0.3, \"reason\": \"\"}\n```", 0.3, false},
+		"above range":   {`{"similarity": 1.5, "reason": ""}`, 1, false},
+		"below range":   {`{"similarity": -1, "reason": ""}`, 0, false},
+		"not JSON":      {"similar", 0, true},
+		"wrong type":    {`{"similarity": "high"}`, 0, true},
+		"bare fence":    {"```\n{\"similarity\": 0.5}\n```", 0.5, false},
+		"empty content": {"", 0, true},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			comparison, err := vision.DecodeCompare(testCase.text)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("DecodeCompare() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if err == nil && comparison.Similarity != testCase.want {
+				t.Errorf(
+					"DecodeCompare() similarity = %v, want %v",
+					comparison.Similarity,
+					testCase.want,
+				)
+			}
+		})
+	}
+}
+
+func TestIsRejectedStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status int
+		want   bool
+	}{
+		"bad request":       {http.StatusBadRequest, true},
+		"unauthorized":      {http.StatusUnauthorized, true},
+		"not found":         {http.StatusNotFound, true},
+		"request timeout":   {http.StatusRequestTimeout, false},
+		"too many requests": {http.StatusTooManyRequests, false},
+		"server error":      {http.StatusInternalServerError, false},
+		"unavailable":       {http.StatusServiceUnavailable, false},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := vision.IsRejectedStatus(testCase.status); got != testCase.want {
+				t.Errorf("IsRejectedStatus(%d) = %v, want %v", testCase.status, got, testCase.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/yashikota/scene-hunter/server/internal/domain/auth"
@@ -239,6 +240,31 @@ func (r *AnonRepositoryKVS) RevokeAllAnonTokens(ctx context.Context, anonID stri
 	return nil
 }
 
+// RevokeSubject rejects every access token issued for the subject until ttl elapses.
+// ttl should be at least the access token lifetime so that all outstanding tokens expire first.
+func (r *AnonRepositoryKVS) RevokeSubject(
+	ctx context.Context,
+	subject string,
+	ttl time.Duration,
+) error {
+	key := r.revokedSubjectKey(subject)
+	if err := r.kvs.Set(ctx, key, strconv.FormatInt(time.Now().Unix(), 10), ttl); err != nil {
+		return errors.Errorf("failed to revoke subject: %w", err)
+	}
+
+	return nil
+}
+
+// IsSubjectRevoked reports whether access tokens for the subject have been revoked.
+func (r *AnonRepositoryKVS) IsSubjectRevoked(ctx context.Context, subject string) (bool, error) {
+	revoked, err := r.kvs.Exists(ctx, r.revokedSubjectKey(subject))
+	if err != nil {
+		return false, errors.Errorf("failed to check subject revocation: %w", err)
+	}
+
+	return revoked, nil
+}
+
 // tokenKey returns the Redis key for a refresh token.
 func (r *AnonRepositoryKVS) tokenKey(tokenID string) string {
 	return "refresh:" + tokenID
@@ -248,3 +274,8 @@ func (r *AnonRepositoryKVS) tokenKey(tokenID string) string {
 func (r *AnonRepositoryKVS) anonTokensKey(anonID string) string {
 	return "anon:tokens:" + anonID
 }
+
+// revokedSubjectKey returns the Redis key marking a revoked token subject.
+func (r *AnonRepositoryKVS) revokedSubjectKey(subject string) string {
+	return "revoked:subject:" + subject
+}
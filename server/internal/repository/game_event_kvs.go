@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// gameEventField is the stream field that holds an event as JSON, as storeGameScript and
+// appendEventsScript write it.
+const gameEventField = "event"
+
+// anonymizeEventsScript rebuilds the event stream of a game with every occurrence of
+// a user ID replaced, keeping the IDs of the events and the expiry of the stream.
+// The stream is rebuilt because its entries cannot be changed in place.
+const anonymizeEventsScript = `
+	local key = KEYS[1]
+	local entries = redis.call('XRANGE', key, '-', '+')
+	if #entries == 0 then
+		return 0
+	end
+
+	local ttl = redis.call('PTTL', key)
+	local pattern = string.gsub(ARGV[1], '%-', '%%-')
+
+	redis.call('DEL', key)
+
+	for _, entry in ipairs(entries) do
+		local fields = entry[2]
+		for index = 2, #fields, 2 do
+			fields[index] = string.gsub(fields[index], pattern, ARGV[2])
+		end
+
+		redis.call('XADD', key, entry[1], unpack(fields))
+	end
+
+	if ttl > 0 then
+		redis.call('PEXPIRE', key, ttl)
+	end
+
+	return #entries
+`
+
+// appendEventsScript adds events to the event stream of a game in one call.
+// ARGV are the TTL of the stream in milliseconds and the events.
+const appendEventsScript = `
+	for index = 2, #ARGV do
+		redis.call('XADD', KEYS[1], '*', 'event', ARGV[index])
+	end
+
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+	return #ARGV - 1
+`
+
+// gameEventsKey generates the KVS key for the append-only event stream of a game.
+// Its hash tag is the one of gameKey.
+func gameEventsKey(roomID uuid.UUID) string {
+	return "game_events:{" + roomID.String() + "}"
+}
+
+// ListEvents returns up to limit events of the game after the event afterID,
+// or from the first event when afterID is empty, oldest first.
+func (r *GameRepositoryKVS) ListEvents(
+	ctx context.Context,
+	roomID uuid.UUID,
+	afterID string,
+	limit int64,
+) ([]*game.Event, error) {
+	messages, err := r.kvs.XRange(ctx, gameEventsKey(roomID), afterID, limit)
+	if err != nil {
+		return nil, errors.Errorf("failed to read game events from KVS: %w", err)
+	}
+
+	events := make([]*game.Event, 0, len(messages))
+
+	for _, message := range messages {
+		var event game.Event
+
+		err := json.Unmarshal([]byte(message.Values[gameEventField]), &event)
+		if err != nil {
+			return nil, errors.Errorf("failed to unmarshal game event %s: %w", message.ID, err)
+		}
+
+		event.ID = message.ID
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// AnonymizeEvents replaces userID with anonymousID in the event stream of a game.
+func (r *GameRepositoryKVS) AnonymizeEvents(
+	ctx context.Context,
+	roomID, userID, anonymousID uuid.UUID,
+) error {
+	_, err := r.kvs.Eval(
+		ctx,
+		anonymizeEventsScript,
+		[]string{gameEventsKey(roomID)},
+		userID.String(),
+		anonymousID.String(),
+	)
+	if err != nil {
+		return errors.Errorf("failed to anonymize game events in KVS: %w", err)
+	}
+
+	return nil
+}
+
+// AppendEvents adds the events of the changes to the game to its event stream,
+// stamped with the time they are stored.
+func (r *GameRepositoryKVS) AppendEvents(ctx context.Context, gameSession *game.Game) error {
+	events, err := r.takeEvents(gameSession)
+	if err != nil {
+		return err
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	// 監査のため、ゲームが削除されても期限までは残す
+	_, err = r.kvs.Eval(
+		ctx,
+		appendEventsScript,
+		[]string{gameEventsKey(gameSession.RoomID)},
+		append([]any{gameTTL.Milliseconds()}, events...)...,
+	)
+	if err != nil {
+		return errors.Errorf("failed to append game events to KVS: %w", err)
+	}
+
+	return nil
+}
+
+// takeEvents takes the pending events of the game stamped with the current time,
+// as the JSON stored in the event stream.
+func (r *GameRepositoryKVS) takeEvents(gameSession *game.Game) ([]any, error) {
+	events := gameSession.TakeEvents()
+	values := make([]any, 0, len(events))
+
+	for _, event := range events {
+		event.OccurredAt = r.chrono.Now()
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return nil, errors.Errorf("failed to marshal game event: %w", err)
+		}
+
+		values = append(values, string(data))
+	}
+
+	return values, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// getDelayedScript returns the newest game stored at or before the given time,
+// or the oldest game if every game is newer. It returns an empty string for no history.
+const getDelayedScript = `
+	local key = KEYS[1]
+
+	local found = redis.call('ZREVRANGEBYSCORE', key, ARGV[1], '-inf', 'LIMIT', 0, 1)
+	if #found == 0 then
+		found = redis.call('ZRANGE', key, 0, 0)
+	end
+
+	if #found == 0 then
+		return ''
+	end
+
+	return found[1]
+`
+
+// gameHistoryKey generates the KVS key for the recent states of a game shown to spectators.
+// Its hash tag is the one of gameKey.
+func gameHistoryKey(roomID uuid.UUID) string {
+	return "game_history:{" + roomID.String() + "}"
+}
+
+// GetDelayed returns the game as it was stored delay ago, or as it was first stored
+// if it is younger.
+func (r *GameRepositoryKVS) GetDelayed(
+	ctx context.Context,
+	roomID uuid.UUID,
+	delay time.Duration,
+) (*game.Game, error) {
+	asOf := time.Now().Add(-delay).UnixMilli()
+
+	result, err := r.kvs.Eval(
+		ctx,
+		getDelayedScript,
+		[]string{gameHistoryKey(roomID)},
+		strconv.FormatInt(asOf, 10),
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to get delayed game from KVS: %w", err)
+	}
+
+	data, ok := result.(string)
+	if !ok || data == "" {
+		return nil, errors.Errorf("%w: no history for roomID=%s", ErrGameNotFound, roomID)
+	}
+
+	var gameSession game.Game
+
+	err = json.Unmarshal([]byte(data), &gameSession)
+	if err != nil {
+		return nil, errors.Errorf("failed to unmarshal delayed game: %w", err)
+	}
+
+	return &gameSession, nil
+}
+
+// ClearHistory drops the past states of a game kept for spectators.
+func (r *GameRepositoryKVS) ClearHistory(ctx context.Context, roomID uuid.UUID) error {
+	err := r.kvs.Delete(ctx, gameHistoryKey(roomID))
+	if err != nil {
+		return errors.Errorf("failed to clear game history in KVS: %w", err)
+	}
+
+	return nil
+}
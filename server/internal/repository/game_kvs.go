@@ -4,11 +4,13 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/yashikota/scene-hunter/server/internal/domain/game"
 	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
@@ -26,44 +28,92 @@ const (
 	gameTTL = 24 * time.Hour
 )
 
+// storeGameScript stores a game together with its state for spectators and the events of
+// its changes, so that none of them is kept without the others.
+// KEYS are the game, its history and its event stream. ARGV are the version the game was
+// read as, or empty for a new game; the game; the TTL in milliseconds; the time and the oldest
+// time spectators may still be shown in milliseconds, empty when the game keeps no history;
+// and the events.
+// It returns 1 when the game is stored, 0 when a new game already exists or the stored
+// version differs from the version read, and -1 when there is no game to update.
+const storeGameScript = `
+	local current = redis.call('GET', KEYS[1])
+	local created = ARGV[1] == ''
+	if created and current then
+		return 0
+	end
+
+	if not created and not current then
+		return -1
+	end
+
+	if not created and (cjson.decode(current).version or 0) ~= tonumber(ARGV[1]) then
+		return 0
+	end
+
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+
+	if ARGV[4] ~= '' then
+		redis.call('ZADD', KEYS[2], ARGV[4], ARGV[2])
+
+		local kept = redis.call('ZREVRANGEBYSCORE', KEYS[2], ARGV[5], '-inf', 'WITHSCORES', 'LIMIT', 0, 1)
+		if #kept > 0 then
+			redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', '(' .. kept[2])
+		end
+
+		redis.call('PEXPIRE', KEYS[2], ARGV[3])
+	end
+
+	if #ARGV > 5 then
+		for index = 6, #ARGV do
+			redis.call('XADD', KEYS[3], '*', 'event', ARGV[index])
+		end
+
+		redis.call('PEXPIRE', KEYS[3], ARGV[3])
+	end
+
+	return 1
+`
+
 // GameRepositoryKVS implements GameRepository interface using KVS.
 type GameRepositoryKVS struct {
 	kvs service.KVS
+	// chrono stamps the events of the game when they are stored.
+	chrono chrono.Chrono
 }
 
 // NewGameRepository creates a new game repository.
-func NewGameRepository(kvsClient service.KVS) service.GameRepository {
+func NewGameRepository(kvsClient service.KVS, chronoProvider chrono.Chrono) service.GameRepository {
 	return &GameRepositoryKVS{
-		kvs: kvsClient,
+		kvs:    kvsClient,
+		chrono: chronoProvider,
 	}
 }
 
 // gameKey generates the KVS key for a game by room ID.
+// The room ID is a hash tag so that the keys of a game are in the same slot of a cluster,
+// which storeGameScript needs.
 func gameKey(roomID uuid.UUID) string {
-	return "game:" + roomID.String()
+	return "game:{" + roomID.String() + "}"
+}
+
+// playerGamesKey generates the KVS key for the set of rooms a user has played in.
+func playerGamesKey(userID uuid.UUID) string {
+	return "player_games:" + userID.String()
 }
 
 // Create saves a new game to KVS.
 func (r *GameRepositoryKVS) Create(ctx context.Context, gameSession *game.Game) error {
-	// Serialize game to JSON
-	data, err := json.Marshal(gameSession)
-	if err != nil {
-		return errors.Errorf("failed to marshal game: %w", err)
-	}
-
-	// Save to KVS with TTL
-	key := gameKey(gameSession.RoomID)
-
-	set, err := r.kvs.SetNX(ctx, key, string(data), gameTTL)
+	stored, err := r.store(ctx, gameSession, "")
 	if err != nil {
-		return errors.Errorf("failed to save game to KVS: %w", err)
+		return err
 	}
 
-	if !set {
+	if stored != 1 {
 		return errors.Errorf("%w: roomID=%s", ErrGameAlreadyExists, gameSession.RoomID)
 	}
 
-	return nil
+	return r.indexPlayers(ctx, gameSession)
 }
 
 // Get retrieves a game from KVS by room ID.
@@ -90,31 +140,29 @@ func (r *GameRepositoryKVS) Get(ctx context.Context, roomID uuid.UUID) (*game.Ga
 }
 
 // Update updates an existing game in KVS.
+// It fails with service.ErrConflict when the game has been updated since it was read.
 func (r *GameRepositoryKVS) Update(ctx context.Context, gameSession *game.Game) error {
-	// Check if game exists
-	_, err := r.Get(ctx, gameSession.RoomID)
-	if err != nil {
-		return err
-	}
-
-	// Update timestamp
+	readVersion := gameSession.Version
+	gameSession.Version++
 	gameSession.UpdatedAt = time.Now()
 
-	// Serialize game to JSON
-	data, err := json.Marshal(gameSession)
-	if err != nil {
-		return errors.Errorf("failed to marshal game: %w", err)
+	stored, err := r.store(ctx, gameSession, strconv.FormatInt(readVersion, 10))
+	if err == nil && stored != 1 {
+		err = errors.Errorf("%w: roomID=%s", ErrGameNotFound, gameSession.RoomID)
+		if stored == 0 {
+			err = errors.Errorf("%w: game roomID=%s version=%d",
+				service.ErrConflict, gameSession.RoomID, readVersion)
+		}
 	}
 
-	// Save to KVS with TTL (overwrites existing key)
-	key := gameKey(gameSession.RoomID)
-
-	err = r.kvs.Set(ctx, key, string(data), gameTTL)
 	if err != nil {
-		return errors.Errorf("failed to update game in KVS: %w", err)
+		// 失敗した場合は読み込んだときの版に戻し、読み直さずに再試行されても検出できるようにする
+		gameSession.Version = readVersion
+
+		return err
 	}
 
-	return nil
+	return r.indexPlayers(ctx, gameSession)
 }
 
 // Delete removes a game from KVS.
@@ -133,6 +181,11 @@ func (r *GameRepositoryKVS) Delete(ctx context.Context, roomID uuid.UUID) error
 		return errors.Errorf("failed to delete game from KVS: %w", err)
 	}
 
+	err = r.kvs.Delete(ctx, gameHistoryKey(roomID))
+	if err != nil {
+		return errors.Errorf("failed to delete game history from KVS: %w", err)
+	}
+
 	return nil
 }
 
@@ -147,3 +200,139 @@ func (r *GameRepositoryKVS) Exists(ctx context.Context, roomID uuid.UUID) (bool,
 
 	return exists, nil
 }
+
+// ListByPlayer returns all games the given user has joined or watched and that have not expired yet.
+func (r *GameRepositoryKVS) ListByPlayer(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*game.Game, error) {
+	key := playerGamesKey(userID)
+
+	roomIDs, err := r.kvs.SMembers(ctx, key)
+	if err != nil {
+		return nil, errors.Errorf("failed to get player games from KVS: %w", err)
+	}
+
+	games := make([]*game.Game, 0, len(roomIDs))
+
+	for _, roomIDStr := range roomIDs {
+		roomID, err := uuid.Parse(roomIDStr)
+		if err != nil {
+			continue
+		}
+
+		gameSession, err := r.Get(ctx, roomID)
+		if err != nil {
+			if errors.Is(err, ErrGameNotFound) {
+				// The game has expired, drop the stale index entry
+				_ = r.kvs.SRem(ctx, key, roomIDStr)
+
+				continue
+			}
+
+			return nil, err
+		}
+
+		games = append(games, gameSession)
+	}
+
+	return games, nil
+}
+
+// RemovePlayerIndex removes a game from the given user's game index.
+func (r *GameRepositoryKVS) RemovePlayerIndex(
+	ctx context.Context,
+	userID, roomID uuid.UUID,
+) error {
+	err := r.kvs.SRem(ctx, playerGamesKey(userID), roomID.String())
+	if err != nil {
+		return errors.Errorf("failed to remove player game index: %w", err)
+	}
+
+	return nil
+}
+
+// indexPlayers records the game in the game index of every player and spectator.
+// Anonymized players have no account left to look the game up.
+func (r *GameRepositoryKVS) indexPlayers(ctx context.Context, gameSession *game.Game) error {
+	userIDs := make([]uuid.UUID, 0, len(gameSession.Players)+len(gameSession.Spectators))
+	for _, player := range gameSession.Players {
+		if player.Anonymized {
+			continue
+		}
+
+		userIDs = append(userIDs, player.UserID)
+	}
+
+	for _, spectator := range gameSession.Spectators {
+		userIDs = append(userIDs, spectator.UserID)
+	}
+
+	for _, userID := range userIDs {
+		key := playerGamesKey(userID)
+
+		err := r.kvs.SAdd(ctx, key, gameSession.RoomID.String())
+		if err != nil {
+			return errors.Errorf("failed to index game by player: %w", err)
+		}
+
+		err = r.kvs.Expire(ctx, key, gameTTL)
+		if err != nil {
+			return errors.Errorf("failed to set expiration for player game index: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// store saves a game with its history and pending events in one call unless readVersion
+// differs from the stored version, or when readVersion is empty, unless the game exists.
+// It returns the result of storeGameScript.
+func (r *GameRepositoryKVS) store(
+	ctx context.Context,
+	gameSession *game.Game,
+	readVersion string,
+) (int64, error) {
+	data, err := json.Marshal(gameSession)
+	if err != nil {
+		return 0, errors.Errorf("failed to marshal game: %w", err)
+	}
+
+	// 観戦者に遅延がなければ履歴は残さない
+	now, cutoff := "", ""
+
+	delay := time.Duration(gameSession.Settings.SpectatorDelaySeconds) * time.Second
+	if delay > 0 {
+		storedAt := time.Now()
+		now = strconv.FormatInt(storedAt.UnixMilli(), 10)
+		cutoff = strconv.FormatInt(storedAt.Add(-delay).UnixMilli(), 10)
+	}
+
+	events, err := r.takeEvents(gameSession)
+	if err != nil {
+		return 0, err
+	}
+
+	args := append([]any{readVersion, string(data), gameTTL.Milliseconds(), now, cutoff}, events...)
+
+	result, err := r.kvs.Eval(
+		ctx,
+		storeGameScript,
+		[]string{
+			gameKey(gameSession.RoomID),
+			gameHistoryKey(gameSession.RoomID),
+			gameEventsKey(gameSession.RoomID),
+		},
+		args...,
+	)
+	if err != nil {
+		return 0, errors.Errorf("failed to save game to KVS: %w", err)
+	}
+
+	stored, ok := result.(int64)
+	if !ok {
+		return 0, errors.Errorf("unexpected result of saving game: %v", result)
+	}
+
+	return stored, nil
+}
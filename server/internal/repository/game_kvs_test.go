@@ -0,0 +1,183 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// newGame returns a waiting game with one player.
+func newGame(t *testing.T) *game.Game {
+	t.Helper()
+
+	gameSession, err := game.NewGame(uuid.New(), 1, uuid.New(), game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	return gameSession
+}
+
+// addPlayer adds a new player to gameSession.
+func addPlayer(t *testing.T, gameSession *game.Game) {
+	t.Helper()
+
+	player, err := game.NewPlayer(uuid.New(), "player", false, false)
+	if err != nil {
+		t.Fatalf("NewPlayer() failed: %v", err)
+	}
+
+	err = gameSession.AddPlayer(player)
+	if err != nil {
+		t.Fatalf("AddPlayer() failed: %v", err)
+	}
+}
+
+func TestGameRepository_Update_Conflict(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	gameRepo := repository.NewGameRepository(testutil.NewKVS(t), chrono.New())
+	gameSession := newGame(t)
+
+	err := gameRepo.Create(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	first, err := gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	second, err := gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	addPlayer(t, first)
+
+	err = gameRepo.Update(ctx, first)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+
+	// 読み込んだ後に保存された変更は上書きしない
+	addPlayer(t, second)
+
+	err = gameRepo.Update(ctx, second)
+	if !errors.Is(err, service.ErrConflict) {
+		t.Fatalf("Update() of a stale game error = %v, want %v", err, service.ErrConflict)
+	}
+
+	// 失敗しても、再度の保存で競合を検出できる
+	err = gameRepo.Update(ctx, second)
+	if !errors.Is(err, service.ErrConflict) {
+		t.Errorf("retried Update() of a stale game error = %v, want %v", err, service.ErrConflict)
+	}
+
+	// 読み直せば両方の変更が残る
+	second, err = gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	addPlayer(t, second)
+
+	err = gameRepo.Update(ctx, second)
+	if err != nil {
+		t.Fatalf("Update() after reading again failed: %v", err)
+	}
+
+	stored, err := gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	if len(stored.Players) != 2 {
+		t.Errorf("stored game has %d players, want 2", len(stored.Players))
+	}
+}
+
+func TestGameRepository_Update_NotFound(t *testing.T) {
+	t.Parallel()
+
+	gameRepo := repository.NewGameRepository(testutil.NewKVS(t), chrono.New())
+
+	err := gameRepo.Update(t.Context(), newGame(t))
+	if !errors.Is(err, repository.ErrGameNotFound) {
+		t.Errorf("Update() error = %v, want %v", err, repository.ErrGameNotFound)
+	}
+}
+
+func TestGameRepository_Update_ConflictStoresNothing(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	gameRepo := repository.NewGameRepository(testutil.NewKVS(t), chrono.New())
+	gameSession := newGame(t)
+	gameSession.Settings.SpectatorDelaySeconds = 60
+
+	err := gameRepo.Create(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	first, err := gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	second, err := gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	addPlayer(t, first)
+
+	err = gameRepo.Update(ctx, first)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+
+	before, err := gameRepo.ListEvents(ctx, gameSession.RoomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	addPlayer(t, second)
+	addPlayer(t, second)
+
+	err = gameRepo.Update(ctx, second)
+	if !errors.Is(err, service.ErrConflict) {
+		t.Fatalf("Update() of a stale game error = %v, want %v", err, service.ErrConflict)
+	}
+
+	// 保存されなかった変更の出来事も、観戦者向けの状態も残らない
+	after, err := gameRepo.ListEvents(ctx, gameSession.RoomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	if len(after) != len(before) {
+		t.Errorf("events after a conflict = %d, want %d", len(after), len(before))
+	}
+
+	latest, err := gameRepo.GetDelayed(ctx, gameSession.RoomID, 0)
+	if err != nil {
+		t.Fatalf("GetDelayed() failed: %v", err)
+	}
+
+	if len(latest.Players) != 1 {
+		t.Errorf("latest state for spectators has %d players, want 1", len(latest.Players))
+	}
+}
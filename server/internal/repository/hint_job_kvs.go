@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	// hintJobStream is the KVS stream of hint generation jobs.
+	hintJobStream = "hint_jobs"
+	// hintJobGroup is the consumer group shared by all hint workers.
+	hintJobGroup = "hint_workers"
+	// hintJobField is the stream field that holds a job as JSON.
+	hintJobField = "job"
+	// hintJobBatch is how many jobs a worker receives at once.
+	hintJobBatch = 1
+	// hintJobWait is how long Receive waits for a new job.
+	hintJobWait = 5 * time.Second
+)
+
+// deliveriesScript returns how many times a pending job has been delivered to the workers,
+// or 0 when it is not pending. ARGV are the group and the first and last ID to look up.
+const deliveriesScript = `
+	local pending = redis.call('XPENDING', KEYS[1], ARGV[1], ARGV[2], ARGV[3], 1)
+	if #pending == 0 then
+		return 0
+	end
+
+	return pending[1][4]
+`
+
+// HintJobQueueKVS implements HintJobQueue interface using a KVS stream.
+type HintJobQueueKVS struct {
+	kvs service.KVS
+	// claimAfter is how long a received job may stay unacknowledged
+	// before another worker takes it over.
+	claimAfter time.Duration
+
+	mu         sync.Mutex
+	groupReady bool
+}
+
+// NewHintJobQueue creates a new hint job queue.
+func NewHintJobQueue(kvsClient service.KVS, claimAfter time.Duration) service.HintJobQueue {
+	return &HintJobQueueKVS{
+		kvs:        kvsClient,
+		claimAfter: claimAfter,
+	}
+}
+
+// Enqueue adds a job to the queue.
+func (q *HintJobQueueKVS) Enqueue(ctx context.Context, job *game.HintJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return errors.Errorf("failed to marshal hint job: %w", err)
+	}
+
+	_, err = q.kvs.XAdd(ctx, hintJobStream, map[string]string{hintJobField: string(data)})
+	if err != nil {
+		return errors.Errorf("failed to enqueue hint job: %w", err)
+	}
+
+	return nil
+}
+
+// Receive returns the jobs that other workers left unfinished, or else waits for a new job.
+func (q *HintJobQueueKVS) Receive(ctx context.Context, worker string) ([]*game.HintJob, error) {
+	err := q.prepareGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := q.kvs.XAutoClaim(
+		ctx,
+		hintJobStream,
+		hintJobGroup,
+		worker,
+		q.claimAfter,
+		hintJobBatch,
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to claim hint jobs: %w", err)
+	}
+
+	if len(messages) == 0 {
+		messages, err = q.kvs.XReadGroup(
+			ctx,
+			hintJobStream,
+			hintJobGroup,
+			worker,
+			hintJobBatch,
+			hintJobWait,
+		)
+		if err != nil {
+			return nil, errors.Errorf("failed to read hint jobs: %w", err)
+		}
+	}
+
+	jobs := make([]*game.HintJob, 0, len(messages))
+
+	for _, message := range messages {
+		var job game.HintJob
+
+		err := json.Unmarshal([]byte(message.Values[hintJobField]), &job)
+		if err != nil {
+			// 読めないジョブは何度配送しても処理できないので取り除く
+			errors.LogErrorCtx(ctx, "dropping malformed hint job", err, "id", message.ID)
+
+			err = q.remove(ctx, message.ID)
+			if err != nil {
+				return nil, errors.Errorf("failed to drop malformed hint job: %w", err)
+			}
+
+			continue
+		}
+
+		job.ID = message.ID
+
+		job.Deliveries, err = q.deliveries(ctx, message.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// Ack removes a finished job from the queue.
+func (q *HintJobQueueKVS) Ack(ctx context.Context, job *game.HintJob) error {
+	err := q.remove(ctx, job.ID)
+	if err != nil {
+		return errors.Errorf("failed to ack hint job: %w", err)
+	}
+
+	return nil
+}
+
+// deliveries returns how many times the job messageID has been delivered.
+func (q *HintJobQueueKVS) deliveries(ctx context.Context, messageID string) (int, error) {
+	result, err := q.kvs.Eval(
+		ctx,
+		deliveriesScript,
+		[]string{hintJobStream},
+		hintJobGroup,
+		messageID,
+		messageID,
+	)
+	if err != nil {
+		return 0, errors.Errorf("failed to get hint job deliveries: %w", err)
+	}
+
+	count, ok := result.(int64)
+	if !ok {
+		return 0, errors.Errorf("unexpected hint job deliveries: %v", result)
+	}
+
+	return int(count), nil
+}
+
+// remove acknowledges a job and deletes it so that the stream does not keep finished jobs.
+func (q *HintJobQueueKVS) remove(ctx context.Context, messageID string) error {
+	err := q.kvs.XAck(ctx, hintJobStream, hintJobGroup, messageID)
+	if err != nil {
+		return errors.Errorf("failed to acknowledge job: %w", err)
+	}
+
+	// 確認応答の後に削除し、削除に失敗しても再配送されないようにする
+	err = q.kvs.XDel(ctx, hintJobStream, messageID)
+	if err != nil {
+		return errors.Errorf("failed to delete job: %w", err)
+	}
+
+	return nil
+}
+
+// prepareGroup creates the consumer group once.
+func (q *HintJobQueueKVS) prepareGroup(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.groupReady {
+		return nil
+	}
+
+	err := q.kvs.XGroupCreate(ctx, hintJobStream, hintJobGroup)
+	if err != nil {
+		return errors.Errorf("failed to create hint worker group: %w", err)
+	}
+
+	q.groupReady = true
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+)
+
+func TestHintJobQueue_Receive_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	kvsClient := testutil.NewKVS(t)
+
+	// 確認応答されないジョブはすぐに別のワーカーが引き継ぐ
+	queue := repository.NewHintJobQueue(kvsClient, 0)
+
+	// グループは作成後に追加されたジョブだけを読むため、ワーカーより先に作っておく
+	err := kvsClient.XGroupCreate(ctx, "hint_jobs", "hint_workers")
+	if err != nil {
+		t.Fatalf("XGroupCreate() failed: %v", err)
+	}
+
+	err = queue.Enqueue(ctx, &game.HintJob{RoomID: uuid.New(), RoundNumber: 1, Attempt: 1})
+	if err != nil {
+		t.Fatalf("Enqueue() failed: %v", err)
+	}
+
+	for want := 1; want <= 3; want++ {
+		jobs, err := queue.Receive(ctx, "worker")
+		if err != nil {
+			t.Fatalf("Receive() failed: %v", err)
+		}
+
+		if len(jobs) != 1 || jobs[0].Deliveries != want {
+			t.Fatalf("Receive() = %v, want the job delivered %d times", jobs, want)
+		}
+	}
+}
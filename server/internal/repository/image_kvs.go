@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// roomImagesTTL is the time-to-live of the image index of a room.
+// It matches the longest lifetime of a stored image.
+const roomImagesTTL = 24 * time.Hour
+
+// ImageRepositoryKVS implements ImageRepository interface using KVS.
+type ImageRepositoryKVS struct {
+	kvs service.KVS
+}
+
+// NewImageRepository creates a new image catalog repository.
+func NewImageRepository(kvsClient service.KVS) service.ImageRepository {
+	return &ImageRepositoryKVS{
+		kvs: kvsClient,
+	}
+}
+
+// imageKey generates the KVS key for the metadata of an image.
+func imageKey(roomID, imageID uuid.UUID) string {
+	return "image:" + roomID.String() + ":" + imageID.String()
+}
+
+// roomImagesKey generates the KVS key for the set of images stored in a room.
+func roomImagesKey(roomID uuid.UUID) string {
+	return "room_images:" + roomID.String()
+}
+
+// uploaderImagesKey generates the KVS key for the set of images a user uploaded.
+// Members are "<room ID>:<image ID>" because images are stored per room.
+func uploaderImagesKey(uploaderID uuid.UUID) string {
+	return "uploader_images:" + uploaderID.String()
+}
+
+// uploaderImageMember generates the member of an image in the image index of its uploader.
+func uploaderImageMember(roomID, imageID uuid.UUID) string {
+	return roomID.String() + ":" + imageID.String()
+}
+
+// Save stores the metadata of an image and adds it to the image index of its room
+// and, when the uploader is known, of its uploader.
+func (r *ImageRepositoryKVS) Save(
+	ctx context.Context,
+	metadata *image.Metadata,
+	ttl time.Duration,
+) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Errorf("failed to marshal image metadata: %w", err)
+	}
+
+	err = r.kvs.Set(ctx, imageKey(metadata.RoomID, metadata.ID), string(data), ttl)
+	if err != nil {
+		return errors.Errorf("failed to save image metadata to KVS: %w", err)
+	}
+
+	indexKey := roomImagesKey(metadata.RoomID)
+
+	err = r.kvs.SAdd(ctx, indexKey, metadata.ID.String())
+	if err != nil {
+		return errors.Errorf("failed to index image by room: %w", err)
+	}
+
+	err = r.kvs.Expire(ctx, indexKey, roomImagesTTL)
+	if err != nil {
+		return errors.Errorf("failed to set expiration for room image index: %w", err)
+	}
+
+	if metadata.UploaderID == uuid.Nil {
+		return nil
+	}
+
+	uploaderKey := uploaderImagesKey(metadata.UploaderID)
+
+	err = r.kvs.SAdd(ctx, uploaderKey, uploaderImageMember(metadata.RoomID, metadata.ID))
+	if err != nil {
+		return errors.Errorf("failed to index image by uploader: %w", err)
+	}
+
+	err = r.kvs.Expire(ctx, uploaderKey, roomImagesTTL)
+	if err != nil {
+		return errors.Errorf("failed to set expiration for uploader image index: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves the metadata of an image.
+// It returns service.ErrNotFound when the image is not in the catalog.
+func (r *ImageRepositoryKVS) Get(
+	ctx context.Context,
+	roomID, imageID uuid.UUID,
+) (*image.Metadata, error) {
+	data, err := r.kvs.Get(ctx, imageKey(roomID, imageID))
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, errors.Errorf("image %w: id=%s", service.ErrNotFound, imageID)
+		}
+
+		return nil, errors.Errorf("failed to get image metadata from KVS: %w", err)
+	}
+
+	var metadata image.Metadata
+
+	err = json.Unmarshal([]byte(data), &metadata)
+	if err != nil {
+		return nil, errors.Errorf("failed to unmarshal image metadata: %w", err)
+	}
+
+	return &metadata, nil
+}
+
+// ListByRoom returns the metadata of all images in a room that have not expired yet.
+func (r *ImageRepositoryKVS) ListByRoom(
+	ctx context.Context,
+	roomID uuid.UUID,
+) ([]*image.Metadata, error) {
+	indexKey := roomImagesKey(roomID)
+
+	imageIDs, err := r.kvs.SMembers(ctx, indexKey)
+	if err != nil {
+		return nil, errors.Errorf("failed to get room images from KVS: %w", err)
+	}
+
+	images := make([]*image.Metadata, 0, len(imageIDs))
+
+	for _, imageIDStr := range imageIDs {
+		imageID, err := uuid.Parse(imageIDStr)
+		if err != nil {
+			continue
+		}
+
+		metadata, err := r.Get(ctx, roomID, imageID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				// The image has expired, drop the stale index entry
+				_ = r.kvs.SRem(ctx, indexKey, imageIDStr)
+
+				continue
+			}
+
+			return nil, err
+		}
+
+		images = append(images, metadata)
+	}
+
+	return images, nil
+}
+
+// ListByUploader returns the metadata of all images the given user uploaded
+// that have not expired yet, in any room.
+func (r *ImageRepositoryKVS) ListByUploader(
+	ctx context.Context,
+	uploaderID uuid.UUID,
+) ([]*image.Metadata, error) {
+	indexKey := uploaderImagesKey(uploaderID)
+
+	members, err := r.kvs.SMembers(ctx, indexKey)
+	if err != nil {
+		return nil, errors.Errorf("failed to get uploader images from KVS: %w", err)
+	}
+
+	images := make([]*image.Metadata, 0, len(members))
+
+	for _, member := range members {
+		rawRoomID, rawImageID, found := strings.Cut(member, ":")
+		if !found {
+			continue
+		}
+
+		roomID, err := uuid.Parse(rawRoomID)
+		if err != nil {
+			continue
+		}
+
+		imageID, err := uuid.Parse(rawImageID)
+		if err != nil {
+			continue
+		}
+
+		metadata, err := r.Get(ctx, roomID, imageID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				// The image has expired, drop the stale index entry
+				_ = r.kvs.SRem(ctx, indexKey, member)
+
+				continue
+			}
+
+			return nil, err
+		}
+
+		images = append(images, metadata)
+	}
+
+	return images, nil
+}
+
+// Delete removes the metadata of an image and its index entries.
+func (r *ImageRepositoryKVS) Delete(ctx context.Context, metadata *image.Metadata) error {
+	err := r.kvs.Delete(ctx, imageKey(metadata.RoomID, metadata.ID))
+	if err != nil {
+		return errors.Errorf("failed to delete image metadata from KVS: %w", err)
+	}
+
+	err = r.kvs.SRem(ctx, roomImagesKey(metadata.RoomID), metadata.ID.String())
+	if err != nil {
+		return errors.Errorf("failed to remove room image index: %w", err)
+	}
+
+	if metadata.UploaderID == uuid.Nil {
+		return nil
+	}
+
+	err = r.kvs.SRem(
+		ctx,
+		uploaderImagesKey(metadata.UploaderID),
+		uploaderImageMember(metadata.RoomID, metadata.ID),
+	)
+	if err != nil {
+		return errors.Errorf("failed to remove uploader image index: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// UploadRepositoryKVS implements UploadRepository interface using KVS.
+type UploadRepositoryKVS struct {
+	kvs service.KVS
+}
+
+// NewUploadRepository creates a new pending upload repository.
+func NewUploadRepository(kvsClient service.KVS) service.UploadRepository {
+	return &UploadRepositoryKVS{
+		kvs: kvsClient,
+	}
+}
+
+// uploadKey generates the KVS key for a pending upload.
+func uploadKey(uploadID uuid.UUID) string {
+	return "upload:" + uploadID.String()
+}
+
+// uploadLockKey generates the KVS key for the lock of a pending upload.
+func uploadLockKey(uploadID uuid.UUID) string {
+	return "upload_lock:" + uploadID.String()
+}
+
+// Save stores a pending upload until it is completed or expires.
+func (r *UploadRepositoryKVS) Save(
+	ctx context.Context,
+	upload *image.PendingUpload,
+	ttl time.Duration,
+) error {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return errors.Errorf("failed to marshal upload: %w", err)
+	}
+
+	err = r.kvs.Set(ctx, uploadKey(upload.ID), string(data), ttl)
+	if err != nil {
+		return errors.Errorf("failed to save upload to KVS: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a pending upload.
+// It returns service.ErrNotFound when the upload does not exist or has expired.
+func (r *UploadRepositoryKVS) Get(
+	ctx context.Context,
+	uploadID uuid.UUID,
+) (*image.PendingUpload, error) {
+	data, err := r.kvs.Get(ctx, uploadKey(uploadID))
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, errors.Errorf("upload %w: id=%s", service.ErrNotFound, uploadID)
+		}
+
+		return nil, errors.Errorf("failed to get upload from KVS: %w", err)
+	}
+
+	var upload image.PendingUpload
+
+	err = json.Unmarshal([]byte(data), &upload)
+	if err != nil {
+		return nil, errors.Errorf("failed to unmarshal upload: %w", err)
+	}
+
+	return &upload, nil
+}
+
+// Delete removes a pending upload.
+func (r *UploadRepositoryKVS) Delete(ctx context.Context, uploadID uuid.UUID) error {
+	err := r.kvs.Delete(ctx, uploadKey(uploadID))
+	if err != nil {
+		return errors.Errorf("failed to delete upload from KVS: %w", err)
+	}
+
+	return nil
+}
+
+// Lock reserves a pending upload until it is unlocked or ttl elapses.
+func (r *UploadRepositoryKVS) Lock(
+	ctx context.Context,
+	uploadID uuid.UUID,
+	ttl time.Duration,
+) (bool, error) {
+	locked, err := r.kvs.SetNX(ctx, uploadLockKey(uploadID), "1", ttl)
+	if err != nil {
+		return false, errors.Errorf("failed to lock upload: %w", err)
+	}
+
+	return locked, nil
+}
+
+// Unlock releases a pending upload.
+func (r *UploadRepositoryKVS) Unlock(ctx context.Context, uploadID uuid.UUID) error {
+	err := r.kvs.Delete(ctx, uploadLockKey(uploadID))
+	if err != nil {
+		return errors.Errorf("failed to unlock upload: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/user"
+	"github.com/yashikota/scene-hunter/server/internal/infra/db"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// UserRepositoryDB implements UserRepository interface using Postgres.
+type UserRepositoryDB struct {
+	DB *db.Client
+}
+
+// NewUserRepository creates a new UserRepository.
+func NewUserRepository(dbClient *db.Client) service.UserRepository {
+	return &UserRepositoryDB{
+		DB: dbClient,
+	}
+}
+
+// GetUser retrieves a user that has not been deleted.
+func (r *UserRepositoryDB) GetUser(ctx context.Context, userID uuid.UUID) (*user.User, error) {
+	row, err := r.DB.Queries.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get user: %w", err)
+	}
+
+	return &user.User{
+		ID:        row.ID,
+		Code:      row.Code,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+		DeletedAt: row.DeletedAt,
+	}, nil
+}
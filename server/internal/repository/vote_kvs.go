@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// addBallotScript records the voter in the set of voters of the round and stores the ballot
+// only if they were not in it yet, so that a voter cannot vote twice.
+const addBallotScript = `
+	if redis.call('SADD', KEYS[1], ARGV[1]) == 0 then
+		return 0
+	end
+
+	redis.call('SADD', KEYS[2], ARGV[2])
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+	redis.call('PEXPIRE', KEYS[2], ARGV[3])
+	return 1
+`
+
+// VoteRepositoryKVS implements VoteRepository interface using KVS sets.
+type VoteRepositoryKVS struct {
+	kvs service.KVS
+}
+
+// NewVoteRepository creates a new vote repository.
+func NewVoteRepository(kvsClient service.KVS) service.VoteRepository {
+	return &VoteRepositoryKVS{
+		kvs: kvsClient,
+	}
+}
+
+// votersKey generates the KVS key for the set of users who voted in a round.
+// The room ID is a hash tag so that the keys of a round are in the same slot of a cluster,
+// which addBallotScript needs.
+func votersKey(roomID uuid.UUID, roundNumber int) string {
+	return "round_voters:{" + roomID.String() + "}:" + strconv.Itoa(roundNumber)
+}
+
+// ballotsKey generates the KVS key for the set of ballots of a round.
+func ballotsKey(roomID uuid.UUID, roundNumber int) string {
+	return "round_ballots:{" + roomID.String() + "}:" + strconv.Itoa(roundNumber)
+}
+
+// AddBallot stores a ballot and reports false if the voter has already voted in the round.
+func (r *VoteRepositoryKVS) AddBallot(
+	ctx context.Context,
+	roomID uuid.UUID,
+	roundNumber int,
+	ballot *game.Ballot,
+) (bool, error) {
+	data, err := json.Marshal(ballot)
+	if err != nil {
+		return false, errors.Errorf("failed to marshal ballot: %w", err)
+	}
+
+	result, err := r.kvs.Eval(
+		ctx,
+		addBallotScript,
+		[]string{votersKey(roomID, roundNumber), ballotsKey(roomID, roundNumber)},
+		ballot.VoterID.String(),
+		string(data),
+		gameTTL.Milliseconds(),
+	)
+	if err != nil {
+		return false, errors.Errorf("failed to add ballot to KVS: %w", err)
+	}
+
+	added, ok := result.(int64)
+	if !ok {
+		return false, errors.Errorf("unexpected result type from lua script: %T", result)
+	}
+
+	return added == 1, nil
+}
+
+// ListBallots returns the ballots of a round.
+func (r *VoteRepositoryKVS) ListBallots(
+	ctx context.Context,
+	roomID uuid.UUID,
+	roundNumber int,
+) ([]*game.Ballot, error) {
+	members, err := r.kvs.SMembers(ctx, ballotsKey(roomID, roundNumber))
+	if err != nil {
+		return nil, errors.Errorf("failed to get ballots from KVS: %w", err)
+	}
+
+	ballots := make([]*game.Ballot, 0, len(members))
+
+	for _, member := range members {
+		var ballot game.Ballot
+
+		err = json.Unmarshal([]byte(member), &ballot)
+		if err != nil {
+			return nil, errors.Errorf("failed to unmarshal ballot: %w", err)
+		}
+
+		ballots = append(ballots, &ballot)
+	}
+
+	return ballots, nil
+}
@@ -0,0 +1,40 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+)
+
+func TestVoteRepository_AddBallot(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	voteRepo := repository.NewVoteRepository(testutil.NewKVS(t))
+	roomID := uuid.New()
+	ballot := &game.Ballot{VoterID: uuid.New(), Ranking: []uuid.UUID{uuid.New()}}
+
+	added, err := voteRepo.AddBallot(ctx, roomID, 1, ballot)
+	if err != nil || !added {
+		t.Fatalf("AddBallot() = %v, %v, want true", added, err)
+	}
+
+	// 同じラウンドで二度は投票できない
+	added, err = voteRepo.AddBallot(ctx, roomID, 1, ballot)
+	if err != nil || added {
+		t.Errorf("AddBallot() twice = %v, %v, want false", added, err)
+	}
+
+	ballots, err := voteRepo.ListBallots(ctx, roomID, 1)
+	if err != nil || len(ballots) != 1 || ballots[0].VoterID != ballot.VoterID {
+		t.Errorf("ListBallots() = %v, %v, want the ballot", ballots, err)
+	}
+
+	ballots, err = voteRepo.ListBallots(ctx, roomID, 2)
+	if err != nil || len(ballots) != 0 {
+		t.Errorf("ListBallots() of another round = %v, %v, want none", ballots, err)
+	}
+}
@@ -0,0 +1,458 @@
+package account_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	goimage "image"
+	"image/jpeg"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/config"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	domainuser "github.com/yashikota/scene-hunter/server/internal/domain/user"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/account"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// anonymousUsers は永続ユーザーを持たない匿名ユーザーだけのユーザーリポジトリ.
+type anonymousUsers struct{}
+
+func (anonymousUsers) GetUser(context.Context, uuid.UUID) (*domainuser.User, error) {
+	return nil, pgx.ErrNoRows
+}
+
+// fixture はminiredisとメモリ上のBlobを使うアカウントサービスと、その保存先.
+type fixture struct {
+	service  *account.Service
+	catalog  *imagesvc.Catalog
+	gameRepo service.GameRepository
+	anonRepo service.AnonRepository
+	blob     *testutil.MemoryBlob
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	kvsClient := testutil.NewKVS(t)
+	blobClient := testutil.NewMemoryBlob()
+
+	catalog := imagesvc.NewCatalog(
+		blobClient,
+		repository.NewImageRepository(kvsClient),
+		repository.NewUploadRepository(kvsClient),
+		imagesvc.CatalogOptions{
+			Normalize: domainimage.NormalizeOptions{
+				MaxDimension: 2048,
+				Quality:      85,
+				ThumbnailDimensions: domainimage.ThumbnailDimensions{
+					Small:  16,
+					Medium: 32,
+					Large:  64,
+				},
+				ThumbnailQuality: 75,
+				MaxPixels:        4_000_000,
+			},
+			PresignedURLTTL:  15 * time.Minute,
+			ThumbnailWorkers: 2,
+		},
+	)
+
+	var cfg config.AppConfig
+
+	cfg.Auth.AccessTokenTTL = time.Hour
+
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+	anonRepo := repository.NewAnonRepository(kvsClient)
+
+	return &fixture{
+		service: account.NewService(
+			anonymousUsers{},
+			nil,
+			anonRepo,
+			gameRepo,
+			catalog,
+			&cfg,
+		),
+		catalog:  catalog,
+		gameRepo: gameRepo,
+		anonRepo: anonRepo,
+		blob:     blobClient,
+	}
+}
+
+// storeImage stores a test JPEG uploaded by uploaderID into a room.
+func (env *fixture) storeImage(
+	t *testing.T,
+	roomID, uploaderID uuid.UUID,
+	role domainimage.Role,
+) *domainimage.Metadata {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	err := jpeg.Encode(&buf, goimage.NewRGBA(goimage.Rect(0, 0, 64, 48)), nil)
+	if err != nil {
+		t.Fatalf("failed to encode JPEG: %v", err)
+	}
+
+	metadata, err := env.catalog.Store(t.Context(), imagesvc.Upload{
+		RoomID:      roomID,
+		ContentType: "",
+		Data:        buf.Bytes(),
+		UploaderID:  uploaderID,
+		RoundNumber: 0,
+		Role:        role,
+		TTL:         time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Store() failed: %v", err)
+	}
+
+	return metadata
+}
+
+// createGame stores a game of three players whose game master is userID
+// and who has submitted their photo in the first round.
+// Spectators see the game with a delay, so its past states are kept.
+func (env *fixture) createGame(t *testing.T, userID uuid.UUID) *game.Game {
+	t.Helper()
+
+	settings := game.DefaultGameSettings()
+	settings.SpectatorDelaySeconds = game.MaxSpectatorDelaySeconds
+
+	gameSession, err := game.NewGame(uuid.New(), 1, userID, game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, settings, false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index, playerID := range []uuid.UUID{userID, uuid.New(), uuid.New()} {
+		player, err := game.NewPlayer(playerID, "player", index == 0, index == 0)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	err = gameSession.Start(userID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(userID)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	photo := env.storeImage(t, gameSession.RoomID, userID, domainimage.RoleGameMaster)
+
+	err = gameSession.Rounds[0].StartHintGeneration(photo.ID.String())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = env.gameRepo.Create(t.Context(), gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	return gameSession
+}
+
+// authenticated returns a context authenticated as userID.
+func authenticated(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, middleware.AnonIDContextKey, userID.String())
+}
+
+// assertAnonymizedEvents checks that events are the events before the deletion
+// with userID replaced by anonymousID.
+func assertAnonymizedEvents(
+	t *testing.T,
+	before, events []*game.Event,
+	userID, anonymousID uuid.UUID,
+) {
+	t.Helper()
+
+	// 削除の記録が最後に1件増える
+	if len(events) != len(before)+1 {
+		t.Fatalf("ListEvents() = %d events, want %d", len(events), len(before)+1)
+	}
+
+	last := events[len(before)]
+	if last.Type != game.EventPlayerAnonymized || last.UserID != anonymousID {
+		t.Errorf("last event = %v by %v, want %v by %v",
+			last.Type, last.UserID, game.EventPlayerAnonymized, anonymousID)
+	}
+
+	for index, event := range events[:len(before)] {
+		wantUserID := before[index].UserID
+		if wantUserID == userID {
+			wantUserID = anonymousID
+		}
+
+		if event.ID != before[index].ID || event.UserID != wantUserID {
+			t.Errorf("event %d = %s by %v, want %s by %v",
+				index, event.ID, event.UserID, before[index].ID, wantUserID)
+		}
+	}
+}
+
+func TestService_DeleteAccount(t *testing.T) {
+	t.Parallel()
+
+	env := newFixture(t)
+	userID := uuid.New()
+	gameSession := env.createGame(t, userID)
+	otherID := gameSession.Players[1].UserID
+
+	// ゲーム外でアップロードした画像とシーンプールの画像も削除される
+	env.storeImage(t, gameSession.RoomID, userID, domainimage.RoleUpload)
+	env.storeImage(t, uuid.New(), userID, domainimage.RoleScene)
+	otherPhoto := env.storeImage(t, gameSession.RoomID, otherID, domainimage.RoleUpload)
+
+	eventsBefore, err := env.gameRepo.ListEvents(t.Context(), gameSession.RoomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	if !slices.ContainsFunc(eventsBefore, func(event *game.Event) bool {
+		return event.UserID == userID
+	}) {
+		t.Fatal("ListEvents() has no events of the user before the deletion")
+	}
+
+	ctx := authenticated(t.Context(), userID)
+	req := &scene_hunterv1.DeleteAccountRequest{UserId: userID.String()}
+
+	data, err := env.service.PrepareAccountDeletion(ctx, req)
+	if err != nil {
+		t.Fatalf("PrepareAccountDeletion() failed: %v", err)
+	}
+
+	if data.User != nil || len(data.Games) != 1 {
+		t.Fatalf("PrepareAccountDeletion() = user %v, %d games, want no user and 1 game",
+			data.User, len(data.Games))
+	}
+
+	resp, err := env.service.PurgeAccountData(ctx, data)
+	if err != nil {
+		t.Fatalf("PurgeAccountData() failed: %v", err)
+	}
+
+	if resp.GetAnonymizedGames() != 1 || resp.GetDeletedImages() != 3 {
+		t.Errorf("PurgeAccountData() = %d games, %d images, want 1 game, 3 images",
+			resp.GetAnonymizedGames(), resp.GetDeletedImages())
+	}
+
+	err = env.service.CompleteAccountDeletion(ctx, data)
+	if err != nil {
+		t.Fatalf("CompleteAccountDeletion() failed: %v", err)
+	}
+
+	uploads, err := env.catalog.ListByUploader(t.Context(), userID)
+	if err != nil || len(uploads) != 0 {
+		t.Errorf("ListByUploader() = %d images, %v, want none", len(uploads), err)
+	}
+
+	// 他のプレイヤーの画像は残る
+	remaining, err := env.catalog.List(t.Context(), gameSession.RoomID)
+	if err != nil || len(remaining) != 1 || remaining[0].ID != otherPhoto.ID {
+		t.Errorf("List() = %v, %v, want only the other player's image", remaining, err)
+	}
+
+	for _, key := range env.blob.Keys() {
+		if !strings.Contains(key, otherPhoto.ID.String()) {
+			t.Errorf("blob %s is left after the deletion", key)
+		}
+	}
+
+	stored, err := env.gameRepo.Get(t.Context(), gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	anonymousID := stored.Players[0].UserID
+	if anonymousID == userID || stored.Players[0].Name != game.AnonymizedPlayerName {
+		t.Errorf("stored player = %+v, want anonymized", stored.Players[0])
+	}
+
+	// 観戦者に遅れて見せる過去の状態にも削除したユーザーは残らない
+	delayed, err := env.gameRepo.GetDelayed(t.Context(), gameSession.RoomID, time.Hour)
+	if err != nil {
+		t.Fatalf("GetDelayed() failed: %v", err)
+	}
+
+	if _, err := delayed.GetPlayer(userID); err == nil {
+		t.Error("GetDelayed() still shows the deleted user")
+	}
+
+	// イベントストリームでは同じ位置のまま匿名のIDに置き換わる
+	events, err := env.gameRepo.ListEvents(t.Context(), gameSession.RoomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	assertAnonymizedEvents(t, eventsBefore, events, userID, anonymousID)
+
+	// 削除したユーザーと匿名のIDのどちらからもゲームを辿れない
+	for _, indexedID := range []uuid.UUID{userID, anonymousID} {
+		games, err := env.gameRepo.ListByPlayer(t.Context(), indexedID)
+		if err != nil || len(games) != 0 {
+			t.Errorf("ListByPlayer(%s) = %d games, %v, want none", indexedID, len(games), err)
+		}
+	}
+
+	revoked, err := env.anonRepo.IsSubjectRevoked(t.Context(), userID.String())
+	if err != nil || !revoked {
+		t.Errorf("IsSubjectRevoked() = %v, %v, want true", revoked, err)
+	}
+}
+
+func TestService_PurgeAccountData_Retry(t *testing.T) {
+	t.Parallel()
+
+	env := newFixture(t)
+	userID := uuid.New()
+	env.createGame(t, userID)
+	env.storeImage(t, uuid.New(), userID, domainimage.RoleUpload)
+
+	ctx := authenticated(t.Context(), userID)
+
+	data, err := env.service.PrepareAccountDeletion(
+		ctx,
+		&scene_hunterv1.DeleteAccountRequest{UserId: userID.String()},
+	)
+	if err != nil {
+		t.Fatalf("PrepareAccountDeletion() failed: %v", err)
+	}
+
+	_, err = env.service.PurgeAccountData(ctx, data)
+	if err != nil {
+		t.Fatalf("PurgeAccountData() failed: %v", err)
+	}
+
+	// 途中で失敗した削除を再度要求しても、削除済みのものは数えずに続きから進む
+	resp, err := env.service.PurgeAccountData(ctx, data)
+	if err != nil {
+		t.Fatalf("retried PurgeAccountData() failed: %v", err)
+	}
+
+	if resp.GetAnonymizedGames() != 0 || resp.GetDeletedImages() != 0 {
+		t.Errorf("retried PurgeAccountData() = %d games, %d images, want none",
+			resp.GetAnonymizedGames(), resp.GetDeletedImages())
+	}
+}
+
+func TestService_PrepareAccountDeletion_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	// callerID が uuid.Nil の場合は認証しない
+	tests := map[string]struct {
+		callerID uuid.UUID
+		rawID    string
+		wantCode connect.Code
+	}{
+		"another user":    {uuid.New(), userID.String(), connect.CodePermissionDenied},
+		"unauthenticated": {uuid.Nil, userID.String(), connect.CodeUnauthenticated},
+		"invalid user ID": {userID, "invalid", connect.CodeInvalidArgument},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newFixture(t)
+
+			ctx := t.Context()
+			if testCase.callerID != uuid.Nil {
+				ctx = authenticated(ctx, testCase.callerID)
+			}
+
+			_, err := env.service.PrepareAccountDeletion(
+				ctx,
+				&scene_hunterv1.DeleteAccountRequest{UserId: testCase.rawID},
+			)
+			if connect.CodeOf(err) != testCase.wantCode {
+				t.Errorf("PrepareAccountDeletion() error = %v, want %v", err, testCase.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_ExportMyData(t *testing.T) {
+	t.Parallel()
+
+	env := newFixture(t)
+	userID := uuid.New()
+	gameSession := env.createGame(t, userID)
+
+	resp, err := env.service.ExportMyData(
+		authenticated(t.Context(), userID),
+		&scene_hunterv1.ExportMyDataRequest{UserId: userID.String()},
+	)
+	if err != nil {
+		t.Fatalf("ExportMyData() failed: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(resp.GetArchive()), int64(len(resp.GetArchive())))
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+
+	names := make([]string, 0, len(archive.File))
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+
+	photoPrefix := "photos/" + gameSession.RoomID.String() + "/" +
+		gameSession.Rounds[0].GameMasterImageID
+
+	for _, want := range []string{"profile.json", "identities.json", "matches.json"} {
+		if !slices.Contains(names, want) {
+			t.Errorf("archive entries = %v, want %s", names, want)
+		}
+	}
+
+	if !slices.ContainsFunc(names, func(name string) bool {
+		return strings.HasPrefix(name, photoPrefix)
+	}) {
+		t.Errorf("archive entries = %v, want the photo %s", names, photoPrefix)
+	}
+}
+
+func TestService_ExportMyData_OtherUser(t *testing.T) {
+	t.Parallel()
+
+	env := newFixture(t)
+
+	_, err := env.service.ExportMyData(
+		authenticated(t.Context(), uuid.New()),
+		&scene_hunterv1.ExportMyDataRequest{UserId: uuid.NewString()},
+	)
+	if !errors.Is(err, account.ErrForbidden) {
+		t.Errorf("ExportMyData() error = %v, want %v", err, account.ErrForbidden)
+	}
+}
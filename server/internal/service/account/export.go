@@ -0,0 +1,264 @@
+package account
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// exportContentType is the content type of the export archive.
+const exportContentType = "application/zip"
+
+// exportProfile is the profile section of a personal data export.
+type exportProfile struct {
+	UserID    string     `json:"userId"`
+	Anonymous bool       `json:"anonymous"`
+	Code      string     `json:"code,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// exportIdentity is a linked OAuth identity in a personal data export.
+type exportIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// exportMatch is a single game in the match history of a personal data export.
+type exportMatch struct {
+	RoomID      string        `json:"roomId"`
+	Status      string        `json:"status"`
+	PlayerName  string        `json:"playerName"`
+	Spectator   bool          `json:"spectator,omitempty"`
+	TotalPoints int           `json:"totalPoints"`
+	Rounds      []exportRound `json:"rounds"`
+	CreatedAt   time.Time     `json:"createdAt"`
+}
+
+// exportRound is the user's part in a single round of a game.
+type exportRound struct {
+	RoundNumber int    `json:"roundNumber"`
+	Role        string `json:"role"`
+	ImageID     string `json:"imageId,omitempty"`
+	Rank        int    `json:"rank,omitempty"`
+	Points      int    `json:"points"`
+}
+
+// ExportMyData builds a ZIP archive with the authenticated user's personal data.
+func (s *Service) ExportMyData(
+	ctx context.Context,
+	req *scene_hunterv1.ExportMyDataRequest,
+) (*scene_hunterv1.ExportMyDataResponse, error) {
+	userID, err := s.authorize(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.findIdentities(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.gameRepo.ListByPlayer(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to list games: %w", err)
+	}
+
+	profile := exportProfile{UserID: userID.String(), Anonymous: user == nil}
+	if user != nil {
+		profile.Code = user.Code
+		profile.Name = user.Name
+		profile.CreatedAt = &user.CreatedAt
+		profile.UpdatedAt = &user.UpdatedAt
+	}
+
+	exportIdentities := make([]exportIdentity, len(identities))
+	for i, identity := range identities {
+		exportIdentities[i] = exportIdentity{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		}
+	}
+
+	matches := make([]exportMatch, 0, len(games))
+	for _, gameSession := range games {
+		matches = append(matches, buildExportMatch(gameSession, userID))
+	}
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	for name, content := range map[string]any{
+		"profile.json":    profile,
+		"identities.json": exportIdentities,
+		"matches.json":    matches,
+	} {
+		if err := writeJSONEntry(archive, name, content); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, gameSession := range games {
+		for _, imageID := range gameSession.ImageIDsByPlayer(userID) {
+			err := s.writePhotoEntry(ctx, archive, gameSession.RoomID, imageID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, errors.Errorf("failed to finalize archive: %w", err)
+	}
+
+	return &scene_hunterv1.ExportMyDataResponse{
+		Archive:     buf.Bytes(),
+		Filename:    fmt.Sprintf("scene-hunter-%s.zip", userID),
+		ContentType: exportContentType,
+	}, nil
+}
+
+// writePhotoEntry copies one of the user's photos from blob storage into the archive.
+// Photos that have already expired from blob storage are skipped.
+func (s *Service) writePhotoEntry(
+	ctx context.Context,
+	archive *zip.Writer,
+	roomID uuid.UUID,
+	imageID string,
+) error {
+	photo, err := s.findPhoto(ctx, roomID, imageID)
+	if err != nil {
+		return err
+	}
+
+	if photo == nil {
+		return nil
+	}
+
+	data, err := s.imageCatalog.Read(ctx, photo)
+	if err != nil {
+		return errors.Errorf("failed to read photo: %w", err)
+	}
+
+	name := fmt.Sprintf("photos/%s/%s", roomID, imageID)
+	if ext := domainimage.Extension(photo.ContentType); ext != "" {
+		name += "." + ext
+	}
+
+	entry, err := archive.Create(name)
+	if err != nil {
+		return errors.Errorf("failed to create archive entry: %w", err)
+	}
+
+	if _, err := entry.Write(data); err != nil {
+		return errors.Errorf("failed to write photo to archive: %w", err)
+	}
+
+	return nil
+}
+
+// writeJSONEntry writes content as an indented JSON file into the archive.
+func writeJSONEntry(archive *zip.Writer, name string, content any) error {
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return errors.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	entry, err := archive.Create(name)
+	if err != nil {
+		return errors.Errorf("failed to create archive entry: %w", err)
+	}
+
+	if _, err := entry.Write(data); err != nil {
+		return errors.Errorf("failed to write %s to archive: %w", name, err)
+	}
+
+	return nil
+}
+
+// buildExportMatch summarizes the user's part in a game.
+func buildExportMatch(gameSession *game.Game, userID uuid.UUID) exportMatch {
+	match := exportMatch{
+		RoomID:    gameSession.RoomID.String(),
+		Status:    gameStatusName(gameSession.Status),
+		Rounds:    make([]exportRound, 0, len(gameSession.Rounds)),
+		CreatedAt: gameSession.CreatedAt,
+	}
+
+	// 観戦者はラウンドに参加していないので名前だけを書き出す
+	for _, spectator := range gameSession.Spectators {
+		if spectator.UserID == userID {
+			match.PlayerName = spectator.Name
+			match.Spectator = true
+
+			return match
+		}
+	}
+
+	if player, err := gameSession.GetPlayer(userID); err == nil {
+		match.PlayerName = player.Name
+		match.TotalPoints = player.TotalPoints
+	}
+
+	for _, round := range gameSession.Rounds {
+		entry := exportRound{RoundNumber: round.RoundNumber, Role: "hunter"}
+
+		if round.GameMasterUserID == userID {
+			entry.Role = "game_master"
+			entry.ImageID = round.GameMasterImageID
+		}
+
+		for _, submission := range round.HunterSubmissions {
+			if submission.UserID == userID {
+				entry.ImageID = submission.ImageID
+			}
+		}
+
+		for _, result := range round.Results {
+			if result.UserID == userID {
+				entry.Rank = result.Rank
+				entry.Points = result.Points
+			}
+		}
+
+		match.Rounds = append(match.Rounds, entry)
+	}
+
+	return match
+}
+
+// gameStatusName returns a stable name for a game status.
+func gameStatusName(status game.GameStatus) string {
+	switch status {
+	case game.GameStatusWaiting:
+		return "waiting"
+	case game.GameStatusInProgress:
+		return "in_progress"
+	case game.GameStatusFinished:
+		return "finished"
+	case game.GameStatusPaused:
+		return "paused"
+	default:
+		return "unknown"
+	}
+}
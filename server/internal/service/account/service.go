@@ -0,0 +1,313 @@
+// Package account provides account deletion and personal data export.
+package account
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/config"
+	domainauth "github.com/yashikota/scene-hunter/server/internal/domain/auth"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	domainuser "github.com/yashikota/scene-hunter/server/internal/domain/user"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrForbidden is returned when a user tries to access another user's account.
+var ErrForbidden = errors.New("cannot access another user's account")
+
+// anonymizeAttempts is how many times a game is read again when it changes
+// while the user is anonymized in it.
+const anonymizeAttempts = 3
+
+// Service implements the AccountService.
+type Service struct {
+	userRepo     service.UserRepository
+	identityRepo service.IdentityRepository
+	anonRepo     service.AnonRepository
+	gameRepo     service.GameRepository
+	imageCatalog *imagesvc.Catalog
+	config       *config.AppConfig
+}
+
+// NewService creates a new account Service.
+func NewService(
+	userRepo service.UserRepository,
+	identityRepo service.IdentityRepository,
+	anonRepo service.AnonRepository,
+	gameRepo service.GameRepository,
+	imageCatalog *imagesvc.Catalog,
+	cfg *config.AppConfig,
+) *Service {
+	return &Service{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		anonRepo:     anonRepo,
+		gameRepo:     gameRepo,
+		imageCatalog: imageCatalog,
+		config:       cfg,
+	}
+}
+
+// PreparedDeletionData contains all the data prepared for an account deletion.
+type PreparedDeletionData struct {
+	// UserID is the ID of the account being deleted
+	UserID uuid.UUID
+
+	// User is the permanent user record (nil for anonymous users)
+	User *domainuser.User
+
+	// Games are the games the user has taken part in
+	Games []*game.Game
+}
+
+// PrepareAccountDeletion verifies the caller and collects everything that has to be deleted.
+func (s *Service) PrepareAccountDeletion(
+	ctx context.Context,
+	req *scene_hunterv1.DeleteAccountRequest,
+) (*PreparedDeletionData, error) {
+	userID, err := s.authorize(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.gameRepo.ListByPlayer(ctx, userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to list games: %w", err)
+	}
+
+	return &PreparedDeletionData{
+		UserID: userID,
+		User:   user,
+		Games:  games,
+	}, nil
+}
+
+// PurgeAccountData anonymizes the user in games and purges every image they uploaded.
+// It runs before the user record is soft-deleted, so that a failure leaves the account in place
+// and the deletion can be requested again. What was purged before is skipped then.
+func (s *Service) PurgeAccountData(
+	ctx context.Context,
+	data *PreparedDeletionData,
+) (*scene_hunterv1.DeleteAccountResponse, error) {
+	photos, err := s.collectPhotos(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var anonymizedGames, deletedImages uint32
+
+	for _, photo := range photos {
+		err = s.imageCatalog.Delete(ctx, photo)
+		if err != nil {
+			return nil, errors.Errorf("failed to delete image: %w", err)
+		}
+
+		deletedImages++
+	}
+
+	for _, gameSession := range data.Games {
+		anonymized, err := s.anonymizeInGame(ctx, gameSession, data.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if anonymized {
+			anonymizedGames++
+		}
+
+		err = s.gameRepo.RemovePlayerIndex(ctx, data.UserID, gameSession.RoomID)
+		if err != nil {
+			return nil, errors.Errorf("failed to remove game index: %w", err)
+		}
+	}
+
+	return &scene_hunterv1.DeleteAccountResponse{
+		AnonymizedGames: anonymizedGames,
+		DeletedImages:   deletedImages,
+	}, nil
+}
+
+// CompleteAccountDeletion revokes all tokens of the user.
+// It runs after the user record has been soft-deleted.
+func (s *Service) CompleteAccountDeletion(ctx context.Context, data *PreparedDeletionData) error {
+	subject := data.UserID.String()
+
+	if err := s.anonRepo.RevokeAllAnonTokens(ctx, subject); err != nil {
+		return errors.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	// Access tokens are stateless, so reject the subject until all outstanding tokens expire
+	if err := s.anonRepo.RevokeSubject(ctx, subject, s.config.Auth.AccessTokenTTL); err != nil {
+		return errors.Errorf("failed to revoke access tokens: %w", err)
+	}
+
+	return nil
+}
+
+// anonymizeInGame replaces the user with an anonymous player in a game and its event stream,
+// and drops the past states kept for spectators, which still show the user. When the game
+// has changed since it was read, it is read again so that the change is not lost.
+func (s *Service) anonymizeInGame(
+	ctx context.Context,
+	gameSession *game.Game,
+	userID uuid.UUID,
+) (bool, error) {
+	for attempt := 1; ; attempt++ {
+		anonymousID, err := gameSession.AnonymizePlayer(userID)
+		if err != nil {
+			return false, errors.Errorf("failed to anonymize player: %w", err)
+		}
+
+		if anonymousID == uuid.Nil {
+			return false, nil
+		}
+
+		err = s.gameRepo.ClearHistory(ctx, gameSession.RoomID)
+		if err != nil {
+			return false, errors.Errorf("failed to clear game history: %w", err)
+		}
+
+		err = s.gameRepo.Update(ctx, gameSession)
+		if err == nil {
+			err = s.gameRepo.AnonymizeEvents(ctx, gameSession.RoomID, userID, anonymousID)
+			if err != nil {
+				return false, errors.Errorf("failed to anonymize game events: %w", err)
+			}
+
+			return true, nil
+		}
+
+		if !errors.Is(err, service.ErrConflict) || attempt == anonymizeAttempts {
+			return false, errors.Errorf("failed to update game: %w", err)
+		}
+
+		gameSession, err = s.gameRepo.Get(ctx, gameSession.RoomID)
+		if err != nil {
+			return false, errors.Errorf("failed to get game: %w", err)
+		}
+	}
+}
+
+// collectPhotos returns every image the user uploaded that has not expired, from their uploads
+// and their games, once each.
+func (s *Service) collectPhotos(
+	ctx context.Context,
+	data *PreparedDeletionData,
+) ([]*domainimage.Metadata, error) {
+	// ゲーム外のアップロードやシーンプールの画像もアップロードしたユーザーから探す
+	photos, err := s.imageCatalog.ListByUploader(ctx, data.UserID)
+	if err != nil {
+		return nil, errors.Errorf("failed to list uploaded images: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(photos))
+	for _, photo := range photos {
+		seen[photo.ID] = true
+	}
+
+	for _, gameSession := range data.Games {
+		for _, imageID := range gameSession.ImageIDsByPlayer(data.UserID) {
+			photo, err := s.findPhoto(ctx, gameSession.RoomID, imageID)
+			if err != nil {
+				return nil, err
+			}
+
+			if photo == nil || seen[photo.ID] {
+				continue
+			}
+
+			seen[photo.ID] = true
+			photos = append(photos, photo)
+		}
+	}
+
+	return photos, nil
+}
+
+// authorize verifies that the request targets the authenticated user's own account.
+func (s *Service) authorize(ctx context.Context, rawUserID string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid user_id: %w", err),
+		)
+	}
+
+	authenticatedUserID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	if userID != authenticatedUserID {
+		return uuid.Nil, connect.NewError(connect.CodePermissionDenied, ErrForbidden)
+	}
+
+	return userID, nil
+}
+
+// findUser returns the permanent user record, or nil if the user is anonymous.
+func (s *Service) findUser(ctx context.Context, userID uuid.UUID) (*domainuser.User, error) {
+	user, err := s.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil //nolint:nilnil // anonymous users have no user record
+		}
+
+		return nil, errors.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// findIdentities returns the OAuth identities linked to a permanent user.
+func (s *Service) findIdentities(
+	ctx context.Context,
+	user *domainuser.User,
+) ([]*domainauth.Identity, error) {
+	if user == nil {
+		return []*domainauth.Identity{}, nil
+	}
+
+	identities, err := s.identityRepo.GetIdentitiesByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get identities: %w", err)
+	}
+
+	return identities, nil
+}
+
+// findPhoto returns the catalog entry of a photo, or nil if it has already expired.
+func (s *Service) findPhoto(
+	ctx context.Context,
+	roomID uuid.UUID,
+	rawImageID string,
+) (*domainimage.Metadata, error) {
+	imageID, err := uuid.Parse(rawImageID)
+	if err != nil {
+		return nil, errors.Errorf("invalid image ID: %w", err)
+	}
+
+	photo, err := s.imageCatalog.Get(ctx, roomID, imageID)
+	if err != nil {
+		if errors.Is(err, imagesvc.ErrImageNotFound) {
+			return nil, nil //nolint:nilnil // expired photos have nothing left to delete or export
+		}
+
+		return nil, errors.Errorf("failed to get photo: %w", err)
+	}
+
+	return photo, nil
+}
@@ -3,7 +3,6 @@ package auth
 
 import (
 	"context"
-	"os"
 	"strings"
 
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
@@ -26,21 +25,16 @@ type Service struct {
 func NewService(
 	anonRepo service.AnonRepository,
 	identityRepo service.IdentityRepository,
+	tokenSigner *domainauth.TokenSigner,
 	cfg *config.AppConfig,
 ) *Service {
-	// Get HMAC secret from environment
-	hmacSecret := os.Getenv("AUTH_HMAC_SECRET")
-	if hmacSecret == "" {
-		panic("AUTH_HMAC_SECRET environment variable is required")
-	}
-
 	// Initialize Google verifier
 	googleVerifier := NewGoogleVerifier()
 
 	return &Service{
 		anonRepo:       anonRepo,
 		identityRepo:   identityRepo,
-		tokenSigner:    domainauth.NewTokenSigner([]byte(hmacSecret)),
+		tokenSigner:    tokenSigner,
 		googleVerifier: googleVerifier,
 		config:         cfg,
 	}
@@ -101,7 +101,7 @@ func (s *Service) CompleteExistingUserUpgrade(
 	}
 
 	// Create user session token
-	userSession, err := s.tokenSigner.SignAnonToken(
+	userSession, err := s.tokenSigner.SignUserToken(
 		data.ExistingIdentity.UserID.String(),
 		s.config.Auth.AccessTokenTTL,
 	)
@@ -151,7 +151,7 @@ func (s *Service) CompleteNewUserUpgrade(
 	}
 
 	// Create permanent user session token
-	userSession, err := s.tokenSigner.SignAnonToken(
+	userSession, err := s.tokenSigner.SignUserToken(
 		data.NewUser.ID.String(),
 		s.config.Auth.AccessTokenTTL,
 	)
@@ -248,7 +248,7 @@ func (s *Service) CompleteExistingUserLogin(
 	data *PreparedLoginData,
 ) (*scene_hunterv1.LoginWithGoogleResponse, error) {
 	// Create user session token
-	userSession, err := s.tokenSigner.SignAnonToken(
+	userSession, err := s.tokenSigner.SignUserToken(
 		data.ExistingIdentity.UserID.String(),
 		s.config.Auth.AccessTokenTTL,
 	)
@@ -274,7 +274,7 @@ func (s *Service) CompleteNewUserLogin(
 	data *PreparedLoginData,
 ) (*scene_hunterv1.LoginWithGoogleResponse, error) {
 	// Create user session token
-	userSession, err := s.tokenSigner.SignAnonToken(
+	userSession, err := s.tokenSigner.SignUserToken(
 		data.NewUser.ID.String(),
 		s.config.Auth.AccessTokenTTL,
 	)
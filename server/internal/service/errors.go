@@ -6,4 +6,10 @@ import "github.com/yashikota/scene-hunter/server/internal/util/errors"
 var (
 	// ErrNotFound is returned when a resource is not found.
 	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when a resource was changed by someone else after it was read.
+	// Reading it again and repeating the change may succeed.
+	ErrConflict = errors.New("changed concurrently")
+	// ErrVisionRequestRejected is returned when the vision model rejects a request.
+	// Sending the same request again fails the same way, so it is not retried.
+	ErrVisionRequestRejected = errors.New("vision request rejected")
 )
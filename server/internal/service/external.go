@@ -14,6 +14,18 @@ type Blob interface {
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	// Stat returns the metadata of an object, or ErrNotFound when it does not exist.
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	// PresignPut returns a URL that uploads an object with an HTTP PUT until it expires.
+	PresignPut(ctx context.Context, key string, expiry time.Duration) (string, error)
+	// PresignGet returns a URL that downloads an object until it expires.
+	// The object is served with the given content type.
+	PresignGet(
+		ctx context.Context,
+		key string,
+		contentType string,
+		expiry time.Duration,
+	) (string, error)
 }
 
 // ObjectInfo represents metadata about a blob object.
@@ -38,19 +50,109 @@ type KVS interface {
 	SRem(ctx context.Context, key string, members ...string) error
 	Expire(ctx context.Context, key string, ttl time.Duration) error
 	TTL(ctx context.Context, key string) (time.Duration, error)
+	// XAdd appends a message to a stream and returns its ID.
+	XAdd(ctx context.Context, stream string, values map[string]string) (string, error)
+	// XGroupCreate creates a consumer group that reads new messages of a stream,
+	// creating the stream if needed. An existing group is not an error.
+	XGroupCreate(ctx context.Context, stream, group string) error
+	// XReadGroup reads up to count new messages for a consumer of a group,
+	// waiting up to block for them to arrive.
+	XReadGroup(
+		ctx context.Context,
+		stream, group, consumer string,
+		count int64,
+		block time.Duration,
+	) ([]StreamMessage, error)
+	// XAutoClaim takes over up to count messages that other consumers of a group
+	// read more than minIdle ago and have not acknowledged.
+	XAutoClaim(
+		ctx context.Context,
+		stream, group, consumer string,
+		minIdle time.Duration,
+		count int64,
+	) ([]StreamMessage, error)
+	// XAck acknowledges messages so that they are not delivered again.
+	XAck(ctx context.Context, stream, group string, ids ...string) error
+	// XDel removes messages from a stream.
+	XDel(ctx context.Context, stream string, ids ...string) error
+	// XRange reads up to count messages of a stream after the message afterID,
+	// or from the start when afterID is empty.
+	XRange(ctx context.Context, stream, afterID string, count int64) ([]StreamMessage, error)
 }
 
-// Gemini defines the interface for AI image analysis operations.
-type Gemini interface {
-	AnalyzeImage(
-		ctx context.Context,
-		imageData []byte,
-		mimeType string,
-		prompt string,
-	) (*ImageAnalysisResult, error)
+// StreamMessage is a message of a KVS stream.
+type StreamMessage struct {
+	ID     string
+	Values map[string]string
+}
+
+// VisionModel defines the interface for AI models that understand images.
+// It does not depend on the AI provider, which is selected in the configuration.
+type VisionModel interface {
+	// Describe writes texts about an image as instructed by prompt.
+	Describe(ctx context.Context, image VisionImage, prompt string) (*ImageAnalysisResult, error)
+	// Compare rates how similar a candidate photo is to a reference photo of the same place.
+	Compare(ctx context.Context, reference, candidate VisionImage) (*ImageComparison, error)
+	// Moderate checks whether a text is safe to show to players.
+	Moderate(ctx context.Context, text string) (*ModerationResult, error)
+}
+
+// VisionImage is an image sent to a vision model.
+type VisionImage struct {
+	Data     []byte
+	MIMEType string
 }
 
 // ImageAnalysisResult represents the result of image analysis.
 type ImageAnalysisResult struct {
 	Features []string
+	Usage    VisionUsage
+}
+
+// ImageComparison represents how similar two photos are.
+type ImageComparison struct {
+	// Similarity is from 0 (unrelated) to 1 (same place and composition).
+	Similarity float64
+	Reason     string
+	Usage      VisionUsage
 }
+
+// ModerationResult represents whether a text is safe to show to players.
+type ModerationResult struct {
+	Flagged bool
+	// Categories names the reasons a text was flagged, such as "personal_information".
+	Categories []string
+	Usage      VisionUsage
+}
+
+// VisionUsage is what a call to a vision model was billed for.
+type VisionUsage struct {
+	// Model is the model that answered, as reported by the AI provider.
+	Model        string
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// VisionMetrics records calls to a vision model for monitoring and cost accounting.
+type VisionMetrics interface {
+	RecordVisionCall(ctx context.Context, call VisionCall)
+}
+
+// VisionCall describes one call to a vision model.
+type VisionCall struct {
+	// Operation is "describe", "compare" or "moderate".
+	Operation string
+	// Outcome is one of the VisionOutcome values. Only successful calls are billed.
+	Outcome string
+	Usage   VisionUsage
+	// Latency is how long the model took, or zero if it was not called.
+	Latency time.Duration
+}
+
+// Outcomes of a call to a vision model.
+const (
+	VisionOutcomeSuccess        = "success"
+	VisionOutcomeError          = "error"
+	VisionOutcomeCached         = "cached"
+	VisionOutcomeBudgetExceeded = "budget_exceeded"
+)
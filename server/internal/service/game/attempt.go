@@ -0,0 +1,82 @@
+package game
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// PickFinalPhoto makes one of the hunter's attempts in the current round their final photo,
+// or their team's in team mode. It returns whether every hunter has decided their final photo.
+func (s *Service) PickFinalPhoto(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	attempt int,
+) (bool, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return false, errors.Errorf("failed to get game: %w", err)
+	}
+
+	round, err := gameSession.PlayingRound()
+	if err != nil {
+		return false, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	if gameSession.IsSpectator(userID) {
+		return false, game.ErrSpectatorCannotPlay
+	}
+
+	teamID, err := submittingTeam(gameSession, userID)
+	if err != nil {
+		return false, err
+	}
+
+	err = round.PickFinal(userID, cmp.Or(teamID, userID), attempt)
+	if err != nil {
+		return false, errors.Errorf("failed to pick final photo: %w", err)
+	}
+
+	allSubmitted, err := s.finishHuntIfDone(gameSession, round)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return false, errors.Errorf("failed to update game: %w", err)
+	}
+
+	return allSubmitted, nil
+}
+
+// finishHuntIfDone ends the hunters' turn once every hunter, or every hunting team in team mode,
+// has decided their final photo, and opens the voting if the audience ranks the photos.
+// It reports whether the turn has ended.
+func (s *Service) finishHuntIfDone(gameSession *game.Game, round *game.Round) (bool, error) {
+	allSubmitted := round.CheckAllHuntersSubmitted(gameSession.CountHunters(round))
+	if !allSubmitted {
+		return false, nil
+	}
+
+	var err error
+
+	settings := gameSession.Settings.OrDefault()
+	if settings.RankingMode == game.RankingAudience {
+		err = round.StartVoting(
+			s.chrono.Now().Add(time.Duration(settings.VotingSeconds) * time.Second),
+		)
+	} else {
+		err = round.StartWaitingForSelection()
+	}
+
+	if err != nil {
+		return false, errors.Errorf("failed to finish hunters' turn: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// BeginGame lets the admin start a waiting game once enough players have joined,
+// so that its first round can start.
+func (s *Service) BeginGame(ctx context.Context, roomID, userID uuid.UUID) (*game.Game, error) {
+	return s.updateAsAdmin(ctx, roomID, userID, func(gameSession *game.Game) error {
+		err := gameSession.Start(userID)
+		if err != nil {
+			return errors.Errorf("failed to start game: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// PauseGame lets the admin pause the game, which stops the turn clock and the voting time.
+func (s *Service) PauseGame(ctx context.Context, roomID, userID uuid.UUID) (*game.Game, error) {
+	return s.updateAsAdmin(ctx, roomID, userID, func(gameSession *game.Game) error {
+		err := gameSession.Pause(userID, s.chrono.Now())
+		if err != nil {
+			return errors.Errorf("failed to pause game: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ResumeGame lets the admin resume a paused game.
+func (s *Service) ResumeGame(ctx context.Context, roomID, userID uuid.UUID) (*game.Game, error) {
+	return s.updateAsAdmin(ctx, roomID, userID, func(gameSession *game.Game) error {
+		err := gameSession.Resume(userID, s.chrono.Now())
+		if err != nil {
+			return errors.Errorf("failed to resume game: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SkipRound lets the admin void the current round with no points. The next round starts at once,
+// with its scene picked in scene pool games, or the game finishes after the last round.
+func (s *Service) SkipRound(ctx context.Context, roomID, userID uuid.UUID) (*game.Game, error) {
+	gameSession, err := s.updateAsAdmin(ctx, roomID, userID, func(gameSession *game.Game) error {
+		err := gameSession.SkipRound(userID, s.chrono.Now())
+		if err != nil {
+			return errors.Errorf("failed to skip round: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil || !gameSession.WaitsForScene() {
+		return gameSession, err
+	}
+
+	picked, err := s.startSceneRound(ctx, gameSession, uuid.Nil)
+	if err == nil {
+		return picked, nil
+	}
+
+	// 飛ばしたことは保存済みなので、シーンは親が選び直せる
+	errors.LogErrorCtx(ctx, "failed to pick the scene of the next round", err,
+		"room_id", roomID.String(),
+	)
+
+	stored, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get game: %w", err)
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,284 @@
+package game_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// testChrono is a clock that only moves when advanced.
+type testChrono struct {
+	now time.Time
+}
+
+func (c *testChrono) Now() time.Time {
+	return c.now
+}
+
+// storedGame is a stored game of three players.
+type storedGame struct {
+	svc      *gamesvc.Service
+	gameRepo service.GameRepository
+	clock    *testChrono
+	roomID   uuid.UUID
+	adminID  uuid.UUID
+	playerID uuid.UUID
+}
+
+// newStoredGame stores a game in status whose first player is its admin and game master,
+// using miniredis, an in-memory blob and a clock of its own. A started game is in its first round.
+func newStoredGame(t *testing.T, status game.GameStatus) *storedGame {
+	t.Helper()
+
+	clock := &testChrono{now: time.Now()}
+	kvsClient := testutil.NewKVS(t)
+	blobClient := testutil.NewMemoryBlob()
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+	svc := gamesvc.NewService(
+		gameRepo,
+		repository.NewRoomRepository(kvsClient),
+		repository.NewVoteRepository(kvsClient),
+		blobClient,
+		nil,
+		newCatalog(kvsClient, blobClient),
+		nil,
+		repository.NewHintJobQueue(kvsClient, time.Minute),
+		3,
+		clock,
+	)
+
+	adminID := uuid.New()
+
+	gameSession, err := game.NewGame(uuid.New(), 1, adminID, game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index, playerID := range []uuid.UUID{adminID, uuid.New(), uuid.New()} {
+		player, err := game.NewPlayer(playerID, "player", index == 0, index == 0)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	if status != game.GameStatusWaiting {
+		startGame(t, gameSession, status == game.GameStatusPaused)
+	}
+
+	err = gameRepo.Create(t.Context(), gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	return &storedGame{
+		svc:      svc,
+		gameRepo: gameRepo,
+		clock:    clock,
+		roomID:   gameSession.RoomID,
+		adminID:  adminID,
+		playerID: gameSession.Players[1].UserID,
+	}
+}
+
+// startGame starts gameSession and its first round, and pauses it when paused is true.
+func startGame(t *testing.T, gameSession *game.Game, paused bool) {
+	t.Helper()
+
+	adminID := gameSession.Players[0].UserID
+
+	err := gameSession.Start(adminID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(adminID)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	if paused {
+		err = gameSession.Pause(adminID, time.Now())
+		if err != nil {
+			t.Fatalf("Pause() failed: %v", err)
+		}
+	}
+}
+
+// control calls one of the admin's controls of the game.
+type control func(
+	svc *gamesvc.Service,
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+) (*game.Game, error)
+
+func TestService_Controls_AdminOnly(t *testing.T) {
+	t.Parallel()
+
+	// changed は管理者が操作した後の保存済みのゲームを検証する
+	controls := map[string]struct {
+		control control
+		status  game.GameStatus
+		changed func(gameSession *game.Game) bool
+	}{
+		"begin": {
+			(*gamesvc.Service).BeginGame,
+			game.GameStatusWaiting,
+			func(gameSession *game.Game) bool { return gameSession.Status == game.GameStatusInProgress },
+		},
+		"pause": {
+			(*gamesvc.Service).PauseGame,
+			game.GameStatusInProgress,
+			func(gameSession *game.Game) bool { return gameSession.Status == game.GameStatusPaused },
+		},
+		"resume": {
+			(*gamesvc.Service).ResumeGame,
+			game.GameStatusPaused,
+			func(gameSession *game.Game) bool { return gameSession.Status == game.GameStatusInProgress },
+		},
+		"skip round": {
+			(*gamesvc.Service).SkipRound,
+			game.GameStatusInProgress,
+			func(gameSession *game.Game) bool { return gameSession.Rounds[0].Skipped },
+		},
+	}
+
+	// wantErr が nil の場合は成功を期待する
+	callers := map[string]struct {
+		caller  func(env *storedGame) uuid.UUID
+		wantErr error
+	}{
+		"admin": {
+			func(env *storedGame) uuid.UUID { return env.adminID },
+			nil,
+		},
+		"player": {
+			func(env *storedGame) uuid.UUID { return env.playerID },
+			gamesvc.ErrNotGameAdmin,
+		},
+		"outsider": {
+			func(*storedGame) uuid.UUID { return uuid.New() },
+			game.ErrPlayerNotFound,
+		},
+	}
+
+	for controlName, controlCase := range controls {
+		for callerName, callerCase := range callers {
+			t.Run(controlName+" by "+callerName, func(t *testing.T) {
+				t.Parallel()
+
+				env := newStoredGame(t, controlCase.status)
+				ctx := t.Context()
+
+				_, err := controlCase.control(env.svc, ctx, env.roomID, callerCase.caller(env))
+				if !errors.Is(err, callerCase.wantErr) {
+					t.Fatalf("error = %v, want %v", err, callerCase.wantErr)
+				}
+
+				stored, err := env.gameRepo.Get(ctx, env.roomID)
+				if err != nil {
+					t.Fatalf("Get() failed: %v", err)
+				}
+
+				// 管理者以外の操作はゲームを変更しない
+				changed := controlCase.changed(stored)
+				if changed != (callerCase.wantErr == nil) {
+					t.Errorf("game changed = %v, want %v", changed, callerCase.wantErr == nil)
+				}
+			})
+		}
+	}
+}
+
+func TestService_StartRound_NotStarted(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusWaiting)
+	ctx := t.Context()
+
+	// 最初のラウンドを始めてもゲームは開始されない
+	_, err := env.svc.StartRound(ctx, env.roomID, env.adminID, uuid.Nil)
+	if !errors.Is(err, game.ErrInvalidGameTransition) {
+		t.Fatalf("StartRound() of a waiting game error = %v, want %v",
+			err, game.ErrInvalidGameTransition)
+	}
+
+	stored, err := env.gameRepo.Get(ctx, env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	if stored.Status != game.GameStatusWaiting {
+		t.Errorf("stored game is %v, want %v", stored.Status, game.GameStatusWaiting)
+	}
+
+	_, err = env.svc.BeginGame(ctx, env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("BeginGame() failed: %v", err)
+	}
+
+	gameSession, err := env.svc.StartRound(ctx, env.roomID, env.adminID, uuid.Nil)
+	if err != nil {
+		t.Fatalf("StartRound() after BeginGame() failed: %v", err)
+	}
+
+	if gameSession.CurrentRound != 1 {
+		t.Errorf("CurrentRound = %d, want 1", gameSession.CurrentRound)
+	}
+}
+
+func TestService_PauseGame_Clock(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+	ctx := t.Context()
+	pausedAt := env.clock.now.Add(time.Hour)
+	env.clock.now = pausedAt
+
+	_, err := env.svc.PauseGame(ctx, env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("PauseGame() failed: %v", err)
+	}
+
+	stored, err := env.gameRepo.Get(ctx, env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	// 一時停止の時刻はサービスの時計で決まる
+	if !stored.PausedAt.Equal(pausedAt) {
+		t.Errorf("PausedAt = %v, want %v", stored.PausedAt, pausedAt)
+	}
+}
+
+func TestService_SkipRound_FinishesLastRound(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+
+	gameSession, err := env.svc.SkipRound(t.Context(), env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("SkipRound() failed: %v", err)
+	}
+
+	if !gameSession.Rounds[0].Skipped || gameSession.Status != game.GameStatusFinished {
+		t.Errorf("round skipped = %v with game %v, want skipped and %v",
+			gameSession.Rounds[0].Skipped, gameSession.Status, game.GameStatusFinished)
+	}
+}
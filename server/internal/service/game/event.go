@@ -0,0 +1,48 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// gameEventPageSize is how many events GetGameEvents returns at most.
+const gameEventPageSize = 100
+
+// ErrNotGamePlayer is returned when someone other than the players reads the events of a game.
+var ErrNotGamePlayer = errors.New("only players can read the game events")
+
+// GetGameEvents returns the events of the game after the event afterID,
+// or from the first event when afterID is empty, oldest first.
+// Spectators cannot read them, as the events are not delayed, and the payloads of
+// the round being played are left out until it is completed.
+func (s *Service) GetGameEvents(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	afterID string,
+) ([]*game.Event, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get game: %w", err)
+	}
+
+	_, err = gameSession.GetPlayer(userID)
+	if err != nil {
+		return nil, ErrNotGamePlayer
+	}
+
+	events, err := s.gameRepo.ListEvents(ctx, roomID, afterID, gameEventPageSize)
+	if err != nil {
+		return nil, errors.Errorf("failed to list game events: %w", err)
+	}
+
+	for index, event := range events {
+		if !gameSession.ShowsPayloadOf(event) {
+			events[index] = event.WithoutPayload()
+		}
+	}
+
+	return events, nil
+}
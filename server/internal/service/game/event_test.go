@@ -0,0 +1,68 @@
+package game_test
+
+import (
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+)
+
+// photoEvents counts the photo submissions in events and how many of them have their photo.
+func photoEvents(events []*game.Event) (int, int) {
+	submitted, withPhoto := 0, 0
+
+	for _, event := range events {
+		if event.Type != game.EventPhotoSubmitted {
+			continue
+		}
+
+		submitted++
+
+		if event.Payload.Photo != nil {
+			withPhoto++
+		}
+	}
+
+	return submitted, withPhoto
+}
+
+func TestService_GetGameEvents_HidesPlayingRound(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+	startVoting(t, env)
+	ctx := t.Context()
+
+	// ストリームには写真が残る
+	stored, err := env.gameRepo.ListEvents(ctx, env.roomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	if submitted, withPhoto := photoEvents(stored); submitted != 2 || withPhoto != 2 {
+		t.Errorf("stored photos = %d of %d submissions, want 2 of 2", withPhoto, submitted)
+	}
+
+	// 進行中のラウンドの写真はプレイヤーに見せない
+	events, err := env.svc.GetGameEvents(ctx, env.roomID, env.playerID, "")
+	if err != nil {
+		t.Fatalf("GetGameEvents() failed: %v", err)
+	}
+
+	if submitted, withPhoto := photoEvents(events); submitted != 2 || withPhoto != 0 {
+		t.Errorf("photos while playing = %d of %d submissions, want 0 of 2", withPhoto, submitted)
+	}
+
+	_, err = env.svc.SkipRound(ctx, env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("SkipRound() failed: %v", err)
+	}
+
+	events, err = env.svc.GetGameEvents(ctx, env.roomID, env.playerID, "")
+	if err != nil {
+		t.Fatalf("GetGameEvents() after the round failed: %v", err)
+	}
+
+	if submitted, withPhoto := photoEvents(events); submitted != 2 || withPhoto != 2 {
+		t.Errorf("photos after the round = %d of %d submissions, want 2 of 2", withPhoto, submitted)
+	}
+}
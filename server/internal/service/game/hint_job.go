@@ -0,0 +1,337 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	// hintWorkerBackoff is how long a worker waits after the queue failed.
+	hintWorkerBackoff = time.Second
+	// hintUpdateAttempts is how many times a worker reads the game again when
+	// the game was changed while it updated the round.
+	hintUpdateAttempts = 5
+	// hintJobDeliveries is how many times a job is delivered before it is given up,
+	// when handling it keeps failing.
+	hintJobDeliveries = 5
+	// hintRetryBackoff is how long the first retry of a failed attempt waits.
+	// Each further retry waits twice as long, up to hintRetryBackoffMax.
+	hintRetryBackoff    = 5 * time.Second
+	hintRetryBackoffMax = 30 * time.Second
+)
+
+// RunHintWorker generates the hints of queued jobs until ctx is canceled.
+// Several workers, also on other servers, may share the queue.
+func (s *Service) RunHintWorker(ctx context.Context, worker string) {
+	for ctx.Err() == nil {
+		jobs, err := s.hintJobs.Receive(ctx, worker)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			errors.LogErrorCtx(ctx, "failed to receive hint jobs", err, "worker", worker)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(hintWorkerBackoff):
+			}
+
+			continue
+		}
+
+		for _, job := range jobs {
+			err := s.processHintJob(ctx, job)
+			if err != nil {
+				// 確認応答しなければ、しばらくして別のワーカーが引き継ぐ
+				errors.LogErrorCtx(ctx, "failed to process hint job", err,
+					"room_id", job.RoomID.String(),
+					"round", job.RoundNumber,
+					"attempt", job.Attempt,
+				)
+			}
+		}
+	}
+}
+
+// processHintJob generates the hints of a job and lets the game master review them.
+// A failed attempt is recorded on the round and queued again until the attempts run out,
+// and then hints made without AI are used. The job is acknowledged once it is handled,
+// so an error means it will be delivered again, until it has been delivered too often.
+func (s *Service) processHintJob(ctx context.Context, job *game.HintJob) error {
+	if job.Deliveries > hintJobDeliveries {
+		return s.abandonHintJob(ctx, job)
+	}
+
+	// 再試行は失敗した試行から間を空けて行う
+	wait := job.NotBefore.Sub(s.chrono.Now())
+	if wait > 0 {
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("hint job interrupted: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+
+	ctx = vision.WithRoom(ctx, job.RoomID.String())
+
+	handled, err := s.updateHintRound(ctx, job, func(round *game.Round) error {
+		return round.StartHintAttempt(job.Attempt)
+	})
+	if err != nil || !handled {
+		return s.finishHintJob(ctx, job, err)
+	}
+
+	gameSession, err := s.gameRepo.Get(ctx, job.RoomID)
+	if err != nil {
+		return errors.Errorf("failed to get game: %w", err)
+	}
+
+	hintCount := gameSession.Settings.OrDefault().HintCount
+
+	hints, generateErr := s.hints.Generate(
+		ctx,
+		job.ImagePath,
+		gameSession.HintSettings,
+		hintCount,
+		func(generated int) {
+			// 進捗の保存に失敗してもヒントの生成は続ける
+			_, err := s.updateHintRound(ctx, job, func(round *game.Round) error {
+				return round.UpdateHintProgress(generated, hintCount)
+			})
+			if err != nil {
+				errors.LogErrorCtx(ctx, "failed to save hint progress", err,
+					"room_id", job.RoomID.String(),
+				)
+			}
+		},
+	)
+	if generateErr != nil {
+		return s.failHintAttempt(ctx, job, generateErr)
+	}
+
+	_, err = s.updateHintRound(ctx, job, func(round *game.Round) error {
+		return round.StartHintReview(hints)
+	})
+
+	return s.finishHintJob(ctx, job, err)
+}
+
+// failHintAttempt records a failed attempt and queues the job again.
+// After the last attempt or when the AI budget is used up, hints are made from the photo without AI,
+// and if even that fails the game master is asked for another photo.
+func (s *Service) failHintAttempt(ctx context.Context, job *game.HintJob, cause error) error {
+	errors.LogErrorCtx(ctx, "failed to generate hints", cause,
+		"room_id", job.RoomID.String(),
+		"attempt", job.Attempt,
+	)
+
+	// 予算を使い切った場合は、再試行しても同じように失敗する
+	retry := job.Attempt < s.hintJobAttempts && !errors.Is(cause, vision.ErrBudgetExceeded)
+
+	var fallback []*game.Hint
+
+	if !retry {
+		gameSession, err := s.gameRepo.Get(ctx, job.RoomID)
+		if err != nil {
+			return errors.Errorf("failed to get game: %w", err)
+		}
+
+		fallback, err = s.hints.Fallback(
+			ctx,
+			job.ImagePath,
+			gameSession.HintSettings,
+			gameSession.Settings.OrDefault().HintCount,
+		)
+		if err != nil {
+			errors.LogErrorCtx(ctx, "failed to make fallback hints", err,
+				"room_id", job.RoomID.String(),
+			)
+		}
+	}
+
+	handled, err := s.updateHintRound(ctx, job, func(round *game.Round) error {
+		err := round.RecordHintError(job.Attempt, hintErrorMessage(cause))
+		if err != nil {
+			return errors.Errorf("failed to record hint error: %w", err)
+		}
+
+		switch {
+		case retry:
+			return nil
+		case fallback != nil:
+			return round.StartHintReview(fallback)
+		default:
+			return round.FailHintGeneration()
+		}
+	})
+	if err != nil || !handled || !retry {
+		return s.finishHintJob(ctx, job, err)
+	}
+
+	next := *job
+	next.Attempt++
+	next.NotBefore = s.chrono.Now().Add(hintRetryDelay(job.Attempt))
+
+	err = s.hintJobs.Enqueue(ctx, &next)
+	if err != nil {
+		return errors.Errorf("failed to retry hint job: %w", err)
+	}
+
+	return s.finishHintJob(ctx, job, nil)
+}
+
+// abandonHintJob gives up a job whose handling keeps failing, so that it is not delivered
+// forever, and asks the game master for another photo.
+func (s *Service) abandonHintJob(ctx context.Context, job *game.HintJob) error {
+	_, err := s.updateHintRound(ctx, job, func(round *game.Round) error {
+		err := round.RecordHintError(job.Attempt, "hint generation failed")
+		if err != nil {
+			return errors.Errorf("failed to record hint error: %w", err)
+		}
+
+		return round.FailHintGeneration()
+	})
+	if err != nil {
+		err = errors.Errorf("failed to give up hint job: %w", err)
+	}
+
+	// 記録できなくても、配送され続けないように確認応答する
+	ackErr := s.hintJobs.Ack(ctx, job)
+	if ackErr != nil {
+		ackErr = errors.Errorf("failed to ack hint job: %w", ackErr)
+	}
+
+	return errors.Join(err, ackErr)
+}
+
+// finishHintJob acknowledges a job unless err says it must be delivered again.
+func (s *Service) finishHintJob(ctx context.Context, job *game.HintJob, err error) error {
+	if err != nil {
+		return err
+	}
+
+	err = s.hintJobs.Ack(ctx, job)
+	if err != nil {
+		return errors.Errorf("failed to ack hint job: %w", err)
+	}
+
+	return nil
+}
+
+// updateHintRound applies update to the round of a job and saves the game.
+// It reports false without updating when the round no longer waits for the job,
+// because the round has moved on or the game master has submitted another photo.
+// When the game is changed concurrently, the update is applied again to the new game
+// so that neither change is lost.
+func (s *Service) updateHintRound(
+	ctx context.Context,
+	job *game.HintJob,
+	update func(round *game.Round) error,
+) (bool, error) {
+	var err error
+
+	for range hintUpdateAttempts {
+		var handled bool
+
+		handled, err = s.tryUpdateHintRound(ctx, job, update)
+		if !errors.Is(err, service.ErrConflict) {
+			return handled, err
+		}
+	}
+
+	return false, err
+}
+
+// tryUpdateHintRound reads the game and applies update to the round of a job once.
+func (s *Service) tryUpdateHintRound(
+	ctx context.Context,
+	job *game.HintJob,
+	update func(round *game.Round) error,
+) (bool, error) {
+	gameSession, err := s.gameRepo.Get(ctx, job.RoomID)
+	if err != nil {
+		return false, errors.Errorf("failed to get game: %w", err)
+	}
+
+	round, err := gameSession.GetCurrentRound()
+	if err != nil || round.RoundNumber != job.RoundNumber ||
+		!round.IsGeneratingHintsFor(job.ImageID) {
+		return false, nil
+	}
+
+	err = update(round)
+	if err != nil {
+		return false, errors.Errorf("failed to update hint generation: %w", err)
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return false, errors.Errorf("failed to update game: %w", err)
+	}
+
+	return true, nil
+}
+
+// hintErrorMessage returns a message about a failed attempt that can be shown to players.
+// Details of the AI provider are only logged.
+func hintErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, vision.ErrCircuitOpen):
+		return "AI service is unavailable"
+	case errors.Is(err, vision.ErrBudgetExceeded):
+		return "AI budget is used up"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "AI service timed out"
+	case errors.Is(err, hint.ErrNotEnoughHints):
+		return "AI did not write enough usable hints"
+	default:
+		return "hint generation failed"
+	}
+}
+
+// hintRetryDelay returns how long the retry after a failed attempt waits.
+func hintRetryDelay(attempt int) time.Duration {
+	delay := hintRetryBackoff
+	for range attempt - 1 {
+		delay = min(delay*2, hintRetryBackoffMax)
+	}
+
+	return delay
+}
+
+// queueHintJob generates the hints of the photo of the round in the language and difficulty
+// of the game in the background. The round must be waiting for the hints of the photo.
+func (s *Service) queueHintJob(
+	ctx context.Context,
+	gameSession *game.Game,
+	round *game.Round,
+	photo *domainimage.Metadata,
+) error {
+	err := s.hintJobs.Enqueue(ctx, &game.HintJob{
+		ID:          "",
+		RoomID:      gameSession.RoomID,
+		RoundNumber: round.RoundNumber,
+		ImageID:     photo.ID.String(),
+		ImagePath:   photo.Path,
+		Attempt:     1,
+	})
+	if err != nil {
+		// ジョブを登録できなければヒントは作られないので、写真の提出からやり直してもらう
+		failErr := round.FailHintGeneration()
+		if failErr == nil {
+			failErr = s.gameRepo.Update(ctx, gameSession)
+		}
+
+		return errors.Errorf("failed to queue hint generation: %w", errors.Join(err, failErr))
+	}
+
+	return nil
+}
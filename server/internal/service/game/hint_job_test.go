@@ -0,0 +1,202 @@
+package game_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+)
+
+// fakeHintJobs hands out its jobs once each and records what the worker queues and acknowledges.
+type fakeHintJobs struct {
+	mu       sync.Mutex
+	jobs     []*game.HintJob
+	enqueued []*game.HintJob
+	acked    []*game.HintJob
+	// ackedOnce is closed when the first job is acknowledged.
+	ackedOnce chan struct{}
+}
+
+func (q *fakeHintJobs) Enqueue(_ context.Context, job *game.HintJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.enqueued = append(q.enqueued, job)
+
+	return nil
+}
+
+func (q *fakeHintJobs) Receive(ctx context.Context, _ string) ([]*game.HintJob, error) {
+	q.mu.Lock()
+	jobs := q.jobs
+	q.jobs = nil
+	q.mu.Unlock()
+
+	if len(jobs) == 0 {
+		<-ctx.Done()
+	}
+
+	return jobs, nil
+}
+
+func (q *fakeHintJobs) Ack(_ context.Context, job *game.HintJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.acked = append(q.acked, job)
+	if len(q.acked) == 1 {
+		close(q.ackedOnce)
+	}
+
+	return nil
+}
+
+// runHintJob stores a game of three players whose first round waits for the hints of
+// a photo that is not in blob storage, and lets a worker handle job for it until it is
+// acknowledged. It returns the stored game.
+func runHintJob(t *testing.T, jobs *fakeHintJobs, job *game.HintJob) *game.Game {
+	t.Helper()
+
+	ctx := t.Context()
+	kvsClient := testutil.NewKVS(t)
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+	svc := gamesvc.NewService(
+		gameRepo,
+		repository.NewRoomRepository(kvsClient),
+		repository.NewVoteRepository(kvsClient),
+		testutil.NewMemoryBlob(),
+		nil,
+		nil,
+		nil,
+		jobs,
+		3,
+		chrono.New(),
+	)
+
+	gameSession, err := game.NewGame(uuid.New(), 1, uuid.New(), game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index := range 3 {
+		player, err := game.NewPlayer(uuid.New(), "player", index == 0, index == 0)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	startGame(t, gameSession, false)
+
+	err = gameSession.Rounds[0].StartHintGeneration(job.ImageID)
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = gameRepo.Create(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	job.RoomID = gameSession.RoomID
+	jobs.jobs = []*game.HintJob{job}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		svc.RunHintWorker(workerCtx, "worker")
+	}()
+
+	select {
+	case <-jobs.ackedOnce:
+	case <-time.After(5 * time.Second):
+		t.Error("hint job was not acknowledged")
+	}
+
+	cancel()
+	<-stopped
+
+	stored, err := gameRepo.Get(ctx, gameSession.RoomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	return stored
+}
+
+func TestService_RunHintWorker_RetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	jobs := &fakeHintJobs{ackedOnce: make(chan struct{})}
+	before := time.Now()
+
+	stored := runHintJob(t, jobs, &game.HintJob{
+		RoundNumber: 1,
+		ImageID:     uuid.NewString(),
+		ImagePath:   "missing.jpg",
+		Attempt:     1,
+		Deliveries:  1,
+	})
+
+	// 失敗した試行は記録され、間を空けて再試行される
+	round := stored.Rounds[0]
+	if round.TurnStatus != game.TurnStatusGeneratingHints ||
+		len(round.HintGeneration.Errors) != 1 {
+		t.Errorf("round = %v with %d errors, want generating hints with 1 error",
+			round.TurnStatus, len(round.HintGeneration.Errors))
+	}
+
+	if len(jobs.enqueued) != 1 {
+		t.Fatalf("enqueued %d jobs, want 1 retry", len(jobs.enqueued))
+	}
+
+	retry := jobs.enqueued[0]
+	if retry.Attempt != 2 || !retry.NotBefore.After(before.Add(time.Second)) {
+		t.Errorf("retry = attempt %d not before %v, want attempt 2 later than %v",
+			retry.Attempt, retry.NotBefore, before.Add(time.Second))
+	}
+}
+
+func TestService_RunHintWorker_GivesUp(t *testing.T) {
+	t.Parallel()
+
+	jobs := &fakeHintJobs{ackedOnce: make(chan struct{})}
+
+	// 処理に失敗し続けて何度も配送されたジョブ
+	stored := runHintJob(t, jobs, &game.HintJob{
+		RoundNumber: 1,
+		ImageID:     uuid.NewString(),
+		ImagePath:   "missing.jpg",
+		Attempt:     1,
+		Deliveries:  100,
+	})
+
+	// 諦めてゲームマスターに写真を撮り直してもらう
+	round := stored.Rounds[0]
+	if round.TurnStatus != game.TurnStatusGameMaster || len(round.HintGeneration.Errors) != 1 {
+		t.Errorf("round = %v with %d errors, want %v with 1 error",
+			round.TurnStatus, len(round.HintGeneration.Errors), game.TurnStatusGameMaster)
+	}
+
+	if len(jobs.enqueued) != 0 || len(jobs.acked) != 1 {
+		t.Errorf("enqueued %d and acked %d jobs, want only the job acked",
+			len(jobs.enqueued), len(jobs.acked))
+	}
+}
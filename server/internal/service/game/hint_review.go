@@ -0,0 +1,223 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrNotRoundGameMaster is returned when someone other than the game master reviews hints.
+var ErrNotRoundGameMaster = errors.New("only game master can review hints")
+
+// reviewUpdateAttempts is how many times the game is read again when it was changed
+// while the game master reviewed the hints.
+const reviewUpdateAttempts = 3
+
+// GetHintDraft returns the hints under review.
+func (s *Service) GetHintDraft(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+) ([]*game.Hint, error) {
+	_, round, err := s.getReviewRound(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return round.Hints, nil
+}
+
+// UpdateHint rewrites the text of a hint under review.
+// The text is checked by the content filter before it is saved.
+func (s *Service) UpdateHint(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	hintNumber int,
+	text string,
+) ([]*game.Hint, error) {
+	err := s.hintFilter.Check(text)
+	if err != nil {
+		return nil, errors.Errorf("hint %d: %w", hintNumber, err)
+	}
+
+	_, round, err := s.updateReviewRound(ctx, roomID, userID, func(round *game.Round) error {
+		err := round.EditHint(hintNumber, text)
+		if err != nil {
+			return errors.Errorf("failed to edit hint: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return round.Hints, nil
+}
+
+// ReorderHints reorders the hints under review by their current hint numbers.
+func (s *Service) ReorderHints(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	order []int,
+) ([]*game.Hint, error) {
+	_, round, err := s.updateReviewRound(ctx, roomID, userID, func(round *game.Round) error {
+		err := round.ReorderHints(order)
+		if err != nil {
+			return errors.Errorf("failed to reorder hints: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return round.Hints, nil
+}
+
+// RegenerateHint asks the AI for a new hint in place of a hint under review.
+// The hint is generated before the game is updated, so that a change of the game
+// in the meantime does not waste it.
+func (s *Service) RegenerateHint(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	hintNumber int,
+) ([]*game.Hint, error) {
+	gameSession, round, err := s.getReviewRound(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	imageID, err := uuid.Parse(round.GameMasterImageID)
+	if err != nil {
+		return nil, errors.Errorf("invalid game master image ID: %w", err)
+	}
+
+	stored, err := s.imageCatalog.Get(ctx, roomID, imageID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get game master image: %w", err)
+	}
+
+	text, err := s.hints.Regenerate(
+		vision.WithRoom(ctx, roomID.String()),
+		stored.Path,
+		gameSession.HintSettings,
+		round.Hints,
+		hintNumber,
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to regenerate hint: %w", err)
+	}
+
+	_, round, err = s.updateReviewRound(ctx, roomID, userID, func(round *game.Round) error {
+		// 生成中に別の写真に差し替えられた場合は、古い写真のヒントを使わない
+		if round.GameMasterImageID != imageID.String() {
+			return game.ErrNotReviewingHints
+		}
+
+		err := round.ReplaceHint(hintNumber, text)
+		if err != nil {
+			return errors.Errorf("failed to replace hint: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return round.Hints, nil
+}
+
+// ReleaseHints ends the review and starts the hunters' turn.
+// Every hint is checked by the content filter again, since the game master may have edited it.
+func (s *Service) ReleaseHints(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+) (*game.Game, error) {
+	gameSession, _, err := s.updateReviewRound(ctx, roomID, userID, func(round *game.Round) error {
+		for _, hint := range round.Hints {
+			err := s.hintFilter.Check(hint.Text)
+			if err != nil {
+				return errors.Errorf("hint %d: %w", hint.HintNumber, err)
+			}
+		}
+
+		err := round.ReleaseHints()
+		if err != nil {
+			return errors.Errorf("failed to release hints: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gameSession, nil
+}
+
+// updateReviewRound applies update to the round under review and saves the game.
+// When the game is changed concurrently, the update is applied again to the new game
+// so that neither change is lost.
+func (s *Service) updateReviewRound(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	update func(*game.Round) error,
+) (*game.Game, *game.Round, error) {
+	var err error
+
+	for range reviewUpdateAttempts {
+		gameSession, round, getErr := s.getReviewRound(ctx, roomID, userID)
+		if getErr != nil {
+			return nil, nil, getErr
+		}
+
+		err = update(round)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = s.gameRepo.Update(ctx, gameSession)
+		if err == nil {
+			return gameSession, round, nil
+		}
+
+		if !errors.Is(err, service.ErrConflict) {
+			break
+		}
+	}
+
+	return nil, nil, errors.Errorf("failed to update game: %w", err)
+}
+
+// getReviewRound returns the game and its current round
+// after verifying that userID is reviewing the hints of the round.
+func (s *Service) getReviewRound(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+) (*game.Game, *game.Round, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, nil, errors.Errorf("failed to get game: %w", err)
+	}
+
+	round, err := gameSession.PlayingRound()
+	if err != nil {
+		return nil, nil, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	if round.GameMasterUserID != userID {
+		return nil, nil, ErrNotRoundGameMaster
+	}
+
+	if round.TurnStatus != game.TurnStatusReviewingHints {
+		return nil, nil, game.ErrNotReviewingHints
+	}
+
+	return gameSession, round, nil
+}
@@ -0,0 +1,225 @@
+package game_test
+
+import (
+	"bytes"
+	"context"
+	goimage "image"
+	"image/jpeg"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+)
+
+const (
+	editedHint      = "edited"
+	regeneratedHint = "regenerated"
+)
+
+// newCatalog returns an image catalog on kvsClient and blobClient that makes small thumbnails.
+func newCatalog(kvsClient service.KVS, blobClient service.Blob) *imagesvc.Catalog {
+	return imagesvc.NewCatalog(
+		blobClient,
+		repository.NewImageRepository(kvsClient),
+		repository.NewUploadRepository(kvsClient),
+		imagesvc.CatalogOptions{
+			Normalize: domainimage.NormalizeOptions{
+				MaxDimension: 2048,
+				Quality:      85,
+				ThumbnailDimensions: domainimage.ThumbnailDimensions{
+					Small:  16,
+					Medium: 32,
+					Large:  64,
+				},
+				ThumbnailQuality: 75,
+				MaxPixels:        4_000_000,
+			},
+			PresignedURLTTL:  15 * time.Minute,
+			ThumbnailWorkers: 2,
+		},
+	)
+}
+
+// testJPEG returns a small JPEG photo.
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	err := jpeg.Encode(&buf, goimage.NewRGBA(goimage.Rect(0, 0, 64, 48)), nil)
+	if err != nil {
+		t.Fatalf("failed to encode JPEG: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// reviewingGame is a stored game whose game master reviews the hints of their photo.
+type reviewingGame struct {
+	svc          *gamesvc.Service
+	gameRepo     service.GameRepository
+	roomID       uuid.UUID
+	gameMasterID uuid.UUID
+}
+
+// newReviewingGame stores a game of three players reviewing two hints, using miniredis,
+// an in-memory blob and the given vision model.
+func newReviewingGame(t *testing.T, visionModel service.VisionModel) *reviewingGame {
+	t.Helper()
+
+	ctx := t.Context()
+	kvsClient := testutil.NewKVS(t)
+	blobClient := testutil.NewMemoryBlob()
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+
+	catalog := newCatalog(kvsClient, blobClient)
+
+	filter, err := hint.NewFilter(nil, nil)
+	if err != nil {
+		t.Fatalf("NewFilter() failed: %v", err)
+	}
+
+	svc := gamesvc.NewService(
+		gameRepo,
+		repository.NewRoomRepository(kvsClient),
+		repository.NewVoteRepository(kvsClient),
+		blobClient,
+		visionModel,
+		catalog,
+		filter,
+		repository.NewHintJobQueue(kvsClient, time.Minute),
+		3,
+		chrono.New(),
+	)
+
+	gameSession, err := game.NewGame(uuid.New(), 1, uuid.New(), game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index := range 3 {
+		player, err := game.NewPlayer(uuid.New(), "player", index == 0, index == 0)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	startGame(t, gameSession, false)
+
+	gameMasterID := gameSession.Players[0].UserID
+
+	photo, err := catalog.Store(ctx, imagesvc.Upload{
+		RoomID:      gameSession.RoomID,
+		ContentType: "",
+		Data:        testJPEG(t),
+		UploaderID:  gameMasterID,
+		RoundNumber: 1,
+		Role:        domainimage.RoleGameMaster,
+		TTL:         time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Store() failed: %v", err)
+	}
+
+	round := gameSession.Rounds[0]
+
+	err = round.StartHintGeneration(photo.ID.String())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = round.StartHintReview([]*game.Hint{
+		{HintNumber: 1, Text: "first"},
+		{HintNumber: 2, Text: "second"},
+	})
+	if err != nil {
+		t.Fatalf("StartHintReview() failed: %v", err)
+	}
+
+	err = gameRepo.Create(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	return &reviewingGame{
+		svc:          svc,
+		gameRepo:     gameRepo,
+		roomID:       gameSession.RoomID,
+		gameMasterID: gameMasterID,
+	}
+}
+
+func TestService_RegenerateHint_Conflict(t *testing.T) {
+	t.Parallel()
+
+	ctrl := NewMockController(t)
+	visionModel := Mock[service.VisionModel](ctrl)
+
+	var (
+		env   *reviewingGame
+		calls int
+	)
+
+	// 生成している間にゲームマスターが別のヒントを書き換える
+	WhenDouble(
+		visionModel.Describe(
+			Any[context.Context](),
+			Any[service.VisionImage](),
+			Any[string](),
+		),
+	).ThenAnswer(func([]any) (*service.ImageAnalysisResult, error) {
+		calls++
+
+		_, err := env.svc.UpdateHint(t.Context(), env.roomID, env.gameMasterID, 1, editedHint)
+		if err != nil {
+			t.Errorf("UpdateHint() during generation failed: %v", err)
+		}
+
+		return &service.ImageAnalysisResult{Features: []string{regeneratedHint}}, nil
+	})
+
+	env = newReviewingGame(t, visionModel)
+
+	hints, err := env.svc.RegenerateHint(t.Context(), env.roomID, env.gameMasterID, 2)
+	if err != nil {
+		t.Fatalf("RegenerateHint() failed: %v", err)
+	}
+
+	// 生成し直さずに、両方の変更が残る
+	if calls != 1 {
+		t.Errorf("vision model was called %d times, want 1", calls)
+	}
+
+	if len(hints) != 2 || hints[0].Text != editedHint || hints[1].Text != regeneratedHint {
+		t.Errorf("hints = %v, want the edited hint and the regenerated hint", hints)
+	}
+
+	stored, err := env.gameRepo.Get(t.Context(), env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	if stored.Rounds[0].Hints[0].Text != editedHint ||
+		stored.Rounds[0].Hints[1].Text != regeneratedHint {
+		t.Errorf("stored hints = %v, want the edited hint and the regenerated hint",
+			stored.Rounds[0].Hints)
+	}
+}
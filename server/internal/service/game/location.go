@@ -0,0 +1,48 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// CheckProximity tells a hunter at location whether they are warmer or colder
+// than at their previous check in the current round.
+// The distance itself and the game master's location are never returned.
+func (s *Service) CheckProximity(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	location *game.Location,
+) (game.Proximity, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return game.ProximityUnknown, errors.Errorf("failed to get game: %w", err)
+	}
+
+	round, err := gameSession.PlayingRound()
+	if err != nil {
+		return game.ProximityUnknown, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	if round.GameMasterUserID == userID {
+		return game.ProximityUnknown, errors.New("game master cannot check proximity")
+	}
+
+	if gameSession.IsSpectator(userID) {
+		return game.ProximityUnknown, game.ErrSpectatorCannotPlay
+	}
+
+	proximity, err := round.CheckProximity(userID, location)
+	if err != nil {
+		return game.ProximityUnknown, errors.Errorf("failed to check proximity: %w", err)
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return game.ProximityUnknown, errors.Errorf("failed to update game: %w", err)
+	}
+
+	return proximity, nil
+}
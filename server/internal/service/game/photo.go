@@ -0,0 +1,113 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// photoTTL is how long photos submitted in a game are kept in blob storage.
+const photoTTL = 24 * time.Hour
+
+// ErrUploadNotOwned is returned when a photo refers to an upload of another user or room.
+var ErrUploadNotOwned = errors.New("upload belongs to another user or room")
+
+// Photo is a photo submitted in a round.
+// It is either sent inline as Data or refers to an upload sent to the image service.
+type Photo struct {
+	Data []byte
+	// UploadID refers to an upload from a presigned URL or an upload stream.
+	// It is used instead of Data when it is not uuid.Nil.
+	UploadID uuid.UUID
+	// Location is where the photo was taken as sent by the player.
+	// The GPS position in the photo is used when it is nil.
+	Location *game.Location
+}
+
+// storePhoto stores a photo submitted in a round in the image catalog.
+func (s *Service) storePhoto(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	roundNumber int,
+	role domainimage.Role,
+	photo Photo,
+) (*domainimage.Metadata, error) {
+	if photo.UploadID != uuid.Nil {
+		return s.completePhotoUpload(ctx, roomID, userID, roundNumber, role, photo.UploadID)
+	}
+
+	metadata, err := s.imageCatalog.Store(ctx, imagesvc.Upload{
+		RoomID:      roomID,
+		ContentType: "",
+		Data:        photo.Data,
+		UploaderID:  userID,
+		RoundNumber: roundNumber,
+		Role:        role,
+		TTL:         photoTTL,
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to store photo: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// HunterPhotoResult is the outcome of a hunter's photo submission.
+type HunterPhotoResult struct {
+	Submission *game.HunterSubmission
+	// AllHuntersSubmitted is whether every hunter has decided their final photo.
+	AllHuntersSubmitted bool
+	// Proximity tells whether the photo was taken closer to the game master's photo
+	// than the hunter's previous location. It is unknown when either location is unknown.
+	Proximity game.Proximity
+}
+
+// photoLocation returns where a stored photo was taken: the location sent with it,
+// otherwise the GPS position read from the photo, or nil if neither is known.
+func photoLocation(photo Photo, stored *domainimage.Metadata) *game.Location {
+	if photo.Location != nil {
+		return photo.Location
+	}
+
+	if stored.GPS == nil {
+		return nil
+	}
+
+	location, err := game.NewLocation(stored.GPS.Latitude, stored.GPS.Longitude)
+	if err != nil {
+		return nil
+	}
+
+	return location
+}
+
+// completePhotoUpload validates an upload sent by the user to the room and stores it as
+// a photo of the round.
+func (s *Service) completePhotoUpload(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	roundNumber int,
+	role domainimage.Role,
+	uploadID uuid.UUID,
+) (*domainimage.Metadata, error) {
+	upload, err := s.imageCatalog.GetUpload(ctx, uploadID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get photo upload: %w", err)
+	}
+
+	if upload.RoomID != roomID || upload.UploaderID != userID {
+		return nil, errors.Errorf("%w: upload=%s", ErrUploadNotOwned, uploadID)
+	}
+
+	metadata, err := s.imageCatalog.CompleteUpload(ctx, upload, roundNumber, role, photoTTL)
+	if err != nil {
+		return nil, errors.Errorf("failed to complete photo upload: %w", err)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,119 @@
+package game_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+func TestService_SubmitHunterPhoto_TimedByServer(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+	ctx := t.Context()
+
+	gameSession, err := env.gameRepo.Get(ctx, env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	releaseHints(t, gameSession.Rounds[0])
+
+	err = env.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+
+	// ターンの開始から20秒後に提出する
+	env.clock.now = gameSession.Rounds[0].TurnStartedAt.Add(20 * time.Second)
+
+	result, err := env.svc.SubmitHunterPhoto(ctx, env.roomID, env.playerID, gamesvc.Photo{
+		Data:     testJPEG(t),
+		Location: nil,
+	})
+	if err != nil {
+		t.Fatalf("SubmitHunterPhoto() failed: %v", err)
+	}
+
+	if result.Submission.SubmittedAtSeconds != 20 {
+		t.Errorf("SubmittedAtSeconds = %d, want 20", result.Submission.SubmittedAtSeconds)
+	}
+
+	// ターンが終わった後の写真は受け付けない
+	env.clock.now = env.clock.now.Add(time.Duration(game.DefaultTurnSeconds) * time.Second)
+
+	_, err = env.svc.SubmitHunterPhoto(
+		ctx,
+		env.roomID,
+		gameSession.Players[2].UserID,
+		gamesvc.Photo{Data: testJPEG(t), Location: nil},
+	)
+	if !errors.Is(err, game.ErrInvalidSubmittedAtSeconds) {
+		t.Errorf("SubmitHunterPhoto() after the turn error = %v, want %v",
+			err, game.ErrInvalidSubmittedAtSeconds)
+	}
+}
+
+func TestService_GetHunterPhotos_GameMasterOnly(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+	ctx := t.Context()
+
+	gameSession, err := env.gameRepo.Get(ctx, env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	round := gameSession.Rounds[0]
+	releaseHints(t, round)
+
+	// 1人目は写真を決定し、2人目はまだ試している
+	for index, hunter := range gameSession.Players[1:] {
+		submission, err := game.NewHunterSubmission(hunter.UserID, hunter.UserID.String(), 10, 60)
+		if err != nil {
+			t.Fatalf("NewHunterSubmission() failed: %v", err)
+		}
+
+		err = round.AddHunterSubmission(submission, index+1)
+		if err != nil {
+			t.Fatalf("AddHunterSubmission() failed: %v", err)
+		}
+	}
+
+	spectator, err := game.NewSpectator(uuid.New(), "spectator")
+	if err != nil {
+		t.Fatalf("NewSpectator() failed: %v", err)
+	}
+
+	err = gameSession.AddSpectator(spectator)
+	if err != nil {
+		t.Fatalf("AddSpectator() failed: %v", err)
+	}
+
+	err = env.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+
+	// 観戦者も遅延を飛ばして写真を見ることはできない
+	for _, callerID := range []uuid.UUID{env.playerID, spectator.UserID} {
+		_, err = env.svc.GetHunterPhotos(ctx, env.roomID, callerID)
+		if err == nil {
+			t.Errorf("GetHunterPhotos() by %v succeeded, want an error", callerID)
+		}
+	}
+
+	photos, err := env.svc.GetHunterPhotos(ctx, env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("GetHunterPhotos() failed: %v", err)
+	}
+
+	if len(photos) != 1 || photos[0].UserID != env.playerID || !photos[0].Final {
+		t.Errorf("GetHunterPhotos() = %v, want only the final photo of %v", photos, env.playerID)
+	}
+}
@@ -0,0 +1,90 @@
+package game
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// startSceneRound picks the scene of the round that has just started, or whose scene failed,
+// and queues the generation of its hints.
+func (s *Service) startSceneRound(
+	ctx context.Context,
+	gameSession *game.Game,
+	sceneImageID uuid.UUID,
+) (*game.Game, error) {
+	scenes, err := s.scenePool(ctx, gameSession.RoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := make([]string, 0, len(scenes))
+	for _, scene := range scenes {
+		pool = append(pool, scene.ID.String())
+	}
+
+	chosen := ""
+	if sceneImageID != uuid.Nil {
+		chosen = sceneImageID.String()
+	}
+
+	imageID, err := gameSession.PickScene(pool, chosen)
+	if err != nil {
+		return nil, errors.Errorf("failed to pick scene: %w", err)
+	}
+
+	round, err := gameSession.GetCurrentRound()
+	if err != nil {
+		return nil, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return nil, errors.Errorf("failed to update game: %w", err)
+	}
+
+	err = s.queueHintJob(ctx, gameSession, round, scenes[slices.Index(pool, imageID)])
+	if err != nil {
+		return nil, err
+	}
+
+	return gameSession, nil
+}
+
+// repickScene picks another scene for the current round of a scene pool game
+// whose hints could not be generated.
+func (s *Service) repickScene(
+	ctx context.Context,
+	gameSession *game.Game,
+	gameMasterUserID, sceneImageID uuid.UUID,
+) (*game.Game, error) {
+	round, err := gameSession.GetCurrentRound()
+	if err != nil {
+		return nil, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	if round.GameMasterUserID != gameMasterUserID {
+		return nil, errors.New("only game master can pick the scene")
+	}
+
+	return s.startSceneRound(ctx, gameSession, sceneImageID)
+}
+
+// scenePool returns the scenes uploaded to the room, oldest first.
+func (s *Service) scenePool(
+	ctx context.Context,
+	roomID uuid.UUID,
+) ([]*domainimage.Metadata, error) {
+	images, err := s.imageCatalog.List(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to list scenes: %w", err)
+	}
+
+	return slices.DeleteFunc(images, func(image *domainimage.Metadata) bool {
+		return image.Role != domainimage.RoleScene
+	}), nil
+}
@@ -0,0 +1,184 @@
+package game_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	gamesvc "github.com/yashikota/scene-hunter/server/internal/service/game"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+)
+
+// scenePoolGame is a stored scene pool game of three players whose room has two scenes.
+type scenePoolGame struct {
+	svc      *gamesvc.Service
+	gameRepo service.GameRepository
+	roomID   uuid.UUID
+	adminID  uuid.UUID
+}
+
+// newScenePoolGame stores a started scene pool game of totalRounds rounds, whose first player
+// is its admin, using miniredis and an in-memory blob.
+func newScenePoolGame(t *testing.T, totalRounds int) *scenePoolGame {
+	t.Helper()
+
+	ctx := t.Context()
+	kvsClient := testutil.NewKVS(t)
+	blobClient := testutil.NewMemoryBlob()
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+	catalog := newCatalog(kvsClient, blobClient)
+	svc := gamesvc.NewService(
+		gameRepo,
+		repository.NewRoomRepository(kvsClient),
+		repository.NewVoteRepository(kvsClient),
+		blobClient,
+		nil,
+		catalog,
+		nil,
+		repository.NewHintJobQueue(kvsClient, time.Minute),
+		3,
+		chrono.New(),
+	)
+
+	adminID := uuid.New()
+
+	gameSession, err := game.NewGame(uuid.New(), totalRounds, adminID, game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), true)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index, playerID := range []uuid.UUID{adminID, uuid.New(), uuid.New()} {
+		player, err := game.NewPlayer(playerID, "player", index == 0, index == 0)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	err = gameSession.Start(adminID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameRepo.Create(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	for range 2 {
+		_, err = catalog.Store(ctx, imagesvc.Upload{
+			RoomID:      gameSession.RoomID,
+			ContentType: "",
+			Data:        testJPEG(t),
+			UploaderID:  adminID,
+			RoundNumber: 0,
+			Role:        domainimage.RoleScene,
+			TTL:         time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("Store() failed: %v", err)
+		}
+	}
+
+	return &scenePoolGame{
+		svc:      svc,
+		gameRepo: gameRepo,
+		roomID:   gameSession.RoomID,
+		adminID:  adminID,
+	}
+}
+
+func TestService_StartRound_RepicksFailedScene(t *testing.T) {
+	t.Parallel()
+
+	env := newScenePoolGame(t, 1)
+	ctx := t.Context()
+
+	started, err := env.svc.StartRound(ctx, env.roomID, env.adminID, uuid.Nil)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	first := started.Rounds[0].GameMasterImageID
+
+	// ヒントを作れずにシーンの選択へ戻る
+	err = started.Rounds[0].FailHintGeneration()
+	if err != nil {
+		t.Fatalf("FailHintGeneration() failed: %v", err)
+	}
+
+	err = env.gameRepo.Update(ctx, started)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+
+	_, err = env.svc.StartRound(ctx, env.roomID, uuid.New(), uuid.Nil)
+	if err == nil {
+		t.Error("StartRound() by another player succeeded, want an error")
+	}
+
+	repicked, err := env.svc.StartRound(ctx, env.roomID, env.adminID, uuid.Nil)
+	if err != nil {
+		t.Fatalf("StartRound() again failed: %v", err)
+	}
+
+	// 同じラウンドのまま、別のシーンのヒントを作る
+	round := repicked.Rounds[len(repicked.Rounds)-1]
+	if len(repicked.Rounds) != 1 || round.TurnStatus != game.TurnStatusGeneratingHints ||
+		round.GameMasterImageID == first {
+		t.Errorf(
+			"rounds = %d in %v for %q, want round 1 generating hints for another scene than %q",
+			len(repicked.Rounds),
+			round.TurnStatus,
+			round.GameMasterImageID,
+			first,
+		)
+	}
+}
+
+func TestService_SkipRound_PicksNextScene(t *testing.T) {
+	t.Parallel()
+
+	env := newScenePoolGame(t, 2)
+	ctx := t.Context()
+
+	started, err := env.svc.StartRound(ctx, env.roomID, env.adminID, uuid.Nil)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	first := started.Rounds[0].GameMasterImageID
+
+	skipped, err := env.svc.SkipRound(ctx, env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("SkipRound() failed: %v", err)
+	}
+
+	// 次のラウンドは別のシーンのヒントを作り始めている
+	stored, err := env.gameRepo.Get(ctx, env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	for _, gameSession := range []*game.Game{skipped, stored} {
+		round := gameSession.Rounds[len(gameSession.Rounds)-1]
+		if round.RoundNumber != 2 || round.TurnStatus != game.TurnStatusGeneratingHints ||
+			round.GameMasterImageID == first {
+			t.Errorf("round %d in %v for %q, want round 2 generating hints for another scene",
+				round.RoundNumber, round.TurnStatus, round.GameMasterImageID)
+		}
+	}
+}
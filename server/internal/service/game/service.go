@@ -2,53 +2,65 @@
 package game
 
 import (
-	"bytes"
+	"cmp"
 	"context"
-	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
 	"github.com/yashikota/scene-hunter/server/internal/service"
-	servicegemini "github.com/yashikota/scene-hunter/server/internal/service/gemini"
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	imagesvc "github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 )
 
-const (
-	// hintPrompt is the prompt for generating hints from game master's photo.
-	//nolint:gosmopolitan // Japanese text is required for the game
-	hintPrompt = `この写真の特徴を5つの短い文章で説明してください。
-撮影場所を特定できるような情報を含めてください。
-以下の形式で出力してください：
-1. [1つ目のヒント]
-2. [2つ目のヒント]
-3. [3つ目のヒント]
-4. [4つ目のヒント]
-5. [5つ目のヒント]`
-)
-
 // Service implements the GameService.
 type Service struct {
 	gameRepo     service.GameRepository
 	roomRepo     service.RoomRepository
+	voteRepo     service.VoteRepository
 	blobClient   service.Blob
-	geminiClient service.Gemini
-	geminiSvc    *servicegemini.Service
+	visionModel  service.VisionModel
+	hints        *hint.Generator
+	hintFilter   *hint.Filter
+	imageCatalog *imagesvc.Catalog
+	hintJobs     service.HintJobQueue
+	// hintJobAttempts is how many times a hint job runs before hints are made without AI.
+	hintJobAttempts int
+	// chrono is the clock of the turns, the votes and the retries of hint jobs.
+	chrono chrono.Chrono
 }
 
 // NewService creates a new game service.
 func NewService(
 	gameRepo service.GameRepository,
 	roomRepo service.RoomRepository,
+	voteRepo service.VoteRepository,
 	blobClient service.Blob,
-	geminiClient service.Gemini,
+	visionModel service.VisionModel,
+	imageCatalog *imagesvc.Catalog,
+	hintFilter *hint.Filter,
+	hintJobs service.HintJobQueue,
+	hintJobAttempts int,
+	chronoProvider chrono.Chrono,
 ) *Service {
 	return &Service{
-		gameRepo:     gameRepo,
-		roomRepo:     roomRepo,
-		blobClient:   blobClient,
-		geminiClient: geminiClient,
-		geminiSvc:    servicegemini.NewService(blobClient, geminiClient),
+		gameRepo:    gameRepo,
+		roomRepo:    roomRepo,
+		voteRepo:    voteRepo,
+		blobClient:  blobClient,
+		visionModel: visionModel,
+		hints: hint.NewGenerator(
+			vision.NewService(blobClient, visionModel),
+			hintFilter,
+		),
+		hintFilter:      hintFilter,
+		imageCatalog:    imageCatalog,
+		hintJobs:        hintJobs,
+		hintJobAttempts: hintJobAttempts,
+		chrono:          chronoProvider,
 	}
 }
 
@@ -58,6 +70,9 @@ func (s *Service) StartGame(
 	roomID uuid.UUID,
 	totalRounds int,
 	gameMasterUserID uuid.UUID,
+	hintSettings game.HintSettings,
+	settings game.GameSettings,
+	scenePool bool,
 ) (*game.Game, error) {
 	// Check if room exists
 	_, err := s.roomRepo.Get(ctx, roomID)
@@ -76,7 +91,14 @@ func (s *Service) StartGame(
 	}
 
 	// Create new game
-	gameSession, err := game.NewGame(roomID, totalRounds, gameMasterUserID)
+	gameSession, err := game.NewGame(
+		roomID,
+		totalRounds,
+		gameMasterUserID,
+		hintSettings,
+		settings,
+		scenePool,
+	)
 	if err != nil {
 		return nil, errors.Errorf("failed to create new game: %w", err)
 	}
@@ -124,11 +146,16 @@ func (s *Service) JoinGame(
 	return gameSession, nil
 }
 
-// StartRound starts a new round.
+// StartRound starts a new round of a started game once the current round is completed.
+// In scene pool games the round uses sceneImageID, or a random unused scene when it is uuid.Nil,
+// and its hints are generated at once instead of waiting for the game master's photo.
+// When the hints of the scene could not be generated, the game master picks another scene
+// for the same round by starting it again.
 func (s *Service) StartRound(
 	ctx context.Context,
 	roomID uuid.UUID,
 	gameMasterUserID uuid.UUID,
+	sceneImageID uuid.UUID,
 ) (*game.Game, error) {
 	// Get game
 	gameSession, err := s.gameRepo.Get(ctx, roomID)
@@ -136,12 +163,9 @@ func (s *Service) StartRound(
 		return nil, errors.Errorf("failed to get game: %w", err)
 	}
 
-	// Start game if not started
-	if gameSession.Status == game.GameStatusWaiting {
-		err = gameSession.Start()
-		if err != nil {
-			return nil, errors.Errorf("failed to start game: %w", err)
-		}
+	// シーンのヒントを作れなかったラウンドは、新しく始めずにシーンを選び直す
+	if gameSession.WaitsForScene() {
+		return s.repickScene(ctx, gameSession, gameMasterUserID, sceneImageID)
 	}
 
 	// Start round
@@ -150,6 +174,10 @@ func (s *Service) StartRound(
 		return nil, errors.Errorf("failed to start round: %w", err)
 	}
 
+	if gameSession.ScenePool {
+		return s.startSceneRound(ctx, gameSession, sceneImageID)
+	}
+
 	// Update game
 	err = s.gameRepo.Update(ctx, gameSession)
 	if err != nil {
@@ -159,148 +187,193 @@ func (s *Service) StartRound(
 	return gameSession, nil
 }
 
-// SubmitGameMasterPhoto submits game master's photo and generates hints.
+// SubmitGameMasterPhoto submits game master's photo and queues the generation of its hints.
+// It returns the image ID at once while the hints are generated in the background.
+// The round shows the progress and moves to the hint review when the hints are ready.
 func (s *Service) SubmitGameMasterPhoto(
 	ctx context.Context,
 	roomID, userID uuid.UUID,
-	imageData []byte,
-) (string, []*game.Hint, error) {
+	photo Photo,
+) (string, error) {
 	// Get game
 	gameSession, err := s.gameRepo.Get(ctx, roomID)
 	if err != nil {
-		return "", nil, errors.Errorf("failed to get game: %w", err)
+		return "", errors.Errorf("failed to get game: %w", err)
 	}
 
 	// Get current round
-	round, err := gameSession.GetCurrentRound()
+	round, err := gameSession.PlayingRound()
 	if err != nil {
-		return "", nil, errors.Errorf("failed to get current round: %w", err)
+		return "", errors.Errorf("failed to get current round: %w", err)
 	}
 
 	// Verify user is game master for this round
 	if round.GameMasterUserID != userID {
-		return "", nil, errors.New("only game master can submit photo")
+		return "", errors.New("only game master can submit photo")
 	}
 
-	// Generate image ID
-	imageID := uuid.New().String()
-	imageKey := fmt.Sprintf("images/%s/%s", roomID, imageID)
-
-	// Upload image to blob storage
-	imageReader := bytes.NewReader(imageData)
-
-	err = s.blobClient.Put(ctx, imageKey, imageReader, 24*time.Hour)
+	// Normalize and upload image to the image catalog
+	stored, err := s.storePhoto(
+		ctx,
+		roomID,
+		userID,
+		round.RoundNumber,
+		domainimage.RoleGameMaster,
+		photo,
+	)
 	if err != nil {
-		return "", nil, errors.Errorf("failed to upload image: %w", err)
+		return "", errors.Errorf("failed to store game master image: %w", err)
 	}
 
-	// Generate hints using Gemini
-	result, err := s.geminiSvc.AnalyzeImageFromBlob(ctx, imageKey, hintPrompt)
-	if err != nil {
-		return "", nil, errors.Errorf("failed to generate hints: %w", err)
-	}
+	imageID := stored.ID.String()
 
-	// Parse hints from result
-	hints, err := parseHints(result.Features)
+	// Update round with image and wait for the hints
+	err = round.StartHintGeneration(imageID)
 	if err != nil {
-		return "", nil, errors.Errorf("failed to parse hints: %w", err)
+		return "", errors.Errorf("failed to start hint generation: %w", err)
 	}
 
-	// Update round with image and hints
-	round.SetGameMasterImage(imageID)
-	round.SetHints(hints)
+	round.SetGameMasterLocation(photoLocation(photo, stored))
 
-	// Start hunters' turn
-	err = round.StartHuntersTurn()
+	// Update game
+	err = s.gameRepo.Update(ctx, gameSession)
 	if err != nil {
-		return "", nil, errors.Errorf("failed to start hunters' turn: %w", err)
+		return "", errors.Errorf("failed to update game: %w", err)
 	}
 
-	// Update game
-	err = s.gameRepo.Update(ctx, gameSession)
+	err = s.queueHintJob(ctx, gameSession, round, stored)
 	if err != nil {
-		return "", nil, errors.Errorf("failed to update game: %w", err)
+		return "", err
 	}
 
-	return imageID, hints, nil
+	return imageID, nil
 }
 
-// SubmitHunterPhoto submits hunter's photo.
+// SubmitHunterPhoto submits hunter's photo as their next attempt, timed by the service clock
+// from the start of the hunters' turn. When the photo's location is known it also tells
+// the hunter whether they got warmer.
 func (s *Service) SubmitHunterPhoto(
 	ctx context.Context,
 	roomID, userID uuid.UUID,
-	imageData []byte,
-	elapsedSeconds int,
-) (string, bool, error) {
+	photo Photo,
+) (*HunterPhotoResult, error) {
 	// Get game
 	gameSession, err := s.gameRepo.Get(ctx, roomID)
 	if err != nil {
-		return "", false, errors.Errorf("failed to get game: %w", err)
+		return nil, errors.Errorf("failed to get game: %w", err)
 	}
 
 	// Get current round
-	round, err := gameSession.GetCurrentRound()
+	round, err := gameSession.PlayingRound()
 	if err != nil {
-		return "", false, errors.Errorf("failed to get current round: %w", err)
+		return nil, errors.Errorf("failed to get current round: %w", err)
 	}
 
 	// Verify round is in hunters' phase
 	if round.TurnStatus != game.TurnStatusHunters {
-		return "", false, errors.New("not in hunters' turn")
+		return nil, game.ErrNotHuntersTurn
 	}
 
 	// Verify game master image has been uploaded
 	if round.GameMasterImageID == "" {
-		return "", false, errors.New("game master has not uploaded image yet")
+		return nil, errors.New("game master has not uploaded image yet")
 	}
 
 	// Verify user is not game master
 	if round.GameMasterUserID == userID {
-		return "", false, errors.New("game master cannot submit as hunter")
+		return nil, errors.New("game master cannot submit as hunter")
+	}
+
+	if gameSession.IsSpectator(userID) {
+		return nil, game.ErrSpectatorCannotPlay
+	}
+
+	teamID, err := submittingTeam(gameSession, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Generate hunter's image ID and upload
-	hunterImageID := uuid.New().String()
-	hunterImageKey := fmt.Sprintf("images/%s/%s", roomID, hunterImageID)
+	// 写真を保存する前に、まだ提出できるかを確かめる
+	settings := gameSession.Settings.OrDefault()
 
-	hunterImageReader := bytes.NewReader(imageData)
+	err = round.CanSubmit(cmp.Or(teamID, userID), settings.AttemptLimit)
+	if err != nil {
+		return nil, errors.Errorf("cannot submit hunter photo: %w", err)
+	}
 
-	err = s.blobClient.Put(ctx, hunterImageKey, hunterImageReader, 24*time.Hour)
+	// Normalize and upload hunter's image
+	stored, err := s.storePhoto(
+		ctx,
+		roomID,
+		userID,
+		round.RoundNumber,
+		domainimage.RoleHunter,
+		photo,
+	)
 	if err != nil {
-		return "", false, errors.Errorf("failed to upload hunter image: %w", err)
+		return nil, errors.Errorf("failed to store hunter image: %w", err)
 	}
 
+	hunterImageID := stored.ID.String()
+
+	// 提出時刻はクライアントの申告ではなく、ターンの開始からサーバーで計る
+	gameSession.UpdateTurnClock(s.chrono.Now())
+
 	// Create hunter submission
-	submission, err := game.NewHunterSubmission(userID, hunterImageID, elapsedSeconds)
+	submission, err := game.NewHunterSubmission(
+		userID,
+		hunterImageID,
+		round.TurnElapsedSeconds,
+		settings.TurnSeconds,
+	)
 	if err != nil {
-		return "", false, errors.Errorf("failed to create hunter submission: %w", err)
+		return nil, errors.Errorf("failed to create hunter submission: %w", err)
 	}
 
+	submission.Location = photoLocation(photo, stored)
+
 	// Add submission to round
-	round.AddHunterSubmission(submission)
+	if teamID == uuid.Nil {
+		err = round.AddHunterSubmission(submission, settings.AttemptLimit)
+	} else {
+		err = round.AddTeamSubmission(teamID, submission, settings.AttemptLimit)
+	}
 
-	// Count total hunters (exclude game master)
-	totalHunters := len(gameSession.Players) - 1
+	if err != nil {
+		return nil, errors.Errorf("failed to add hunter submission: %w", err)
+	}
 
-	// Check if all hunters have submitted
-	allSubmitted := round.CheckAllHuntersSubmitted(totalHunters)
-	if allSubmitted {
-		round.StartWaitingForSelection()
+	proximity := game.ProximityUnknown
+	if submission.Location != nil && round.GameMasterLocation != nil {
+		proximity, err = round.CheckProximity(userID, submission.Location)
+		if err != nil {
+			return nil, errors.Errorf("failed to check proximity: %w", err)
+		}
+	}
+
+	allSubmitted, err := s.finishHuntIfDone(gameSession, round)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update game
 	err = s.gameRepo.Update(ctx, gameSession)
 	if err != nil {
-		return "", false, errors.Errorf("failed to update game: %w", err)
+		return nil, errors.Errorf("failed to update game: %w", err)
 	}
 
-	return hunterImageID, allSubmitted, nil
+	return &HunterPhotoResult{
+		Submission:          submission,
+		AllHuntersSubmitted: allSubmitted,
+		Proximity:           proximity,
+	}, nil
 }
 
-// GetHunterPhotos returns all hunter photo submissions for the current round.
+// GetHunterPhotos returns the final photos of the hunters in the current round
+// to its game master. Attempts that are not final are not shown.
 func (s *Service) GetHunterPhotos(
 	ctx context.Context,
-	roomID uuid.UUID,
+	roomID, gameMasterUserID uuid.UUID,
 ) ([]*game.HunterSubmission, error) {
 	// Get game
 	gameSession, err := s.gameRepo.Get(ctx, roomID)
@@ -314,10 +387,17 @@ func (s *Service) GetHunterPhotos(
 		return nil, errors.Errorf("failed to get current round: %w", err)
 	}
 
-	return round.HunterSubmissions, nil
+	if round.GameMasterUserID != gameMasterUserID {
+		return nil, errors.New("only game master can see hunter photos")
+	}
+
+	return round.DecidedSubmissions(), nil
 }
 
-// SelectWinners allows the game master to select winners and assign ranks.
+// SelectWinners allows the game master to select winners and assign ranks
+// unless the audience ranks the photos.
+// In team mode rankings are keyed by team ID and points go to the teams and their members,
+// otherwise they are keyed by user ID.
 func (s *Service) SelectWinners(
 	ctx context.Context,
 	roomID, gameMasterUserID uuid.UUID,
@@ -330,7 +410,7 @@ func (s *Service) SelectWinners(
 	}
 
 	// Get current round
-	round, err := gameSession.GetCurrentRound()
+	round, err := gameSession.PlayingRound()
 	if err != nil {
 		return nil, errors.Errorf("failed to get current round: %w", err)
 	}
@@ -340,31 +420,15 @@ func (s *Service) SelectWinners(
 		return nil, errors.New("only game master can select winners")
 	}
 
-	// Verify round is waiting for selection
-	if round.TurnStatus != game.TurnStatusWaitingForSelection {
-		return nil, errors.New("round is not waiting for selection")
+	if gameSession.Settings.OrDefault().RankingMode == game.RankingAudience {
+		return nil, game.ErrAudienceRanking
 	}
 
-	// Create results from rankings
-	results := make([]*game.RoundResult, 0, len(rankings))
-	for userID, rank := range rankings {
-		result, err := game.NewRoundResult(userID, rank)
-		if err != nil {
-			return nil, errors.Errorf("failed to create round result: %w", err)
-		}
-
-		results = append(results, result)
-
-		// Update player points
-		err = gameSession.UpdatePlayerPoints(userID, result.Points)
-		if err != nil {
-			return nil, errors.Errorf("failed to update player points: %w", err)
-		}
+	err = rankRound(gameSession, round, rankings)
+	if err != nil {
+		return nil, err
 	}
 
-	// Set results for the round
-	round.SetResults(results)
-
 	// Update game
 	err = s.gameRepo.Update(ctx, gameSession)
 	if err != nil {
@@ -374,13 +438,23 @@ func (s *Service) SelectWinners(
 	return gameSession, nil
 }
 
-// GetGameState returns the current game state.
-func (s *Service) GetGameState(ctx context.Context, roomID uuid.UUID) (*game.Game, error) {
+// GetGameState returns the current game state as the viewer sees it.
+// Spectators see it without unranked photos and behind by the spectator delay.
+func (s *Service) GetGameState(
+	ctx context.Context,
+	roomID, viewerID uuid.UUID,
+) (*game.Game, error) {
 	gameSession, err := s.gameRepo.Get(ctx, roomID)
 	if err != nil {
 		return nil, errors.Errorf("failed to get game: %w", err)
 	}
 
+	gameSession.UpdateTurnClock(s.chrono.Now())
+
+	if gameSession.IsSpectator(viewerID) {
+		return s.spectatorState(ctx, gameSession)
+	}
+
 	return gameSession, nil
 }
 
@@ -412,27 +486,3 @@ func (s *Service) EndGame(
 
 	return gameSession, rankings, nil
 }
-
-// parseHints parses hints from AI response features.
-func parseHints(features []string) ([]*game.Hint, error) {
-	hints := make([]*game.Hint, 0, 5)
-
-	// Ensure we have exactly 5 hints
-	for hintIndex := range 5 {
-		var hintText string
-		if hintIndex < len(features) && features[hintIndex] != "" {
-			hintText = features[hintIndex]
-		} else {
-			hintText = fmt.Sprintf("ヒント%d", hintIndex+1)
-		}
-
-		hint, err := game.NewHint(hintIndex+1, hintText)
-		if err != nil {
-			return nil, errors.Errorf("failed to create hint: %w", err)
-		}
-
-		hints = append(hints, hint)
-	}
-
-	return hints, nil
-}
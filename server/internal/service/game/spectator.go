@@ -0,0 +1,55 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// WatchGame adds the user to the game as a spectator and returns the game as they see it.
+func (s *Service) WatchGame(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	name string,
+) (*game.Game, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get game: %w", err)
+	}
+
+	spectator, err := game.NewSpectator(userID, name)
+	if err != nil {
+		return nil, errors.Errorf("failed to create spectator: %w", err)
+	}
+
+	err = gameSession.AddSpectator(spectator)
+	if err != nil {
+		return nil, errors.Errorf("failed to add spectator to game: %w", err)
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return nil, errors.Errorf("failed to update game: %w", err)
+	}
+
+	return s.spectatorState(ctx, gameSession)
+}
+
+// spectatorState returns the game as spectators see it,
+// as it was the spectator delay of the game ago.
+func (s *Service) spectatorState(ctx context.Context, gameSession *game.Game) (*game.Game, error) {
+	delay := time.Duration(gameSession.Settings.SpectatorDelaySeconds) * time.Second
+	if delay == 0 {
+		return gameSession.SpectatorView(), nil
+	}
+
+	delayed, err := s.gameRepo.GetDelayed(ctx, gameSession.RoomID, delay)
+	if err != nil {
+		return nil, errors.Errorf("failed to get delayed game: %w", err)
+	}
+
+	return delayed.SpectatorView(), nil
+}
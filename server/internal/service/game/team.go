@@ -0,0 +1,137 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrNotGameAdmin is returned when someone other than the admin sets up teams or controls the game.
+var ErrNotGameAdmin = errors.New("only admin can set up teams or control the game")
+
+// TeamAssignment is a team as the admin sets it up.
+type TeamAssignment struct {
+	Name      string
+	MemberIDs []uuid.UUID
+}
+
+// AssignTeams puts the players into the teams chosen by the admin.
+// No assignments return the game to individual mode.
+func (s *Service) AssignTeams(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	assignments []TeamAssignment,
+) (*game.Game, error) {
+	teams := make([]*game.Team, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		team, err := game.NewTeam(assignment.Name, assignment.MemberIDs)
+		if err != nil {
+			return nil, errors.Errorf("failed to create team %q: %w", assignment.Name, err)
+		}
+
+		teams = append(teams, team)
+	}
+
+	return s.updateAsAdmin(ctx, roomID, userID, func(gameSession *game.Game) error {
+		err := gameSession.SetTeams(userID, teams)
+		if err != nil {
+			return errors.Errorf("failed to assign teams: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// BalanceTeams splits the players into teamCount teams of nearly equal size.
+func (s *Service) BalanceTeams(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	teamCount int,
+) (*game.Game, error) {
+	return s.updateAsAdmin(ctx, roomID, userID, func(gameSession *game.Game) error {
+		err := gameSession.BalanceTeams(userID, teamCount)
+		if err != nil {
+			return errors.Errorf("failed to balance teams: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// updateAsAdmin applies update to the game after verifying that the user is its admin.
+func (s *Service) updateAsAdmin(
+	ctx context.Context,
+	roomID, userID uuid.UUID,
+	update func(gameSession *game.Game) error,
+) (*game.Game, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get game: %w", err)
+	}
+
+	player, err := gameSession.GetPlayer(userID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get player: %w", err)
+	}
+
+	if !player.IsAdmin {
+		return nil, ErrNotGameAdmin
+	}
+
+	err = update(gameSession)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return nil, errors.Errorf("failed to update game: %w", err)
+	}
+
+	return gameSession, nil
+}
+
+// submittingTeam returns the team that a hunter submits a photo for,
+// or uuid.Nil outside team mode.
+func submittingTeam(gameSession *game.Game, userID uuid.UUID) (uuid.UUID, error) {
+	if !gameSession.IsTeamMode() {
+		return uuid.Nil, nil
+	}
+
+	team, err := gameSession.TeamOf(userID)
+	if err != nil {
+		return uuid.Nil, errors.Errorf("failed to get team: %w", err)
+	}
+
+	return team.ID, nil
+}
+
+// rankTeams creates the results of ranked teams with their distance points
+// and credits their points to the teams and their members.
+func rankTeams(
+	gameSession *game.Game,
+	round *game.Round,
+	rankings, distancePoints map[uuid.UUID]int,
+) ([]*game.RoundResult, error) {
+	results := make([]*game.RoundResult, 0, len(rankings))
+
+	for teamID, rank := range rankings {
+		result, err := game.NewTeamRoundResult(teamID, rank)
+		if err != nil {
+			return nil, errors.Errorf("failed to create round result: %w", err)
+		}
+
+		result.AddDistancePoints(distancePoints[teamID])
+		results = append(results, result)
+
+		err = gameSession.UpdateTeamPoints(teamID, result.Points, round)
+		if err != nil {
+			return nil, errors.Errorf("failed to update team points: %w", err)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,198 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// SubmitVote records a voter's ranking of the photos of the current round, best first,
+// where photos are identified by their hunter, or by their team in team mode.
+// Voting closes and the round is ranked by Borda count once everyone has voted,
+// in which case it returns true.
+func (s *Service) SubmitVote(
+	ctx context.Context,
+	roomID, voterID uuid.UUID,
+	ranking []uuid.UUID,
+) (bool, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return false, errors.Errorf("failed to get game: %w", err)
+	}
+
+	round, err := gameSession.PlayingRound()
+	if err != nil {
+		return false, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	err = round.CastVote(voterID, s.chrono.Now())
+	if err != nil {
+		return false, errors.Errorf("cannot vote: %w", err)
+	}
+
+	candidates, err := gameSession.BallotCandidates(round, voterID)
+	if err != nil {
+		return false, errors.Errorf("cannot vote: %w", err)
+	}
+
+	ballot, err := game.NewBallot(voterID, ranking, candidates)
+	if err != nil {
+		return false, errors.Errorf("failed to create ballot: %w", err)
+	}
+
+	added, err := s.voteRepo.AddBallot(ctx, roomID, round.RoundNumber, ballot)
+	if err != nil {
+		return false, errors.Errorf("failed to add ballot: %w", err)
+	}
+
+	if !added {
+		return false, game.ErrAlreadyVoted
+	}
+
+	ballots, err := s.voteRepo.ListBallots(ctx, roomID, round.RoundNumber)
+	if err != nil {
+		return false, errors.Errorf("failed to list ballots: %w", err)
+	}
+
+	// 全員が投票するまでは締め切らず、投票の記録だけを残す
+	if len(ballots) < gameSession.CountVoters(round) {
+		err = s.gameRepo.AppendEvents(ctx, gameSession)
+		if err != nil {
+			return false, errors.Errorf("failed to record vote: %w", err)
+		}
+
+		return false, nil
+	}
+
+	err = round.CloseVoting(uuid.Nil, s.chrono.Now(), len(ballots), gameSession.CountVoters(round))
+	if err != nil {
+		return false, errors.Errorf("cannot close voting: %w", err)
+	}
+
+	err = s.closeVoting(ctx, gameSession, round, ballots)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CloseVoting lets the game master rank the photos of the current round by the votes so far
+// once the voting time is over.
+func (s *Service) CloseVoting(
+	ctx context.Context,
+	roomID, gameMasterUserID uuid.UUID,
+) (*game.Game, error) {
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to get game: %w", err)
+	}
+
+	round, err := gameSession.PlayingRound()
+	if err != nil {
+		return nil, errors.Errorf("failed to get current round: %w", err)
+	}
+
+	if round.GameMasterUserID != gameMasterUserID {
+		return nil, errors.New("only game master can close voting")
+	}
+
+	ballots, err := s.voteRepo.ListBallots(ctx, roomID, round.RoundNumber)
+	if err != nil {
+		return nil, errors.Errorf("failed to list ballots: %w", err)
+	}
+
+	err = round.CloseVoting(
+		gameMasterUserID,
+		s.chrono.Now(),
+		len(ballots),
+		gameSession.CountVoters(round),
+	)
+	if err != nil {
+		return nil, errors.Errorf("cannot close voting: %w", err)
+	}
+
+	err = s.closeVoting(ctx, gameSession, round, ballots)
+	if err != nil {
+		return nil, err
+	}
+
+	return gameSession, nil
+}
+
+// closeVoting ranks the photos of the round by the Borda count of ballots and stores the game.
+func (s *Service) closeVoting(
+	ctx context.Context,
+	gameSession *game.Game,
+	round *game.Round,
+	ballots []*game.Ballot,
+) error {
+	err := rankRound(gameSession, round, game.BordaRanks(round.RankedHunterIDs(), ballots))
+	if err != nil {
+		return err
+	}
+
+	err = s.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		return errors.Errorf("failed to update game: %w", err)
+	}
+
+	return nil
+}
+
+// rankRound creates the results of the round from rankings, keyed by team ID in team mode
+// and by user ID otherwise, and credits their points after applying the distance scoring.
+func rankRound(gameSession *game.Game, round *game.Round, rankings map[uuid.UUID]int) error {
+	// ポイントを加算する前に順位を付けられる状態かを確かめる
+	err := round.CanApply(game.RoundActionRank)
+	if err != nil {
+		return errors.Errorf("cannot rank round: %w", err)
+	}
+
+	// 距離による順位付けを反映してから結果を作る
+	rankings, distancePoints := round.ScoreDistances(
+		gameSession.Settings.OrDefault().DistanceScoring,
+		rankings,
+	)
+
+	if gameSession.IsTeamMode() {
+		results, err := rankTeams(gameSession, round, rankings, distancePoints)
+		if err != nil {
+			return err
+		}
+
+		return completeRound(round, results)
+	}
+
+	results := make([]*game.RoundResult, 0, len(rankings))
+
+	for userID, rank := range rankings {
+		result, err := game.NewRoundResult(userID, rank)
+		if err != nil {
+			return errors.Errorf("failed to create round result: %w", err)
+		}
+
+		result.AddDistancePoints(distancePoints[userID])
+		results = append(results, result)
+
+		// Update player points
+		err = gameSession.UpdatePlayerPoints(userID, result.Points)
+		if err != nil {
+			return errors.Errorf("failed to update player points: %w", err)
+		}
+	}
+
+	return completeRound(round, results)
+}
+
+// completeRound completes the round with results.
+func completeRound(round *game.Round, results []*game.RoundResult) error {
+	err := round.SetResults(results)
+	if err != nil {
+		return errors.Errorf("failed to complete round: %w", err)
+	}
+
+	return nil
+}
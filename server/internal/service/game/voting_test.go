@@ -0,0 +1,128 @@
+package game_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// releaseHints starts the hunters' turn of round with a single hint.
+func releaseHints(t *testing.T, round *game.Round) {
+	t.Helper()
+
+	err := round.StartHintGeneration(uuid.NewString())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = round.StartHintReview([]*game.Hint{{HintNumber: 1, Text: "hint"}})
+	if err != nil {
+		t.Fatalf("StartHintReview() failed: %v", err)
+	}
+
+	err = round.ReleaseHints()
+	if err != nil {
+		t.Fatalf("ReleaseHints() failed: %v", err)
+	}
+}
+
+// startVoting moves the stored game of env to voting on a photo of each hunter.
+func startVoting(t *testing.T, env *storedGame) []uuid.UUID {
+	t.Helper()
+
+	ctx := t.Context()
+
+	gameSession, err := env.gameRepo.Get(ctx, env.roomID)
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+
+	round := gameSession.Rounds[0]
+	releaseHints(t, round)
+
+	hunterIDs := []uuid.UUID{gameSession.Players[1].UserID, gameSession.Players[2].UserID}
+	for _, hunterID := range hunterIDs {
+		err = round.AddHunterSubmission(
+			&game.HunterSubmission{UserID: hunterID, ImageID: uuid.NewString()},
+			1,
+		)
+		if err != nil {
+			t.Fatalf("AddHunterSubmission() failed: %v", err)
+		}
+	}
+
+	err = round.StartVoting(env.clock.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("StartVoting() failed: %v", err)
+	}
+
+	err = env.gameRepo.Update(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+
+	return hunterIDs
+}
+
+func TestService_SubmitVote_RecordsVote(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+	hunterIDs := startVoting(t, env)
+	ctx := t.Context()
+
+	closed, err := env.svc.SubmitVote(ctx, env.roomID, env.adminID, hunterIDs)
+	if err != nil || closed {
+		t.Fatalf("SubmitVote() = %v, %v, want open voting", closed, err)
+	}
+
+	// 締め切らない投票もゲームを保存せずにイベントとして残る
+	events, err := env.gameRepo.ListEvents(ctx, env.roomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	last := events[len(events)-1]
+	if last.Type != game.EventVoteCast || last.UserID != env.adminID {
+		t.Errorf("last event = %v by %v, want %v by %v",
+			last.Type, last.UserID, game.EventVoteCast, env.adminID)
+	}
+
+	_, err = env.svc.SubmitVote(ctx, env.roomID, env.adminID, hunterIDs)
+	if !errors.Is(err, game.ErrAlreadyVoted) {
+		t.Fatalf("SubmitVote() twice error = %v, want %v", err, game.ErrAlreadyVoted)
+	}
+
+	after, err := env.gameRepo.ListEvents(ctx, env.roomID, "", 100)
+	if err != nil {
+		t.Fatalf("ListEvents() failed: %v", err)
+	}
+
+	if len(after) != len(events) {
+		t.Errorf("ListEvents() after a rejected vote = %d events, want %d", len(after), len(events))
+	}
+}
+
+func TestService_CloseVoting_Deadline(t *testing.T) {
+	t.Parallel()
+
+	env := newStoredGame(t, game.GameStatusInProgress)
+	startVoting(t, env)
+	ctx := t.Context()
+
+	_, err := env.svc.CloseVoting(ctx, env.roomID, env.adminID)
+	if !errors.Is(err, game.ErrVotingOpen) {
+		t.Fatalf("CloseVoting() before the deadline error = %v, want %v", err, game.ErrVotingOpen)
+	}
+
+	// 締め切りはサービスの時計で判断される
+	env.clock.now = env.clock.now.Add(2 * time.Minute)
+
+	_, err = env.svc.CloseVoting(ctx, env.roomID, env.adminID)
+	if err != nil {
+		t.Fatalf("CloseVoting() after the deadline failed: %v", err)
+	}
+}
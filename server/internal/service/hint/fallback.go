@@ -0,0 +1,146 @@
+package hint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	// brightThreshold and darkThreshold split photos into bright, dim and dark ones.
+	brightThreshold = 0.6
+	darkThreshold   = 0.3
+)
+
+// fallbackTemplate is the set of sentences used to write hints without AI in one language.
+type fallbackTemplate struct {
+	outdoor, indoor   string
+	bright, dim, dark string
+	mainColor         string
+	topColor          string
+	bottomColor       string
+	otherColor        string
+	colorNames        map[image.Color]string
+}
+
+// fallbackTemplateFor returns the sentences of a language. Unknown languages use the default language.
+//
+//nolint:gosmopolitan // Japanese text is required for the game
+func fallbackTemplateFor(language game.Language) fallbackTemplate {
+	switch language {
+	case game.LanguageEnglish:
+		return fallbackTemplate{
+			outdoor:     "The photo was taken outdoors.",
+			indoor:      "The photo was taken indoors.",
+			bright:      "The place is brightly lit.",
+			dim:         "The place is softly lit.",
+			dark:        "The place is dark.",
+			mainColor:   "The most common color in the photo is %s.",
+			topColor:    "The upper part of the photo is mostly %s.",
+			bottomColor: "The lower part of the photo is mostly %s.",
+			otherColor:  "The photo also contains a lot of %s.",
+			colorNames:  nil,
+		}
+	case game.LanguageJapanese:
+	}
+
+	return fallbackTemplate{
+		outdoor:     "屋外で撮影された写真です。",
+		indoor:      "屋内で撮影された写真です。",
+		bright:      "明るい場所です。",
+		dim:         "やや薄暗い場所です。",
+		dark:        "暗い場所です。",
+		mainColor:   "写真全体で一番多い色は%sです。",
+		topColor:    "写真の上の方は主に%sです。",
+		bottomColor: "写真の下の方は主に%sです。",
+		otherColor:  "写真には%sも多く含まれています。",
+		colorNames: map[image.Color]string{
+			image.ColorBlack:  "黒",
+			image.ColorWhite:  "白",
+			image.ColorGray:   "灰色",
+			image.ColorRed:    "赤",
+			image.ColorOrange: "オレンジ",
+			image.ColorBrown:  "茶色",
+			image.ColorYellow: "黄色",
+			image.ColorGreen:  "緑",
+			image.ColorBlue:   "青",
+			image.ColorPurple: "紫",
+			image.ColorPink:   "ピンク",
+		},
+	}
+}
+
+// colorName returns the name of a color in the language of the template.
+func (t fallbackTemplate) colorName(color image.Color) string {
+	name, ok := t.colorNames[color]
+	if !ok {
+		return string(color)
+	}
+
+	return name
+}
+
+// Fallback writes up to count hints for the photo stored at imagePath without AI.
+// It is used while the AI is unavailable so that the round can continue.
+// The hints describe only whether the photo is outdoors, its brightness and its colors,
+// and the game master can rewrite them while reviewing the hints.
+// Fewer hints are written when the photo has too few colors to describe.
+func (g *Generator) Fallback(
+	ctx context.Context,
+	imagePath string,
+	settings game.HintSettings,
+	count int,
+) ([]*game.Hint, error) {
+	features, err := g.analyzer.ExtractFeaturesFromBlob(ctx, imagePath)
+	if err != nil {
+		return nil, errors.Errorf("failed to extract features for fallback hints: %w", err)
+	}
+
+	template := fallbackTemplateFor(settings.OrDefault().Language)
+
+	// 曖昧なものから具体的なものの順に並べる
+	texts := []string{
+		template.indoor,
+		template.dim,
+		fmt.Sprintf(template.mainColor, template.colorName(features.DominantColors[0])),
+		fmt.Sprintf(template.topColor, template.colorName(features.TopColor)),
+		fmt.Sprintf(template.bottomColor, template.colorName(features.BottomColor)),
+	}
+
+	if features.Outdoor {
+		texts[0] = template.outdoor
+	}
+
+	switch {
+	case features.Brightness >= brightThreshold:
+		texts[1] = template.bright
+	case features.Brightness < darkThreshold:
+		texts[1] = template.dark
+	}
+
+	// 足りない分は二番目以降に多い色で補う
+	for _, color := range features.DominantColors[1:] {
+		if len(texts) >= count {
+			break
+		}
+
+		texts = append(texts, fmt.Sprintf(template.otherColor, template.colorName(color)))
+	}
+
+	texts = texts[:min(count, len(texts))]
+	hints := make([]*game.Hint, 0, len(texts))
+
+	for index, text := range texts {
+		hint, err := game.NewHint(index+1, count, text)
+		if err != nil {
+			return nil, errors.Errorf("failed to create hint: %w", err)
+		}
+
+		hints = append(hints, hint)
+	}
+
+	return hints, nil
+}
@@ -0,0 +1,175 @@
+package hint_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+)
+
+// skyPhoto returns a PNG photo of blue sky over grass.
+func skyPhoto(t *testing.T) []byte {
+	t.Helper()
+
+	const size = 30
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+
+	for row := range size {
+		band := color.RGBA{R: 135, G: 206, B: 235, A: 255}
+		if row >= size/2 {
+			band = color.RGBA{R: 40, G: 160, B: 40, A: 255}
+		}
+
+		for column := range size {
+			img.Set(column, row, band)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := png.Encode(&buf, img)
+	if err != nil {
+		t.Fatalf("failed to encode photo: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// newFallbackGenerator returns a generator whose blob holds skyPhoto at testImagePath.
+func newFallbackGenerator(t *testing.T) *hint.Generator {
+	t.Helper()
+
+	ctrl := NewMockController(t)
+	blobClient := Mock[service.Blob](ctrl)
+	photo := skyPhoto(t)
+
+	WhenDouble(blobClient.Get(Any[context.Context](), Exact(testImagePath))).
+		ThenAnswer(func([]any) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(photo)), nil
+		})
+
+	filter, err := hint.NewFilter(nil, nil)
+	if err != nil {
+		t.Fatalf("NewFilter() failed: %v", err)
+	}
+
+	// AIは呼ばれない
+	return hint.NewGenerator(
+		vision.NewService(blobClient, Mock[service.VisionModel](ctrl)),
+		filter,
+	)
+}
+
+func TestGenerator_Fallback(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		language  game.Language
+		wantHints []string
+	}{
+		"english": {
+			game.LanguageEnglish,
+			[]string{
+				"The photo was taken outdoors.",
+				"The place is brightly lit.",
+				"The most common color in the photo is blue.",
+				"The upper part of the photo is mostly blue.",
+				"The lower part of the photo is mostly green.",
+			},
+		},
+		//nolint:gosmopolitan // Japanese hints are checked
+		"japanese": {
+			game.LanguageJapanese,
+			[]string{
+				"屋外で撮影された写真です。",
+				"明るい場所です。",
+				"写真全体で一番多い色は青です。",
+				"写真の上の方は主に青です。",
+				"写真の下の方は主に緑です。",
+			},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := newFallbackGenerator(t)
+
+			hints, err := generator.Fallback(context.Background(), testImagePath, game.HintSettings{
+				Language:   testCase.language,
+				Difficulty: game.DifficultyNormal,
+			}, game.DefaultHintCount)
+			if err != nil {
+				t.Fatalf("Fallback() failed: %v", err)
+			}
+
+			if len(hints) != game.DefaultHintCount {
+				t.Fatalf("Fallback() returned %d hints, want %d", len(hints), game.DefaultHintCount)
+			}
+
+			for index, want := range testCase.wantHints {
+				if hints[index].HintNumber != index+1 || hints[index].Text != want {
+					t.Errorf(
+						"Fallback() hint %d = (%d, %q), want (%d, %q)",
+						index,
+						hints[index].HintNumber,
+						hints[index].Text,
+						index+1,
+						want,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_Fallback_Count(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		count    int
+		wantLast string
+	}{
+		"fewer hints": {3, "The most common color in the photo is blue."},
+		"more hints":  {6, "The photo also contains a lot of green."},
+		// 写真の色が足りなければ、書けるだけのヒントを返す
+		"too many hints": {game.MaxHintCount, "The photo also contains a lot of green."},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := newFallbackGenerator(t)
+
+			hints, err := generator.Fallback(context.Background(), testImagePath, game.HintSettings{
+				Language:   game.LanguageEnglish,
+				Difficulty: game.DifficultyNormal,
+			}, testCase.count)
+			if err != nil {
+				t.Fatalf("Fallback() failed: %v", err)
+			}
+
+			if len(hints) == 0 || len(hints) > testCase.count {
+				t.Fatalf("Fallback() returned %d hints, want 1 to %d", len(hints), testCase.count)
+			}
+
+			last := hints[len(hints)-1]
+			if last.HintNumber != len(hints) || last.Text != testCase.wantLast {
+				t.Errorf("Fallback() last hint = (%d, %q), want (%d, %q)",
+					last.HintNumber, last.Text, len(hints), testCase.wantLast)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package hint
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrHintRejected is returned when a hint contains a banned word or personal information.
+var ErrHintRejected = errors.New("hint rejected by content filter")
+
+// Filter rejects hints that must not be shown to hunters.
+type Filter struct {
+	bannedWords []string
+	piiPatterns []*regexp.Regexp
+}
+
+// NewFilter creates a new content filter.
+// Banned words match case-insensitively anywhere in a hint,
+// and piiPatterns are regular expressions of personal information such as phone numbers.
+func NewFilter(bannedWords, piiPatterns []string) (*Filter, error) {
+	filter := &Filter{
+		bannedWords: make([]string, 0, len(bannedWords)),
+		piiPatterns: make([]*regexp.Regexp, 0, len(piiPatterns)),
+	}
+
+	for _, word := range bannedWords {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			filter.bannedWords = append(filter.bannedWords, word)
+		}
+	}
+
+	for _, pattern := range piiPatterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.Errorf("invalid PII pattern %q: %w", pattern, err)
+		}
+
+		filter.piiPatterns = append(filter.piiPatterns, compiled)
+	}
+
+	return filter, nil
+}
+
+// Check returns ErrHintRejected if text contains a banned word or personal information.
+func (f *Filter) Check(text string) error {
+	lower := strings.ToLower(text)
+
+	for _, word := range f.bannedWords {
+		if strings.Contains(lower, word) {
+			return errors.Errorf("%w: contains banned word", ErrHintRejected)
+		}
+	}
+
+	for _, pattern := range f.piiPatterns {
+		if pattern.MatchString(text) {
+			return errors.Errorf("%w: contains personal information", ErrHintRejected)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package hint_test
+
+import (
+	"testing"
+
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+func TestFilter_Check(t *testing.T) {
+	t.Parallel()
+
+	filter, err := hint.NewFilter(
+		[]string{"Secret Base", " ", ""},
+		[]string{`0\d{1,4}-\d{1,4}-\d{3,4}`, `[\w.+-]+@[\w-]+(?:\.[\w-]+)+`},
+	)
+	if err != nil {
+		t.Fatalf("NewFilter() failed: %v", err)
+	}
+
+	tests := map[string]struct {
+		text    string
+		wantErr error
+	}{
+		"allowed":                {"A red door next to a vending machine", nil},
+		"banned word":            {"The secret base is near", hint.ErrHintRejected},
+		"banned word any case":   {"the SECRET BASE is near", hint.ErrHintRejected},
+		"phone number":           {"The sign says 03-1234-5678", hint.ErrHintRejected},
+		"email address":          {"Mail hunter@example.com", hint.ErrHintRejected},
+		"numbers without format": {"There are 3 benches and 12 trees", nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := filter.Check(testCase.text)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("Check(%q) error = %v, want %v", testCase.text, err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewFilter_InvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := hint.NewFilter(nil, []string{"("})
+	if err == nil {
+		t.Error("NewFilter() succeeded, want an error for an invalid pattern")
+	}
+}
@@ -0,0 +1,223 @@
+// Package hint generates the hints of a round from the game master's photo.
+package hint
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	// maxAttempts is how many times the AI is asked before giving up.
+	maxAttempts = 3
+	// maxHintLength is the longest hint in runes. Longer answers are not usable as hints.
+	maxHintLength = 200
+)
+
+// ErrNotEnoughHints is returned when the AI did not write enough usable hints.
+var ErrNotEnoughHints = errors.New("not enough hints generated")
+
+// hintNumbering matches numbering and bullets that the AI adds despite the prompt.
+var hintNumbering = regexp.MustCompile(`^\s*(?:\d+\s*[.)．、:：]|[-*・•])\s*`)
+
+// Generator generates hints with the AI.
+// Hints are ordered from vague to specific in the language and difficulty of the game.
+// Hints rejected by the content filter are dropped and written again.
+type Generator struct {
+	analyzer *vision.Service
+	filter   *Filter
+}
+
+// NewGenerator creates a new hint generator.
+func NewGenerator(analyzer *vision.Service, filter *Filter) *Generator {
+	return &Generator{
+		analyzer: analyzer,
+		filter:   filter,
+	}
+}
+
+// Progress is told how many hints have been written so far.
+type Progress func(generated int)
+
+// Generate generates count hints for the photo stored at imagePath.
+// Failed or incomplete answers are retried, asking only for the missing hints,
+// and ErrNotEnoughHints is returned if the hints are still incomplete.
+// progress, if not nil, is called whenever more hints have been written.
+func (g *Generator) Generate(
+	ctx context.Context,
+	imagePath string,
+	settings game.HintSettings,
+	count int,
+	progress Progress,
+) ([]*game.Hint, error) {
+	settings = settings.OrDefault()
+	texts := make([]string, 0, count)
+
+	var lastErr error
+
+	for attempt := range maxAttempts {
+		missing := count - len(texts)
+		if missing == 0 {
+			break
+		}
+
+		// 足りない分だけを、作成済みのヒントより具体的なものとして依頼する
+		prompt := generatePrompt(settings, missing)
+		if len(texts) > 0 {
+			prompt = repairPrompt(settings, texts, missing)
+		}
+
+		result, err := g.analyzer.DescribeFromBlob(askContext(ctx, attempt), imagePath, prompt)
+		if err != nil {
+			lastErr = err
+
+			if !retryable(err) {
+				break
+			}
+
+			continue
+		}
+
+		generated := len(texts)
+
+		texts = g.appendHints(texts, result.Features, count)
+		if progress != nil && len(texts) > generated {
+			progress(len(texts))
+		}
+	}
+
+	if len(texts) < count {
+		err := errors.Errorf("%w: got %d of %d", ErrNotEnoughHints, len(texts), count)
+		if lastErr != nil {
+			return nil, errors.Errorf("%w: %w", err, lastErr)
+		}
+
+		return nil, err
+	}
+
+	hints := make([]*game.Hint, 0, count)
+
+	for index, text := range texts {
+		hint, err := game.NewHint(index+1, count, text)
+		if err != nil {
+			return nil, errors.Errorf("failed to create hint: %w", err)
+		}
+
+		hints = append(hints, hint)
+	}
+
+	return hints, nil
+}
+
+// Regenerate writes a new hint in place of hint hintNumber of hints.
+// The new hint differs from all of hints and fits between its neighbors in how specific it is.
+func (g *Generator) Regenerate(
+	ctx context.Context,
+	imagePath string,
+	settings game.HintSettings,
+	hints []*game.Hint,
+	hintNumber int,
+) (string, error) {
+	settings = settings.OrDefault()
+
+	texts := make([]string, 0, len(hints))
+	for _, hint := range hints {
+		texts = append(texts, hint.Text)
+	}
+
+	var lastErr error
+
+	for attempt := range maxAttempts {
+		result, err := g.analyzer.DescribeFromBlob(
+			askContext(ctx, attempt),
+			imagePath,
+			regeneratePrompt(settings, texts, hintNumber),
+		)
+		if err != nil {
+			lastErr = err
+
+			if !retryable(err) {
+				break
+			}
+
+			continue
+		}
+
+		for _, feature := range result.Features {
+			text, ok := g.usableHint(texts, feature)
+			if ok {
+				return text, nil
+			}
+		}
+	}
+
+	if lastErr != nil {
+		return "", errors.Errorf("%w: %w", ErrNotEnoughHints, lastErr)
+	}
+
+	return "", ErrNotEnoughHints
+}
+
+// askContext returns the context of an attempt to ask the AI.
+// A retry may send the same prompt again, so it must not get the cached answer
+// that was just found unusable.
+func askContext(ctx context.Context, attempt int) context.Context {
+	if attempt == 0 {
+		return ctx
+	}
+
+	return vision.Uncached(ctx)
+}
+
+// retryable reports whether asking the AI again may succeed.
+// While the AI is stopped or the budget is used up, a retry fails the same way.
+func retryable(err error) bool {
+	return !errors.Is(err, vision.ErrCircuitOpen) && !errors.Is(err, vision.ErrBudgetExceeded)
+}
+
+// appendHints appends usable features to texts until there are count hints.
+func (g *Generator) appendHints(texts, features []string, count int) []string {
+	for _, feature := range features {
+		if len(texts) == count {
+			break
+		}
+
+		text, ok := g.usableHint(texts, feature)
+		if ok {
+			texts = append(texts, text)
+		}
+	}
+
+	return texts
+}
+
+// usableHint cleans a feature and reports whether it can be used as a hint besides texts.
+// Empty, too long, duplicated and filtered features are not usable.
+func (g *Generator) usableHint(texts []string, feature string) (string, bool) {
+	text := cleanHint(feature)
+	if text == "" || utf8.RuneCountInString(text) > maxHintLength {
+		return "", false
+	}
+
+	for _, existing := range texts {
+		if strings.EqualFold(existing, text) {
+			return "", false
+		}
+	}
+
+	if g.filter.Check(text) != nil {
+		return "", false
+	}
+
+	return text, true
+}
+
+// cleanHint removes surrounding whitespace and numbering from a feature.
+func cleanHint(feature string) string {
+	return strings.TrimSpace(hintNumbering.ReplaceAllString(strings.TrimSpace(feature), ""))
+}
@@ -0,0 +1,401 @@
+package hint_test
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/hint"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	testImagePath  = "game/room/image.jpg"
+	testBannedWord = "secret"
+	testPhone      = "call 090-1234-5678"
+	testNewHint    = "new"
+)
+
+// testHints returns five distinct hints.
+func testHints() []string {
+	return []string{"first", "second", "third", "fourth", "fifth"}
+}
+
+// modelAnswer is one response of the mocked vision model.
+type modelAnswer struct {
+	features []string
+	err      error
+}
+
+// newTestGenerator returns a generator whose vision model answers in order
+// and the prompts it was sent.
+func newTestGenerator(t *testing.T, answers []modelAnswer) (*hint.Generator, *[]string) {
+	t.Helper()
+
+	ctrl := NewMockController(t)
+
+	blobClient := Mock[service.Blob](ctrl)
+	visionModel := Mock[service.VisionModel](ctrl)
+	prompts := make([]string, 0, len(answers))
+
+	WhenDouble(blobClient.Get(Any[context.Context](), Exact(testImagePath))).
+		ThenAnswer(func([]any) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("fake image data")), nil
+		})
+
+	WhenDouble(
+		visionModel.Describe(
+			Any[context.Context](),
+			Any[service.VisionImage](),
+			Any[string](),
+		),
+	).ThenAnswer(func(args []any) (*service.ImageAnalysisResult, error) {
+		prompt, _ := args[2].(string)
+		prompts = append(prompts, prompt)
+
+		if len(prompts) > len(answers) {
+			t.Fatalf(
+				"vision model was called %d times, want at most %d",
+				len(prompts),
+				len(answers),
+			)
+		}
+
+		answer := answers[len(prompts)-1]
+		if answer.err != nil {
+			return nil, answer.err
+		}
+
+		return &service.ImageAnalysisResult{Features: answer.features}, nil
+	})
+
+	filter, err := hint.NewFilter([]string{testBannedWord}, []string{`\d{3}-\d{4}`})
+	if err != nil {
+		t.Fatalf("NewFilter() failed: %v", err)
+	}
+
+	return hint.NewGenerator(vision.NewService(blobClient, visionModel), filter), &prompts
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	t.Parallel()
+
+	errUnavailable := errors.New("service unavailable")
+	five := testHints()
+
+	tests := map[string]struct {
+		answers     []modelAnswer
+		wantHints   []string
+		wantPrompts int
+		wantErr     error
+	}{
+		"complete answer": {
+			[]modelAnswer{{five, nil}},
+			five,
+			1,
+			nil,
+		},
+		"numbering is removed": {
+			[]modelAnswer{
+				{
+					[]string{
+						"1. " + five[0],
+						" 2) " + five[1],
+						"- " + five[2],
+						"・" + five[3],
+						"5．" + five[4],
+					},
+					nil,
+				},
+			},
+			five,
+			1,
+			nil,
+		},
+		"missing hints are repaired": {
+			[]modelAnswer{
+				{[]string{five[0], "", five[1], strings.ToUpper(five[0]), five[2]}, nil},
+				{[]string{five[2], five[3], five[4], "sixth", "seventh"}, nil},
+			},
+			five,
+			2,
+			nil,
+		},
+		"failed call is retried": {
+			[]modelAnswer{{nil, errUnavailable}, {five, nil}},
+			five,
+			2,
+			nil,
+		},
+		"too long hints are dropped": {
+			[]modelAnswer{
+				{append(five[:4:4], strings.Repeat("x", 201)), nil},
+				{five[4:], nil},
+			},
+			five,
+			2,
+			nil,
+		},
+		"filtered hints are repaired": {
+			[]modelAnswer{
+				{
+					[]string{five[0], "a " + testBannedWord + " door", five[1], testPhone, five[2]},
+					nil,
+				},
+				{five[3:], nil},
+			},
+			five,
+			2,
+			nil,
+		},
+		"open circuit is not retried": {
+			[]modelAnswer{{five[:2], nil}, {nil, vision.ErrCircuitOpen}},
+			nil,
+			2,
+			vision.ErrCircuitOpen,
+		},
+		"used up budget is not retried": {
+			[]modelAnswer{{nil, vision.ErrBudgetExceeded}},
+			nil,
+			1,
+			vision.ErrBudgetExceeded,
+		},
+		"gives up without placeholders": {
+			[]modelAnswer{{five[:1], nil}, {[]string{""}, nil}, {nil, errUnavailable}},
+			nil,
+			3,
+			hint.ErrNotEnoughHints,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator, prompts := newTestGenerator(t, testCase.answers)
+
+			progress := make([]int, 0, len(testCase.answers))
+
+			hints, err := generator.Generate(
+				context.Background(),
+				testImagePath,
+				game.HintSettings{
+					Language:   game.LanguageEnglish,
+					Difficulty: game.DifficultyNormal,
+				},
+				game.DefaultHintCount,
+				func(generated int) { progress = append(progress, generated) },
+			)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("Generate() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			// 進捗はヒントが増えたときだけ、増えた後の数で伝えられる
+			if !slices.IsSorted(progress) ||
+				len(slices.Compact(slices.Clone(progress))) != len(progress) {
+				t.Errorf("Generate() progress = %v, want increasing counts", progress)
+			}
+
+			if err == nil && progress[len(progress)-1] != game.DefaultHintCount {
+				t.Errorf(
+					"Generate() progress = %v, want it to end at %d",
+					progress,
+					game.DefaultHintCount,
+				)
+			}
+
+			if len(*prompts) != testCase.wantPrompts {
+				t.Errorf(
+					"Generate() called the vision model %d times, want %d",
+					len(*prompts),
+					testCase.wantPrompts,
+				)
+			}
+
+			if len(hints) != len(testCase.wantHints) {
+				t.Fatalf(
+					"Generate() returned %d hints, want %d",
+					len(hints),
+					len(testCase.wantHints),
+				)
+			}
+
+			for index, want := range testCase.wantHints {
+				if hints[index].HintNumber != index+1 || hints[index].Text != want {
+					t.Errorf(
+						"Generate() hint %d = (%d, %q), want (%d, %q)",
+						index,
+						hints[index].HintNumber,
+						hints[index].Text,
+						index+1,
+						want,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_Generate_Prompt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		settings game.HintSettings
+		want     []string
+	}{
+		"english hard": {
+			game.HintSettings{Language: game.LanguageEnglish, Difficulty: game.DifficultyHard},
+			[]string{"Write 5 hints", "from vague to specific", "Never mention text"},
+		},
+		"english easy": {
+			game.HintSettings{Language: game.LanguageEnglish, Difficulty: game.DifficultyEasy},
+			[]string{"Write 5 hints", "nearly identify the place"},
+		},
+		"japanese by default": {
+			game.HintSettings{Language: "", Difficulty: 0},
+			//nolint:gosmopolitan // Japanese prompt is checked
+			[]string{"ヒントを5個", "曖昧なものから具体的なもの", "探しながら確かめられる"},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator, prompts := newTestGenerator(t, []modelAnswer{
+				{testHints(), nil},
+			})
+
+			_, err := generator.Generate(
+				context.Background(),
+				testImagePath,
+				testCase.settings,
+				game.DefaultHintCount,
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("Generate() failed: %v", err)
+			}
+
+			for _, want := range testCase.want {
+				if !strings.Contains((*prompts)[0], want) {
+					t.Errorf("Generate() prompt = %q, want it to contain %q", (*prompts)[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_Generate_RepairPrompt(t *testing.T) {
+	t.Parallel()
+
+	generator, prompts := newTestGenerator(t, []modelAnswer{
+		{testHints()[:2], nil},
+		{testHints()[2:], nil},
+	})
+
+	_, err := generator.Generate(context.Background(), testImagePath, game.HintSettings{
+		Language:   game.LanguageEnglish,
+		Difficulty: game.DifficultyNormal,
+	}, game.DefaultHintCount, nil)
+	if err != nil {
+		t.Fatalf("Generate() failed: %v", err)
+	}
+
+	// 作成済みのヒントを伝え、足りない数だけを依頼する
+	for _, want := range []string{"- first\n- second", "Write 3 more hints"} {
+		if !strings.Contains((*prompts)[1], want) {
+			t.Errorf("Generate() repair prompt = %q, want it to contain %q", (*prompts)[1], want)
+		}
+	}
+}
+
+func TestGenerator_Regenerate(t *testing.T) {
+	t.Parallel()
+
+	errUnavailable := errors.New("service unavailable")
+
+	tests := map[string]struct {
+		answers     []modelAnswer
+		want        string
+		wantPrompts int
+		wantErr     error
+	}{
+		"first usable hint": {
+			[]modelAnswer{{[]string{testNewHint, "other"}, nil}},
+			testNewHint,
+			1,
+			nil,
+		},
+		"existing and filtered hints are skipped": {
+			[]modelAnswer{{[]string{"Third", testPhone, testBannedWord, testNewHint}, nil}},
+			testNewHint,
+			1,
+			nil,
+		},
+		"failed call is retried": {
+			[]modelAnswer{{nil, errUnavailable}, {[]string{testNewHint}, nil}},
+			testNewHint,
+			2,
+			nil,
+		},
+		"gives up": {
+			[]modelAnswer{{testHints(), nil}, {nil, errUnavailable}, {[]string{""}, nil}},
+			"",
+			3,
+			hint.ErrNotEnoughHints,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			generator, prompts := newTestGenerator(t, testCase.answers)
+
+			hints := make([]*game.Hint, 0, game.DefaultHintCount)
+			for index, text := range testHints() {
+				hints = append(hints, &game.Hint{HintNumber: index + 1, Text: text})
+			}
+
+			text, err := generator.Regenerate(
+				context.Background(),
+				testImagePath,
+				game.HintSettings{
+					Language:   game.LanguageEnglish,
+					Difficulty: game.DifficultyNormal,
+				},
+				hints,
+				3,
+			)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("Regenerate() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if text != testCase.want {
+				t.Errorf("Regenerate() = %q, want %q", text, testCase.want)
+			}
+
+			if len(*prompts) != testCase.wantPrompts {
+				t.Fatalf(
+					"Regenerate() called the vision model %d times, want %d",
+					len(*prompts),
+					testCase.wantPrompts,
+				)
+			}
+
+			// 全ヒントを番号付きで伝え、置き換える番号を指定する
+			for _, want := range []string{"1. first\n2. second\n3. third", "replace hint 3"} {
+				if !strings.Contains((*prompts)[0], want) {
+					t.Errorf("Regenerate() prompt = %q, want it to contain %q", (*prompts)[0], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package hint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+)
+
+// promptTemplate is the set of prompts used to generate hints in one language.
+type promptTemplate struct {
+	// generate asks for count hints. It takes the count and the difficulty guidance.
+	generate string
+	// repair asks for count more hints after the listed ones.
+	// It takes the listed hints, the count and the difficulty guidance.
+	repair string
+	// regenerate asks for a hint in place of one of the numbered hints.
+	// It takes the numbered hints, the hint number and the difficulty guidance.
+	regenerate string
+	guidance   map[game.Difficulty]string
+}
+
+// templateFor returns the prompts of a language. Unknown languages use the default language.
+//
+//nolint:gosmopolitan,funlen // Japanese text is required for the game
+func templateFor(language game.Language) promptTemplate {
+	switch language {
+	case game.LanguageEnglish:
+		return promptTemplate{
+			generate: `Write %d hints that help hunters find the place where this photo was taken.
+Order the hints from vague to specific: the first hint describes the overall scene,
+and each following hint narrows down the place.
+%s
+Write each hint as one short sentence in English. Do not number the hints.`,
+			repair: `These hints about this photo have already been written:
+%s
+Write %d more hints that are more specific than these and do not repeat them.
+%s
+Write each hint as one short sentence in English. Do not number the hints.`,
+			regenerate: `These hints about this photo are ordered from vague to specific:
+%s
+Write new hints that could replace hint %d. Each must be as specific as the hints
+around it and must not repeat any of these hints.
+%s
+Write each hint as one short sentence in English. Do not number the hints.`,
+			guidance: map[game.Difficulty]string{
+				game.DifficultyEasy: "The last hints may mention signs, text or landmarks " +
+					"that nearly identify the place.",
+				game.DifficultyNormal: "Describe features such as colors, shapes and nearby " +
+					"objects that hunters can check while searching.",
+				game.DifficultyHard: "Never mention text, names or landmarks that directly " +
+					"identify the place. Describe only indirect features such as colors, " +
+					"shapes and light.",
+			},
+		}
+	case game.LanguageJapanese:
+	}
+
+	return promptTemplate{
+		generate: `この写真が撮影された場所をハンターが探すためのヒントを%d個作成してください。
+ヒントは曖昧なものから具体的なものの順に並べてください。
+1つ目は写真全体の雰囲気を表し、後のヒントほど場所を絞り込めるようにしてください。
+%s
+各ヒントは日本語の短い1文にしてください。番号は付けないでください。`,
+		repair: `この写真について、次のヒントを作成済みです。
+%s
+これらより具体的で、重複しないヒントを%d個追加してください。
+%s
+各ヒントは日本語の短い1文にしてください。番号は付けないでください。`,
+		regenerate: `この写真について、曖昧なものから具体的なものの順に次のヒントを作成済みです。
+%s
+%d番目のヒントの代わりになるヒントを作成してください。
+前後のヒントと同じくらいの具体さにして、これらのヒントと重複しないようにしてください。
+%s
+各ヒントは日本語の短い1文にしてください。番号は付けないでください。`,
+		guidance: map[game.Difficulty]string{
+			game.DifficultyEasy:   "最後のヒントでは、看板の文字や目印など、場所をほぼ特定できる特徴を書いてください。",
+			game.DifficultyNormal: "色や形、周囲の物など、探しながら確かめられる特徴を書いてください。",
+			game.DifficultyHard: "場所を直接特定できる文字や名前、目印は書かず、" +
+				"色や形、光の当たり方などの間接的な特徴だけを書いてください。",
+		},
+	}
+}
+
+// generatePrompt returns the prompt asking for count hints.
+func generatePrompt(settings game.HintSettings, count int) string {
+	tmpl := templateFor(settings.Language)
+
+	return fmt.Sprintf(tmpl.generate, count, tmpl.guidance[settings.Difficulty])
+}
+
+// regeneratePrompt returns the prompt asking for a hint in place of hint hintNumber of existing.
+func regeneratePrompt(settings game.HintSettings, existing []string, hintNumber int) string {
+	tmpl := templateFor(settings.Language)
+
+	var list strings.Builder
+	for index, text := range existing {
+		fmt.Fprintf(&list, "%d. %s\n", index+1, text)
+	}
+
+	return fmt.Sprintf(
+		tmpl.regenerate,
+		strings.TrimSuffix(list.String(), "\n"),
+		hintNumber,
+		tmpl.guidance[settings.Difficulty],
+	)
+}
+
+// repairPrompt returns the prompt asking for count hints after the existing ones.
+func repairPrompt(settings game.HintSettings, existing []string, count int) string {
+	tmpl := templateFor(settings.Language)
+
+	var list strings.Builder
+	for _, text := range existing {
+		list.WriteString("- " + text + "\n")
+	}
+
+	return fmt.Sprintf(
+		tmpl.repair,
+		strings.TrimSuffix(list.String(), "\n"),
+		count,
+		tmpl.guidance[settings.Difficulty],
+	)
+}
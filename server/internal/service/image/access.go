@@ -0,0 +1,112 @@
+package image
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+var (
+	// ErrNotRoomMember is returned when someone outside of the room reads its images.
+	ErrNotRoomMember = errors.New("only players and spectators of the room can see its images")
+	// ErrImageHidden is returned when an image of a round is read before the round reveals it.
+	ErrImageHidden = errors.New("image is hidden until the round is over")
+)
+
+// viewer is an authorized caller reading the images of a room.
+type viewer struct {
+	userID uuid.UUID
+	// game is nil when the room has no game yet.
+	game *game.Game
+}
+
+// authorizeViewer checks that the caller is the room admin, or a player or spectator
+// of the room's game.
+func (s *Service) authorizeViewer(ctx context.Context, roomID uuid.UUID) (*viewer, error) {
+	userID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	err = s.ensureRoomExists(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := s.roomRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Errorf("failed to get room: %w", err),
+		)
+	}
+
+	exists, err := s.gameRepo.Exists(ctx, roomID)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Errorf("failed to check game existence: %w", err),
+		)
+	}
+
+	if !exists {
+		if !room.IsAdmin(userID) {
+			return nil, connect.NewError(connect.CodePermissionDenied, ErrNotRoomMember)
+		}
+
+		return &viewer{userID: userID, game: nil}, nil
+	}
+
+	gameSession, err := s.gameRepo.Get(ctx, roomID)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Errorf("failed to get game: %w", err),
+		)
+	}
+
+	_, err = gameSession.GetPlayer(userID)
+	if err != nil && !gameSession.IsSpectator(userID) && !room.IsAdmin(userID) {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrNotRoomMember)
+	}
+
+	return &viewer{userID: userID, game: gameSession}, nil
+}
+
+// canSee reports whether the viewer can see an image of the room.
+// Photos of a round are hidden from everyone but their uploader and the round's
+// game master until the round reveals them.
+func (v *viewer) canSee(metadata *domainimage.Metadata) bool {
+	if metadata.UploaderID == v.userID {
+		return true
+	}
+
+	switch metadata.Role {
+	case domainimage.RoleUpload:
+		return true
+	case domainimage.RoleGameMaster, domainimage.RoleHunter:
+		return v.game != nil && v.game.CanSeeRoundPhoto(v.userID, metadata.RoundNumber)
+	case domainimage.RoleScene:
+		return v.game != nil && v.game.CanSeeScene(v.userID, metadata.ID.String())
+	default:
+		return true
+	}
+}
+
+// visible returns the images the viewer can see, in order.
+func (v *viewer) visible(entries []*domainimage.Metadata) []*domainimage.Metadata {
+	images := make([]*domainimage.Metadata, 0, len(entries))
+
+	for _, metadata := range entries {
+		if v.canSee(metadata) {
+			images = append(images, metadata)
+		}
+	}
+
+	return images
+}
@@ -0,0 +1,224 @@
+package image_test
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	domainroom "github.com/yashikota/scene-hunter/server/internal/domain/room"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+)
+
+// huntingRoom is a room whose game is in the hunters' turn of its first round.
+type huntingRoom struct {
+	svc          *image.Service
+	roomID       uuid.UUID
+	gameMasterID uuid.UUID
+	hunterID     uuid.UUID
+	// photo is the game master's photo of the round.
+	photo *domainimage.Metadata
+}
+
+// newHuntingRoom stores a room with a game of three players in the hunters' turn,
+// using miniredis and an in-memory blob.
+func newHuntingRoom(t *testing.T) *huntingRoom {
+	t.Helper()
+
+	ctx := t.Context()
+	kvsClient := testutil.NewKVS(t)
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+	svc, catalog := newService(testutil.NewMemoryBlob(), kvsClient, roomRepo)
+
+	gameMasterID := uuid.New()
+	room := domainroom.NewRoom("123456", gameMasterID)
+
+	err := roomRepo.Create(ctx, room)
+	if err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+
+	gameSession, err := game.NewGame(room.ID, 1, gameMasterID, game.HintSettings{
+		Language:   game.DefaultLanguage,
+		Difficulty: game.DefaultDifficulty,
+	}, game.DefaultGameSettings(), false)
+	if err != nil {
+		t.Fatalf("NewGame() failed: %v", err)
+	}
+
+	for index, playerID := range []uuid.UUID{gameMasterID, uuid.New(), uuid.New()} {
+		player, err := game.NewPlayer(playerID, "player", index == 0, index == 0)
+		if err != nil {
+			t.Fatalf("NewPlayer() failed: %v", err)
+		}
+
+		err = gameSession.AddPlayer(player)
+		if err != nil {
+			t.Fatalf("AddPlayer() failed: %v", err)
+		}
+	}
+
+	err = gameSession.Start(gameMasterID)
+	if err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+
+	err = gameSession.StartRound(gameMasterID)
+	if err != nil {
+		t.Fatalf("StartRound() failed: %v", err)
+	}
+
+	photo, err := catalog.Store(ctx, image.Upload{
+		RoomID:      room.ID,
+		ContentType: "",
+		Data:        testJPEG(t),
+		UploaderID:  gameMasterID,
+		RoundNumber: 1,
+		Role:        domainimage.RoleGameMaster,
+		TTL:         time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Store() failed: %v", err)
+	}
+
+	round := gameSession.Rounds[0]
+
+	err = round.StartHintGeneration(photo.ID.String())
+	if err != nil {
+		t.Fatalf("StartHintGeneration() failed: %v", err)
+	}
+
+	err = round.StartHintReview([]*game.Hint{{HintNumber: 1, Text: "hint"}})
+	if err != nil {
+		t.Fatalf("StartHintReview() failed: %v", err)
+	}
+
+	err = round.ReleaseHints()
+	if err != nil {
+		t.Fatalf("ReleaseHints() failed: %v", err)
+	}
+
+	err = gameRepo.Create(ctx, gameSession)
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	return &huntingRoom{
+		svc:          svc,
+		roomID:       room.ID,
+		gameMasterID: gameMasterID,
+		hunterID:     gameSession.Players[1].UserID,
+		photo:        photo,
+	}
+}
+
+// errorCode returns the Connect code of err, or 0 when err is nil.
+func errorCode(err error) connect.Code {
+	if err == nil {
+		return 0
+	}
+
+	return connect.CodeOf(err)
+}
+
+// wantCode が0の場合は成功を期待する.
+func TestGetImage_HiddenGameMasterPhoto(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		viewer       func(env *huntingRoom) uuid.UUID
+		wantCode     connect.Code
+		wantListCode connect.Code
+		wantListed   int
+	}{
+		"game master": {
+			func(env *huntingRoom) uuid.UUID { return env.gameMasterID },
+			0,
+			0,
+			1,
+		},
+		"hunter": {
+			func(env *huntingRoom) uuid.UUID { return env.hunterID },
+			connect.CodePermissionDenied,
+			0,
+			0,
+		},
+		"outsider": {
+			func(*huntingRoom) uuid.UUID { return uuid.New() },
+			connect.CodePermissionDenied,
+			connect.CodePermissionDenied,
+			0,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newHuntingRoom(t)
+			ctx := authenticated(t.Context(), testCase.viewer(env))
+
+			_, err := env.svc.GetImage(ctx, &scene_hunterv1.GetImageRequest{
+				RoomId:  env.roomID.String(),
+				ImageId: env.photo.ID.String(),
+			})
+			if errorCode(err) != testCase.wantCode {
+				t.Errorf("GetImage() error = %v, want code %v", err, testCase.wantCode)
+			}
+
+			_, err = env.svc.CreateDownloadURL(ctx, &scene_hunterv1.CreateDownloadURLRequest{
+				RoomId:  env.roomID.String(),
+				ImageId: env.photo.ID.String(),
+			})
+			if errorCode(err) != testCase.wantCode {
+				t.Errorf("CreateDownloadURL() error = %v, want code %v", err, testCase.wantCode)
+			}
+
+			listResp, err := env.svc.ListImages(ctx, &scene_hunterv1.ListImagesRequest{
+				RoomId: env.roomID.String(),
+			})
+			if errorCode(err) != testCase.wantListCode ||
+				len(listResp.GetImages()) != testCase.wantListed {
+				t.Errorf("ListImages() = %d images, %v, want %d images, code %v",
+					len(listResp.GetImages()), err, testCase.wantListed, testCase.wantListCode)
+			}
+
+			thumbnailsResp, err := env.svc.ListImageThumbnails(
+				ctx,
+				&scene_hunterv1.ListImageThumbnailsRequest{RoomId: env.roomID.String()},
+			)
+			if errorCode(err) != testCase.wantListCode ||
+				len(thumbnailsResp.GetThumbnails()) != testCase.wantListed {
+				t.Errorf(
+					"ListImageThumbnails() = %d thumbnails, %v, want %d thumbnails, code %v",
+					len(
+						thumbnailsResp.GetThumbnails(),
+					),
+					err,
+					testCase.wantListed,
+					testCase.wantListCode,
+				)
+			}
+		})
+	}
+}
+
+func TestListImages_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	env := newHuntingRoom(t)
+
+	_, err := env.svc.ListImages(t.Context(), &scene_hunterv1.ListImagesRequest{
+		RoomId: env.roomID.String(),
+	})
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("ListImages() error = %v, want %v", err, connect.CodeUnauthenticated)
+	}
+}
@@ -0,0 +1,219 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrInvalidImage is returned when uploaded data is not a supported image.
+var ErrInvalidImage = errors.New("invalid image")
+
+// Upload describes an image to store in the catalog.
+type Upload struct {
+	RoomID uuid.UUID
+	// ContentType is detected from Data when empty.
+	ContentType string
+	Data        []byte
+	// UploaderID is uuid.Nil when the uploader is unknown.
+	UploaderID uuid.UUID
+	// RoundNumber is 0 for images uploaded outside of a round.
+	RoundNumber int
+	Role        domainimage.Role
+	TTL         time.Duration
+}
+
+// CatalogOptions configures the image catalog.
+type CatalogOptions struct {
+	Normalize domainimage.NormalizeOptions
+	// PresignedURLTTL is how long presigned upload and download URLs stay valid.
+	PresignedURLTTL time.Duration
+	// ThumbnailWorkers is how many thumbnails the server reads or generates at once.
+	ThumbnailWorkers int
+}
+
+// Catalog stores images in blob storage under a single path scheme
+// and records their metadata in the image catalog.
+// Every image service, including game photos, goes through the catalog.
+type Catalog struct {
+	blobClient service.Blob
+	imageRepo  service.ImageRepository
+	uploadRepo service.UploadRepository
+	options    CatalogOptions
+	// thumbnailSlots bounds thumbnail generation across all requests,
+	// so that a large room cannot take every CPU of the server.
+	thumbnailSlots chan struct{}
+}
+
+// NewCatalog creates a new image catalog.
+func NewCatalog(
+	blobClient service.Blob,
+	imageRepo service.ImageRepository,
+	uploadRepo service.UploadRepository,
+	options CatalogOptions,
+) *Catalog {
+	options.ThumbnailWorkers = max(options.ThumbnailWorkers, 1)
+
+	return &Catalog{
+		blobClient:     blobClient,
+		imageRepo:      imageRepo,
+		uploadRepo:     uploadRepo,
+		options:        options,
+		thumbnailSlots: make(chan struct{}, options.ThumbnailWorkers),
+	}
+}
+
+// Store normalizes an uploaded image, stores it with its thumbnails and records it in the
+// catalog. The returned metadata carries the GPS position of the upload, which is not stored.
+func (c *Catalog) Store(ctx context.Context, upload Upload) (*domainimage.Metadata, error) {
+	contentType := upload.ContentType
+	if contentType == "" {
+		contentType = http.DetectContentType(upload.Data)
+	}
+
+	img, err := domainimage.NewImage(upload.RoomID.String(), contentType, upload.Data)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", ErrInvalidImage, err)
+	}
+
+	// 回転補正・メタデータ除去・縮小を行い、サムネイルを生成
+	normalized, err := domainimage.Normalize(img, c.options.Normalize)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", ErrInvalidImage, err)
+	}
+
+	metadata := domainimage.NewMetadata(
+		upload.RoomID,
+		normalized.Image,
+		upload.UploaderID,
+		upload.RoundNumber,
+		upload.Role,
+		time.Now().UTC(),
+	)
+
+	// 位置情報は正規化で除去されるため、元の画像から読み取る
+	if gps, ok := domainimage.ReadGPS(upload.Data); ok {
+		metadata.GPS = &gps
+	}
+
+	err = c.blobClient.Put(ctx, metadata.Path, normalized.Image.Reader(), upload.TTL)
+	if err != nil {
+		return nil, errors.Errorf("failed to save image: %w", err)
+	}
+
+	// サムネイルは全サイズを元画像と同じ場所に保存
+	for _, size := range domainimage.ThumbnailSizes() {
+		err = c.blobClient.Put(
+			ctx,
+			metadata.ThumbnailPath(size),
+			bytes.NewReader(normalized.Thumbnails[size]),
+			upload.TTL,
+		)
+		if err != nil {
+			return nil, errors.Errorf("failed to save %s thumbnail: %w", size, err)
+		}
+	}
+
+	err = c.imageRepo.Save(ctx, metadata, upload.TTL)
+	if err != nil {
+		return nil, errors.Errorf("failed to save image metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// Get returns the catalog entry of an image.
+func (c *Catalog) Get(
+	ctx context.Context,
+	roomID, imageID uuid.UUID,
+) (*domainimage.Metadata, error) {
+	metadata, err := c.imageRepo.Get(ctx, roomID, imageID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, errors.Errorf("%w: id=%s", ErrImageNotFound, imageID)
+		}
+
+		return nil, errors.Errorf("failed to get image metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// List returns the catalog entries of all images in a room, oldest first.
+func (c *Catalog) List(ctx context.Context, roomID uuid.UUID) ([]*domainimage.Metadata, error) {
+	images, err := c.imageRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		return nil, errors.Errorf("failed to list image metadata: %w", err)
+	}
+
+	domainimage.SortImages(images)
+
+	return images, nil
+}
+
+// ListByUploader returns the catalog entries of all images the given user uploaded,
+// in any room.
+func (c *Catalog) ListByUploader(
+	ctx context.Context,
+	uploaderID uuid.UUID,
+) ([]*domainimage.Metadata, error) {
+	images, err := c.imageRepo.ListByUploader(ctx, uploaderID)
+	if err != nil {
+		return nil, errors.Errorf("failed to list image metadata: %w", err)
+	}
+
+	return images, nil
+}
+
+// Read returns the data of an image.
+func (c *Catalog) Read(ctx context.Context, metadata *domainimage.Metadata) ([]byte, error) {
+	return c.read(ctx, metadata.Path)
+}
+
+// Delete removes an image, its thumbnails and its catalog entry.
+func (c *Catalog) Delete(ctx context.Context, metadata *domainimage.Metadata) error {
+	err := c.blobClient.Delete(ctx, metadata.Path)
+	if err != nil {
+		return errors.Errorf("failed to delete image: %w", err)
+	}
+
+	for _, size := range domainimage.ThumbnailSizes() {
+		err = c.blobClient.Delete(ctx, metadata.ThumbnailPath(size))
+		if err != nil {
+			return errors.Errorf("failed to delete %s thumbnail: %w", size, err)
+		}
+	}
+
+	err = c.imageRepo.Delete(ctx, metadata)
+	if err != nil {
+		return errors.Errorf("failed to delete image metadata: %w", err)
+	}
+
+	return nil
+}
+
+// read reads an object from blob storage.
+func (c *Catalog) read(ctx context.Context, path string) ([]byte, error) {
+	reader, err := c.blobClient.Get(ctx, path)
+	if err != nil {
+		return nil, errors.Errorf("failed to get object: %w", err)
+	}
+
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
@@ -3,6 +3,12 @@ package image_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	goimage "image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -11,18 +17,74 @@ import (
 	"github.com/testcontainers/testcontainers-go/modules/minio"
 	"github.com/testcontainers/testcontainers-go/modules/valkey"
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1/scene_hunterv1connect"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
 	domainroom "github.com/yashikota/scene-hunter/server/internal/domain/room"
 	infrablob "github.com/yashikota/scene-hunter/server/internal/infra/blob"
 	infrakvs "github.com/yashikota/scene-hunter/server/internal/infra/kvs"
 	"github.com/yashikota/scene-hunter/server/internal/repository"
 	"github.com/yashikota/scene-hunter/server/internal/service"
 	"github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // 以下のテストはサービス層の統合テストであり、各テストが異なるシナリオを検証するため、
 // テーブル駆動テストではなく個別の関数として実装している。
 
+// catalogOptions はテスト用の画像カタログ設定を返す.
+func catalogOptions() image.CatalogOptions {
+	return image.CatalogOptions{
+		Normalize: domainimage.NormalizeOptions{
+			MaxDimension: 2048,
+			Quality:      85,
+			ThumbnailDimensions: domainimage.ThumbnailDimensions{
+				Small:  16,
+				Medium: 32,
+				Large:  64,
+			},
+			ThumbnailQuality: 75,
+			MaxPixels:        4_000_000,
+		},
+		PresignedURLTTL:  15 * time.Minute,
+		ThumbnailWorkers: 2,
+	}
+}
+
+// newService はテスト用の画像カタログを使うサービスを作成する.
+func newService(
+	blobClient service.Blob,
+	kvsClient service.KVS,
+	roomRepo service.RoomRepository,
+) (*image.Service, *image.Catalog) {
+	catalog := image.NewCatalog(
+		blobClient,
+		repository.NewImageRepository(kvsClient),
+		repository.NewUploadRepository(kvsClient),
+		catalogOptions(),
+	)
+
+	gameRepo := repository.NewGameRepository(kvsClient, chrono.New())
+
+	return image.NewService(catalog, kvsClient, roomRepo, gameRepo), catalog
+}
+
+// testJPEG はテスト用のJPEG画像を返す.
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	err := jpeg.Encode(&buf, goimage.NewRGBA(goimage.Rect(0, 0, 64, 48)), nil)
+	if err != nil {
+		t.Fatalf("failed to encode JPEG: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
 // setupMinio はテスト用のMinIOコンテナをセットアップする.
 func setupMinio(ctx context.Context, t *testing.T) (service.Blob, func()) {
 	t.Helper()
@@ -41,6 +103,7 @@ func setupMinio(ctx context.Context, t *testing.T) (service.Blob, func()) {
 	for range 10 {
 		client, err = infrablob.NewClient(
 			connString,
+			"",
 			"minioadmin",
 			"minioadmin",
 			"test-bucket",
@@ -129,26 +192,32 @@ func TestGetImage_Success(t *testing.T) {
 	adminID := uuid.New()
 	room := domainroom.NewRoom("123456", adminID)
 	room.ID = roomID
+	ctx = authenticated(ctx, adminID)
 
 	err := roomRepo.Create(ctx, room)
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
 
-	imageID := uuid.New()
-	imageData := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 0x4A, 0x46, 0x49, 0x46, 0x00, 0x01}
-	imagePath := "game/" + roomID.String() + "/" + imageID.String() + ".jpg"
-
-	err = blobClient.Put(ctx, imagePath, bytes.NewReader(imageData), 0)
+	// サービスの作成とテスト
+	svc, catalog := newService(blobClient, kvsClient, roomRepo)
+
+	metadata, err := catalog.Store(ctx, image.Upload{
+		RoomID:      roomID,
+		ContentType: "",
+		Data:        testJPEG(t),
+		UploaderID:  adminID,
+		RoundNumber: 1,
+		Role:        domainimage.RoleGameMaster,
+		TTL:         time.Hour,
+	})
 	if err != nil {
-		t.Fatalf("failed to put image: %v", err)
+		t.Fatalf("Store() failed: %v", err)
 	}
 
-	// サービスの作成とテスト
-	svc := image.NewService(blobClient, kvsClient, roomRepo)
 	req := &scene_hunterv1.GetImageRequest{
 		RoomId:  roomID.String(),
-		ImageId: imageID.String(),
+		ImageId: metadata.ID.String(),
 	}
 
 	resp, err := svc.GetImage(ctx, req)
@@ -156,12 +225,16 @@ func TestGetImage_Success(t *testing.T) {
 		t.Fatalf("GetImage() failed: %v", err)
 	}
 
-	if resp.GetContentType() != "image/jpeg" {
-		t.Errorf("GetImage() content_type = %s, want %s", resp.GetContentType(), "image/jpeg")
+	if resp.GetContentType() != domainimage.NormalizedContentType {
+		t.Errorf(
+			"GetImage() content_type = %s, want %s",
+			resp.GetContentType(),
+			domainimage.NormalizedContentType,
+		)
 	}
 
-	if !bytes.Equal(resp.GetImageData(), imageData) {
-		t.Error("GetImage() returned different image data")
+	if int64(len(resp.GetImageData())) != metadata.Size {
+		t.Errorf("GetImage() returned %d bytes, want %d", len(resp.GetImageData()), metadata.Size)
 	}
 }
 
@@ -169,7 +242,7 @@ func TestGetImage_Success(t *testing.T) {
 func TestGetImage_RoomNotFound(t *testing.T) {
 	t.Parallel()
 
-	ctx := context.Background()
+	ctx := authenticated(context.Background(), uuid.New())
 
 	blobClient, blobCleanup := setupMinio(ctx, t)
 	defer blobCleanup()
@@ -179,7 +252,7 @@ func TestGetImage_RoomNotFound(t *testing.T) {
 
 	roomRepo := repository.NewRoomRepository(kvsClient)
 
-	svc := image.NewService(blobClient, kvsClient, roomRepo)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
 	req := &scene_hunterv1.GetImageRequest{
 		RoomId:  uuid.New().String(),
 		ImageId: uuid.New().String(),
@@ -219,13 +292,14 @@ func TestGetImage_ImageNotFound(t *testing.T) {
 	adminID := uuid.New()
 	room := domainroom.NewRoom("123456", adminID)
 	room.ID = roomID
+	ctx = authenticated(ctx, adminID)
 
 	err := roomRepo.Create(ctx, room)
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
 
-	svc := image.NewService(blobClient, kvsClient, roomRepo)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
 	req := &scene_hunterv1.GetImageRequest{
 		RoomId:  roomID.String(),
 		ImageId: uuid.New().String(),
@@ -265,27 +339,33 @@ func TestListImages_Success(t *testing.T) {
 	adminID := uuid.New()
 	room := domainroom.NewRoom("123456", adminID)
 	room.ID = roomID
+	ctx = authenticated(ctx, adminID)
 
 	err := roomRepo.Create(ctx, room)
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
 
-	// 複数の画像を保存
-	imageIDs := []uuid.UUID{uuid.New(), uuid.New()}
-
-	for _, imageID := range imageIDs {
-		imagePath := "game/" + roomID.String() + "/" + imageID.String() + ".jpg"
-		imageData := []byte{0xFF, 0xD8, 0xFF, 0xE0}
-
-		err = blobClient.Put(ctx, imagePath, bytes.NewReader(imageData), 0)
+	// ゲーム外のアップロードとハンターの写真を保存
+	svc, catalog := newService(blobClient, kvsClient, roomRepo)
+
+	roles := []domainimage.Role{domainimage.RoleUpload, domainimage.RoleHunter}
+
+	for roundNumber, role := range roles {
+		_, err = catalog.Store(ctx, image.Upload{
+			RoomID:      roomID,
+			ContentType: domainimage.NormalizedContentType,
+			Data:        testJPEG(t),
+			UploaderID:  adminID,
+			RoundNumber: roundNumber,
+			Role:        role,
+			TTL:         time.Hour,
+		})
 		if err != nil {
-			t.Fatalf("failed to put image: %v", err)
+			t.Fatalf("Store() failed: %v", err)
 		}
 	}
 
-	// サービスの作成とテスト
-	svc := image.NewService(blobClient, kvsClient, roomRepo)
 	req := &scene_hunterv1.ListImagesRequest{
 		RoomId: roomID.String(),
 	}
@@ -295,11 +375,36 @@ func TestListImages_Success(t *testing.T) {
 		t.Fatalf("ListImages() failed: %v", err)
 	}
 
-	if len(resp.GetImages()) != len(imageIDs) {
-		t.Errorf("ListImages() returned %d images, want %d", len(resp.GetImages()), len(imageIDs))
+	if len(resp.GetImages()) != len(roles) {
+		t.Fatalf("ListImages() returned %d images, want %d", len(resp.GetImages()), len(roles))
 	}
 
-	for _, img := range resp.GetImages() {
+	wantRoles := []scene_hunterv1.ImageRole{
+		scene_hunterv1.ImageRole_IMAGE_ROLE_UPLOAD,
+		scene_hunterv1.ImageRole_IMAGE_ROLE_HUNTER,
+	}
+
+	for index, img := range resp.GetImages() {
+		if img.GetRole() != wantRoles[index] {
+			t.Errorf("ListImages() role = %v, want %v", img.GetRole(), wantRoles[index])
+		}
+
+		if img.GetRoundNumber() != int32(index) {
+			t.Errorf("ListImages() round number = %d, want %d", img.GetRoundNumber(), index)
+		}
+
+		if img.GetUploaderId() != adminID.String() {
+			t.Errorf("ListImages() uploader = %s, want %s", img.GetUploaderId(), adminID)
+		}
+
+		if img.GetContentType() != domainimage.NormalizedContentType {
+			t.Errorf(
+				"ListImages() content_type = %s, want %s",
+				img.GetContentType(),
+				domainimage.NormalizedContentType,
+			)
+		}
+
 		if img.GetImageId() == "" {
 			t.Error("ListImages() returned image with empty ID")
 		}
@@ -337,13 +442,14 @@ func TestListImages_EmptyResult(t *testing.T) {
 	adminID := uuid.New()
 	room := domainroom.NewRoom("123456", adminID)
 	room.ID = roomID
+	ctx = authenticated(ctx, adminID)
 
 	err := roomRepo.Create(ctx, room)
 	if err != nil {
 		t.Fatalf("failed to create room: %v", err)
 	}
 
-	svc := image.NewService(blobClient, kvsClient, roomRepo)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
 	req := &scene_hunterv1.ListImagesRequest{
 		RoomId: roomID.String(),
 	}
@@ -362,7 +468,7 @@ func TestListImages_EmptyResult(t *testing.T) {
 func TestListImages_RoomNotFound(t *testing.T) {
 	t.Parallel()
 
-	ctx := context.Background()
+	ctx := authenticated(context.Background(), uuid.New())
 
 	blobClient, blobCleanup := setupMinio(ctx, t)
 	defer blobCleanup()
@@ -372,7 +478,7 @@ func TestListImages_RoomNotFound(t *testing.T) {
 
 	roomRepo := repository.NewRoomRepository(kvsClient)
 
-	svc := image.NewService(blobClient, kvsClient, roomRepo)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
 	req := &scene_hunterv1.ListImagesRequest{
 		RoomId: uuid.New().String(),
 	}
@@ -391,3 +497,691 @@ func TestListImages_RoomNotFound(t *testing.T) {
 		t.Errorf("ListImages() error code = %v, want %v", connectErr.Code(), connect.CodeNotFound)
 	}
 }
+
+// createTestRoom はテスト用のルームを作成してIDを返す.
+func createTestRoom(
+	ctx context.Context,
+	t *testing.T,
+	roomRepo service.RoomRepository,
+	code string,
+	adminID uuid.UUID,
+) uuid.UUID {
+	t.Helper()
+
+	room := domainroom.NewRoom(code, adminID)
+
+	err := roomRepo.Create(ctx, room)
+	if err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+
+	return room.ID
+}
+
+// authenticated returns a context authenticated as userID.
+func authenticated(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, middleware.AnonIDContextKey, userID.String())
+}
+
+// putPresigned は署名付きURLにデータをアップロードする.
+func putPresigned(ctx context.Context, t *testing.T, uploadURL string, data []byte) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to upload to presigned URL: %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("presigned upload status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+// assertCode はエラーが指定したConnectのコードを持つことを検証する.
+func assertCode(t *testing.T, err error, want connect.Code, method string) {
+	t.Helper()
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		t.Fatalf("%s() error = %v, want connect.Error", method, err)
+	}
+
+	if connectErr.Code() != want {
+		t.Errorf("%s() error code = %v, want %v", method, connectErr.Code(), want)
+	}
+}
+
+// TestCompleteUpload_Success は署名付きURLへのアップロードが画像として保存されることをテストする.
+func TestCompleteUpload_Success(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	roomID := createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
+
+	uploadResp, err := svc.CreateUploadURL(ctx, &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: domainimage.NormalizedContentType,
+	})
+	if err != nil {
+		t.Fatalf("CreateUploadURL() failed: %v", err)
+	}
+
+	if uploadResp.GetMaxSize() != domainimage.MaxImageSize {
+		t.Errorf(
+			"CreateUploadURL() max_size = %d, want %d",
+			uploadResp.GetMaxSize(),
+			domainimage.MaxImageSize,
+		)
+	}
+
+	putPresigned(ctx, t, uploadResp.GetUploadUrl(), testJPEG(t))
+
+	completeResp, err := svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	if err != nil {
+		t.Fatalf("CompleteUpload() failed: %v", err)
+	}
+
+	// 保存された画像は通常のアップロードと同様に取得できる
+	_, err = svc.GetImage(ctx, &scene_hunterv1.GetImageRequest{
+		RoomId:  roomID.String(),
+		ImageId: completeResp.GetImageId(),
+	})
+	if err != nil {
+		t.Fatalf("GetImage() failed: %v", err)
+	}
+
+	// 一時オブジェクトは削除され、同じアップロードは二度完了できない
+	_, err = svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	assertCode(t, err, connect.CodeNotFound, "CompleteUpload")
+}
+
+// TestCompleteUpload_InvalidImage は宣言と異なる形式のデータが拒否されることをテストする.
+func TestCompleteUpload_InvalidImage(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
+
+	uploadResp, err := svc.CreateUploadURL(ctx, &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: "image/png",
+	})
+	if err != nil {
+		t.Fatalf("CreateUploadURL() failed: %v", err)
+	}
+
+	// PNGとして宣言したアップロードにJPEGを送る
+	putPresigned(ctx, t, uploadResp.GetUploadUrl(), testJPEG(t))
+
+	_, err = svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	assertCode(t, err, connect.CodeInvalidArgument, "CompleteUpload")
+}
+
+// TestCompleteUpload_NotReceived は画像が送られる前に完了した場合のエラーをテストする.
+func TestCompleteUpload_NotReceived(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
+
+	uploadResp, err := svc.CreateUploadURL(ctx, &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: domainimage.NormalizedContentType,
+	})
+	if err != nil {
+		t.Fatalf("CreateUploadURL() failed: %v", err)
+	}
+
+	_, err = svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	assertCode(t, err, connect.CodeFailedPrecondition, "CompleteUpload")
+}
+
+// TestCreateDownloadURL は署名付きURLから画像をダウンロードできることをテストする.
+func TestCreateDownloadURL(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	roomID := createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, catalog := newService(blobClient, kvsClient, roomRepo)
+
+	metadata, err := catalog.Store(ctx, image.Upload{
+		RoomID:      roomID,
+		ContentType: "",
+		Data:        testJPEG(t),
+		UploaderID:  uuid.Nil,
+		RoundNumber: 0,
+		Role:        domainimage.RoleUpload,
+		TTL:         time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Store() failed: %v", err)
+	}
+
+	resp, err := svc.CreateDownloadURL(ctx, &scene_hunterv1.CreateDownloadURLRequest{
+		RoomId:  roomID.String(),
+		ImageId: metadata.ID.String(),
+	})
+	if err != nil {
+		t.Fatalf("CreateDownloadURL() failed: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resp.GetDownloadUrl(), nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	downloadResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to download from presigned URL: %v", err)
+	}
+
+	defer func() {
+		_ = downloadResp.Body.Close()
+	}()
+
+	data, err := io.ReadAll(downloadResp.Body)
+	if err != nil {
+		t.Fatalf("failed to read downloaded image: %v", err)
+	}
+
+	if int64(len(data)) != metadata.Size {
+		t.Errorf("downloaded %d bytes, want %d", len(data), metadata.Size)
+	}
+
+	// 存在しない画像のURLは発行しない
+	_, err = svc.CreateDownloadURL(ctx, &scene_hunterv1.CreateDownloadURLRequest{
+		RoomId:  roomID.String(),
+		ImageId: uuid.New().String(),
+	})
+	assertCode(t, err, connect.CodeNotFound, "CreateDownloadURL")
+}
+
+// streamImageService serves the streaming RPCs of the image service for tests.
+type streamImageService struct {
+	scene_hunterv1connect.UnimplementedImageServiceHandler
+
+	svc *image.Service
+	// userID is the user the requests are authenticated as.
+	userID uuid.UUID
+}
+
+func (s *streamImageService) UploadPhotoStream(
+	ctx context.Context,
+	stream *connect.ClientStream[scene_hunterv1.UploadPhotoStreamRequest],
+) (*scene_hunterv1.UploadPhotoStreamResponse, error) {
+	ctx = authenticated(ctx, s.userID)
+
+	return s.svc.UploadPhotoStream(ctx, stream) //nolint:wrapcheck // test adapter
+}
+
+func (s *streamImageService) StreamImageThumbnails(
+	ctx context.Context,
+	req *scene_hunterv1.StreamImageThumbnailsRequest,
+	stream *connect.ServerStream[scene_hunterv1.StreamImageThumbnailsResponse],
+) error {
+	ctx = authenticated(ctx, s.userID)
+
+	return s.svc.StreamImageThumbnails(ctx, req, stream) //nolint:wrapcheck // test adapter
+}
+
+// setupStreamClient starts the streaming RPCs of svc, authenticated as userID,
+// and returns a client for them.
+func setupStreamClient(
+	t *testing.T,
+	svc *image.Service,
+	userID uuid.UUID,
+) scene_hunterv1connect.ImageServiceClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(scene_hunterv1connect.NewImageServiceHandler(&streamImageService{
+		UnimplementedImageServiceHandler: scene_hunterv1connect.UnimplementedImageServiceHandler{},
+		svc:                              svc,
+		userID:                           userID,
+	}))
+
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return scene_hunterv1connect.NewImageServiceClient(server.Client(), server.URL)
+}
+
+// sendChunks sends an upload stream with the given chunks and an optional checksum.
+func sendChunks(
+	ctx context.Context,
+	t *testing.T,
+	client scene_hunterv1connect.ImageServiceClient,
+	uploadID string,
+	offset int64,
+	chunks [][]byte,
+	checksum []byte,
+) (*scene_hunterv1.UploadPhotoStreamResponse, error) {
+	t.Helper()
+
+	stream, err := client.UploadPhotoStream(ctx)
+	if err != nil {
+		t.Fatalf("UploadPhotoStream() failed: %v", err)
+	}
+
+	messages := []*scene_hunterv1.UploadPhotoStreamRequest{{
+		Message: &scene_hunterv1.UploadPhotoStreamRequest_Header{
+			Header: &scene_hunterv1.UploadPhotoStreamHeader{UploadId: uploadID, Offset: offset},
+		},
+	}}
+
+	for _, chunk := range chunks {
+		messages = append(messages, &scene_hunterv1.UploadPhotoStreamRequest{
+			Message: &scene_hunterv1.UploadPhotoStreamRequest_Chunk{Chunk: chunk},
+		})
+	}
+
+	if checksum != nil {
+		messages = append(messages, &scene_hunterv1.UploadPhotoStreamRequest{
+			Message: &scene_hunterv1.UploadPhotoStreamRequest_Finish{
+				Finish: &scene_hunterv1.UploadPhotoStreamFinish{Sha256: checksum},
+			},
+		})
+	}
+
+	for _, msg := range messages {
+		// 送信エラーはCloseAndReceiveで返される
+		if stream.Send(msg) != nil {
+			break
+		}
+	}
+
+	return stream.CloseAndReceive() //nolint:wrapcheck // test helper
+}
+
+// TestUploadPhotoStream_Resume は中断したアップロードを再開して完了できることをテストする.
+func TestUploadPhotoStream_Resume(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	roomID := createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
+	client := setupStreamClient(t, svc, adminID)
+
+	uploadResp, err := svc.CreateUploadURL(ctx, &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: domainimage.NormalizedContentType,
+	})
+	if err != nil {
+		t.Fatalf("CreateUploadURL() failed: %v", err)
+	}
+
+	data := testJPEG(t)
+	checksum := sha256.Sum256(data)
+	half := int64(len(data) / 2)
+
+	// 前半だけ送って中断
+	resp, err := sendChunks(ctx, t, client, uploadResp.GetUploadId(), 0, [][]byte{data[:half]}, nil)
+	if err != nil {
+		t.Fatalf("first UploadPhotoStream() failed: %v", err)
+	}
+
+	if resp.GetReceivedBytes() != half || resp.GetCompleted() {
+		t.Fatalf(
+			"first stream = (%d, %v), want (%d, false)",
+			resp.GetReceivedBytes(),
+			resp.GetCompleted(),
+			half,
+		)
+	}
+
+	status, err := svc.GetUploadStatus(ctx, &scene_hunterv1.GetUploadStatusRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	if err != nil {
+		t.Fatalf("GetUploadStatus() failed: %v", err)
+	}
+
+	if status.GetReceivedBytes() != half {
+		t.Errorf("GetUploadStatus() received_bytes = %d, want %d", status.GetReceivedBytes(), half)
+	}
+
+	// 誤ったオフセットからは再開できない
+	_, err = sendChunks(ctx, t, client, uploadResp.GetUploadId(), 0, [][]byte{data}, checksum[:])
+	assertCode(t, err, connect.CodeFailedPrecondition, "UploadPhotoStream")
+
+	// 残りを送って完了
+	resp, err = sendChunks(
+		ctx,
+		t,
+		client,
+		uploadResp.GetUploadId(),
+		half,
+		[][]byte{data[half:]},
+		checksum[:],
+	)
+	if err != nil {
+		t.Fatalf("second UploadPhotoStream() failed: %v", err)
+	}
+
+	if resp.GetReceivedBytes() != int64(len(data)) || !resp.GetCompleted() {
+		t.Fatalf(
+			"second stream = (%d, %v), want (%d, true)",
+			resp.GetReceivedBytes(),
+			resp.GetCompleted(),
+			len(data),
+		)
+	}
+
+	completeResp, err := svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	if err != nil {
+		t.Fatalf("CompleteUpload() failed: %v", err)
+	}
+
+	_, err = svc.GetImage(ctx, &scene_hunterv1.GetImageRequest{
+		RoomId:  roomID.String(),
+		ImageId: completeResp.GetImageId(),
+	})
+	if err != nil {
+		t.Fatalf("GetImage() failed: %v", err)
+	}
+}
+
+// TestUploadPhotoStream_ChecksumMismatch は破損したアップロードが拒否されることをテストする.
+func TestUploadPhotoStream_ChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, _ := newService(blobClient, kvsClient, roomRepo)
+	client := setupStreamClient(t, svc, adminID)
+
+	uploadResp, err := svc.CreateUploadURL(ctx, &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: domainimage.NormalizedContentType,
+	})
+	if err != nil {
+		t.Fatalf("CreateUploadURL() failed: %v", err)
+	}
+
+	checksum := sha256.Sum256([]byte("other data"))
+
+	_, err = sendChunks(
+		ctx,
+		t,
+		client,
+		uploadResp.GetUploadId(),
+		0,
+		[][]byte{testJPEG(t)},
+		checksum[:],
+	)
+	assertCode(t, err, connect.CodeInvalidArgument, "UploadPhotoStream")
+
+	// 破損したアップロードは破棄される
+	_, err = svc.GetUploadStatus(ctx, &scene_hunterv1.GetUploadStatusRequest{
+		UploadId: uploadResp.GetUploadId(),
+	})
+	assertCode(t, err, connect.CodeNotFound, "GetUploadStatus")
+}
+
+// storeTestImages は画像をcount枚保存し、保存順に返す.
+func storeTestImages(
+	ctx context.Context,
+	t *testing.T,
+	catalog *image.Catalog,
+	roomID uuid.UUID,
+	count int,
+) []*domainimage.Metadata {
+	t.Helper()
+
+	images := make([]*domainimage.Metadata, 0, count)
+
+	for range count {
+		metadata, err := catalog.Store(ctx, image.Upload{
+			RoomID:      roomID,
+			ContentType: domainimage.NormalizedContentType,
+			Data:        testJPEG(t),
+			UploaderID:  uuid.Nil,
+			RoundNumber: 0,
+			Role:        domainimage.RoleUpload,
+			TTL:         time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("Store() failed: %v", err)
+		}
+
+		images = append(images, metadata)
+	}
+
+	return images
+}
+
+// TestListImageThumbnails_Pagination はサムネイルURLをページごとに取得できることをテストする.
+func TestListImageThumbnails_Pagination(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	roomID := createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, catalog := newService(blobClient, kvsClient, roomRepo)
+	images := storeTestImages(ctx, t, catalog, roomID, 3)
+
+	var got []string
+
+	pageToken := ""
+
+	for range images {
+		resp, err := svc.ListImageThumbnails(ctx, &scene_hunterv1.ListImageThumbnailsRequest{
+			RoomId:    roomID.String(),
+			Size:      scene_hunterv1.ThumbnailSize_THUMBNAIL_SIZE_SMALL,
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			t.Fatalf("ListImageThumbnails() failed: %v", err)
+		}
+
+		for _, thumbnail := range resp.GetThumbnails() {
+			if thumbnail.GetThumbnailUrl() == "" || thumbnail.GetUnavailable() {
+				t.Errorf("ListImageThumbnails() thumbnail %s has no URL", thumbnail.GetImageId())
+			}
+
+			got = append(got, thumbnail.GetImageId())
+		}
+
+		pageToken = resp.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	if len(got) != len(images) {
+		t.Fatalf("ListImageThumbnails() returned %d thumbnails, want %d", len(got), len(images))
+	}
+
+	for index, metadata := range images {
+		if got[index] != metadata.ID.String() {
+			t.Errorf(
+				"ListImageThumbnails() thumbnail %d = %s, want %s",
+				index,
+				got[index],
+				metadata.ID,
+			)
+		}
+	}
+
+	// sinceより後に保存された画像だけを返す
+	resp, err := svc.ListImageThumbnails(ctx, &scene_hunterv1.ListImageThumbnailsRequest{
+		RoomId: roomID.String(),
+		Since:  timestamppb.New(images[1].CreatedAt),
+	})
+	if err != nil {
+		t.Fatalf("ListImageThumbnails() failed: %v", err)
+	}
+
+	if len(resp.GetThumbnails()) != 1 ||
+		resp.GetThumbnails()[0].GetImageId() != images[2].ID.String() {
+		t.Errorf(
+			"ListImageThumbnails() since = %v, want only %s",
+			resp.GetThumbnails(),
+			images[2].ID,
+		)
+	}
+
+	_, err = svc.ListImageThumbnails(ctx, &scene_hunterv1.ListImageThumbnailsRequest{
+		RoomId:    roomID.String(),
+		PageToken: "invalid",
+	})
+	assertCode(t, err, connect.CodeInvalidArgument, "ListImageThumbnails")
+}
+
+// TestStreamImageThumbnails はサムネイルをストリームで受け取れること、
+// 保存されていないサムネイルが生成されることをテストする.
+func TestStreamImageThumbnails(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	ctx := authenticated(context.Background(), adminID)
+
+	blobClient, blobCleanup := setupMinio(ctx, t)
+	defer blobCleanup()
+
+	kvsClient, kvsCleanup := setupValkey(ctx, t)
+	defer kvsCleanup()
+
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	roomID := createTestRoom(ctx, t, roomRepo, "123456", adminID)
+	svc, catalog := newService(blobClient, kvsClient, roomRepo)
+	images := storeTestImages(ctx, t, catalog, roomID, 3)
+	client := setupStreamClient(t, svc, adminID)
+
+	// サイズが追加される前に保存された画像を想定してサムネイルを削除
+	missing := images[1].ThumbnailPath(domainimage.ThumbnailSizeLarge)
+
+	err := blobClient.Delete(ctx, missing)
+	if err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+
+	stream, err := client.StreamImageThumbnails(ctx, &scene_hunterv1.StreamImageThumbnailsRequest{
+		RoomId: roomID.String(),
+		Size:   scene_hunterv1.ThumbnailSize_THUMBNAIL_SIZE_LARGE,
+	})
+	if err != nil {
+		t.Fatalf("StreamImageThumbnails() failed: %v", err)
+	}
+
+	var got []string
+
+	for stream.Receive() {
+		thumbnail := stream.Msg().GetThumbnail()
+		if len(thumbnail.GetThumbnailData()) == 0 || thumbnail.GetUnavailable() {
+			t.Errorf("StreamImageThumbnails() thumbnail %s has no data", thumbnail.GetImageId())
+		}
+
+		got = append(got, thumbnail.GetImageId())
+	}
+
+	if stream.Err() != nil {
+		t.Fatalf("StreamImageThumbnails() failed: %v", stream.Err())
+	}
+
+	if len(got) != len(images) {
+		t.Fatalf("StreamImageThumbnails() returned %d thumbnails, want %d", len(got), len(images))
+	}
+
+	for index, metadata := range images {
+		if got[index] != metadata.ID.String() {
+			t.Errorf(
+				"StreamImageThumbnails() thumbnail %d = %s, want %s",
+				index,
+				got[index],
+				metadata.ID,
+			)
+		}
+	}
+
+	// 生成したサムネイルは保存され、次回以降は再生成されない
+	_, err = blobClient.Stat(ctx, missing)
+	if err != nil {
+		t.Errorf("Stat() of generated thumbnail failed: %v", err)
+	}
+}
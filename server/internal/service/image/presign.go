@@ -0,0 +1,325 @@
+package image
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrUploadNotFound is returned when a presigned upload does not exist or has expired.
+	ErrUploadNotFound = errors.New("upload not found")
+	// ErrUploadNotReceived is returned when the client has not sent the image to the upload URL.
+	ErrUploadNotReceived = errors.New("uploaded image not received")
+	// ErrNotUploader is returned when someone other than the uploader sends or completes
+	// an upload.
+	ErrNotUploader = errors.New("only the uploader can send the upload")
+)
+
+func (s *Service) CreateUploadURL(
+	ctx context.Context,
+	req *scene_hunterv1.CreateUploadURLRequest,
+) (*scene_hunterv1.CreateUploadURLResponse, error) {
+	roomID, err := s.roomIDFromCode(ctx, req.GetRoomCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// アップロードを完了できるのは作成したユーザーだけなので認証を必須にする
+	uploaderID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	upload, uploadURL, err := s.catalog.CreateUpload(ctx, roomID, uploaderID, req.GetContentType())
+	if err != nil {
+		if errors.Is(err, ErrInvalidImage) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &scene_hunterv1.CreateUploadURLResponse{
+		UploadId:  upload.ID.String(),
+		UploadUrl: uploadURL,
+		ExpiresAt: timestamppb.New(upload.ExpiresAt),
+		MaxSize:   domainimage.MaxImageSize,
+	}, nil
+}
+
+func (s *Service) CompleteUpload(
+	ctx context.Context,
+	req *scene_hunterv1.CompleteUploadRequest,
+) (*scene_hunterv1.CompleteUploadResponse, error) {
+	upload, err := s.authorizedUpload(ctx, req.GetUploadId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ensureRoomExists(ctx, upload.RoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.catalog.CompleteUpload(ctx, upload, 0, domainimage.RoleUpload, TTL)
+	if err != nil {
+		return nil, completeUploadError(err)
+	}
+
+	return &scene_hunterv1.CompleteUploadResponse{
+		ImageId:   metadata.ID.String(),
+		ImagePath: metadata.Path,
+	}, nil
+}
+
+func (s *Service) CreateDownloadURL(
+	ctx context.Context,
+	req *scene_hunterv1.CreateDownloadURLRequest,
+) (*scene_hunterv1.CreateDownloadURLResponse, error) {
+	roomID, err := uuid.Parse(req.GetRoomId())
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid room ID: %w", err),
+		)
+	}
+
+	imageID, err := uuid.Parse(req.GetImageId())
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid image ID: %w", err),
+		)
+	}
+
+	caller, err := s.authorizeViewer(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.catalog.Get(ctx, roomID, imageID)
+	if err != nil {
+		if errors.Is(err, ErrImageNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if !caller.canSee(metadata) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.Errorf("%w: id=%s", ErrImageHidden, imageID),
+		)
+	}
+
+	downloadURL, expiresAt, err := s.catalog.DownloadURL(ctx, metadata)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &scene_hunterv1.CreateDownloadURLResponse{
+		DownloadUrl: downloadURL,
+		ExpiresAt:   timestamppb.New(expiresAt),
+		ContentType: metadata.ContentType,
+	}, nil
+}
+
+// authorizeUploader checks that the caller created the upload.
+func authorizeUploader(ctx context.Context, upload *domainimage.PendingUpload) error {
+	userID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil || userID != upload.UploaderID {
+		return ErrNotUploader
+	}
+
+	return nil
+}
+
+// completeUploadError converts an error from Catalog.CompleteUpload to a Connect error.
+func completeUploadError(err error) error {
+	switch {
+	case errors.Is(err, ErrUploadNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrUploadInProgress):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, ErrUploadNotReceived):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, ErrInvalidImage):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// CreateUpload registers an upload into a room and returns the URL the client sends
+// the image to with HTTP PUT.
+func (c *Catalog) CreateUpload(
+	ctx context.Context,
+	roomID uuid.UUID,
+	uploaderID uuid.UUID,
+	contentType string,
+) (*domainimage.PendingUpload, string, error) {
+	ttl := c.options.PresignedURLTTL
+
+	upload, err := domainimage.NewPendingUpload(
+		roomID,
+		uploaderID,
+		contentType,
+		time.Now().UTC().Add(ttl),
+	)
+	if err != nil {
+		return nil, "", errors.Errorf("%w: %w", ErrInvalidImage, err)
+	}
+
+	uploadURL, err := c.blobClient.PresignPut(ctx, upload.StagingPath(), ttl)
+	if err != nil {
+		return nil, "", errors.Errorf("failed to create upload URL: %w", err)
+	}
+
+	err = c.uploadRepo.Save(ctx, upload, ttl)
+	if err != nil {
+		return nil, "", errors.Errorf("failed to save upload: %w", err)
+	}
+
+	return upload, uploadURL, nil
+}
+
+// GetUpload returns a pending upload.
+func (c *Catalog) GetUpload(
+	ctx context.Context,
+	uploadID uuid.UUID,
+) (*domainimage.PendingUpload, error) {
+	upload, err := c.uploadRepo.Get(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, errors.Errorf("%w: id=%s", ErrUploadNotFound, uploadID)
+		}
+
+		return nil, errors.Errorf("failed to get upload: %w", err)
+	}
+
+	return upload, nil
+}
+
+// CompleteUpload validates the object sent to the upload URL and stores it as an image
+// of the given round. The staged object is removed once it has been stored or rejected.
+// It fails with ErrUploadInProgress while a stream is sending chunks to the upload.
+func (c *Catalog) CompleteUpload(
+	ctx context.Context,
+	upload *domainimage.PendingUpload,
+	roundNumber int,
+	role domainimage.Role,
+	ttl time.Duration,
+) (*domainimage.Metadata, error) {
+	unlock, err := c.LockUpload(ctx, upload.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// ロック取得前に別のリクエストが完了または書き込んだ可能性があるため再取得
+	upload, err = c.GetUpload(ctx, upload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	stagingPath := upload.StagingPath()
+
+	info, err := c.blobClient.Stat(ctx, stagingPath)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil, errors.Errorf("%w: upload=%s", ErrUploadNotReceived, upload.ID)
+		}
+
+		return nil, errors.Errorf("failed to stat uploaded image: %w", err)
+	}
+
+	// ダウンロードする前にサイズを検証
+	err = upload.ValidateSize(info.Size)
+	if err != nil {
+		c.discardUpload(ctx, upload)
+
+		return nil, errors.Errorf("%w: %w", ErrInvalidImage, err)
+	}
+
+	data, err := c.readStaged(ctx, stagingPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// マジックバイトとcontent typeはStoreで検証される
+	metadata, err := c.Store(ctx, Upload{
+		RoomID:      upload.RoomID,
+		ContentType: upload.ContentType,
+		Data:        data,
+		UploaderID:  upload.UploaderID,
+		RoundNumber: roundNumber,
+		Role:        role,
+		TTL:         ttl,
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidImage) {
+			c.discardUpload(ctx, upload)
+		}
+
+		return nil, err
+	}
+
+	c.discardUpload(ctx, upload)
+
+	return metadata, nil
+}
+
+// DownloadURL returns a presigned URL to download an image and when it expires.
+func (c *Catalog) DownloadURL(
+	ctx context.Context,
+	metadata *domainimage.Metadata,
+) (string, time.Time, error) {
+	ttl := c.options.PresignedURLTTL
+	expiresAt := time.Now().UTC().Add(ttl)
+
+	downloadURL, err := c.blobClient.PresignGet(ctx, metadata.Path, metadata.ContentType, ttl)
+	if err != nil {
+		return "", time.Time{}, errors.Errorf("failed to create download URL: %w", err)
+	}
+
+	return downloadURL, expiresAt, nil
+}
+
+// readStaged reads an uploaded object, reading at most one byte over the size limit
+// in case the object was replaced after its size was checked.
+func (c *Catalog) readStaged(ctx context.Context, path string) ([]byte, error) {
+	reader, err := c.blobClient.Get(ctx, path)
+	if err != nil {
+		return nil, errors.Errorf("failed to get uploaded image: %w", err)
+	}
+
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(reader, domainimage.MaxImageSize+1))
+	if err != nil {
+		return nil, errors.Errorf("failed to read uploaded image: %w", err)
+	}
+
+	return data, nil
+}
+
+// discardUpload removes a staged object, its parts and its pending upload.
+// Failures are ignored so that they do not hide the result of the upload.
+func (c *Catalog) discardUpload(ctx context.Context, upload *domainimage.PendingUpload) {
+	c.deleteParts(ctx, upload)
+	_ = c.blobClient.Delete(ctx, upload.StagingPath())
+	_ = c.uploadRepo.Delete(ctx, upload.ID)
+}
@@ -0,0 +1,143 @@
+package image_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	domainroom "github.com/yashikota/scene-hunter/server/internal/domain/room"
+	"github.com/yashikota/scene-hunter/server/internal/repository"
+	"github.com/yashikota/scene-hunter/server/internal/service/image"
+	"github.com/yashikota/scene-hunter/server/internal/testutil"
+)
+
+// sentUpload is an upload whose image has been sent to its upload URL.
+type sentUpload struct {
+	svc        *image.Service
+	catalog    *image.Catalog
+	uploaderID uuid.UUID
+	uploadID   string
+}
+
+// newSentUpload creates an upload as a new user and sends a JPEG to its URL,
+// using miniredis and an in-memory blob.
+func newSentUpload(t *testing.T) *sentUpload {
+	t.Helper()
+
+	blobClient := testutil.NewMemoryBlob()
+	kvsClient := testutil.NewKVS(t)
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	svc, catalog := newService(blobClient, kvsClient, roomRepo)
+
+	uploaderID := uuid.New()
+	ctx := authenticated(t.Context(), uploaderID)
+
+	err := roomRepo.Create(ctx, domainroom.NewRoom("123456", uploaderID))
+	if err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+
+	uploadResp, err := svc.CreateUploadURL(ctx, &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: domainimage.NormalizedContentType,
+	})
+	if err != nil {
+		t.Fatalf("CreateUploadURL() failed: %v", err)
+	}
+
+	// メモリ上のBlobの署名付きURLはキーそのもの
+	key := strings.TrimPrefix(uploadResp.GetUploadUrl(), "memory://")
+
+	err = blobClient.Put(ctx, key, bytes.NewReader(testJPEG(t)), time.Hour)
+	if err != nil {
+		t.Fatalf("Put() failed: %v", err)
+	}
+
+	return &sentUpload{
+		svc:        svc,
+		catalog:    catalog,
+		uploaderID: uploaderID,
+		uploadID:   uploadResp.GetUploadId(),
+	}
+}
+
+func TestCreateUploadURL_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	kvsClient := testutil.NewKVS(t)
+	roomRepo := repository.NewRoomRepository(kvsClient)
+	svc, _ := newService(testutil.NewMemoryBlob(), kvsClient, roomRepo)
+
+	err := roomRepo.Create(t.Context(), domainroom.NewRoom("123456", uuid.New()))
+	if err != nil {
+		t.Fatalf("failed to create room: %v", err)
+	}
+
+	_, err = svc.CreateUploadURL(t.Context(), &scene_hunterv1.CreateUploadURLRequest{
+		RoomCode:    "123456",
+		ContentType: domainimage.NormalizedContentType,
+	})
+	assertCode(t, err, connect.CodeUnauthenticated, "CreateUploadURL")
+}
+
+func TestCompleteUpload_NotUploader(t *testing.T) {
+	t.Parallel()
+
+	// callerID が uuid.Nil の場合は認証しない
+	tests := map[string]struct {
+		callerID uuid.UUID
+	}{
+		"another user":    {uuid.New()},
+		"unauthenticated": {uuid.Nil},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newSentUpload(t)
+
+			ctx := t.Context()
+			if testCase.callerID != uuid.Nil {
+				ctx = authenticated(ctx, testCase.callerID)
+			}
+
+			_, err := env.svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+				UploadId: env.uploadID,
+			})
+			assertCode(t, err, connect.CodePermissionDenied, "CompleteUpload")
+		})
+	}
+}
+
+func TestCompleteUpload_Locked(t *testing.T) {
+	t.Parallel()
+
+	env := newSentUpload(t)
+	ctx := authenticated(t.Context(), env.uploaderID)
+
+	// 別のストリームがチャンクを送信している間は完了できない
+	unlock, err := env.catalog.LockUpload(ctx, uuid.MustParse(env.uploadID))
+	if err != nil {
+		t.Fatalf("LockUpload() failed: %v", err)
+	}
+
+	_, err = env.svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: env.uploadID,
+	})
+	assertCode(t, err, connect.CodeAborted, "CompleteUpload")
+
+	unlock()
+
+	_, err = env.svc.CompleteUpload(ctx, &scene_hunterv1.CompleteUploadRequest{
+		UploadId: env.uploadID,
+	})
+	if err != nil {
+		t.Fatalf("CompleteUpload() after unlock failed: %v", err)
+	}
+}
@@ -0,0 +1,368 @@
+package image
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// uploadLockTTL bounds how long a crashed stream can keep an upload locked.
+const uploadLockTTL = 5 * time.Minute
+
+// ErrUploadInProgress is returned when another stream is sending chunks to the same upload.
+var ErrUploadInProgress = errors.New("upload is in progress on another stream")
+
+func (s *Service) UploadPhotoStream(
+	ctx context.Context,
+	stream *connect.ClientStream[scene_hunterv1.UploadPhotoStreamRequest],
+) (*scene_hunterv1.UploadPhotoStreamResponse, error) {
+	// 最初のメッセージはヘッダー
+	if !stream.Receive() {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("upload stream closed before header: %w", stream.Err()),
+		)
+	}
+
+	header := stream.Msg().GetHeader()
+	if header == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("first message of upload stream must be a header"),
+		)
+	}
+
+	upload, err := s.authorizedUpload(ctx, header.GetUploadId())
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := s.catalog.LockUpload(ctx, upload.ID)
+	if err != nil {
+		if errors.Is(err, ErrUploadInProgress) {
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer unlock()
+
+	// ロック取得前に別のストリームが書き込んだ可能性があるため再取得
+	upload, err = s.catalog.GetUpload(ctx, upload.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	err = upload.Resume(header.GetOffset())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	checksum, err := s.receiveChunks(ctx, stream, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum != nil {
+		err = s.catalog.FinishUpload(ctx, upload, checksum)
+		if err != nil {
+			if errors.Is(err, ErrInvalidImage) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return &scene_hunterv1.UploadPhotoStreamResponse{
+		UploadId:      upload.ID.String(),
+		ReceivedBytes: upload.ReceivedBytes,
+		Completed:     upload.Completed,
+	}, nil
+}
+
+func (s *Service) GetUploadStatus(
+	ctx context.Context,
+	req *scene_hunterv1.GetUploadStatusRequest,
+) (*scene_hunterv1.GetUploadStatusResponse, error) {
+	upload, err := s.authorizedUpload(ctx, req.GetUploadId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &scene_hunterv1.GetUploadStatusResponse{
+		ReceivedBytes: upload.ReceivedBytes,
+		Completed:     upload.Completed,
+		ExpiresAt:     timestamppb.New(upload.ExpiresAt),
+	}, nil
+}
+
+// receiveChunks streams the chunks of an upload stream into the next part of the upload.
+// It returns the checksum of the finish message, or nil if the stream ended without one.
+// Chunks received before the stream is interrupted are kept so that the upload can resume.
+func (s *Service) receiveChunks(
+	ctx context.Context,
+	stream *connect.ClientStream[scene_hunterv1.UploadPhotoStreamRequest],
+	upload *domainimage.PendingUpload,
+) ([]byte, error) {
+	reader, writer := io.Pipe()
+	done := make(chan error, 1)
+
+	// 切断されても受信済みのチャンクを保存できるようにキャンセルを引き継がない
+	go func() {
+		err := s.catalog.AppendPart(context.WithoutCancel(ctx), upload, reader)
+		_ = reader.CloseWithError(err)
+
+		done <- err
+	}()
+
+	var checksum []byte
+
+	for stream.Receive() {
+		msg := stream.Msg()
+
+		if finish := msg.GetFinish(); finish != nil {
+			checksum = finish.GetSha256()
+
+			break
+		}
+
+		if msg.GetHeader() != nil {
+			_ = writer.CloseWithError(errors.New("unexpected header"))
+
+			<-done
+
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("upload stream must have a single header"),
+			)
+		}
+
+		// パートの保存に失敗した場合は書き込みがエラーになる
+		_, err := writer.Write(msg.GetChunk())
+		if err != nil {
+			break
+		}
+	}
+
+	_ = writer.Close()
+
+	err := <-done
+	if err != nil {
+		if errors.Is(err, ErrInvalidImage) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// 受信済みのチャンクは保存済みのため、クライアントは再開できる
+	if stream.Err() != nil {
+		return nil, connect.NewError(
+			connect.CodeCanceled,
+			errors.Errorf("upload stream interrupted: %w", stream.Err()),
+		)
+	}
+
+	return checksum, nil
+}
+
+// authorizedUpload returns a pending upload if the caller is allowed to send it.
+func (s *Service) authorizedUpload(
+	ctx context.Context,
+	rawUploadID string,
+) (*domainimage.PendingUpload, error) {
+	uploadID, err := uuid.Parse(rawUploadID)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid upload ID: %w", err),
+		)
+	}
+
+	upload, err := s.catalog.GetUpload(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, ErrUploadNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	err = authorizeUploader(ctx, upload)
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	return upload, nil
+}
+
+// LockUpload reserves an upload for one stream and returns a function that releases it.
+func (c *Catalog) LockUpload(ctx context.Context, uploadID uuid.UUID) (func(), error) {
+	locked, err := c.uploadRepo.Lock(ctx, uploadID, uploadLockTTL)
+	if err != nil {
+		return nil, errors.Errorf("failed to lock upload: %w", err)
+	}
+
+	if !locked {
+		return nil, errors.Errorf("%w: id=%s", ErrUploadInProgress, uploadID)
+	}
+
+	return func() {
+		_ = c.uploadRepo.Unlock(context.WithoutCancel(ctx), uploadID)
+	}, nil
+}
+
+// AppendPart stores everything read from reader as the next part of a chunked upload
+// and records the received bytes.
+// The data is streamed into blob storage without being buffered as a whole.
+func (c *Catalog) AppendPart(
+	ctx context.Context,
+	upload *domainimage.PendingUpload,
+	reader io.Reader,
+) error {
+	hasher, err := upload.Hash()
+	if err != nil {
+		return errors.Errorf("failed to restore upload hash: %w", err)
+	}
+
+	counter := &limitedCounter{
+		reader:   reader,
+		limit:    upload.RemainingBytes(),
+		count:    0,
+		exceeded: false,
+	}
+
+	err = c.blobClient.Put(
+		ctx,
+		upload.PartPath(upload.Parts),
+		io.TeeReader(counter, hasher),
+		time.Until(upload.ExpiresAt),
+	)
+	if counter.exceeded {
+		// 上限を超えたアップロードは再開できないため破棄する
+		c.discardUpload(ctx, upload)
+
+		return errors.Errorf(
+			"%w: %w: more than %d bytes",
+			ErrInvalidImage,
+			domainimage.ErrSizeTooLarge,
+			domainimage.MaxImageSize,
+		)
+	}
+
+	if err != nil {
+		return errors.Errorf("failed to save upload part: %w", err)
+	}
+
+	err = upload.AddPart(counter.count, hasher)
+	if err != nil {
+		return errors.Errorf("failed to record upload part: %w", err)
+	}
+
+	err = c.uploadRepo.Save(ctx, upload, time.Until(upload.ExpiresAt))
+	if err != nil {
+		return errors.Errorf("failed to save upload: %w", err)
+	}
+
+	return nil
+}
+
+// FinishUpload verifies the checksum of a chunked upload and joins its parts into the
+// staged object, so that it can be completed like an upload sent with a presigned URL.
+func (c *Catalog) FinishUpload(
+	ctx context.Context,
+	upload *domainimage.PendingUpload,
+	checksum []byte,
+) error {
+	err := upload.Finish(checksum)
+	if err != nil {
+		if errors.Is(err, domainimage.ErrChecksumMismatch) {
+			// 破損したデータからは再開できないため破棄する
+			c.discardUpload(ctx, upload)
+		}
+
+		return errors.Errorf("%w: %w", ErrInvalidImage, err)
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		_ = writer.CloseWithError(c.copyParts(ctx, upload, writer))
+	}()
+
+	err = c.blobClient.Put(ctx, upload.StagingPath(), reader, time.Until(upload.ExpiresAt))
+	_ = reader.CloseWithError(err)
+
+	if err != nil {
+		return errors.Errorf("failed to join upload parts: %w", err)
+	}
+
+	c.deleteParts(ctx, upload)
+
+	err = c.uploadRepo.Save(ctx, upload, time.Until(upload.ExpiresAt))
+	if err != nil {
+		return errors.Errorf("failed to save upload: %w", err)
+	}
+
+	return nil
+}
+
+// copyParts writes the parts of a chunked upload to writer in order.
+func (c *Catalog) copyParts(
+	ctx context.Context,
+	upload *domainimage.PendingUpload,
+	writer io.Writer,
+) error {
+	for _, path := range upload.PartPaths() {
+		part, err := c.blobClient.Get(ctx, path)
+		if err != nil {
+			return errors.Errorf("failed to get upload part: %w", err)
+		}
+
+		_, err = io.Copy(writer, part)
+		_ = part.Close()
+
+		if err != nil {
+			return errors.Errorf("failed to copy upload part: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteParts removes the stored parts of a chunked upload.
+func (c *Catalog) deleteParts(ctx context.Context, upload *domainimage.PendingUpload) {
+	for _, path := range upload.PartPaths() {
+		_ = c.blobClient.Delete(ctx, path)
+	}
+}
+
+// limitedCounter counts the bytes read from a reader and fails once more than limit
+// bytes have been read.
+type limitedCounter struct {
+	reader   io.Reader
+	limit    int64
+	count    int64
+	exceeded bool
+}
+
+func (l *limitedCounter) Read(p []byte) (int, error) {
+	read, err := l.reader.Read(p)
+	l.count += int64(read)
+
+	if l.count > l.limit {
+		l.exceeded = true
+
+		return read, domainimage.ErrSizeTooLarge
+	}
+
+	return read, err //nolint:wrapcheck // io.Reader must return io.EOF unwrapped
+}
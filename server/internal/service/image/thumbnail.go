@@ -0,0 +1,330 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Thumbnail is a thumbnail of an image, or the error that prevented getting it.
+type Thumbnail struct {
+	Image *domainimage.Metadata
+	// Data is set by Catalog.Thumbnails.
+	Data []byte
+	// URL is set by Catalog.ThumbnailURLs.
+	URL string
+	Err error
+}
+
+func (s *Service) ListImageThumbnails(
+	ctx context.Context,
+	req *scene_hunterv1.ListImageThumbnailsRequest,
+) (*scene_hunterv1.ListImageThumbnailsResponse, error) {
+	entries, err := s.listRoomImages(ctx, req.GetRoomId())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := domainimage.PageImages(
+		entries,
+		timeOrZero(req.GetSince()),
+		req.GetPageToken(),
+		int(req.GetPageSize()),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// URLの有効期限は署名前に決めて、実際の期限より早く通知する
+	expiresAt := time.Now().UTC().Add(s.catalog.options.PresignedURLTTL)
+	thumbnails := make([]*scene_hunterv1.ThumbnailInfo, 0, len(page.Images))
+
+	err = s.catalog.ThumbnailURLs(
+		ctx,
+		page.Images,
+		toThumbnailSize(req.GetSize()),
+		func(thumbnail Thumbnail) error {
+			thumbnails = append(thumbnails, toThumbnailInfo(ctx, thumbnail))
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &scene_hunterv1.ListImageThumbnailsResponse{
+		Thumbnails:    thumbnails,
+		NextPageToken: page.NextPageToken,
+		UrlExpiresAt:  timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *Service) StreamImageThumbnails(
+	ctx context.Context,
+	req *scene_hunterv1.StreamImageThumbnailsRequest,
+	stream *connect.ServerStream[scene_hunterv1.StreamImageThumbnailsResponse],
+) error {
+	entries, err := s.listRoomImages(ctx, req.GetRoomId())
+	if err != nil {
+		return err
+	}
+
+	err = s.catalog.Thumbnails(
+		ctx,
+		domainimage.SinceImages(entries, timeOrZero(req.GetSince())),
+		toThumbnailSize(req.GetSize()),
+		func(thumbnail Thumbnail) error {
+			return stream.Send(&scene_hunterv1.StreamImageThumbnailsResponse{
+				Thumbnail: toThumbnailInfo(ctx, thumbnail),
+			})
+		},
+	)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	return nil
+}
+
+// listRoomImages returns the catalog entries of the images in a room the caller can see,
+// oldest first.
+func (s *Service) listRoomImages(
+	ctx context.Context,
+	rawRoomID string,
+) ([]*domainimage.Metadata, error) {
+	roomID, err := uuid.Parse(rawRoomID)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.Errorf("invalid room ID: %w", err),
+		)
+	}
+
+	caller, err := s.authorizeViewer(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.catalog.List(ctx, roomID)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Errorf("failed to list images: %w", err),
+		)
+	}
+
+	return caller.visible(entries), nil
+}
+
+// ThumbnailURLs calls emit with a presigned URL of the thumbnail of every image, in order.
+// Missing thumbnails are generated and stored first.
+func (c *Catalog) ThumbnailURLs(
+	ctx context.Context,
+	images []*domainimage.Metadata,
+	size domainimage.ThumbnailSize,
+	emit func(Thumbnail) error,
+) error {
+	return c.eachThumbnail(
+		ctx,
+		images,
+		emit,
+		func(ctx context.Context, metadata *domainimage.Metadata) Thumbnail {
+			thumbnail := Thumbnail{Image: metadata, Data: nil, URL: "", Err: nil}
+
+			thumbnail.Err = c.ensureThumbnail(ctx, metadata, size)
+			if thumbnail.Err != nil {
+				return thumbnail
+			}
+
+			thumbnail.URL, thumbnail.Err = c.blobClient.PresignGet(
+				ctx,
+				metadata.ThumbnailPath(size),
+				domainimage.NormalizedContentType,
+				c.options.PresignedURLTTL,
+			)
+
+			return thumbnail
+		},
+	)
+}
+
+// Thumbnails calls emit with the thumbnail of every image, in order.
+// Missing thumbnails are generated and stored first.
+func (c *Catalog) Thumbnails(
+	ctx context.Context,
+	images []*domainimage.Metadata,
+	size domainimage.ThumbnailSize,
+	emit func(Thumbnail) error,
+) error {
+	return c.eachThumbnail(
+		ctx,
+		images,
+		emit,
+		func(ctx context.Context, metadata *domainimage.Metadata) Thumbnail {
+			thumbnail := Thumbnail{Image: metadata, Data: nil, URL: "", Err: nil}
+
+			thumbnail.Err = c.ensureThumbnail(ctx, metadata, size)
+			if thumbnail.Err != nil {
+				return thumbnail
+			}
+
+			thumbnail.Data, thumbnail.Err = c.read(ctx, metadata.ThumbnailPath(size))
+
+			return thumbnail
+		},
+	)
+}
+
+// eachThumbnail runs get for every image on at most ThumbnailWorkers goroutines
+// and calls emit with the results in the order of images.
+// It stops at the first error returned by emit.
+func (c *Catalog) eachThumbnail(
+	ctx context.Context,
+	images []*domainimage.Metadata,
+	emit func(Thumbnail) error,
+	get func(context.Context, *domainimage.Metadata) Thumbnail,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]chan Thumbnail, len(images))
+	for index := range results {
+		results[index] = make(chan Thumbnail, 1)
+	}
+
+	workers := make(chan struct{}, c.options.ThumbnailWorkers)
+
+	go func() {
+		for index, metadata := range images {
+			select {
+			case workers <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func() {
+				defer func() { <-workers }()
+
+				results[index] <- get(ctx, metadata)
+			}()
+		}
+	}()
+
+	for _, result := range results {
+		select {
+		case thumbnail := <-result:
+			err := emit(thumbnail)
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return errors.Errorf("failed to get thumbnails: %w", ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// ensureThumbnail generates and stores a thumbnail if it has not been stored yet,
+// for example for images stored before the size existed.
+func (c *Catalog) ensureThumbnail(
+	ctx context.Context,
+	metadata *domainimage.Metadata,
+	size domainimage.ThumbnailSize,
+) error {
+	path := metadata.ThumbnailPath(size)
+
+	_, err := c.blobClient.Stat(ctx, path)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, service.ErrNotFound) {
+		return errors.Errorf("failed to stat thumbnail: %w", err)
+	}
+
+	// デコードはCPUを使うため、全リクエストで同時に生成する数を制限
+	select {
+	case c.thumbnailSlots <- struct{}{}:
+	case <-ctx.Done():
+		return errors.Errorf("failed to wait for thumbnail generation: %w", ctx.Err())
+	}
+
+	defer func() { <-c.thumbnailSlots }()
+
+	imageData, err := c.read(ctx, metadata.Path)
+	if err != nil {
+		return err
+	}
+
+	data, err := domainimage.GenerateThumbnail(
+		imageData,
+		c.options.Normalize.ThumbnailDimensions.Of(size),
+		c.options.Normalize.ThumbnailQuality,
+		c.options.Normalize.MaxPixels,
+	)
+	if err != nil {
+		return errors.Errorf("failed to generate thumbnail: %w", err)
+	}
+
+	err = c.blobClient.Put(ctx, path, bytes.NewReader(data), TTL)
+	if err != nil {
+		return errors.Errorf("failed to save thumbnail: %w", err)
+	}
+
+	return nil
+}
+
+// toThumbnailInfo converts a thumbnail to its protobuf message.
+// A thumbnail that could not be read is logged and marked as unavailable.
+func toThumbnailInfo(ctx context.Context, thumbnail Thumbnail) *scene_hunterv1.ThumbnailInfo {
+	if thumbnail.Err != nil {
+		errors.LogErrorCtx(
+			ctx,
+			"failed to get thumbnail",
+			thumbnail.Err,
+			"image_id", thumbnail.Image.ID,
+		)
+	}
+
+	return &scene_hunterv1.ThumbnailInfo{
+		ImageId:       thumbnail.Image.ID.String(),
+		ThumbnailData: thumbnail.Data,
+		ContentType:   domainimage.NormalizedContentType,
+		ThumbnailUrl:  thumbnail.URL,
+		CreatedAt:     timestamppb.New(thumbnail.Image.CreatedAt),
+		Unavailable:   thumbnail.Err != nil,
+	}
+}
+
+// toThumbnailSize converts a protobuf thumbnail size. Unspecified sizes are medium.
+func toThumbnailSize(size scene_hunterv1.ThumbnailSize) domainimage.ThumbnailSize {
+	switch size {
+	case scene_hunterv1.ThumbnailSize_THUMBNAIL_SIZE_SMALL:
+		return domainimage.ThumbnailSizeSmall
+	case scene_hunterv1.ThumbnailSize_THUMBNAIL_SIZE_LARGE:
+		return domainimage.ThumbnailSizeLarge
+	case scene_hunterv1.ThumbnailSize_THUMBNAIL_SIZE_UNSPECIFIED,
+		scene_hunterv1.ThumbnailSize_THUMBNAIL_SIZE_MEDIUM:
+	}
+
+	return domainimage.ThumbnailSizeMedium
+}
+
+// timeOrZero returns the time of a timestamp, or the zero time when it is not set.
+func timeOrZero(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
@@ -3,9 +3,6 @@ package image
 
 import (
 	"context"
-	"io"
-	"path/filepath"
-	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -13,6 +10,7 @@ import (
 	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
 	domainimage "github.com/yashikota/scene-hunter/server/internal/domain/image"
 	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
 	"github.com/yashikota/scene-hunter/server/internal/util/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -23,24 +21,33 @@ var ErrRoomNotFound = errors.New("room not found")
 // ErrImageNotFound is returned when the image is not found.
 var ErrImageNotFound = errors.New("image not found")
 
+// ErrNotRoomAdmin is returned when someone other than the room admin adds a scene.
+var ErrNotRoomAdmin = errors.New("only room admin can add scenes")
+
 // TTL は画像の有効期限（1時間）.
 const TTL = 1 * time.Hour
 
+// SceneTTL はシーンプールの画像の有効期限。ルームと同じ24時間残す.
+const SceneTTL = 24 * time.Hour
+
 type Service struct {
-	blobClient service.Blob
-	kvsClient  service.KVS
-	roomRepo   service.RoomRepository
+	catalog   *Catalog
+	kvsClient service.KVS
+	roomRepo  service.RoomRepository
+	gameRepo  service.GameRepository
 }
 
 func NewService(
-	blobClient service.Blob,
+	catalog *Catalog,
 	kvsClient service.KVS,
 	roomRepo service.RoomRepository,
+	gameRepo service.GameRepository,
 ) *Service {
 	return &Service{
-		blobClient: blobClient,
-		kvsClient:  kvsClient,
-		roomRepo:   roomRepo,
+		catalog:   catalog,
+		kvsClient: kvsClient,
+		roomRepo:  roomRepo,
+		gameRepo:  gameRepo,
 	}
 }
 
@@ -48,43 +55,46 @@ func (s *Service) UploadImage(
 	ctx context.Context,
 	req *scene_hunterv1.UploadImageRequest,
 ) (*scene_hunterv1.UploadImageResponse, error) {
-	// room codeからroomを取得
-	roomCodeKey := "room_code:" + req.GetRoomCode()
-
-	roomIDStr, err := s.kvsClient.Get(ctx, roomCodeKey)
+	roomID, err := s.roomIDFromCode(ctx, req.GetRoomCode())
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: code=%s", ErrRoomNotFound, req.GetRoomCode()),
-		)
+		return nil, err
 	}
 
-	roomID, err := uuid.Parse(roomIDStr)
+	// 認証済みであればアップロードしたユーザーを記録
+	uploaderID, err := middleware.GetAuthenticatedUserID(ctx)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("invalid room ID: %w", err),
-		)
+		uploaderID = uuid.Nil
 	}
 
-	// 画像データの作成とバリデーション
-	img, err := domainimage.NewImage(
-		req.GetRoomCode(),
-		req.GetContentType(),
-		req.GetImageData(),
-	)
-	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			errors.Errorf("invalid image data: %w", err),
-		)
-	}
+	role, ttl := domainimage.RoleUpload, TTL
+
+	if req.GetScene() {
+		err = s.ensureRoomAdmin(ctx, roomID, uploaderID)
+		if err != nil {
+			return nil, err
+		}
 
-	// RustFSに保存（roomIDベースのパスを使用）
-	path := domainimage.PathFromRoomID(roomID, img.ID, img.ContentType)
+		role, ttl = domainimage.RoleScene, SceneTTL
+	}
 
-	err = s.blobClient.Put(ctx, path, img.Reader(), TTL)
+	// 正規化してRustFSに保存し、カタログに記録
+	metadata, err := s.catalog.Store(ctx, Upload{
+		RoomID:      roomID,
+		ContentType: req.GetContentType(),
+		Data:        req.GetImageData(),
+		UploaderID:  uploaderID,
+		RoundNumber: 0,
+		Role:        role,
+		TTL:         ttl,
+	})
 	if err != nil {
+		if errors.Is(err, ErrInvalidImage) {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.Errorf("invalid image data: %w", err),
+			)
+		}
+
 		return nil, connect.NewError(
 			connect.CodeInternal,
 			errors.Errorf("failed to save image: %w", err),
@@ -93,8 +103,8 @@ func (s *Service) UploadImage(
 
 	// レスポンス生成
 	return &scene_hunterv1.UploadImageResponse{
-		ImageId:   img.ID.String(),
-		ImagePath: path,
+		ImageId:   metadata.ID.String(),
+		ImagePath: metadata.Path,
 	}, nil
 }
 
@@ -119,63 +129,40 @@ func (s *Service) GetImage(
 		)
 	}
 
-	// room IDの存在確認
-	exists, err := s.roomRepo.Exists(ctx, roomID)
+	caller, err := s.authorizeViewer(ctx, roomID)
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInternal,
-			errors.Errorf("failed to check room existence: %w", err),
-		)
+		return nil, err
 	}
 
-	if !exists {
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrRoomNotFound, roomID),
-		)
-	}
-
-	// 画像のパスを構築 (各contentTypeを試す)
-	var (
-		imageData   []byte
-		contentType string
-	)
-
-	supportedTypes := []string{"image/jpeg", "image/png", "image/webp"}
-
-	for _, candidateType := range supportedTypes {
-		path := domainimage.PathFromRoomID(roomID, imageID, candidateType)
-
-		reader, err := s.blobClient.Get(ctx, path)
-		if err != nil {
-			continue
-		}
-
-		defer func() {
-			_ = reader.Close()
-		}()
-
-		data, err := io.ReadAll(reader)
-		if err != nil {
-			continue
+	// カタログから画像のパスとcontent typeを取得
+	metadata, err := s.catalog.Get(ctx, roomID, imageID)
+	if err != nil {
+		if errors.Is(err, ErrImageNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
 
-		imageData = data
-		contentType = candidateType
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
-		break
+	if !caller.canSee(metadata) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.Errorf("%w: id=%s", ErrImageHidden, imageID),
+		)
 	}
 
-	if imageData == nil {
+	imageData, err := s.catalog.Read(ctx, metadata)
+	if err != nil {
+		// メタデータより先に画像が期限切れになった場合
 		return nil, connect.NewError(
 			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrImageNotFound, imageID),
+			errors.Errorf("%w: id=%s: %w", ErrImageNotFound, imageID, err),
 		)
 	}
 
 	return &scene_hunterv1.GetImageResponse{
 		ImageData:   imageData,
-		ContentType: contentType,
+		ContentType: metadata.ContentType,
 	}, nil
 }
 
@@ -192,56 +179,122 @@ func (s *Service) ListImages(
 		)
 	}
 
-	// room IDの存在確認
-	exists, err := s.roomRepo.Exists(ctx, roomID)
+	caller, err := s.authorizeViewer(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// カタログから画像一覧を取得
+	entries, err := s.catalog.List(ctx, roomID)
 	if err != nil {
 		return nil, connect.NewError(
 			connect.CodeInternal,
-			errors.Errorf("failed to check room existence: %w", err),
+			errors.Errorf("failed to list images: %w", err),
 		)
 	}
 
-	if !exists {
-		return nil, connect.NewError(
+	// 呼び出し元が見られない画像を除いてレスポンスを構築
+	entries = caller.visible(entries)
+	images := make([]*scene_hunterv1.ImageInfo, 0, len(entries))
+
+	for _, metadata := range entries {
+		images = append(images, toImageInfo(metadata))
+	}
+
+	return &scene_hunterv1.ListImagesResponse{
+		Images: images,
+	}, nil
+}
+
+// roomIDFromCode resolves a room code to its room ID.
+func (s *Service) roomIDFromCode(ctx context.Context, roomCode string) (uuid.UUID, error) {
+	roomIDStr, err := s.kvsClient.Get(ctx, "room_code:"+roomCode)
+	if err != nil {
+		return uuid.Nil, connect.NewError(
 			connect.CodeNotFound,
-			errors.Errorf("%w: id=%s", ErrRoomNotFound, roomID),
+			errors.Errorf("%w: code=%s", ErrRoomNotFound, roomCode),
+		)
+	}
+
+	roomID, err := uuid.Parse(roomIDStr)
+	if err != nil {
+		return uuid.Nil, connect.NewError(
+			connect.CodeInternal,
+			errors.Errorf("invalid room ID: %w", err),
 		)
 	}
 
-	// roomIDをprefixにして画像一覧を取得
-	prefix := filepath.Join("game", roomID.String())
+	return roomID, nil
+}
 
-	objects, err := s.blobClient.List(ctx, prefix)
+// ensureRoomExists returns a Connect error if the room does not exist.
+// ensureRoomAdmin verifies that the user is the admin of the room.
+func (s *Service) ensureRoomAdmin(ctx context.Context, roomID, userID uuid.UUID) error {
+	room, err := s.roomRepo.Get(ctx, roomID)
 	if err != nil {
-		return nil, connect.NewError(
+		return connect.NewError(
 			connect.CodeInternal,
-			errors.Errorf("failed to list images: %w", err),
+			errors.Errorf("failed to get room: %w", err),
 		)
 	}
 
-	// レスポンスを構築
-	images := make([]*scene_hunterv1.ImageInfo, 0, len(objects))
+	if userID == uuid.Nil || !room.IsAdmin(userID) {
+		return connect.NewError(connect.CodePermissionDenied, ErrNotRoomAdmin)
+	}
 
-	for _, obj := range objects {
-		// パスからimageIDを抽出
-		filename := filepath.Base(obj.Key)
-		imageIDStr := strings.TrimSuffix(filename, filepath.Ext(filename))
+	return nil
+}
 
-		imageID, err := uuid.Parse(imageIDStr)
-		if err != nil {
-			// 不正なファイル名はスキップ
-			continue
-		}
+func (s *Service) ensureRoomExists(ctx context.Context, roomID uuid.UUID) error {
+	exists, err := s.roomRepo.Exists(ctx, roomID)
+	if err != nil {
+		return connect.NewError(
+			connect.CodeInternal,
+			errors.Errorf("failed to check room existence: %w", err),
+		)
+	}
 
-		images = append(images, &scene_hunterv1.ImageInfo{
-			ImageId:      imageID.String(),
-			ImagePath:    obj.Key,
-			Size:         obj.Size,
-			LastModified: timestamppb.New(obj.LastModified),
-		})
+	if !exists {
+		return connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf("%w: id=%s", ErrRoomNotFound, roomID),
+		)
 	}
 
-	return &scene_hunterv1.ListImagesResponse{
-		Images: images,
-	}, nil
+	return nil
+}
+
+// toImageInfo converts a catalog entry to ImageInfo.
+func toImageInfo(metadata *domainimage.Metadata) *scene_hunterv1.ImageInfo {
+	uploaderID := ""
+	if metadata.UploaderID != uuid.Nil {
+		uploaderID = metadata.UploaderID.String()
+	}
+
+	return &scene_hunterv1.ImageInfo{
+		ImageId:      metadata.ID.String(),
+		ImagePath:    metadata.Path,
+		Size:         metadata.Size,
+		LastModified: timestamppb.New(metadata.CreatedAt),
+		ContentType:  metadata.ContentType,
+		UploaderId:   uploaderID,
+		RoundNumber:  int32(metadata.RoundNumber),
+		Role:         toImageRole(metadata.Role),
+	}
+}
+
+// toImageRole converts a domain image role to proto ImageRole.
+func toImageRole(role domainimage.Role) scene_hunterv1.ImageRole {
+	switch role {
+	case domainimage.RoleUpload:
+		return scene_hunterv1.ImageRole_IMAGE_ROLE_UPLOAD
+	case domainimage.RoleGameMaster:
+		return scene_hunterv1.ImageRole_IMAGE_ROLE_GAME_MASTER
+	case domainimage.RoleHunter:
+		return scene_hunterv1.ImageRole_IMAGE_ROLE_HUNTER
+	case domainimage.RoleScene:
+		return scene_hunterv1.ImageRole_IMAGE_ROLE_SCENE
+	default:
+		return scene_hunterv1.ImageRole_IMAGE_ROLE_UNSPECIFIED
+	}
 }
@@ -1,9 +1,9 @@
-// Package middleware provides Connect interceptors for authentication and authorization.
+// Package middleware provides Connect interceptors for authentication, authorization and rate limiting.
 package middleware
 
 import (
 	"context"
-	"os"
+	"net/http"
 	"slices"
 	"strings"
 
@@ -23,53 +23,115 @@ const (
 	UserIDContextKey contextKey = "user_id"
 )
 
+// RevocationChecker reports whether access tokens issued for a subject have been revoked.
+type RevocationChecker interface {
+	IsSubjectRevoked(ctx context.Context, subject string) (bool, error)
+}
+
 // AuthInterceptor creates a Connect interceptor that verifies authentication tokens.
-func AuthInterceptor() connect.UnaryInterceptorFunc {
-	hmacSecret := os.Getenv("AUTH_HMAC_SECRET")
-	if hmacSecret == "" {
-		panic("AUTH_HMAC_SECRET environment variable is required")
+// It covers both unary and streaming handlers.
+// If revocations is nil, revoked subjects are not checked.
+func AuthInterceptor(
+	tokenSigner *domainauth.TokenSigner,
+	revocations RevocationChecker,
+) connect.Interceptor {
+	return &authInterceptor{
+		tokenSigner: tokenSigner,
+		revocations: revocations,
 	}
+}
+
+// authInterceptor verifies the access token of incoming requests.
+type authInterceptor struct {
+	tokenSigner *domainauth.TokenSigner
+	revocations RevocationChecker
+}
+
+// WrapUnary implements connect.Interceptor.
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor. Outgoing streams are not modified.
+func (i *authInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc,
+) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (i *authInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc,
+) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		return next(ctx, conn)
+	}
+}
+
+// authenticate verifies the token in the Authorization header and stores its subject in
+// the returned context.
+func (i *authInterceptor) authenticate(
+	ctx context.Context,
+	procedure string,
+	header http.Header,
+) (context.Context, error) {
+	// Skip authentication for certain endpoints
+	if shouldSkipAuth(procedure) {
+		return ctx, nil
+	}
+
+	// Extract token from Authorization header
+	authHeader := header.Get("Authorization")
+	if authHeader == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	// Check Bearer token format
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	token := parts[1]
+
+	// Verify token
+	anonToken, err := i.tokenSigner.VerifyAnonToken(token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	// Reject tokens of deleted accounts
+	if i.revocations != nil {
+		revoked, err := i.revocations.IsSubjectRevoked(ctx, anonToken.AnonID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		}
 
-	tokenSigner := domainauth.NewTokenSigner([]byte(hmacSecret))
-
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Skip authentication for certain endpoints
-			if shouldSkipAuth(req.Spec().Procedure) {
-				return next(ctx, req)
-			}
-
-			// Extract token from Authorization header
-			authHeader := req.Header().Get("Authorization")
-			if authHeader == "" {
-				return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-			}
-
-			// Check Bearer token format
-			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-			}
-
-			token := parts[1]
-
-			// Verify token
-			anonToken, err := tokenSigner.VerifyAnonToken(token)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
-			}
-
-			// Store ID in context
-			// Note: Current implementation uses the same token format for both anonymous and permanent users.
-			// The AnonID field contains either the anon_id (for anonymous users) or user_id (for permanent users).
-			// and store in appropriate context keys (AnonIDContextKey vs UserIDContextKey).
-			ctx = context.WithValue(ctx, AnonIDContextKey, anonToken.AnonID)
-
-			return next(ctx, req)
+		if revoked {
+			return nil, connect.NewError(
+				connect.CodeUnauthenticated,
+				errors.New("token has been revoked"),
+			)
 		}
 	}
 
-	return interceptor
+	// Store ID in context
+	// Note: Current implementation uses the same token format for both anonymous and permanent users.
+	// The AnonID field contains either the anon_id (for anonymous users) or user_id (for permanent users).
+	// and store in appropriate context keys (AnonIDContextKey vs UserIDContextKey).
+	return context.WithValue(ctx, AnonIDContextKey, anonToken.AnonID), nil
 }
 
 // shouldSkipAuth determines if authentication should be skipped for a procedure.
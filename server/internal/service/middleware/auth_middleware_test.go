@@ -0,0 +1,179 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1/scene_hunterv1connect"
+	domainauth "github.com/yashikota/scene-hunter/server/internal/domain/auth"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+)
+
+// authEchoImageService returns the authenticated user ID from the upload RPCs.
+type authEchoImageService struct {
+	scene_hunterv1connect.UnimplementedImageServiceHandler
+}
+
+func (authEchoImageService) GetUploadStatus(
+	ctx context.Context,
+	_ *scene_hunterv1.GetUploadStatusRequest,
+) (*scene_hunterv1.GetUploadStatusResponse, error) {
+	_, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &scene_hunterv1.GetUploadStatusResponse{}, nil
+}
+
+func (authEchoImageService) UploadPhotoStream(
+	ctx context.Context,
+	stream *connect.ClientStream[scene_hunterv1.UploadPhotoStreamRequest],
+) (*scene_hunterv1.UploadPhotoStreamResponse, error) {
+	userID, err := middleware.GetAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for stream.Receive() {
+	}
+
+	return &scene_hunterv1.UploadPhotoStreamResponse{UploadId: userID.String()}, nil
+}
+
+// authorizationTransport sets the Authorization header of every request.
+type authorizationTransport struct {
+	base          http.RoundTripper
+	authorization string
+}
+
+func (a *authorizationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if a.authorization != "" {
+		req.Header.Set("Authorization", a.authorization)
+	}
+
+	return a.base.RoundTrip(req) //nolint:wrapcheck // test transport
+}
+
+// setupAuthImageClient starts an ImageService behind the auth interceptor and returns a
+// client that sends the given Authorization header.
+func setupAuthImageClient(
+	t *testing.T,
+	tokenSigner *domainauth.TokenSigner,
+	authorization string,
+) scene_hunterv1connect.ImageServiceClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(scene_hunterv1connect.NewImageServiceHandler(
+		authEchoImageService{},
+		connect.WithInterceptors(middleware.AuthInterceptor(tokenSigner, nil)),
+	))
+
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	httpClient := server.Client()
+	httpClient.Transport = &authorizationTransport{
+		base:          httpClient.Transport,
+		authorization: authorization,
+	}
+
+	return scene_hunterv1connect.NewImageServiceClient(httpClient, server.URL)
+}
+
+// newTestTokenSigner creates a TokenSigner with a single HMAC key.
+func newTestTokenSigner(t *testing.T) *domainauth.TokenSigner {
+	t.Helper()
+
+	keyring, err := domainauth.NewKeyring("test", map[string][]byte{
+		"test": []byte("0123456789abcdef0123456789abcdef"),
+	})
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+
+	return domainauth.NewTokenSigner(keyring)
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const userID = "0190a6f0-0000-7000-8000-000000000001"
+
+	tokenSigner := newTestTokenSigner(t)
+
+	token, err := tokenSigner.SignAnonToken(userID, time.Minute)
+	if err != nil {
+		t.Fatalf("SignAnonToken() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		authorization string
+		wantCode      connect.Code
+	}{
+		"valid token":    {"Bearer " + token.Token, 0},
+		"missing header": {"", connect.CodeUnauthenticated},
+		"not bearer":     {"Basic " + token.Token, connect.CodeUnauthenticated},
+		"invalid token":  {"Bearer invalid", connect.CodeUnauthenticated},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name+"/unary", func(t *testing.T) {
+			t.Parallel()
+
+			client := setupAuthImageClient(t, tokenSigner, testCase.authorization)
+
+			_, err := client.GetUploadStatus(
+				context.Background(),
+				&scene_hunterv1.GetUploadStatusRequest{},
+			)
+			assertAuthCode(t, err, testCase.wantCode)
+		})
+
+		t.Run(name+"/stream", func(t *testing.T) {
+			t.Parallel()
+
+			client := setupAuthImageClient(t, tokenSigner, testCase.authorization)
+
+			stream, err := client.UploadPhotoStream(context.Background())
+			if err != nil {
+				t.Fatalf("UploadPhotoStream() error = %v", err)
+			}
+
+			_ = stream.Send(&scene_hunterv1.UploadPhotoStreamRequest{})
+
+			resp, err := stream.CloseAndReceive()
+			assertAuthCode(t, err, testCase.wantCode)
+
+			if testCase.wantCode == 0 && resp.GetUploadId() != userID {
+				t.Errorf("authenticated user = %v, want %v", resp.GetUploadId(), userID)
+			}
+		})
+	}
+}
+
+// assertAuthCode checks the Connect code of err. A zero code means no error.
+func assertAuthCode(t *testing.T, err error, want connect.Code) {
+	t.Helper()
+
+	if want == 0 {
+		if err != nil {
+			t.Errorf("error = %v, want nil", err)
+		}
+
+		return
+	}
+
+	if connect.CodeOf(err) != want {
+		t.Errorf("error code = %v, want %v", connect.CodeOf(err), want)
+	}
+}
@@ -0,0 +1,232 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// RetryAfterHeader is the response metadata key telling clients how many seconds to wait.
+const RetryAfterHeader = "Retry-After"
+
+// anonymousPrincipal is the principal used for requests without an authenticated user.
+const anonymousPrincipal = "anonymous"
+
+// ErrRateLimited is returned when a caller exceeds the rate limit of a procedure.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimit is the number of requests allowed within a sliding window.
+type RateLimit struct {
+	Limit  int
+	Window time.Duration
+}
+
+// slidingWindowScript records a request in a sliding window log stored as a sorted set.
+// It returns {1, 0} if the request is allowed, or {0, retry_after_ms} if it is rejected.
+const slidingWindowScript = `
+	local key = KEYS[1]
+	local now = tonumber(ARGV[1])
+	local window = tonumber(ARGV[2])
+	local limit = tonumber(ARGV[3])
+	local member = ARGV[4]
+
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+
+	if redis.call('ZCARD', key) >= limit then
+		local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+		local retry_after = tonumber(oldest[2]) + window - now
+		if retry_after < 1 then
+			retry_after = 1
+		end
+		return {0, retry_after}
+	end
+
+	redis.call('ZADD', key, now, member)
+	redis.call('PEXPIRE', key, window)
+	return {1, 0}
+`
+
+// RateLimitInterceptor creates a Connect interceptor that throttles procedures listed in limits.
+// Requests are counted per procedure, principal and client IP.
+// Forwarded client IPs are only used when the request comes from one of trustedProxies.
+// It must run after AuthInterceptor so that the principal is available in the context.
+// If the KVS is unreachable the request is let through so that throttling never causes an outage.
+func RateLimitInterceptor(
+	kvs service.KVS,
+	chronoProvider chrono.Chrono,
+	logger *slog.Logger,
+	limits map[string]RateLimit,
+	trustedProxies []netip.Prefix,
+) connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+
+			limit, ok := limits[procedure]
+			if !ok || limit.Limit <= 0 || limit.Window <= 0 {
+				return next(ctx, req)
+			}
+
+			key := rateLimitKey(procedure, principal(ctx), clientIP(req, trustedProxies))
+
+			retryAfter, err := allow(ctx, kvs, chronoProvider.Now(), key, limit)
+			if err != nil {
+				logger.WarnContext(ctx, "rate limiter unavailable",
+					"procedure", procedure,
+					"error", err,
+				)
+
+				return next(ctx, req)
+			}
+
+			if retryAfter > 0 {
+				connectErr := connect.NewError(connect.CodeResourceExhausted, ErrRateLimited)
+				connectErr.Meta().Set(
+					RetryAfterHeader,
+					strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+				)
+
+				return nil, connectErr
+			}
+
+			return next(ctx, req)
+		}
+	}
+
+	return interceptor
+}
+
+// allow records a request and returns how long the caller has to wait if it exceeds the limit.
+// A zero duration means the request is allowed.
+func allow(
+	ctx context.Context,
+	kvs service.KVS,
+	now time.Time,
+	key string,
+	limit RateLimit,
+) (time.Duration, error) {
+	member, err := uuid.NewV7()
+	if err != nil {
+		return 0, errors.Errorf("failed to generate request ID: %w", err)
+	}
+
+	result, err := kvs.Eval(
+		ctx,
+		slidingWindowScript,
+		[]string{key},
+		now.UnixMilli(),
+		limit.Window.Milliseconds(),
+		limit.Limit,
+		member.String(),
+	)
+	if err != nil {
+		return 0, errors.Errorf("failed to evaluate rate limit: %w", err)
+	}
+
+	values, ok := result.([]any)
+	if !ok || len(values) != 2 {
+		return 0, errors.Errorf("unexpected result type from lua script: %T", result)
+	}
+
+	allowed, okAllowed := values[0].(int64)
+	retryAfterMillis, okRetry := values[1].(int64)
+
+	if !okAllowed || !okRetry {
+		return 0, errors.Errorf("unexpected result values from lua script: %v", values)
+	}
+
+	if allowed == 1 {
+		return 0, nil
+	}
+
+	return time.Duration(retryAfterMillis) * time.Millisecond, nil
+}
+
+// rateLimitKey builds the KVS key of a sliding window.
+func rateLimitKey(procedure, principal, ip string) string {
+	return "ratelimit:" + procedure + ":" + principal + ":" + ip
+}
+
+// principal returns the authenticated subject, or a shared placeholder for unauthenticated calls.
+func principal(ctx context.Context) string {
+	anonID, ok := GetAnonIDFromContext(ctx)
+	if !ok || anonID == "" {
+		return anonymousPrincipal
+	}
+
+	return anonID
+}
+
+// clientIP returns the IP address of the caller.
+// Anyone can send X-Forwarded-For and X-Real-IP, so they are only read when the peer is a
+// trusted proxy. X-Forwarded-For is read from the right, where each proxy appends the address
+// it received the request from, and the first address that is not a trusted proxy is the
+// client. Entries further left are set by the client and are ignored.
+func clientIP(req connect.AnyRequest, trustedProxies []netip.Prefix) string {
+	peer := req.Peer().Addr
+
+	host, _, err := net.SplitHostPort(peer)
+	if err != nil {
+		host = peer
+	}
+
+	if !isTrustedProxy(host, trustedProxies) {
+		return host
+	}
+
+	if forwarded := req.Header().Values("X-Forwarded-For"); len(forwarded) > 0 {
+		entries := strings.Split(strings.Join(forwarded, ","), ",")
+
+		client := host
+
+		for _, entry := range slices.Backward(entries) {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+
+			client = entry
+			if !isTrustedProxy(entry, trustedProxies) {
+				break
+			}
+		}
+
+		return client
+	}
+
+	if realIP := strings.TrimSpace(req.Header().Get("X-Real-IP")); realIP != "" {
+		return realIP
+	}
+
+	return host
+}
+
+// isTrustedProxy reports whether ip is in one of trustedProxies.
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,234 @@
+package middleware_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alicebob/miniredis/v2"
+	scene_hunterv1 "github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1"
+	"github.com/yashikota/scene-hunter/server/gen/scene_hunter/v1/scene_hunterv1connect"
+	"github.com/yashikota/scene-hunter/server/internal/infra/kvs"
+	"github.com/yashikota/scene-hunter/server/internal/service/health"
+	"github.com/yashikota/scene-hunter/server/internal/service/middleware"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const (
+	clientA = "192.0.2.1"
+	clientB = "198.51.100.7"
+)
+
+type mockChrono struct {
+	mu       sync.Mutex
+	mockTime time.Time
+}
+
+func (m *mockChrono) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.mockTime
+}
+
+func (m *mockChrono) Advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mockTime = m.mockTime.Add(d)
+}
+
+// rateLimitCall is a single Health call made by a test case.
+type rateLimitCall struct {
+	advance        time.Duration
+	clientIP       string
+	wantCode       connect.Code
+	wantRetryAfter string
+}
+
+// setupHealthClient starts a HealthService behind the rate limit interceptor backed by miniredis.
+func setupHealthClient(
+	t *testing.T,
+	chronoProvider *mockChrono,
+	limits map[string]middleware.RateLimit,
+	trustedProxies []netip.Prefix,
+) scene_hunterv1connect.HealthServiceClient {
+	t.Helper()
+
+	redis := miniredis.RunT(t)
+
+	kvsClient, err := kvs.NewClient(redis.Addr(), "")
+	if err != nil {
+		t.Fatalf("failed to create kvs client: %v", err)
+	}
+
+	t.Cleanup(kvsClient.Close)
+
+	interceptor := middleware.RateLimitInterceptor(
+		kvsClient,
+		chronoProvider,
+		slog.Default(),
+		limits,
+		trustedProxies,
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(scene_hunterv1connect.NewHealthServiceHandler(
+		health.NewService(chronoProvider),
+		connect.WithInterceptors(interceptor),
+	))
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return scene_hunterv1connect.NewHealthServiceClient(server.Client(), server.URL)
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	// テストサーバーのピアはクライアント自身のため、リバースプロキシとして信頼する
+	loopback := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+	healthLimit := map[string]middleware.RateLimit{
+		scene_hunterv1connect.HealthServiceHealthProcedure: {Limit: 2, Window: time.Minute},
+	}
+
+	tests := map[string]struct {
+		limits         map[string]middleware.RateLimit
+		trustedProxies []netip.Prefix
+		calls          []rateLimitCall
+	}{
+		"allows requests within the limit": {
+			healthLimit,
+			loopback,
+			[]rateLimitCall{
+				{0, clientA, 0, ""},
+				{0, clientA, 0, ""},
+			},
+		},
+		"rejects requests over the limit with retry-after": {
+			healthLimit,
+			loopback,
+			[]rateLimitCall{
+				{0, clientA, 0, ""},
+				{10 * time.Second, clientA, 0, ""},
+				{10 * time.Second, clientA, connect.CodeResourceExhausted, "40"},
+			},
+		},
+		"allows requests again once the window slides": {
+			healthLimit,
+			loopback,
+			[]rateLimitCall{
+				{0, clientA, 0, ""},
+				{30 * time.Second, clientA, 0, ""},
+				{0, clientA, connect.CodeResourceExhausted, "30"},
+				{31 * time.Second, clientA, 0, ""},
+				{0, clientA, connect.CodeResourceExhausted, "29"},
+			},
+		},
+		"keys limits by client IP": {
+			healthLimit,
+			loopback,
+			[]rateLimitCall{
+				{0, clientA, 0, ""},
+				{0, clientA, 0, ""},
+				{0, clientA, connect.CodeResourceExhausted, "60"},
+				{0, clientB, 0, ""},
+			},
+		},
+		"ignores forwarded headers from untrusted peers": {
+			healthLimit,
+			nil,
+			[]rateLimitCall{
+				{0, clientA, 0, ""},
+				{0, clientA, 0, ""},
+				{0, clientB, connect.CodeResourceExhausted, "60"},
+			},
+		},
+		"ignores addresses the client forwards through a trusted proxy": {
+			healthLimit,
+			loopback,
+			[]rateLimitCall{
+				{0, "203.0.113.1, " + clientA, 0, ""},
+				{0, "203.0.113.2, " + clientA, 0, ""},
+				{0, "203.0.113.3, " + clientA, connect.CodeResourceExhausted, "60"},
+				{0, clientB + ", 127.0.0.1", 0, ""},
+			},
+		},
+		"does not limit unlisted procedures": {
+			map[string]middleware.RateLimit{
+				scene_hunterv1connect.RoomServiceCreateRoomProcedure: {
+					Limit:  1,
+					Window: time.Minute,
+				},
+			},
+			loopback,
+			[]rateLimitCall{
+				{0, clientA, 0, ""},
+				{0, clientA, 0, ""},
+				{0, clientA, 0, ""},
+			},
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chronoProvider := &mockChrono{mockTime: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+			client := setupHealthClient(
+				t,
+				chronoProvider,
+				testCase.limits,
+				testCase.trustedProxies,
+			)
+
+			for callIndex, call := range testCase.calls {
+				chronoProvider.Advance(call.advance)
+
+				ctx, callInfo := connect.NewClientContext(context.Background())
+				callInfo.RequestHeader().Set("X-Forwarded-For", call.clientIP)
+
+				_, err := client.Health(ctx, &scene_hunterv1.HealthRequest{})
+
+				if call.wantCode == 0 {
+					if err != nil {
+						t.Fatalf("call %d: Health() error = %v, want nil", callIndex, err)
+					}
+
+					continue
+				}
+
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) {
+					t.Fatalf("call %d: Health() error = %v, want connect error", callIndex, err)
+				}
+
+				if connectErr.Code() != call.wantCode {
+					t.Errorf(
+						"call %d: code = %v, want %v",
+						callIndex,
+						connectErr.Code(),
+						call.wantCode,
+					)
+				}
+
+				retryAfter := connectErr.Meta().Get(middleware.RetryAfterHeader)
+				if retryAfter != call.wantRetryAfter {
+					t.Errorf(
+						"call %d: Retry-After = %q, want %q",
+						callIndex,
+						retryAfter,
+						call.wantRetryAfter,
+					)
+				}
+			}
+		})
+	}
+}
@@ -3,11 +3,14 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yashikota/scene-hunter/server/internal/domain/auth"
 	"github.com/yashikota/scene-hunter/server/internal/domain/game"
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
 	"github.com/yashikota/scene-hunter/server/internal/domain/room"
+	"github.com/yashikota/scene-hunter/server/internal/domain/user"
 )
 
 // GameRepository defines the interface for game persistence.
@@ -17,6 +20,29 @@ type GameRepository interface {
 	Update(ctx context.Context, gameSession *game.Game) error
 	Delete(ctx context.Context, roomID uuid.UUID) error
 	Exists(ctx context.Context, roomID uuid.UUID) (bool, error)
+	ListByPlayer(ctx context.Context, userID uuid.UUID) ([]*game.Game, error)
+	RemovePlayerIndex(ctx context.Context, userID, roomID uuid.UUID) error
+	// GetDelayed returns the game as it was stored delay ago, or as it was first stored
+	// if it is younger. Games are only kept for this while they have a spectator delay.
+	GetDelayed(ctx context.Context, roomID uuid.UUID, delay time.Duration) (*game.Game, error)
+	// ClearHistory drops the past states of a game kept for spectators.
+	// The next update of the game is kept as its first state.
+	ClearHistory(ctx context.Context, roomID uuid.UUID) error
+	// ListEvents returns up to limit events of the append-only event stream of a game
+	// after the event afterID, or from the first event when afterID is empty.
+	// The changes of a game are added to the stream when the game is stored.
+	ListEvents(
+		ctx context.Context,
+		roomID uuid.UUID,
+		afterID string,
+		limit int64,
+	) ([]*game.Event, error)
+	// AppendEvents adds the pending events of a game to its event stream without storing
+	// the game, for changes that are kept apart from it such as the ballots of a vote.
+	AppendEvents(ctx context.Context, gameSession *game.Game) error
+	// AnonymizeEvents replaces userID with anonymousID in the event stream of a game,
+	// keeping the position of every event.
+	AnonymizeEvents(ctx context.Context, roomID, userID, anonymousID uuid.UUID) error
 }
 
 // RoomRepository defines the interface for room persistence.
@@ -28,6 +54,48 @@ type RoomRepository interface {
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
 }
 
+// ImageRepository defines the interface for the image catalog.
+type ImageRepository interface {
+	Save(ctx context.Context, metadata *image.Metadata, ttl time.Duration) error
+	Get(ctx context.Context, roomID, imageID uuid.UUID) (*image.Metadata, error)
+	ListByRoom(ctx context.Context, roomID uuid.UUID) ([]*image.Metadata, error)
+	ListByUploader(ctx context.Context, uploaderID uuid.UUID) ([]*image.Metadata, error)
+	Delete(ctx context.Context, metadata *image.Metadata) error
+}
+
+// UploadRepository defines the interface for presigned uploads waiting for completion.
+type UploadRepository interface {
+	Save(ctx context.Context, upload *image.PendingUpload, ttl time.Duration) error
+	Get(ctx context.Context, uploadID uuid.UUID) (*image.PendingUpload, error)
+	Delete(ctx context.Context, uploadID uuid.UUID) error
+	// Lock reserves an upload for one stream. It returns false if the upload is already locked.
+	Lock(ctx context.Context, uploadID uuid.UUID, ttl time.Duration) (bool, error)
+	Unlock(ctx context.Context, uploadID uuid.UUID) error
+}
+
+// HintJobQueue defines the interface for the queue of background hint generation jobs.
+// A received job is delivered again to another worker if it is not acknowledged in time.
+type HintJobQueue interface {
+	Enqueue(ctx context.Context, job *game.HintJob) error
+	// Receive waits for jobs for a worker and returns none when nothing arrives for a while.
+	// The jobs tell how many times they have been delivered.
+	Receive(ctx context.Context, worker string) ([]*game.HintJob, error)
+	// Ack removes a finished job from the queue.
+	Ack(ctx context.Context, job *game.HintJob) error
+}
+
+// VoteRepository defines the interface for the audience's ballots on the photos of a round.
+type VoteRepository interface {
+	// AddBallot stores a ballot and reports false if the voter has already voted in the round.
+	AddBallot(
+		ctx context.Context,
+		roomID uuid.UUID,
+		roundNumber int,
+		ballot *game.Ballot,
+	) (bool, error)
+	ListBallots(ctx context.Context, roomID uuid.UUID, roundNumber int) ([]*game.Ballot, error)
+}
+
 // AnonRepository defines the interface for anonymous token storage.
 type AnonRepository interface {
 	SaveRefreshToken(ctx context.Context, token *auth.RefreshToken) error
@@ -35,6 +103,13 @@ type AnonRepository interface {
 	MarkRefreshTokenAsUsed(ctx context.Context, tokenID string) error
 	RevokeRefreshToken(ctx context.Context, tokenID string) error
 	RevokeAllAnonTokens(ctx context.Context, anonID string) error
+	RevokeSubject(ctx context.Context, subject string, ttl time.Duration) error
+	IsSubjectRevoked(ctx context.Context, subject string) (bool, error)
+}
+
+// UserRepository defines the interface for permanent user storage.
+type UserRepository interface {
+	GetUser(ctx context.Context, userID uuid.UUID) (*user.User, error)
 }
 
 // IdentityRepository defines the interface for user identity storage.
@@ -0,0 +1,383 @@
+package vision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrBudgetExceeded is returned without calling the vision model
+// when the room or the whole service has used up its tokens.
+var ErrBudgetExceeded = errors.New("vision model budget exceeded")
+
+// Operations of a vision model, as reported in metrics.
+const (
+	operationDescribe = "describe"
+	operationCompare  = "compare"
+	operationModerate = "moderate"
+)
+
+const (
+	// cacheKeyPrefix is the KVS key prefix of cached answers.
+	cacheKeyPrefix = "vision_cache:"
+	// budgetKeyPrefix is the KVS key prefix of used tokens.
+	budgetKeyPrefix = "vision_budget:"
+	// dailyBudgetTTL keeps the tokens of a day until the day is surely over in every time zone.
+	dailyBudgetTTL = 48 * time.Hour
+	// roomBudgetTTL keeps the tokens of a room as long as its game is kept.
+	roomBudgetTTL = 24 * time.Hour
+)
+
+// chargeScript adds tokens to a budget and renews its expiry.
+const chargeScript = `
+	local used = redis.call('INCRBY', KEYS[1], ARGV[1])
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return used
+`
+
+// AccountingOptions configure how calls to the vision model are cached and budgeted.
+type AccountingOptions struct {
+	// CacheTTL is how long answers are reused for the same images and prompt.
+	// Zero disables the cache.
+	CacheTTL time.Duration
+	// RoomTokenBudget is how many tokens the calls for one room may use. Zero means no limit.
+	RoomTokenBudget int64
+	// DailyTokenBudget is how many tokens all calls may use in a day (UTC). Zero means no limit.
+	DailyTokenBudget int64
+}
+
+type (
+	roomContextKey     struct{}
+	uncachedContextKey struct{}
+)
+
+// WithRoom returns a context whose calls to the vision model are billed to the room.
+func WithRoom(ctx context.Context, roomID string) context.Context {
+	return context.WithValue(ctx, roomContextKey{}, roomID)
+}
+
+// Uncached returns a context whose calls ask the vision model again
+// instead of reusing a cached answer. The new answer replaces the cached one.
+func Uncached(ctx context.Context) context.Context {
+	return context.WithValue(ctx, uncachedContextKey{}, true)
+}
+
+// Accounting is a vision model that caches the answers of another vision model,
+// keeps its calls within token budgets and records them as metrics.
+// Answers are cached by the content of the images and the prompt,
+// so that submitting the same photo again costs nothing.
+// Budgets are checked before a call and charged with the tokens the provider reports,
+// so a call may go over a budget once, and then ErrBudgetExceeded is returned.
+// If the KVS fails the call is let through so that accounting never causes an outage.
+type Accounting struct {
+	model   service.VisionModel
+	kvs     service.KVS
+	metrics service.VisionMetrics
+	options AccountingOptions
+	chrono  chrono.Chrono
+}
+
+// NewAccounting wraps a vision model with a cache, token budgets and metrics.
+func NewAccounting(
+	model service.VisionModel,
+	kvsClient service.KVS,
+	metrics service.VisionMetrics,
+	options AccountingOptions,
+	chrono chrono.Chrono,
+) *Accounting {
+	return &Accounting{
+		model:   model,
+		kvs:     kvsClient,
+		metrics: metrics,
+		options: options,
+		chrono:  chrono,
+	}
+}
+
+// Describe analyzes an image and returns features.
+func (a *Accounting) Describe(
+	ctx context.Context,
+	image service.VisionImage,
+	prompt string,
+) (*service.ImageAnalysisResult, error) {
+	return account(
+		ctx,
+		a,
+		operationDescribe,
+		cacheKey(operationDescribe, image.Data, []byte(prompt)),
+		func(ctx context.Context) (*service.ImageAnalysisResult, error) {
+			return a.model.Describe(ctx, image, prompt)
+		},
+		func(result *service.ImageAnalysisResult) service.VisionUsage {
+			return result.Usage
+		},
+	)
+}
+
+// Compare rates how similar a candidate photo is to a reference photo.
+func (a *Accounting) Compare(
+	ctx context.Context,
+	reference, candidate service.VisionImage,
+) (*service.ImageComparison, error) {
+	return account(
+		ctx,
+		a,
+		operationCompare,
+		cacheKey(operationCompare, reference.Data, candidate.Data),
+		func(ctx context.Context) (*service.ImageComparison, error) {
+			return a.model.Compare(ctx, reference, candidate)
+		},
+		func(comparison *service.ImageComparison) service.VisionUsage {
+			return comparison.Usage
+		},
+	)
+}
+
+// Moderate checks whether a text is safe to show to players.
+func (a *Accounting) Moderate(ctx context.Context, text string) (*service.ModerationResult, error) {
+	return account(
+		ctx,
+		a,
+		operationModerate,
+		cacheKey(operationModerate, []byte(text)),
+		func(ctx context.Context) (*service.ModerationResult, error) {
+			return a.model.Moderate(ctx, text)
+		},
+		func(moderation *service.ModerationResult) service.VisionUsage {
+			return moderation.Usage
+		},
+	)
+}
+
+// account answers from the cache, or else calls the vision model within the budgets.
+func account[T any](
+	ctx context.Context,
+	accounting *Accounting,
+	operation, key string,
+	send func(ctx context.Context) (*T, error),
+	usage func(result *T) service.VisionUsage,
+) (*T, error) {
+	cached, ok := lookup[T](ctx, accounting, key)
+	if ok {
+		accounting.record(ctx, operation, service.VisionOutcomeCached, usage(cached), 0)
+
+		return cached, nil
+	}
+
+	budgets := accounting.budgets(ctx)
+
+	err := accounting.checkBudgets(ctx, budgets)
+	if err != nil {
+		accounting.record(
+			ctx,
+			operation,
+			service.VisionOutcomeBudgetExceeded,
+			service.VisionUsage{},
+			0,
+		)
+
+		return nil, err
+	}
+
+	start := accounting.chrono.Now()
+	result, err := send(ctx)
+	latency := accounting.chrono.Now().Sub(start)
+
+	if err != nil {
+		accounting.record(
+			ctx,
+			operation,
+			service.VisionOutcomeError,
+			service.VisionUsage{},
+			latency,
+		)
+
+		return nil, err
+	}
+
+	accounting.charge(ctx, budgets, usage(result))
+	accounting.store(ctx, key, result)
+	accounting.record(ctx, operation, service.VisionOutcomeSuccess, usage(result), latency)
+
+	return result, nil
+}
+
+// lookup returns the cached answer of key.
+func lookup[T any](ctx context.Context, accounting *Accounting, key string) (*T, bool) {
+	if accounting.options.CacheTTL <= 0 {
+		return nil, false
+	}
+
+	if uncached, _ := ctx.Value(uncachedContextKey{}).(bool); uncached {
+		return nil, false
+	}
+
+	data, err := accounting.kvs.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, service.ErrNotFound) {
+			errors.LogErrorCtx(ctx, "failed to read cached vision answer", err)
+		}
+
+		return nil, false
+	}
+
+	var cached T
+
+	err = json.Unmarshal([]byte(data), &cached)
+	if err != nil {
+		errors.LogErrorCtx(ctx, "dropping malformed cached vision answer", err)
+
+		return nil, false
+	}
+
+	return &cached, true
+}
+
+// store caches an answer under key.
+func (a *Accounting) store(ctx context.Context, key string, result any) {
+	if a.options.CacheTTL <= 0 {
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		errors.LogErrorCtx(ctx, "failed to marshal vision answer", err)
+
+		return
+	}
+
+	err = a.kvs.Set(ctx, key, string(data), a.options.CacheTTL)
+	if err != nil {
+		errors.LogErrorCtx(ctx, "failed to cache vision answer", err)
+	}
+}
+
+// tokenBudget is a limit on the tokens counted under a KVS key.
+type tokenBudget struct {
+	name  string
+	key   string
+	limit int64
+	ttl   time.Duration
+}
+
+// budgets returns the budgets that a call in ctx is charged to.
+func (a *Accounting) budgets(ctx context.Context) []tokenBudget {
+	budgets := make([]tokenBudget, 0, 2)
+
+	if a.options.DailyTokenBudget > 0 {
+		day := a.chrono.Now().UTC().Format(time.DateOnly)
+		budgets = append(budgets, tokenBudget{
+			name:  "daily",
+			key:   budgetKeyPrefix + "day:" + day,
+			limit: a.options.DailyTokenBudget,
+			ttl:   dailyBudgetTTL,
+		})
+	}
+
+	roomID, _ := ctx.Value(roomContextKey{}).(string)
+	if a.options.RoomTokenBudget > 0 && roomID != "" {
+		budgets = append(budgets, tokenBudget{
+			name:  "room",
+			key:   budgetKeyPrefix + "room:" + roomID,
+			limit: a.options.RoomTokenBudget,
+			ttl:   roomBudgetTTL,
+		})
+	}
+
+	return budgets
+}
+
+// checkBudgets returns ErrBudgetExceeded if any of budgets is used up.
+func (a *Accounting) checkBudgets(ctx context.Context, budgets []tokenBudget) error {
+	for _, budget := range budgets {
+		data, err := a.kvs.Get(ctx, budget.key)
+		if errors.Is(err, service.ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			errors.LogErrorCtx(ctx, "failed to read vision budget", err, "budget", budget.name)
+
+			continue
+		}
+
+		used, err := strconv.ParseInt(data, 10, 64)
+		if err != nil {
+			errors.LogErrorCtx(ctx, "malformed vision budget", err, "budget", budget.name)
+
+			continue
+		}
+
+		if used >= budget.limit {
+			return errors.Errorf(
+				"%w: %s budget of %d tokens used up",
+				ErrBudgetExceeded,
+				budget.name,
+				budget.limit,
+			)
+		}
+	}
+
+	return nil
+}
+
+// charge adds the tokens of a call to budgets.
+func (a *Accounting) charge(
+	ctx context.Context,
+	budgets []tokenBudget,
+	usage service.VisionUsage,
+) {
+	tokens := usage.InputTokens + usage.OutputTokens
+	if tokens <= 0 {
+		return
+	}
+
+	for _, budget := range budgets {
+		_, err := a.kvs.Eval(
+			ctx,
+			chargeScript,
+			[]string{budget.key},
+			tokens,
+			budget.ttl.Milliseconds(),
+		)
+		if err != nil {
+			errors.LogErrorCtx(ctx, "failed to charge vision budget", err, "budget", budget.name)
+		}
+	}
+}
+
+// record reports a call to the metrics.
+func (a *Accounting) record(
+	ctx context.Context,
+	operation, outcome string,
+	usage service.VisionUsage,
+	latency time.Duration,
+) {
+	a.metrics.RecordVisionCall(ctx, service.VisionCall{
+		Operation: operation,
+		Outcome:   outcome,
+		Usage:     usage,
+		Latency:   latency,
+	})
+}
+
+// cacheKey returns the KVS key of the answer to an operation on parts.
+// Each part is hashed with its length so that different splits never collide.
+func cacheKey(operation string, parts ...[]byte) string {
+	hash := sha256.New()
+
+	for _, part := range parts {
+		_ = binary.Write(hash, binary.BigEndian, uint64(len(part)))
+		_, _ = hash.Write(part)
+	}
+
+	return cacheKeyPrefix + operation + ":" + hex.EncodeToString(hash.Sum(nil))
+}
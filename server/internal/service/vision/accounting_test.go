@@ -0,0 +1,297 @@
+package vision_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+	"github.com/yashikota/scene-hunter/server/internal/infra/kvs"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const testPrompt = "Write hints"
+
+// outcomeRecorder records the outcomes of vision calls.
+type outcomeRecorder struct {
+	mu       sync.Mutex
+	outcomes []string
+}
+
+func (r *outcomeRecorder) RecordVisionCall(_ context.Context, call service.VisionCall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.outcomes = append(r.outcomes, call.Outcome)
+}
+
+// newDescribeModel returns a vision model whose Describe is billed for 100 tokens,
+// and the number of calls it received.
+func newDescribeModel(t *testing.T) (service.VisionModel, *int) {
+	t.Helper()
+
+	ctrl := NewMockController(t)
+	model := Mock[service.VisionModel](ctrl)
+	calls := 0
+
+	WhenDouble(model.Describe(
+		Any[context.Context](),
+		Any[service.VisionImage](),
+		Any[string](),
+	)).ThenAnswer(func([]any) (*service.ImageAnalysisResult, error) {
+		calls++
+
+		return &service.ImageAnalysisResult{
+			Features: []string{testText},
+			Usage:    service.VisionUsage{Model: "test-model", InputTokens: 80, OutputTokens: 20},
+		}, nil
+	})
+
+	return model, &calls
+}
+
+// newAccounting wraps model with options, keeping its records in miniredis.
+func newAccounting(
+	t *testing.T,
+	model service.VisionModel,
+	options vision.AccountingOptions,
+	chronoProvider *testChrono,
+) (*vision.Accounting, *outcomeRecorder) {
+	t.Helper()
+
+	redis := miniredis.RunT(t)
+
+	kvsClient, err := kvs.NewClient(redis.Addr(), "")
+	if err != nil {
+		t.Fatalf("failed to create kvs client: %v", err)
+	}
+
+	t.Cleanup(kvsClient.Close)
+
+	recorder := &outcomeRecorder{mu: sync.Mutex{}, outcomes: nil}
+
+	return vision.NewAccounting(model, kvsClient, recorder, options, chronoProvider), recorder
+}
+
+func TestAccounting_Cache(t *testing.T) {
+	t.Parallel()
+
+	model, calls := newDescribeModel(t)
+	accounting, recorder := newAccounting(
+		t,
+		model,
+		vision.AccountingOptions{CacheTTL: time.Hour, RoomTokenBudget: 0, DailyTokenBudget: 0},
+		&testChrono{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	)
+
+	photo := service.VisionImage{Data: []byte("photo"), MIMEType: "image/jpeg"}
+
+	for _, request := range []struct {
+		image    service.VisionImage
+		prompt   string
+		uncached bool
+	}{
+		{photo, testPrompt, false},
+		// 同じ写真とプロンプトは保存した回答を使う
+		{photo, testPrompt, false},
+		{photo, testPrompt + " in English", false},
+		{service.VisionImage{Data: []byte("another photo"), MIMEType: "image/jpeg"}, testPrompt, false},
+		{photo, testPrompt, true},
+	} {
+		ctx := context.Background()
+		if request.uncached {
+			ctx = vision.Uncached(ctx)
+		}
+
+		result, err := accounting.Describe(ctx, request.image, request.prompt)
+		if err != nil {
+			t.Fatalf("Describe() failed: %v", err)
+		}
+
+		if !slices.Equal(result.Features, []string{testText}) {
+			t.Errorf("Describe() = %v, want %v", result.Features, []string{testText})
+		}
+	}
+
+	if *calls != 4 {
+		t.Errorf("Describe() called the model %d times, want 4", *calls)
+	}
+
+	want := []string{
+		service.VisionOutcomeSuccess,
+		service.VisionOutcomeCached,
+		service.VisionOutcomeSuccess,
+		service.VisionOutcomeSuccess,
+		service.VisionOutcomeSuccess,
+	}
+	if !slices.Equal(recorder.outcomes, want) {
+		t.Errorf("recorded outcomes = %v, want %v", recorder.outcomes, want)
+	}
+}
+
+func TestAccounting_Budget(t *testing.T) {
+	t.Parallel()
+
+	// 1回の呼び出しは100トークンなので、150トークンの予算では2回目で使い切る
+	tests := map[string]struct {
+		options   vision.AccountingOptions
+		rooms     []string
+		wantCalls int
+	}{
+		"no budget": {
+			vision.AccountingOptions{CacheTTL: 0, RoomTokenBudget: 0, DailyTokenBudget: 0},
+			[]string{"a", "a", "a"},
+			3,
+		},
+		"room budget": {
+			vision.AccountingOptions{CacheTTL: 0, RoomTokenBudget: 150, DailyTokenBudget: 0},
+			[]string{"a", "a", "a"},
+			2,
+		},
+		"room budget is per room": {
+			vision.AccountingOptions{CacheTTL: 0, RoomTokenBudget: 150, DailyTokenBudget: 0},
+			[]string{"a", "b", "c"},
+			3,
+		},
+		"room budget needs a room": {
+			vision.AccountingOptions{CacheTTL: 0, RoomTokenBudget: 150, DailyTokenBudget: 0},
+			[]string{"", "", ""},
+			3,
+		},
+		"daily budget is shared": {
+			vision.AccountingOptions{CacheTTL: 0, RoomTokenBudget: 0, DailyTokenBudget: 150},
+			[]string{"a", "b", "c"},
+			2,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model, calls := newDescribeModel(t)
+			accounting, recorder := newAccounting(
+				t,
+				model,
+				testCase.options,
+				&testChrono{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)},
+			)
+
+			for index, room := range testCase.rooms {
+				ctx := context.Background()
+				if room != "" {
+					ctx = vision.WithRoom(ctx, room)
+				}
+
+				_, err := accounting.Describe(ctx, service.VisionImage{}, testPrompt)
+
+				wantErr := index >= testCase.wantCalls
+				if wantErr != errors.Is(err, vision.ErrBudgetExceeded) {
+					t.Errorf("Describe() call %d error = %v, want budget exceeded %v",
+						index+1, err, wantErr)
+				}
+			}
+
+			if *calls != testCase.wantCalls {
+				t.Errorf(
+					"Describe() called the model %d times, want %d",
+					*calls,
+					testCase.wantCalls,
+				)
+			}
+
+			if len(recorder.outcomes) != len(testCase.rooms) {
+				t.Errorf("recorded %d calls, want %d", len(recorder.outcomes), len(testCase.rooms))
+			}
+		})
+	}
+}
+
+func TestAccounting_DailyBudgetResets(t *testing.T) {
+	t.Parallel()
+
+	model, calls := newDescribeModel(t)
+	chronoProvider := &testChrono{now: time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)}
+	accounting, _ := newAccounting(
+		t,
+		model,
+		vision.AccountingOptions{CacheTTL: 0, RoomTokenBudget: 0, DailyTokenBudget: 100},
+		chronoProvider,
+	)
+
+	ctx := context.Background()
+
+	_, err := accounting.Describe(ctx, service.VisionImage{}, testPrompt)
+	if err != nil {
+		t.Fatalf("Describe() failed: %v", err)
+	}
+
+	_, err = accounting.Describe(ctx, service.VisionImage{}, testPrompt)
+	if !errors.Is(err, vision.ErrBudgetExceeded) {
+		t.Fatalf("Describe() error = %v, want %v", err, vision.ErrBudgetExceeded)
+	}
+
+	// 日付(UTC)が変われば新しい予算で呼び出せる
+	chronoProvider.now = chronoProvider.now.Add(time.Hour)
+
+	_, err = accounting.Describe(ctx, service.VisionImage{}, testPrompt)
+	if err != nil {
+		t.Fatalf("Describe() on the next day failed: %v", err)
+	}
+
+	if *calls != 2 {
+		t.Errorf("Describe() called the model %d times, want 2", *calls)
+	}
+}
+
+func TestAccounting_KVSUnavailable(t *testing.T) {
+	t.Parallel()
+
+	model, calls := newDescribeModel(t)
+
+	ctrl := NewMockController(t)
+	kvsClient := Mock[service.KVS](ctrl)
+	WhenDouble(kvsClient.Get(Any[context.Context](), Any[string]())).
+		ThenReturn("", errUnavailable)
+	WhenSingle(kvsClient.Set(
+		Any[context.Context](),
+		Any[string](),
+		Any[string](),
+		Any[time.Duration](),
+	)).ThenReturn(errUnavailable)
+	WhenDouble(kvsClient.Eval(Any[context.Context](), Any[string](), Any[[]string]())).
+		ThenReturn(nil, errUnavailable)
+
+	recorder := &outcomeRecorder{mu: sync.Mutex{}, outcomes: nil}
+	accounting := vision.NewAccounting(
+		model,
+		kvsClient,
+		recorder,
+		vision.AccountingOptions{CacheTTL: time.Hour, RoomTokenBudget: 1, DailyTokenBudget: 1},
+		&testChrono{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	)
+
+	// KVSが使えなくても、キャッシュと予算を使わずに呼び出す
+	ctx := vision.WithRoom(context.Background(), "a")
+
+	for range 2 {
+		_, err := accounting.Describe(ctx, service.VisionImage{}, testPrompt)
+		if err != nil {
+			t.Fatalf("Describe() failed: %v", err)
+		}
+	}
+
+	if *calls != 2 {
+		t.Errorf("Describe() called the model %d times, want 2", *calls)
+	}
+
+	want := []string{service.VisionOutcomeSuccess, service.VisionOutcomeSuccess}
+	if !slices.Equal(recorder.outcomes, want) {
+		t.Errorf("recorded outcomes = %v, want %v", recorder.outcomes, want)
+	}
+}
@@ -0,0 +1,94 @@
+// Package vision provides AI image analysis services.
+package vision
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/yashikota/scene-hunter/server/internal/domain/image"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// Service analyzes images in blob storage with a vision model.
+type Service struct {
+	blobClient  service.Blob
+	visionModel service.VisionModel
+}
+
+// NewService creates a new vision service.
+func NewService(blobClient service.Blob, visionModel service.VisionModel) *Service {
+	return &Service{
+		blobClient:  blobClient,
+		visionModel: visionModel,
+	}
+}
+
+// detectImageMIMEType detects the MIME type of an image from its content.
+func detectImageMIMEType(data []byte) string {
+	// http.DetectContentType correctly detects JPEG, PNG, WebP and other common formats
+	return http.DetectContentType(data)
+}
+
+// DescribeFromBlob describes an image from blob storage.
+func (s *Service) DescribeFromBlob(
+	ctx context.Context,
+	imageKey, prompt string,
+) (*service.ImageAnalysisResult, error) {
+	imageData, err := s.readBlob(ctx, imageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Describe image with the vision model
+	result, err := s.visionModel.Describe(
+		ctx,
+		service.VisionImage{Data: imageData, MIMEType: detectImageMIMEType(imageData)},
+		prompt,
+	)
+	if err != nil {
+		return nil, errors.Errorf("failed to describe image: %w", err)
+	}
+
+	return result, nil
+}
+
+// ExtractFeaturesFromBlob finds simple features of an image from blob storage without AI.
+func (s *Service) ExtractFeaturesFromBlob(
+	ctx context.Context,
+	imageKey string,
+) (*image.Features, error) {
+	imageData, err := s.readBlob(ctx, imageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	features, err := image.ExtractFeatures(imageData)
+	if err != nil {
+		return nil, errors.Errorf("failed to extract image features: %w", err)
+	}
+
+	return features, nil
+}
+
+// readBlob reads an image from blob storage.
+func (s *Service) readBlob(ctx context.Context, imageKey string) ([]byte, error) {
+	// Get image from blob storage
+	reader, err := s.blobClient.Get(ctx, imageKey)
+	if err != nil {
+		return nil, errors.Errorf("failed to get image from blob: %w", err)
+	}
+
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	// Read image data
+	imageData, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Errorf("failed to read image data: %w", err)
+	}
+
+	return imageData, nil
+}
@@ -1,4 +1,4 @@
-package gemini_test
+package vision_test
 
 import (
 	"context"
@@ -8,7 +8,7 @@ import (
 
 	. "github.com/ovechkin-dm/mockio/v2/mock"
 	"github.com/yashikota/scene-hunter/server/internal/service"
-	"github.com/yashikota/scene-hunter/server/internal/service/gemini"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
 )
 
 func TestNewService(t *testing.T) {
@@ -17,27 +17,27 @@ func TestNewService(t *testing.T) {
 	ctrl := NewMockController(t)
 
 	blobClient := Mock[service.Blob](ctrl)
-	geminiClient := Mock[service.Gemini](ctrl)
+	visionModel := Mock[service.VisionModel](ctrl)
 
-	svc := gemini.NewService(blobClient, geminiClient)
+	svc := vision.NewService(blobClient, visionModel)
 	if svc == nil {
 		t.Error("NewService() returned nil")
 	}
 }
 
-func TestService_AnalyzeImageFromBlob(t *testing.T) {
+func TestService_DescribeFromBlob(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 
 	tests := map[string]struct {
-		imageKey     string
-		prompt       string
-		blobData     []byte
-		blobError    error
-		geminiResult *service.ImageAnalysisResult
-		geminiError  error
-		wantErr      bool
+		imageKey    string
+		prompt      string
+		blobData    []byte
+		blobError   error
+		modelResult *service.ImageAnalysisResult
+		modelError  error
+		wantErr     bool
 	}{
 		"successful analysis": {
 			"test.jpg",
@@ -59,7 +59,7 @@ func TestService_AnalyzeImageFromBlob(t *testing.T) {
 			ctrl := NewMockController(t)
 
 			blobClient := Mock[service.Blob](ctrl)
-			geminiClient := Mock[service.Gemini](ctrl)
+			visionModel := Mock[service.VisionModel](ctrl)
 
 			// Setup expectations
 			//nolint:contextcheck // Mock expectation setup doesn't inherit context
@@ -69,27 +69,26 @@ func TestService_AnalyzeImageFromBlob(t *testing.T) {
 			if testCase.blobError == nil {
 				//nolint:contextcheck // Mock expectation setup doesn't inherit context
 				WhenDouble(
-					geminiClient.AnalyzeImage(
+					visionModel.Describe(
 						Any[context.Context](),
-						Any[[]byte](),
-						Any[string](),
+						Any[service.VisionImage](),
 						Exact(testCase.prompt),
 					),
 				).
-					ThenReturn(testCase.geminiResult, testCase.geminiError)
+					ThenReturn(testCase.modelResult, testCase.modelError)
 			}
 
-			svc := gemini.NewService(blobClient, geminiClient)
-			result, err := svc.AnalyzeImageFromBlob(ctx, testCase.imageKey, testCase.prompt)
+			svc := vision.NewService(blobClient, visionModel)
+			result, err := svc.DescribeFromBlob(ctx, testCase.imageKey, testCase.prompt)
 
 			if (err != nil) != testCase.wantErr {
-				t.Errorf("AnalyzeImageFromBlob() error = %v, wantErr %v", err, testCase.wantErr)
+				t.Errorf("DescribeFromBlob() error = %v, wantErr %v", err, testCase.wantErr)
 
 				return
 			}
 
 			if !testCase.wantErr && result == nil {
-				t.Error("AnalyzeImageFromBlob() returned nil result")
+				t.Error("DescribeFromBlob() returned nil result")
 			}
 		})
 	}
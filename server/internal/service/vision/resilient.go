@@ -0,0 +1,267 @@
+package vision
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/chrono"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// ErrCircuitOpen is returned without calling the vision model while it keeps failing.
+var ErrCircuitOpen = errors.New("vision model circuit is open")
+
+// ResilienceOptions configure how calls to the vision model are limited and retried.
+type ResilienceOptions struct {
+	// Timeout limits each call to the vision model.
+	Timeout time.Duration
+	// MaxRetries is how many times a failed call is retried.
+	MaxRetries int
+	// RetryBaseDelay is the longest wait before the first retry. It doubles with every retry,
+	// and the actual wait is a random duration up to it.
+	RetryBaseDelay time.Duration
+	// FailureThreshold is how many calls in a row must fail to open the circuit.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before one call is let through to probe.
+	OpenDuration time.Duration
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// Resilient is a vision model that limits, retries and stops calls to another vision model.
+// After FailureThreshold failures in a row the circuit opens and calls fail fast with
+// ErrCircuitOpen, so that callers can fall back at once instead of waiting for timeouts.
+// Rejected requests and calls canceled by the caller are not failures of the model.
+// The state of the circuit is reported as a health check.
+type Resilient struct {
+	model   service.VisionModel
+	options ResilienceOptions
+	chrono  chrono.Chrono
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewResilient wraps a vision model with timeouts, retries and a circuit breaker.
+func NewResilient(
+	model service.VisionModel,
+	options ResilienceOptions,
+	chrono chrono.Chrono,
+) *Resilient {
+	return &Resilient{
+		model:   model,
+		options: options,
+		chrono:  chrono,
+	}
+}
+
+// Describe analyzes an image and returns features.
+func (r *Resilient) Describe(
+	ctx context.Context,
+	image service.VisionImage,
+	prompt string,
+) (*service.ImageAnalysisResult, error) {
+	return call(ctx, r, func(ctx context.Context) (*service.ImageAnalysisResult, error) {
+		return r.model.Describe(ctx, image, prompt)
+	})
+}
+
+// Compare rates how similar a candidate photo is to a reference photo.
+func (r *Resilient) Compare(
+	ctx context.Context,
+	reference, candidate service.VisionImage,
+) (*service.ImageComparison, error) {
+	return call(ctx, r, func(ctx context.Context) (*service.ImageComparison, error) {
+		return r.model.Compare(ctx, reference, candidate)
+	})
+}
+
+// Moderate checks whether a text is safe to show to players.
+func (r *Resilient) Moderate(ctx context.Context, text string) (*service.ModerationResult, error) {
+	return call(ctx, r, func(ctx context.Context) (*service.ModerationResult, error) {
+		return r.model.Moderate(ctx, text)
+	})
+}
+
+// Check reports an error while the circuit is not closed.
+func (r *Resilient) Check(_ context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch r.state {
+	case circuitOpen:
+		return errors.Errorf(
+			"%w: %d failures in a row, retrying after %s",
+			ErrCircuitOpen,
+			r.failures,
+			r.openedAt.Add(r.options.OpenDuration).Format(time.RFC3339),
+		)
+	case circuitHalfOpen:
+		return errors.Errorf("%w: probing the vision model", ErrCircuitOpen)
+	case circuitClosed:
+	}
+
+	return nil
+}
+
+// Name returns the name of the health check.
+func (r *Resilient) Name() string {
+	return "vision"
+}
+
+// call calls the vision model with a timeout, retrying temporary failures.
+func call[T any](
+	ctx context.Context,
+	resilient *Resilient,
+	send func(ctx context.Context) (T, error),
+) (T, error) {
+	var zero T
+
+	for retry := 0; ; retry++ {
+		err := resilient.allow()
+		if err != nil {
+			return zero, err
+		}
+
+		result, err := attempt(ctx, resilient.options.Timeout, send)
+		if err == nil {
+			resilient.succeed()
+
+			return result, nil
+		}
+
+		switch {
+		case ctx.Err() != nil:
+			// 呼び出し元のキャンセルはモデルの失敗として数えない
+			resilient.release()
+
+			return zero, errors.Errorf("vision call canceled: %w", err)
+		case errors.Is(err, service.ErrVisionRequestRejected):
+			// モデルは応答しているので、回路は閉じたままにする
+			resilient.succeed()
+
+			return zero, err
+		}
+
+		resilient.fail()
+
+		if retry >= resilient.options.MaxRetries {
+			return zero, errors.Errorf("vision call failed after %d attempts: %w", retry+1, err)
+		}
+
+		err = wait(ctx, resilient.retryDelay(retry))
+		if err != nil {
+			return zero, err
+		}
+	}
+}
+
+// attempt calls the vision model once within timeout.
+func attempt[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	send func(ctx context.Context) (T, error),
+) (T, error) {
+	if timeout <= 0 {
+		return send(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return send(ctx)
+}
+
+// retryDelay returns a random wait up to RetryBaseDelay doubled for each earlier retry.
+func (r *Resilient) retryDelay(retry int) time.Duration {
+	limit := r.options.RetryBaseDelay << retry
+	if limit <= 0 {
+		return 0
+	}
+
+	//nolint:gosec // the jitter does not need a secure random number
+	return rand.N(limit)
+}
+
+// wait sleeps for delay or until ctx is done.
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return errors.Errorf("retry wait canceled: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// allow reports ErrCircuitOpen unless a call may be made now.
+// Once OpenDuration has passed, a single call is let through to probe the model.
+func (r *Resilient) allow() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch r.state {
+	case circuitOpen:
+		if r.chrono.Now().Before(r.openedAt.Add(r.options.OpenDuration)) {
+			return ErrCircuitOpen
+		}
+
+		r.state = circuitHalfOpen
+		r.probing = true
+	case circuitHalfOpen:
+		if r.probing {
+			return ErrCircuitOpen
+		}
+
+		r.probing = true
+	case circuitClosed:
+	}
+
+	return nil
+}
+
+// succeed closes the circuit.
+func (r *Resilient) succeed() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state = circuitClosed
+	r.failures = 0
+	r.probing = false
+}
+
+// fail counts a failure and opens the circuit when the probe or too many calls in a row failed.
+func (r *Resilient) fail() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failures++
+	r.probing = false
+
+	if r.state == circuitHalfOpen || r.failures >= r.options.FailureThreshold {
+		r.state = circuitOpen
+		r.openedAt = r.chrono.Now()
+	}
+}
+
+// release lets another call probe the model when a probe ended without an answer.
+func (r *Resilient) release() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.probing = false
+}
@@ -0,0 +1,221 @@
+package vision_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/service/vision"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+const testText = "a red door"
+
+var errUnavailable = errors.New("service unavailable")
+
+// testChrono is a clock that only moves when advanced.
+type testChrono struct {
+	now time.Time
+}
+
+func (c *testChrono) Now() time.Time {
+	return c.now
+}
+
+// testOptions returns resilience options that retry without waiting.
+func testOptions(maxRetries, failureThreshold int) vision.ResilienceOptions {
+	return vision.ResilienceOptions{
+		Timeout:          time.Second,
+		MaxRetries:       maxRetries,
+		RetryBaseDelay:   time.Millisecond,
+		FailureThreshold: failureThreshold,
+		OpenDuration:     time.Minute,
+	}
+}
+
+// newModerateModel returns a vision model whose Moderate answers with errs in order,
+// succeeding once they run out, and the number of calls it received.
+func newModerateModel(t *testing.T, errs ...error) (service.VisionModel, *int) {
+	t.Helper()
+
+	ctrl := NewMockController(t)
+	model := Mock[service.VisionModel](ctrl)
+	calls := 0
+
+	WhenDouble(model.Moderate(Any[context.Context](), Any[string]())).
+		ThenAnswer(func([]any) (*service.ModerationResult, error) {
+			calls++
+
+			if calls <= len(errs) && errs[calls-1] != nil {
+				return nil, errs[calls-1]
+			}
+
+			return &service.ModerationResult{Flagged: false, Categories: nil}, nil
+		})
+
+	return model, &calls
+}
+
+func TestResilient_Retry(t *testing.T) {
+	t.Parallel()
+
+	rejected := errors.Errorf("%w: status 401", service.ErrVisionRequestRejected)
+
+	tests := map[string]struct {
+		errs       []error
+		maxRetries int
+		wantCalls  int
+		wantErr    error
+	}{
+		"success on first call":      {nil, 2, 1, nil},
+		"temporary failures retried": {[]error{errUnavailable, errUnavailable}, 2, 3, nil},
+		"gives up after retries": {
+			[]error{errUnavailable, errUnavailable, errUnavailable},
+			1,
+			2,
+			errUnavailable,
+		},
+		"rejected request not retried": {
+			[]error{rejected},
+			2,
+			1,
+			service.ErrVisionRequestRejected,
+		},
+	}
+
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model, calls := newModerateModel(t, testCase.errs...)
+			resilient := vision.NewResilient(
+				model,
+				testOptions(testCase.maxRetries, 10),
+				&testChrono{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			)
+
+			_, err := resilient.Moderate(context.Background(), testText)
+			if !errors.Is(err, testCase.wantErr) || (err == nil) != (testCase.wantErr == nil) {
+				t.Errorf("Moderate() error = %v, want %v", err, testCase.wantErr)
+			}
+
+			if *calls != testCase.wantCalls {
+				t.Errorf(
+					"Moderate() called the model %d times, want %d",
+					*calls,
+					testCase.wantCalls,
+				)
+			}
+		})
+	}
+}
+
+func TestResilient_Timeout(t *testing.T) {
+	t.Parallel()
+
+	ctrl := NewMockController(t)
+	model := Mock[service.VisionModel](ctrl)
+
+	WhenDouble(model.Moderate(Any[context.Context](), Any[string]())).
+		ThenAnswer(func(args []any) (*service.ModerationResult, error) {
+			ctx, _ := args[0].(context.Context)
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		})
+
+	options := testOptions(0, 10)
+	options.Timeout = 10 * time.Millisecond
+
+	resilient := vision.NewResilient(
+		model,
+		options,
+		&testChrono{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := resilient.Moderate(context.Background(), testText)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Moderate() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestResilient_Circuit(t *testing.T) {
+	t.Parallel()
+
+	clock := &testChrono{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	model, calls := newModerateModel(t, errUnavailable, errUnavailable, errUnavailable)
+	resilient := vision.NewResilient(model, testOptions(0, 2), clock)
+	ctx := context.Background()
+
+	// 2回続けて失敗すると回路が開き、モデルを呼ばずに失敗する
+	for range 2 {
+		_, err := resilient.Moderate(ctx, testText)
+		if !errors.Is(err, errUnavailable) {
+			t.Fatalf("Moderate() error = %v, want %v", err, errUnavailable)
+		}
+	}
+
+	_, err := resilient.Moderate(ctx, testText)
+	if !errors.Is(err, vision.ErrCircuitOpen) || *calls != 2 {
+		t.Fatalf(
+			"Moderate() error = %v after %d calls, want %v",
+			err,
+			*calls,
+			vision.ErrCircuitOpen,
+		)
+	}
+
+	if err := resilient.Check(ctx); !errors.Is(err, vision.ErrCircuitOpen) {
+		t.Errorf("Check() error = %v, want %v", err, vision.ErrCircuitOpen)
+	}
+
+	// 開いている時間が過ぎると1回だけ試し、失敗すれば再び開く
+	clock.now = clock.now.Add(time.Minute)
+
+	_, err = resilient.Moderate(ctx, testText)
+	if !errors.Is(err, errUnavailable) || *calls != 3 {
+		t.Fatalf("Moderate() error = %v after %d calls, want a probe", err, *calls)
+	}
+
+	_, err = resilient.Moderate(ctx, testText)
+	if !errors.Is(err, vision.ErrCircuitOpen) {
+		t.Fatalf("Moderate() error = %v, want %v", err, vision.ErrCircuitOpen)
+	}
+
+	// 試しの呼び出しが成功すると回路が閉じる
+	clock.now = clock.now.Add(time.Minute)
+
+	_, err = resilient.Moderate(ctx, testText)
+	if err != nil {
+		t.Fatalf("Moderate() failed: %v", err)
+	}
+
+	if err := resilient.Check(ctx); err != nil {
+		t.Errorf("Check() error = %v, want nil", err)
+	}
+}
+
+func TestResilient_CanceledNotCounted(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	model, calls := newModerateModel(t, context.Canceled, context.Canceled)
+	resilient := vision.NewResilient(
+		model,
+		testOptions(2, 1),
+		&testChrono{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := resilient.Moderate(ctx, testText)
+	if !errors.Is(err, context.Canceled) || *calls != 1 {
+		t.Errorf("Moderate() error = %v after %d calls, want canceled without retry", err, *calls)
+	}
+
+	if err := resilient.Check(context.Background()); err != nil {
+		t.Errorf("Check() error = %v, want the circuit to stay closed", err)
+	}
+}
@@ -0,0 +1,130 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yashikota/scene-hunter/server/internal/service"
+	"github.com/yashikota/scene-hunter/server/internal/util/errors"
+)
+
+// MemoryBlob はテスト用にオブジェクトをメモリに保持する service.Blob の実装.
+// 有効期限は無視する.
+type MemoryBlob struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+// NewMemoryBlob は空の MemoryBlob を作成します。
+func NewMemoryBlob() *MemoryBlob {
+	return &MemoryBlob{mu: sync.Mutex{}, objects: map[string][]byte{}}
+}
+
+// Keys は保存されているオブジェクトのキーを返します。
+func (b *MemoryBlob) Keys() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func (b *MemoryBlob) Ping(context.Context) error {
+	return nil
+}
+
+func (b *MemoryBlob) Put(_ context.Context, key string, data io.Reader, _ time.Duration) error {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return errors.Errorf("failed to read object: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.objects[key] = content
+
+	return nil
+}
+
+func (b *MemoryBlob) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	content, ok := b.objects[key]
+	if !ok {
+		return nil, errors.Errorf("%w: key=%s", service.ErrNotFound, key)
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (b *MemoryBlob) Delete(_ context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.objects, key)
+
+	return nil
+}
+
+func (b *MemoryBlob) Exists(_ context.Context, key string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.objects[key]
+
+	return ok, nil
+}
+
+func (b *MemoryBlob) List(_ context.Context, prefix string) ([]service.ObjectInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	objects := make([]service.ObjectInfo, 0)
+
+	for key, content := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, service.ObjectInfo{
+				Key:          key,
+				Size:         int64(len(content)),
+				LastModified: time.Time{},
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+func (b *MemoryBlob) Stat(_ context.Context, key string) (service.ObjectInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	content, ok := b.objects[key]
+	if !ok {
+		return service.ObjectInfo{}, errors.Errorf("%w: key=%s", service.ErrNotFound, key)
+	}
+
+	return service.ObjectInfo{Key: key, Size: int64(len(content)), LastModified: time.Time{}}, nil
+}
+
+func (b *MemoryBlob) PresignPut(_ context.Context, key string, _ time.Duration) (string, error) {
+	return "memory://" + key, nil
+}
+
+func (b *MemoryBlob) PresignGet(
+	_ context.Context,
+	key string,
+	_ string,
+	_ time.Duration,
+) (string, error) {
+	return "memory://" + key, nil
+}
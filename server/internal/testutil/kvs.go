@@ -0,0 +1,25 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/yashikota/scene-hunter/server/internal/infra/kvs"
+	"github.com/yashikota/scene-hunter/server/internal/service"
+)
+
+// NewKVS はテスト終了時に停止する miniredis に接続した KVS クライアントを作成します。
+func NewKVS(t *testing.T) service.KVS {
+	t.Helper()
+
+	redis := miniredis.RunT(t)
+
+	kvsClient, err := kvs.NewClient(redis.Addr(), "")
+	if err != nil {
+		t.Fatalf("failed to create kvs client: %v", err)
+	}
+
+	t.Cleanup(kvsClient.Close)
+
+	return kvsClient
+}